@@ -0,0 +1,146 @@
+//nolint:forbidigo // the result of the import is printed to the user
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"bum-service/internal/app"
+	"bum-service/internal/domain"
+	"bum-service/internal/service/student"
+	"bum-service/pkg/liberror"
+	"bum-service/pkg/libsheet"
+)
+
+// ImportCmd is import cmd command.
+func ImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import students from CSV or XLSX roster",
+		Long: "Imports the students of the CSV or XLSX roster with their users and guardians.\n" +
+			"The roster has the header with the columns first_name, last_name, middle_name, gender, email, phone,\n" +
+			"group and the same columns of the guardians prefixed with guardian_, guardian2_ and guardian3_\n" +
+			"with their relation, e.g. guardian_relation. All rows are imported or none of them.",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: loadConfigs,
+		RunE:    runImportCmd,
+	}
+
+	cmd.Flags().String(configPathFlag, defaultConfigPath, "path to config yml file")
+	cmd.Flags().String(schoolIDFlag, "", "school the students are imported to")
+	cmd.Flags().String(academicYearIDFlag, "", "academic year the groups of the roster are searched in")
+	cmd.Flags().Bool(dryRunFlag, false, "only validate the roster, nothing is imported")
+
+	_ = cmd.MarkFlagRequired(schoolIDFlag)
+
+	return cmd
+}
+
+const (
+	schoolIDFlag       = "school_id"
+	academicYearIDFlag = "academic_year_id"
+	dryRunFlag         = "dry_run"
+)
+
+// runImportCmd imports the students of the roster file.
+func runImportCmd(cmd *cobra.Command, args []string) error {
+	importArgs, err := importStudentsArgs(cmd, args[0])
+	if err != nil {
+		return err
+	}
+
+	application, err := app.NewService(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create application: %w", err)
+	}
+
+	defer application.Close()
+
+	result, err := application.ImportStudents(cmd.Context(), importArgs)
+	if err != nil {
+		printStudentImportErrors(err)
+
+		return fmt.Errorf("failed to import students: %w", err)
+	}
+
+	if result.DryRun {
+		fmt.Printf("Roster is valid, %d students can be imported\n", len(result.Rows))
+	} else {
+		fmt.Printf("%d students are imported\n", len(result.Students))
+	}
+
+	fmt.Printf("New users: %d, existing users as guardians: %d\n", result.NewUsers(), result.ExistingGuardians())
+
+	return nil
+}
+
+// importStudentsArgs returns the arguments of the import from the flags and the roster file.
+func importStudentsArgs(cmd *cobra.Command, path string) (student.ImportStudentsArgs, error) {
+	var args student.ImportStudentsArgs
+
+	schoolID, err := cmd.Flags().GetString(schoolIDFlag)
+	if err != nil {
+		return args, fmt.Errorf("failed to get school id: %w", err)
+	}
+
+	if args.SchoolID, err = uuid.Parse(schoolID); err != nil {
+		return args, fmt.Errorf("failed to parse school id: %w", err)
+	}
+
+	academicYearID, err := cmd.Flags().GetString(academicYearIDFlag)
+	if err != nil {
+		return args, fmt.Errorf("failed to get academic year id: %w", err)
+	}
+
+	if academicYearID != "" {
+		id, err := uuid.Parse(academicYearID)
+		if err != nil {
+			return args, fmt.Errorf("failed to parse academic year id: %w", err)
+		}
+
+		args.AcademicYearID = &id
+	}
+
+	if args.DryRun, err = cmd.Flags().GetBool(dryRunFlag); err != nil {
+		return args, fmt.Errorf("failed to get dry run: %w", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return args, fmt.Errorf("failed to open roster: %w", err)
+	}
+
+	defer file.Close()
+
+	if args.Records, err = libsheet.Read(path, file, domain.StudentRosterMaxSize); err != nil {
+		return args, fmt.Errorf("failed to read roster: %w", err)
+	}
+
+	return args, nil
+}
+
+// printStudentImportErrors prints the errors of the rows of the roster.
+func printStudentImportErrors(err error) {
+	var customErr *liberror.Error
+	if !errors.As(err, &customErr) {
+		return
+	}
+
+	errs, ok := customErr.Details.(domain.StudentImportErrors)
+	if !ok {
+		return
+	}
+
+	for _, rowErr := range errs {
+		if rowErr.Column == "" {
+			fmt.Printf("line %d: %s\n", rowErr.Line, rowErr.Message)
+			continue
+		}
+
+		fmt.Printf("line %d, column %s: %s\n", rowErr.Line, rowErr.Column, rowErr.Message)
+	}
+}
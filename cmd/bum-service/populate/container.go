@@ -130,8 +130,10 @@ func (s *Service) logger() liblog.Logger {
 	return s.container.logger
 }
 
+// nowFunc returns the current time with the precision of the database timestamps,
+// so the updated_at of the saved entity is its version both before and after it's read back.
 func (*Service) nowFunc() func() time.Time {
 	return func() time.Time {
-		return time.Now().UTC()
+		return time.Now().UTC().Truncate(time.Microsecond)
 	}
 }
@@ -1,6 +1,9 @@
 package populate
 
 import (
+	"bum-service/internal/infrastructure/mailer"
+	"bum-service/internal/infrastructure/memory"
+	"bum-service/internal/infrastructure/sms"
 	"bum-service/internal/service/auth"
 	"bum-service/internal/service/director"
 	eduorganization "bum-service/internal/service/edu-organization"
@@ -25,6 +28,24 @@ func (s *Service) authService() *auth.Service {
 	s.container.Service.authService.Service = auth.NewService(
 		s.container.Service.userService,
 
+		s.userRepository(),
+		// populated users never log in nor receive emails.
+		memory.NewLoginAttempt(0),
+		mailer.NewLog(s.cfg.Infrastructure.Mailer.From, ""),
+		sms.NewLog(),
+
+		auth.Config{
+			PasswordCost:              s.cfg.Application.PasswordCost,
+			PasswordResetURL:          s.cfg.Application.PasswordResetURL,
+			PasswordResetTokenExp:     s.cfg.Application.PasswordResetTokenExp,
+			EmailVerificationURL:      s.cfg.Application.EmailVerificationURL,
+			EmailVerificationTokenExp: s.cfg.Application.EmailVerificationTokenExp,
+
+			PhoneLoginCodeExp:            s.cfg.Application.PhoneLoginCodeExp,
+			PhoneLoginCodeResendInterval: s.cfg.Application.PhoneLoginCodeResendInterval,
+			PhoneLoginCodeMaxAttempts:    s.cfg.Application.PhoneLoginCodeMaxAttempts,
+		},
+		s.sessionAdapter(),
 		s.logger(),
 		s.nowFunc(),
 	)
@@ -273,6 +294,7 @@ func (s *Service) lessonService() *lesson.Service {
 	s.container.Service.lessonService.Service = lesson.NewService(
 		s.container.Service.groupService,
 		s.container.Service.schoolService,
+		s.container.Service.gradesService,
 
 		s.lessonRepository(),
 
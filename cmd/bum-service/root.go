@@ -24,6 +24,8 @@ func rootCmd() *cobra.Command {
 
 	cmd.AddCommand(RunCmd())
 	cmd.AddCommand(MigrateCmd())
+	cmd.AddCommand(ImportCmd())
+	cmd.AddCommand(SetupCmd())
 	cmd.AddCommand(VersionCmd())
 	cmd.AddCommand(populate.Cmd())
 
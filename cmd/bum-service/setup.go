@@ -0,0 +1,239 @@
+//nolint:forbidigo // the result of the import is printed to the user
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"bum-service/internal/app"
+	"bum-service/internal/domain"
+	"bum-service/internal/service/setup"
+	"bum-service/pkg/liberror"
+	"bum-service/pkg/libsheet"
+)
+
+// SetupCmd is setup cmd command.
+func SetupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "setup <file|dir>",
+		Short: "Import the school setup from YAML or JSON file or CSV or XLSX sheets",
+		Long: "Creates or updates the teachers, the subjects, the groups and the subjects of the groups of the school.\n" +
+			"The setup is the YAML or JSON file with the sections teachers, subjects and groups, or the directory\n" +
+			"with the CSV or XLSX sheets named after the sections, e.g. teachers.csv and group_subjects.xlsx.\n" +
+			"The entries are matched by the email of the teacher, the name of the subject and the name and the grade\n" +
+			"of the group, so the same setup can be imported again. All entries are imported or none of them.",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: loadConfigs,
+		RunE:    runSetupCmd,
+	}
+
+	cmd.Flags().String(configPathFlag, defaultConfigPath, "path to config yml file")
+	cmd.Flags().String(schoolIDFlag, "", "school the setup is imported to")
+	cmd.Flags().String(academicYearIDFlag, "", "academic year the groups are searched in and created in")
+	cmd.Flags().Bool(dryRunFlag, false, "only report the changes of the setup, nothing is imported")
+
+	_ = cmd.MarkFlagRequired(schoolIDFlag)
+
+	return cmd
+}
+
+// runSetupCmd imports the school setup of the file or the directory.
+func runSetupCmd(cmd *cobra.Command, args []string) error {
+	setupArgs, err := importSchoolSetupArgs(cmd, args[0])
+	if err != nil {
+		printSchoolSetupErrors(err)
+
+		return err
+	}
+
+	application, err := app.NewService(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create application: %w", err)
+	}
+
+	defer application.Close()
+
+	result, err := application.ImportSchoolSetup(cmd.Context(), setupArgs)
+	if err != nil {
+		printSchoolSetupErrors(err)
+
+		return fmt.Errorf("failed to import school setup: %w", err)
+	}
+
+	if result.DryRun {
+		fmt.Println("Setup is valid, nothing is imported")
+	}
+
+	for _, section := range []struct {
+		name   string
+		counts domain.SchoolSetupCounts
+	}{
+		{name: domain.SchoolSetupTeachers, counts: result.Teachers},
+		{name: domain.SchoolSetupSubjects, counts: result.Subjects},
+		{name: domain.SchoolSetupGroups, counts: result.Groups},
+		{name: domain.SchoolSetupGroupSubjects, counts: result.GroupSubjects},
+	} {
+		fmt.Printf("%s: created %d, updated %d, skipped %d\n",
+			section.name, section.counts.Created, section.counts.Updated, section.counts.Skipped)
+	}
+
+	return nil
+}
+
+// importSchoolSetupArgs returns the arguments of the import from the flags and the setup file or directory.
+func importSchoolSetupArgs(cmd *cobra.Command, path string) (setup.ImportSchoolSetupArgs, error) {
+	var args setup.ImportSchoolSetupArgs
+
+	schoolID, err := cmd.Flags().GetString(schoolIDFlag)
+	if err != nil {
+		return args, fmt.Errorf("failed to get school id: %w", err)
+	}
+
+	if args.SchoolID, err = uuid.Parse(schoolID); err != nil {
+		return args, fmt.Errorf("failed to parse school id: %w", err)
+	}
+
+	academicYearID, err := cmd.Flags().GetString(academicYearIDFlag)
+	if err != nil {
+		return args, fmt.Errorf("failed to get academic year id: %w", err)
+	}
+
+	if academicYearID != "" {
+		id, err := uuid.Parse(academicYearID)
+		if err != nil {
+			return args, fmt.Errorf("failed to parse academic year id: %w", err)
+		}
+
+		args.AcademicYearID = &id
+	}
+
+	if args.DryRun, err = cmd.Flags().GetBool(dryRunFlag); err != nil {
+		return args, fmt.Errorf("failed to get dry run: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return args, fmt.Errorf("failed to open school setup: %w", err)
+	}
+
+	if info.IsDir() {
+		args.Setup, err = readSchoolSetupDir(path)
+	} else {
+		args.Setup, err = readSchoolSetupFile(path, info.Size())
+	}
+
+	return args, err
+}
+
+// readSchoolSetupFile reads the YAML or JSON document of the setup.
+func readSchoolSetupFile(path string, size int64) (domain.SchoolSetup, error) {
+	if size > domain.SchoolSetupMaxSize {
+		return domain.SchoolSetup{}, domain.ErrSchoolSetupTooLarge
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return domain.SchoolSetup{}, fmt.Errorf("failed to read school setup: %w", err)
+	}
+
+	return domain.ParseSchoolSetupDocument(data)
+}
+
+// readSchoolSetupDir reads the CSV or XLSX sheets of the directory, the sheets are named after the sections,
+// the other files of the directory are ignored.
+func readSchoolSetupDir(path string) (domain.SchoolSetup, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return domain.SchoolSetup{}, fmt.Errorf("failed to read school setup directory: %w", err)
+	}
+
+	sheets := make(map[string][][]string)
+
+	for _, entry := range entries {
+		name := entry.Name()
+		section := strings.TrimSuffix(name, filepath.Ext(name))
+
+		if entry.IsDir() || !isSchoolSetupSection(section) {
+			continue
+		}
+
+		if _, err = libsheet.FormatOf(name); err != nil {
+			continue
+		}
+
+		if _, ok := sheets[section]; ok {
+			return domain.SchoolSetup{}, fmt.Errorf("%w: %s sheet is repeated", domain.ErrInvalidSchoolSetupFile, section)
+		}
+
+		if sheets[section], err = readSchoolSetupSheet(filepath.Join(path, name)); err != nil {
+			return domain.SchoolSetup{}, err
+		}
+	}
+
+	if len(sheets) == 0 {
+		return domain.SchoolSetup{}, fmt.Errorf("%w: no sheets in %s", domain.ErrInvalidSchoolSetupFile, path)
+	}
+
+	schoolSetup, errs := domain.ParseSchoolSetupSheets(sheets)
+	if len(errs) > 0 {
+		return domain.SchoolSetup{}, errs.Err()
+	}
+
+	return schoolSetup, nil
+}
+
+// readSchoolSetupSheet reads the rows of the CSV or XLSX sheet of the setup.
+func readSchoolSetupSheet(path string) ([][]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sheet: %w", err)
+	}
+
+	defer file.Close()
+
+	records, err := libsheet.Read(path, file, domain.SchoolSetupMaxSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sheet %s: %w", path, err)
+	}
+
+	return records, nil
+}
+
+// isSchoolSetupSection checks whether the name is the name of the section of the setup.
+func isSchoolSetupSection(name string) bool {
+	switch name {
+	case domain.SchoolSetupTeachers, domain.SchoolSetupSubjects, domain.SchoolSetupGroups,
+		domain.SchoolSetupGroupSubjects:
+		return true
+	default:
+		return false
+	}
+}
+
+// printSchoolSetupErrors prints the errors of the entries of the setup.
+func printSchoolSetupErrors(err error) {
+	var customErr *liberror.Error
+	if !errors.As(err, &customErr) {
+		return
+	}
+
+	errs, ok := customErr.Details.(domain.SchoolSetupErrors)
+	if !ok {
+		return
+	}
+
+	for _, entryErr := range errs {
+		if entryErr.Field == "" {
+			fmt.Printf("%s, line %d: %s\n", entryErr.Section, entryErr.Line, entryErr.Message)
+			continue
+		}
+
+		fmt.Printf("%s, line %d, field %s: %s\n", entryErr.Section, entryErr.Line, entryErr.Field, entryErr.Message)
+	}
+}
@@ -10,8 +10,36 @@ type Application struct {
 
 	AccessTokenExp  time.Duration `yaml:"access_token_exp" validate:"required"`
 	RefreshTokenExp time.Duration `yaml:"refresh_token_exp" validate:"required"`
-	JwtSecret       string        `yaml:"jwt_secret" validate:"required"`
+	// JwtSecret signs tokens with HS256 when no JwtKeys are configured.
+	JwtSecret   string   `yaml:"jwt_secret" validate:"required_without=JwtKeys"`
+	JwtIssuer   string   `yaml:"jwt_issuer" validate:"required"`
+	JwtAudience []string `yaml:"jwt_audience"`
+	// JwtSigningKeyID is id of the key of JwtKeys new tokens are signed with,
+	// the rest keys only verify tokens and are published until the tokens they signed expire.
+	JwtSigningKeyID string   `yaml:"jwt_signing_key_id" validate:"required_with=JwtKeys"`
+	JwtKeys         []JwtKey `yaml:"jwt_keys" validate:"dive"`
+
+	// PasswordResetURL and EmailVerificationURL are pages of the web application
+	// the token is sent to in the token query parameter.
+	PasswordResetURL          string        `yaml:"password_reset_url" validate:"required,url"`
+	PasswordResetTokenExp     time.Duration `yaml:"password_reset_token_exp" validate:"required"`
+	EmailVerificationURL      string        `yaml:"email_verification_url" validate:"required,url"`
+	EmailVerificationTokenExp time.Duration `yaml:"email_verification_token_exp" validate:"required"`
+
+	// PhoneLoginCodeExp is lifetime of one-time login codes sent by SMS, a new code is not sent
+	// to the phone more often than PhoneLoginCodeResendInterval.
+	PhoneLoginCodeExp            time.Duration `yaml:"phone_login_code_exp" validate:"required"`
+	PhoneLoginCodeResendInterval time.Duration `yaml:"phone_login_code_resend_interval" validate:"required,gt=0"`
+	PhoneLoginCodeMaxAttempts    int           `yaml:"phone_login_code_max_attempts" validate:"required,gt=0"`
+
+	LoginThrottle LoginThrottle `yaml:"login_throttle" validate:"required"`
 
 	PprofHost string `yaml:"pprof_host"`
 	PprofPort int    `yaml:"pprof_port" validate:"required"`
 }
+
+// JwtKey is an asymmetric key for signing tokens, RSA keys sign with RS256 and Ed25519 keys with EdDSA.
+type JwtKey struct {
+	ID             string `yaml:"id" validate:"required"`
+	PrivateKeyPath string `yaml:"private_key_path" validate:"required"`
+}
@@ -3,4 +3,5 @@ package config
 // Infrastructure is a collection of infrastructure.
 type Infrastructure struct {
 	Database Database `yaml:"database" validate:"required"`
+	Mailer   Mailer   `yaml:"mailer" validate:"required"`
 }
@@ -0,0 +1,21 @@
+package config
+
+import "time"
+
+// LoginThrottle is configuration of delaying and locking logins after failed attempts.
+type LoginThrottle struct {
+	// Store is postgres for sharing failures between instances of the service or memory for a single instance.
+	Store   string              `yaml:"store" validate:"required,oneof=postgres memory"`
+	Account LoginThrottlePolicy `yaml:"account"`
+	IP      LoginThrottlePolicy `yaml:"ip"`
+}
+
+// LoginThrottlePolicy is a policy of delaying and locking logins of an account or from an IP address.
+type LoginThrottlePolicy struct {
+	DelayAfter      int           `yaml:"delay_after" validate:"gte=0"`
+	BaseDelay       time.Duration `yaml:"base_delay"`
+	MaxDelay        time.Duration `yaml:"max_delay"`
+	LockoutAfter    int           `yaml:"lockout_after" validate:"gte=0"`
+	LockoutDuration time.Duration `yaml:"lockout_duration"`
+	Window          time.Duration `yaml:"window" validate:"required"`
+}
@@ -0,0 +1,18 @@
+package config
+
+// Mailer is configuration of sending emails.
+type Mailer struct {
+	// Driver is smtp for sending emails or log for writing them to the log and Dir in local development.
+	Driver string `yaml:"driver" validate:"required,oneof=smtp log"`
+	From   string `yaml:"from" validate:"required"`
+	Dir    string `yaml:"dir"`
+	SMTP   SMTP   `yaml:"smtp"`
+}
+
+// SMTP is configuration of SMTP server.
+type SMTP struct {
+	Host     string `env:"SMTP_HOST" yaml:"host"`
+	Port     int    `env:"SMTP_PORT" yaml:"port"`
+	Username string `env:"SMTP_USERNAME" yaml:"username"`
+	Password string `env:"SMTP_PASSWORD" yaml:"password"`
+}
@@ -8,6 +8,9 @@ import (
 	"time"
 
 	"bum-service/config"
+	"bum-service/internal/domain"
+	"bum-service/internal/service/setup"
+	"bum-service/internal/service/student"
 	"bum-service/pkg/liblog"
 	closer "bum-service/pkg/service-closer"
 )
@@ -61,9 +64,27 @@ func (s *Service) Run() (err error) {
 
 	logger.Info("Service application starting ...")
 
-	ctx := liblog.With(context.Background(), s.logger())
+	if err = s.initServices(); err != nil {
+		return err
+	}
+
+	err = s.HTTPService()
+	if err != nil {
+		logger.Errorf("failed to run http server: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// initServices connects to the database and initializes the services.
+func (s *Service) initServices() error {
+	var (
+		logger = s.logger()
+		ctx    = liblog.With(context.Background(), logger)
+	)
 
-	_, err = s.databaseService(ctx)
+	_, err := s.databaseService(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get database: %w", err)
 	}
@@ -106,6 +127,10 @@ func (s *Service) Run() (err error) {
 
 	s.lessonService()
 
+	s.policyService()
+
+	s.setupService()
+
 	if err = s.container.Service.CheckInitialized(); err != nil {
 		logger.Error("Ошибка:", err)
 		return err
@@ -116,13 +141,28 @@ func (s *Service) Run() (err error) {
 		return err
 	}
 
-	err = s.HTTPService()
-	if err != nil {
-		logger.Errorf("failed to run http server: %v", err)
-		return err
+	return nil
+}
+
+// ImportStudents imports the students of the roster without running the server.
+func (s *Service) ImportStudents(ctx context.Context, args student.ImportStudentsArgs) (domain.StudentImport, error) {
+	if err := s.initServices(); err != nil {
+		return domain.StudentImport{}, err
 	}
 
-	return nil
+	return s.studentService().ImportStudents(liblog.With(ctx, s.logger()), args)
+}
+
+// ImportSchoolSetup imports the school setup without running the server.
+func (s *Service) ImportSchoolSetup(
+	ctx context.Context,
+	args setup.ImportSchoolSetupArgs,
+) (domain.SchoolSetupResult, error) {
+	if err := s.initServices(); err != nil {
+		return domain.SchoolSetupResult{}, err
+	}
+
+	return s.setupService().ImportSchoolSetup(liblog.With(ctx, s.logger()), args)
 }
 
 // Close closes the service.
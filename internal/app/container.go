@@ -14,7 +14,9 @@ import (
 	"bum-service/internal/service/headmaster"
 	"bum-service/internal/service/lesson"
 	"bum-service/internal/service/owner"
+	"bum-service/internal/service/policy"
 	"bum-service/internal/service/school"
+	"bum-service/internal/service/setup"
 	"bum-service/internal/service/student"
 	"bum-service/internal/service/subject"
 	"bum-service/internal/service/system"
@@ -53,6 +55,8 @@ type ServiceContainer struct {
 	studentService         *struct{ *student.Service }
 	lessonService          *struct{ *lesson.Service }
 	groupService           *struct{ *school.Service }
+	policyService          *struct{ *policy.Service }
+	setupService           *struct{ *setup.Service }
 }
 
 // NewServiceContainer creates a new service container.
@@ -73,6 +77,8 @@ func NewServiceContainer() ServiceContainer {
 		studentService:         &struct{ *student.Service }{},
 		lessonService:          &struct{ *lesson.Service }{},
 		groupService:           &struct{ *school.Service }{},
+		policyService:          &struct{ *policy.Service }{},
+		setupService:           &struct{ *setup.Service }{},
 	}
 }
 
@@ -130,8 +136,10 @@ func (s *Service) logger() liblog.Logger {
 	return s.container.logger
 }
 
+// nowFunc returns the current time with the precision of the database timestamps,
+// so the updated_at of the saved entity is its version both before and after it's read back.
 func (*Service) nowFunc() func() time.Time {
 	return func() time.Time {
-		return time.Now().UTC()
+		return time.Now().UTC().Truncate(time.Microsecond)
 	}
 }
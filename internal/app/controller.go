@@ -12,6 +12,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	controllerhttp "bum-service/internal/controller/http"
+	"bum-service/internal/controller/http/handlers"
 	"bum-service/pkg/liblog"
 )
 
@@ -26,13 +27,22 @@ func (s *Service) HTTPService() error {
 
 	logger.Info("Registering handlers ...")
 
-	err := controllerhttp.RegisterHandlers(
+	jwtKeys, err := s.jwtKeySet()
+	if err != nil {
+		return fmt.Errorf("failed to load jwt keys: %w", err)
+	}
+
+	err = controllerhttp.RegisterHandlers(
 		handler,
 		s.logger(),
 
-		s.cfg.Application.JwtSecret,
-		s.cfg.Application.AccessTokenExp,
-		s.cfg.Application.RefreshTokenExp,
+		handlers.TokenConfig{
+			Keys:            jwtKeys,
+			Issuer:          s.cfg.Application.JwtIssuer,
+			Audience:        s.cfg.Application.JwtAudience,
+			AccessTokenExp:  s.cfg.Application.AccessTokenExp,
+			RefreshTokenExp: s.cfg.Application.RefreshTokenExp,
+		},
 
 		s.authService(),
 		s.systemService(),
@@ -47,6 +57,8 @@ func (s *Service) HTTPService() error {
 		s.gradesService(),
 		s.studentService(),
 		s.lessonService(),
+		s.policyService(),
+		s.setupService(),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create a new HTTP controller: %w", err)
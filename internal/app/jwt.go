@@ -0,0 +1,68 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"bum-service/pkg/libjwt"
+)
+
+// hmacKeyID is the key id of tokens signed with jwt_secret.
+const hmacKeyID = "hmac"
+
+var errJwtSigningKeyNotFound = errors.New("jwt signing key is not configured")
+
+// jwtKeySet loads keys of the user tokens, jwt_secret is used only when no asymmetric keys are configured.
+func (s *Service) jwtKeySet() (*libjwt.KeySet, error) {
+	cfg := s.cfg.Application
+
+	if len(cfg.JwtKeys) == 0 {
+		key, err := libjwt.NewHMACKey(hmacKeyID, []byte(cfg.JwtSecret))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create jwt secret key: %w", err)
+		}
+
+		keySet, err := libjwt.NewKeySet(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create jwt key set: %w", err)
+		}
+
+		return keySet, nil
+	}
+
+	var (
+		signing libjwt.Key
+		found   bool
+		keys    = make([]libjwt.Key, 0, len(cfg.JwtKeys))
+	)
+
+	for _, keyCfg := range cfg.JwtKeys {
+		data, err := os.ReadFile(keyCfg.PrivateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read jwt key %q: %w", keyCfg.ID, err)
+		}
+
+		key, err := libjwt.ParsePrivateKeyPEM(keyCfg.ID, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse jwt key %q: %w", keyCfg.ID, err)
+		}
+
+		if key.ID == cfg.JwtSigningKeyID {
+			signing, found = key, true
+		}
+
+		keys = append(keys, key)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("%w: %q", errJwtSigningKeyNotFound, cfg.JwtSigningKeyID)
+	}
+
+	keySet, err := libjwt.NewKeySet(signing, keys...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jwt key set: %w", err)
+	}
+
+	return keySet, nil
+}
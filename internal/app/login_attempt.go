@@ -0,0 +1,37 @@
+package app
+
+import (
+	"bum-service/config"
+	"bum-service/internal/domain"
+	"bum-service/internal/infrastructure/memory"
+	"bum-service/internal/infrastructure/repository"
+	"bum-service/internal/service/auth"
+)
+
+// loginThrottleStoreMemory is the store keeping failed logins in memory of the instance.
+const loginThrottleStoreMemory = "memory"
+
+//nolint:ireturn // the store is chosen by configuration.
+func (s *Service) loginAttemptStore() auth.ILoginAttemptStore {
+	cfg := s.cfg.Application.LoginThrottle
+
+	if cfg.Store == loginThrottleStoreMemory {
+		return memory.NewLoginAttempt(max(
+			cfg.Account.Window, cfg.Account.LockoutDuration, cfg.IP.Window, cfg.IP.LockoutDuration,
+		))
+	}
+
+	return repository.NewLoginAttempt(s.db(), s.sessionAdapter())
+}
+
+// loginThrottlePolicy converts configuration to the domain policy.
+func loginThrottlePolicy(cfg config.LoginThrottlePolicy) domain.LoginThrottlePolicy {
+	return domain.LoginThrottlePolicy{
+		DelayAfter:      cfg.DelayAfter,
+		BaseDelay:       cfg.BaseDelay,
+		MaxDelay:        cfg.MaxDelay,
+		LockoutAfter:    cfg.LockoutAfter,
+		LockoutDuration: cfg.LockoutDuration,
+		Window:          cfg.Window,
+	}
+}
@@ -0,0 +1,20 @@
+package app
+
+import (
+	"bum-service/internal/infrastructure/mailer"
+	"bum-service/internal/service/auth"
+)
+
+// mailerDriverSMTP is the mailer driver sending emails through SMTP server.
+const mailerDriverSMTP = "smtp"
+
+//nolint:ireturn // the mailer is chosen by configuration.
+func (s *Service) mailer() auth.IMailer {
+	cfg := s.cfg.Infrastructure.Mailer
+
+	if cfg.Driver == mailerDriverSMTP {
+		return mailer.NewSMTP(cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password, cfg.From)
+	}
+
+	return mailer.NewLog(cfg.From, cfg.Dir)
+}
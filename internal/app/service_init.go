@@ -8,7 +8,9 @@ import (
 	"bum-service/internal/service/headmaster"
 	"bum-service/internal/service/lesson"
 	"bum-service/internal/service/owner"
+	"bum-service/internal/service/policy"
 	"bum-service/internal/service/school"
+	"bum-service/internal/service/setup"
 	"bum-service/internal/service/student"
 	"bum-service/internal/service/subject"
 	"bum-service/internal/service/system"
@@ -25,6 +27,25 @@ func (s *Service) authService() *auth.Service {
 	s.container.Service.authService.Service = auth.NewService(
 		s.container.Service.userService,
 
+		s.userRepository(),
+		s.loginAttemptStore(),
+		s.mailer(),
+		s.smsSender(),
+
+		auth.Config{
+			PasswordCost:              s.cfg.Application.PasswordCost,
+			AccountLoginPolicy:        loginThrottlePolicy(s.cfg.Application.LoginThrottle.Account),
+			IPLoginPolicy:             loginThrottlePolicy(s.cfg.Application.LoginThrottle.IP),
+			PasswordResetURL:          s.cfg.Application.PasswordResetURL,
+			PasswordResetTokenExp:     s.cfg.Application.PasswordResetTokenExp,
+			EmailVerificationURL:      s.cfg.Application.EmailVerificationURL,
+			EmailVerificationTokenExp: s.cfg.Application.EmailVerificationTokenExp,
+
+			PhoneLoginCodeExp:            s.cfg.Application.PhoneLoginCodeExp,
+			PhoneLoginCodeResendInterval: s.cfg.Application.PhoneLoginCodeResendInterval,
+			PhoneLoginCodeMaxAttempts:    s.cfg.Application.PhoneLoginCodeMaxAttempts,
+		},
+		s.sessionAdapter(),
 		s.logger(),
 		s.nowFunc(),
 	)
@@ -273,6 +294,7 @@ func (s *Service) lessonService() *lesson.Service {
 	s.container.Service.lessonService.Service = lesson.NewService(
 		s.container.Service.groupService,
 		s.container.Service.schoolService,
+		s.container.Service.gradesService,
 
 		s.lessonRepository(),
 
@@ -283,3 +305,41 @@ func (s *Service) lessonService() *lesson.Service {
 
 	return s.container.Service.lessonService.Service
 }
+
+func (s *Service) policyService() *policy.Service {
+	if s.container.Service.policyService.Service != nil {
+		return s.container.Service.policyService.Service
+	}
+
+	s.container.Service.policyService.Service = policy.NewService(
+		s.container.Service.userService,
+		s.container.Service.schoolService,
+		s.container.Service.teacherService,
+		s.container.Service.lessonService,
+
+		s.logger(),
+		s.nowFunc(),
+	)
+
+	return s.container.Service.policyService.Service
+}
+
+func (s *Service) setupService() *setup.Service {
+	if s.container.Service.setupService.Service != nil {
+		return s.container.Service.setupService.Service
+	}
+
+	s.container.Service.setupService.Service = setup.NewService(
+		s.container.Service.userService,
+		s.container.Service.teacherService,
+		s.container.Service.subjectService,
+		s.container.Service.gradesService,
+		s.container.Service.schoolService,
+
+		s.sessionAdapter(),
+		s.logger(),
+		s.nowFunc(),
+	)
+
+	return s.container.Service.setupService.Service
+}
@@ -0,0 +1,11 @@
+package app
+
+import (
+	"bum-service/internal/infrastructure/sms"
+	"bum-service/internal/service/auth"
+)
+
+//nolint:ireturn // the sender is replaced by a provider one when SMS are sent for real.
+func (s *Service) smsSender() auth.ISMSSender {
+	return sms.NewLog()
+}
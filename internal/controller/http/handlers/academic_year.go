@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/controller/http/handlers/response"
+	"bum-service/internal/domain"
+	"bum-service/internal/service/school"
+	"bum-service/pkg/liblog"
+)
+
+// CreateAcademicYear creates a new academic year of the school with its terms and holidays.
+func (s School) CreateAcademicYear(c *gin.Context) {
+	var (
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		req             request.CreateAcademicYear
+		schoolIDPathVar = request.GetSchoolIDPathVar(c)
+		schoolID        uuid.UUID
+		err             error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req, "school_id": schoolID})
+	ctx = liblog.With(ctx, logger)
+
+	year, err := s.schoolService.CreateAcademicYear(ctx, convertCreateAcademicYearToArgs(req, schoolID))
+	if err != nil {
+		logger.Errorf("failed to create academic year: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.NewAcademicYear(year))
+}
+
+func convertCreateAcademicYearToArgs(r request.CreateAcademicYear, schoolID uuid.UUID) school.CreateAcademicYearArgs {
+	dateFrom, dateTill := r.Period()
+
+	args := school.CreateAcademicYearArgs{
+		SchoolID: schoolID,
+		Name:     r.Name,
+		DateFrom: dateFrom,
+		DateTill: dateTill,
+		Terms:    make([]school.AcademicYearPeriodArgs, 0, len(r.Terms)),
+		Holidays: make([]school.AcademicYearPeriodArgs, 0, len(r.Holidays)),
+	}
+
+	for _, term := range r.Terms {
+		from, till := term.Period()
+
+		args.Terms = append(args.Terms, school.AcademicYearPeriodArgs{Name: term.Name, DateFrom: from, DateTill: till})
+	}
+
+	for _, holiday := range r.Holidays {
+		from, till := holiday.Period()
+
+		args.Holidays = append(args.Holidays, school.AcademicYearPeriodArgs{
+			Name:     holiday.Name,
+			DateFrom: from,
+			DateTill: till,
+		})
+	}
+
+	return args
+}
+
+// AcademicYearByID returns academic year of the school.
+func (s School) AcademicYearByID(c *gin.Context) {
+	var (
+		ctx                   = c.Request.Context()
+		logger                = liblog.Must(ctx)
+		schoolIDPathVar       = request.GetSchoolIDPathVar(c)
+		academicYearIDPathVar = request.GetAcademicYearIDPathVar(c)
+		schoolID              uuid.UUID
+		academicYearID        uuid.UUID
+		err                   error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if academicYearID, err = uuid.Parse(academicYearIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	year, err := s.schoolService.AcademicYearByID(ctx, academicYearID, schoolID)
+	if err != nil {
+		logger.Errorf("failed to get academic year by id: %v", c.Error(err))
+		return
+	}
+
+	if notModified(c, year.UpdatedAt) {
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewAcademicYear(year))
+}
+
+// AcademicYearList returns academic years of the school.
+func (s School) AcademicYearList(c *gin.Context) {
+	var (
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		schoolIDPathVar = request.GetSchoolIDPathVar(c)
+		schoolID        uuid.UUID
+		err             error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	list, err := s.schoolService.AcademicYearList(ctx, schoolID)
+	if err != nil {
+		logger.Errorf("failed to get academic year list: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewAcademicYears(list))
+}
+
+// RollOverAcademicYear creates the next academic year of the school from the given one.
+func (s School) RollOverAcademicYear(c *gin.Context) {
+	var (
+		ctx                   = c.Request.Context()
+		logger                = liblog.Must(ctx)
+		req                   request.RollOverAcademicYear
+		schoolIDPathVar       = request.GetSchoolIDPathVar(c)
+		academicYearIDPathVar = request.GetAcademicYearIDPathVar(c)
+		schoolID              uuid.UUID
+		academicYearID        uuid.UUID
+		err                   error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if academicYearID, err = uuid.Parse(academicYearIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{
+		"request":          req,
+		"school_id":        schoolID,
+		"academic_year_id": academicYearID,
+	})
+	ctx = liblog.With(ctx, logger)
+
+	year, err := s.schoolService.RollOverAcademicYear(ctx, academicYearID, schoolID, req.Name)
+	if err != nil {
+		logger.Errorf("failed to roll over academic year: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.NewAcademicYear(year))
+}
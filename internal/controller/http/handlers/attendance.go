@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/controller/http/handlers/response"
+	"bum-service/internal/domain"
+	"bum-service/internal/service/lesson"
+	"bum-service/pkg/liblog"
+)
+
+// RecordAttendance records attendance of the group students on the lesson.
+func (l *Lesson) RecordAttendance(c *gin.Context) {
+	var (
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		req             request.RecordAttendance
+		lessonIDPathVar = request.GetLessonIDPathVar(c)
+		lessonID        uuid.UUID
+		err             error
+	)
+
+	if lessonID, err = uuid.Parse(lessonIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req, "lesson_id": lessonID})
+	ctx = liblog.With(ctx, logger)
+
+	args := lesson.RecordAttendanceArgs{
+		LessonID: lessonID,
+		Students: make([]lesson.StudentAttendance, 0, len(req.Students)),
+	}
+
+	for _, student := range req.Students {
+		args.Students = append(args.Students, lesson.StudentAttendance{
+			StudentID:   student.StudentID,
+			Status:      student.Status,
+			Description: student.Description,
+		})
+	}
+
+	attendances, err := l.lessonService.RecordAttendance(ctx, args)
+	if err != nil {
+		logger.Errorf("failed to record attendance: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewAttendances(attendances))
+}
+
+// LessonAttendances returns attendance of the lesson.
+func (l *Lesson) LessonAttendances(c *gin.Context) {
+	var (
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		lessonIDPathVar = request.GetLessonIDPathVar(c)
+		lessonID        uuid.UUID
+		err             error
+	)
+
+	if lessonID, err = uuid.Parse(lessonIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	attendances, err := l.lessonService.LessonAttendances(ctx, lessonID)
+	if err != nil {
+		logger.Errorf("failed to get lesson attendances: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewAttendances(attendances))
+}
+
+// AttendanceList returns attendance of the school students with summaries.
+func (l *Lesson) AttendanceList(c *gin.Context) {
+	var (
+		ctx               = c.Request.Context()
+		logger            = liblog.Must(ctx)
+		req               request.AttendanceList
+		schoolIDHeaderVar = GetListSchoolID(c)
+		schoolID          uuid.UUID
+		err               error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDHeaderVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindQuery(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req, "school_id": schoolID})
+	ctx = liblog.With(ctx, logger)
+
+	list, summaries, err := l.lessonService.AttendanceList(ctx, domain.NewAttendanceFilters(
+		domain.NewDateFilter(req.Period.DateFrom(), req.Period.DateTill()),
+		schoolID,
+		req.GroupID,
+		req.StudentID,
+		req.TermID,
+	))
+	if err != nil {
+		logger.Errorf("failed to get attendance list: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewAttendanceList(list, summaries))
+}
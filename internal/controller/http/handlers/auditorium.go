@@ -30,7 +30,7 @@ func (s School) CreateAuditorium(c *gin.Context) {
 	}
 
 	if err = c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -72,7 +72,7 @@ func (s School) AuditoriumList(c *gin.Context) {
 	}
 
 	if err = c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -82,10 +82,16 @@ func (s School) AuditoriumList(c *gin.Context) {
 	})
 	ctx = liblog.With(ctx, logger)
 
+	listFilter, err := req.ListFilter.Fields(domain.AuditoriumListFields(), domain.NewPagination(req.Page, req.PerPage))
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	list, total, err := s.schoolService.AuditoriumList(
 		ctx,
 		domain.NewAuditoriumListFilters(
-			domain.NewListFilter(req.SortOrder, domain.NewPagination(req.Page, req.PerPage)),
+			listFilter,
 			schoolID,
 		),
 	)
@@ -138,5 +144,132 @@ func (s School) AuditoriumByIDAndSchoolID(c *gin.Context) {
 		return
 	}
 
+	if notModified(c, auditoriumDomain.UpdatedAt) {
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewSchoolAuditorium(auditoriumDomain))
+}
+
+// DeleteAuditorium deletes auditorium of the school.
+func (s School) DeleteAuditorium(c *gin.Context) {
+	var (
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		schoolIDVar     = request.GetSchoolIDPathVar(c)
+		auditoriumIDVar = request.GetSchoolAuditoriumIDPathVar(c)
+		schoolID        uuid.UUID
+		auditoriumID    uuid.UUID
+		err             error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "auditorium_id": auditoriumIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if auditoriumID, err = uuid.Parse(auditoriumIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = s.schoolService.DeleteAuditorium(ctx, schoolID, auditoriumID); err != nil {
+		logger.Errorf("failed to delete auditorium: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RestoreAuditorium restores deleted auditorium of the school.
+func (s School) RestoreAuditorium(c *gin.Context) {
+	var (
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		schoolIDVar     = request.GetSchoolIDPathVar(c)
+		auditoriumIDVar = request.GetSchoolAuditoriumIDPathVar(c)
+		schoolID        uuid.UUID
+		auditoriumID    uuid.UUID
+		err             error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "auditorium_id": auditoriumIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if auditoriumID, err = uuid.Parse(auditoriumIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = s.schoolService.RestoreAuditorium(ctx, schoolID, auditoriumID); err != nil {
+		logger.Errorf("failed to restore auditorium: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// UpdateAuditorium updates auditorium of the school.
+func (s School) UpdateAuditorium(c *gin.Context) {
+	var (
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		req             request.UpdateAuditorium
+		schoolIDVar     = request.GetSchoolIDPathVar(c)
+		auditoriumIDVar = request.GetSchoolAuditoriumIDPathVar(c)
+		schoolID        uuid.UUID
+		auditoriumID    uuid.UUID
+		err             error
+	)
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "auditorium_id": auditoriumIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if auditoriumID, err = uuid.Parse(auditoriumIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	version, err := updateVersion(c, req.Version)
+	if err != nil {
+		logger.Errorf("failed to get version: %v", c.Error(err))
+		return
+	}
+
+	auditoriumDomain, err := s.schoolService.UpdateAuditorium(
+		ctx,
+		school.UpdateAuditoriumArgs{
+			ID:              auditoriumID,
+			SchoolID:        schoolID,
+			Name:            req.Name,
+			SchoolSubjectID: req.SchoolSubjectID,
+			Description:     req.Description,
+			UpdatedAt:       version,
+		},
+	)
+	if err != nil {
+		logger.Errorf("failed to update auditorium: %v", c.Error(preconditionErr(c, err)))
+		return
+	}
+
+	setETag(c, auditoriumDomain.UpdatedAt)
 	c.JSON(http.StatusOK, response.NewSchoolAuditorium(auditoriumDomain))
 }
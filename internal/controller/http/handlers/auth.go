@@ -1,26 +1,47 @@
 package handlers
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 
 	"bum-service/internal/controller/http/handlers/request"
 	"bum-service/internal/controller/http/handlers/response"
 	"bum-service/internal/domain"
+	"bum-service/internal/service/auth"
+	"bum-service/pkg/libjwt"
 	"bum-service/pkg/liblog"
 )
 
+const (
+	accessTokenType  = "access"
+	refreshTokenType = "refresh"
+)
+
+// TokenConfig is configuration of the issued user tokens.
+type TokenConfig struct {
+	Keys *libjwt.KeySet
+	// Issuer is the issuer of tokens and the audience of refresh tokens.
+	Issuer string
+	// Audience are services access tokens are issued for besides the issuer.
+	Audience []string
+
+	AccessTokenExp  time.Duration
+	RefreshTokenExp time.Duration
+}
+
 // Auth is auth handler.
 type Auth struct {
 	authService IAuthService
 	userService IUserService
 
-	jwtSecret       []byte
-	accessTokenExp  time.Duration
-	refreshTokenExp time.Duration
+	tokenConfig TokenConfig
 }
 
 // NewAuth creates a new auth handler.
@@ -28,26 +49,66 @@ func NewAuth(
 	authService IAuthService,
 	userService IUserService,
 
-	jwtSecret string,
-	accessTokenExp time.Duration,
-	refreshTokenExp time.Duration,
+	tokenConfig TokenConfig,
 ) *Auth {
 	return &Auth{
 		authService: authService,
 		userService: userService,
 
-		jwtSecret:       []byte(jwtSecret),
-		accessTokenExp:  accessTokenExp,
-		refreshTokenExp: refreshTokenExp,
+		tokenConfig: tokenConfig,
 	}
 }
 
-// UserClaims is user auth claims.
+// UserClaims is user auth claims, the subject is the user id.
+// ID of the registered claims is the refresh token id of the session for refresh tokens.
 type UserClaims struct {
-	UserID string `json:"user_id"`
+	TokenType string          `json:"typ"`
+	SessionID string          `json:"sid,omitempty"`
+	Roles     []UserRoleClaim `json:"roles,omitempty"`
+	// ActiveRole is the role the user acts as in the session, one of Roles.
+	ActiveRole *UserRoleClaim `json:"active_role,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// UserRoleClaim is a role the user had when the access token was issued.
+type UserRoleClaim struct {
+	ID             uuid.UUID   `json:"id"`
+	Role           domain.Role `json:"role"`
+	SchoolID       *uuid.UUID  `json:"school_id,omitempty"`
+	OrganizationID *uuid.UUID  `json:"organization_id,omitempty"`
+}
+
+// newUserRoleClaim makes a snapshot of the user role.
+func newUserRoleClaim(role domain.UserRole) UserRoleClaim {
+	return UserRoleClaim{
+		ID:             role.ID,
+		Role:           role.Role,
+		SchoolID:       role.SchoolID,
+		OrganizationID: role.OrganizationID,
+	}
+}
+
+// newUserRoleClaims makes a snapshot of the user roles.
+func newUserRoleClaims(roles domain.UserRoles) []UserRoleClaim {
+	claims := make([]UserRoleClaim, 0, len(roles))
+
+	for _, role := range roles {
+		claims = append(claims, newUserRoleClaim(role))
+	}
+
+	return claims
+}
+
+// toDomain converts to entity.
+func (u UserRoleClaim) toDomain() domain.ActiveRole {
+	return domain.ActiveRole{
+		RoleID:         u.ID,
+		Role:           u.Role,
+		SchoolID:       u.SchoolID,
+		OrganizationID: u.OrganizationID,
+	}
+}
+
 // LoginByEmail creates a new user token by email.
 func (a Auth) LoginByEmail(c *gin.Context) {
 	var (
@@ -57,100 +118,323 @@ func (a Auth) LoginByEmail(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
-	userID, err := a.authService.GetUserIDByEmailAndPassword(ctx, req.Email, req.Password)
+	userID, err := a.authService.GetUserIDByEmailAndPassword(ctx, auth.LoginArgs{
+		Email:     req.Email,
+		Password:  req.Password,
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	})
 	if err != nil {
 		logger.Errorf("failed to create token: %v", c.Error(err))
 		return
 	}
 
-	// If password is correct then create a new access and refresh token
+	// If password is correct then start a new session of the device and create its tokens
+	userToken, err := a.login(c, userID)
+	if err != nil {
+		logger.Errorf("failed to login: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, userToken)
+}
 
-	// Create a new token object, specifying signing method and the claims
-	// you would like it to contain.
-	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256,
-		UserClaims{
-			UserID: userID.String(),
-			RegisteredClaims: jwt.RegisteredClaims{
-				ExpiresAt: jwt.NewNumericDate(time.Now().Add(a.accessTokenExp)),
-			},
-		},
-		// Sign and get the complete encoded token as a string using the secret
-	).SignedString(a.jwtSecret)
+// login starts a new auth session of the user on the device of the request and creates its tokens.
+func (a Auth) login(c *gin.Context, userID uuid.UUID) (response.UserToken, error) {
+	ctx := c.Request.Context()
+
+	session, err := a.authService.StartSession(ctx, auth.StartSessionArgs{
+		UserID:    userID,
+		UserAgent: c.Request.UserAgent(),
+		IP:        c.ClientIP(),
+		ExpiresAt: time.Now().Add(a.tokenConfig.RefreshTokenExp),
+	})
 	if err != nil {
-		logger.Errorf("failed to create access token: %v", c.Error(err))
+		return response.UserToken{}, fmt.Errorf("failed to start auth session: %w", err)
+	}
+
+	userToken, err := a.newUserToken(ctx, session)
+	if err != nil {
+		return response.UserToken{}, fmt.Errorf("failed to create user token: %w", err)
+	}
+
+	return userToken, nil
+}
+
+// RefreshToken exchanges the refresh token for new user tokens, the refresh token can be used only once.
+func (a Auth) RefreshToken(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		req    request.RefreshToken
+	)
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
-	// Create a new refresh token object, specifying signing method and the claims
-	// you would like it to contain.
-	refreshToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256,
-		UserClaims{
-			UserID: userID.String(),
-			RegisteredClaims: jwt.RegisteredClaims{
-				ExpiresAt: jwt.NewNumericDate(time.Now().Add(a.refreshTokenExp)),
-			},
-		},
-		// Sign and get the complete encoded token as a string using the secret
-	).SignedString(a.jwtSecret)
+	args, err := a.parseRefreshToken(req.RefreshToken)
+	if err != nil {
+		logger.Errorf("invalid refresh token: %v", c.Error(err))
+		return
+	}
+
+	args.ExpiresAt = time.Now().Add(a.tokenConfig.RefreshTokenExp)
+
+	session, err := a.authService.RefreshSession(ctx, args)
 	if err != nil {
-		logger.Errorf("failed to create refresh token: %v", c.Error(err))
+		logger.Errorf("failed to refresh auth session: %v", c.Error(err))
 		return
 	}
 
-	c.JSON(http.StatusOK, response.NewUserToken(accessToken, refreshToken))
+	userToken, err := a.newUserToken(ctx, session)
+	if err != nil {
+		logger.Errorf("failed to create user token: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, userToken)
 }
 
-// RefreshToken updates user tokens.
-func (a Auth) RefreshToken(c *gin.Context) {
+// SelectRole selects the role the user acts as in the current session and returns tokens carrying it.
+func (a Auth) SelectRole(c *gin.Context) {
 	var (
 		ctx    = c.Request.Context()
 		logger = liblog.Must(ctx)
 		userID = MustGetUserID(c)
+		req    request.SelectRole
 	)
 
-	_, err := a.userService.UserByID(ctx, userID)
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	sessionID, ok := GetSessionID(c)
+	if !ok {
+		logger.Errorf("token has no session: %v", c.Error(domain.ErrInvalidToken))
+		return
+	}
+
+	session, err := a.authService.SelectSessionRole(ctx, auth.SelectSessionRoleArgs{
+		UserID:    userID,
+		SessionID: sessionID,
+		RoleID:    req.RoleID,
+	})
 	if err != nil {
-		logger.Errorf("failed to create token: %v", c.Error(err))
+		logger.Errorf("failed to select auth session role: %v", c.Error(err))
 		return
 	}
 
-	// If password is correct then create a new access and refresh token
+	userToken, err := a.newUserToken(ctx, session)
+	if err != nil {
+		logger.Errorf("failed to create user token: %v", c.Error(err))
+		return
+	}
 
-	// Create a new token object, specifying signing method and the claims
-	// you would like it to contain.
-	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256,
-		UserClaims{
-			UserID: userID.String(),
-			RegisteredClaims: jwt.RegisteredClaims{
-				ExpiresAt: jwt.NewNumericDate(time.Now().Add(a.accessTokenExp)),
-			},
-		},
-		// Sign and get the complete encoded token as a string using the secret
-	).SignedString(a.jwtSecret)
+	c.JSON(http.StatusOK, userToken)
+}
+
+// Sessions returns active auth sessions of the user.
+func (a Auth) Sessions(c *gin.Context) {
+	var (
+		ctx          = c.Request.Context()
+		logger       = liblog.Must(ctx)
+		userID       = MustGetUserID(c)
+		sessionID, _ = GetSessionID(c)
+	)
+
+	sessions, err := a.authService.UserSessions(ctx, userID)
 	if err != nil {
-		logger.Errorf("failed to create access token: %v", c.Error(err))
+		logger.Errorf("failed to get auth sessions: %v", c.Error(err))
 		return
 	}
 
-	// Create a new refresh token object, specifying signing method and the claims
-	// you would like it to contain.
-	refreshToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256,
-		UserClaims{
-			UserID: userID.String(),
-			RegisteredClaims: jwt.RegisteredClaims{
-				ExpiresAt: jwt.NewNumericDate(time.Now().Add(a.refreshTokenExp)),
-			},
-		},
-		// Sign and get the complete encoded token as a string using the secret
-	).SignedString(a.jwtSecret)
+	c.JSON(http.StatusOK, response.NewAuthSessions(sessions, sessionID))
+}
+
+// Logout revokes the auth session of the request.
+func (a Auth) Logout(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		userID = MustGetUserID(c)
+	)
+
+	sessionID, ok := GetSessionID(c)
+	if !ok {
+		logger.Errorf("token has no session: %v", c.Error(domain.ErrInvalidToken))
+		return
+	}
+
+	if err := a.authService.Logout(ctx, userID, sessionID); err != nil {
+		logger.Errorf("failed to logout: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// LogoutAll revokes all auth sessions of the user.
+func (a Auth) LogoutAll(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		userID = MustGetUserID(c)
+	)
+
+	if err := a.authService.LogoutAll(ctx, userID); err != nil {
+		logger.Errorf("failed to logout from all sessions: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RevokeUserSessions revokes all auth sessions of the user from path.
+func (a Auth) RevokeUserSessions(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		userID = request.GetUserIDPathVar(c)
+	)
+
+	userUUID, err := uuid.Parse(userID)
 	if err != nil {
-		logger.Errorf("failed to create refresh token: %v", c.Error(err))
+		logger.Errorf("failed to parse user id to uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"user_id": userUUID})
+	ctx = liblog.With(ctx, logger)
+
+	if err = a.authService.LogoutAll(ctx, userUUID); err != nil {
+		logger.Errorf("failed to revoke user auth sessions: %v", c.Error(err))
 		return
 	}
 
-	c.JSON(http.StatusOK, response.NewUserToken(accessToken, refreshToken))
+	c.Status(http.StatusOK)
+}
+
+// UnlockUserLogin forgets failed logins to the account of the user from path and so unlocks it.
+func (a Auth) UnlockUserLogin(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		userID = request.GetUserIDPathVar(c)
+	)
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		logger.Errorf("failed to parse user id to uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"user_id": userUUID})
+	ctx = liblog.With(ctx, logger)
+
+	if err = a.authService.UnlockUserLogin(ctx, userUUID); err != nil {
+		logger.Errorf("failed to unlock user login: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// JWKS returns public keys of the user tokens for verifying them by other services.
+func (a Auth) JWKS(c *gin.Context) {
+	c.JSON(http.StatusOK, a.tokenConfig.Keys.JWKS())
+}
+
+// newUserToken creates access and refresh tokens of the session.
+func (a Auth) newUserToken(ctx context.Context, session domain.AuthSession) (response.UserToken, error) {
+	roles, err := a.userService.UserRoles(ctx, session.UserID)
+	if err != nil {
+		return response.UserToken{}, fmt.Errorf("failed to get user roles: %w", err)
+	}
+
+	now := time.Now()
+
+	var activeRole *UserRoleClaim
+	if role, ok := roles.Active(session.ActiveRoleID); ok {
+		claim := newUserRoleClaim(role)
+		activeRole = &claim
+	}
+
+	accessToken, err := a.tokenConfig.Keys.Sign(UserClaims{
+		TokenType:  accessTokenType,
+		SessionID:  session.ID.String(),
+		Roles:      newUserRoleClaims(roles),
+		ActiveRole: activeRole,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   session.UserID.String(),
+			Issuer:    a.tokenConfig.Issuer,
+			Audience:  a.accessTokenAudience(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(a.tokenConfig.AccessTokenExp)),
+		},
+	})
+	if err != nil {
+		return response.UserToken{}, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	// refresh tokens are accepted only by the issuer and expire together with the session.
+	refreshToken, err := a.tokenConfig.Keys.Sign(UserClaims{
+		TokenType: refreshTokenType,
+		SessionID: session.ID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        session.TokenID.String(),
+			Subject:   session.UserID.String(),
+			Issuer:    a.tokenConfig.Issuer,
+			Audience:  jwt.ClaimStrings{a.tokenConfig.Issuer},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(session.ExpiresAt),
+		},
+	})
+	if err != nil {
+		return response.UserToken{}, fmt.Errorf("failed to sign refresh token: %w", err)
+	}
+
+	return response.NewUserToken(accessToken, refreshToken), nil
+}
+
+// accessTokenAudience returns the issuer and the configured audience.
+func (a Auth) accessTokenAudience() jwt.ClaimStrings {
+	audience := jwt.ClaimStrings{a.tokenConfig.Issuer}
+
+	for _, aud := range a.tokenConfig.Audience {
+		if !slices.Contains(audience, aud) {
+			audience = append(audience, aud)
+		}
+	}
+
+	return audience
+}
+
+// parseRefreshToken checks the refresh token and returns the session and the token it belongs to.
+func (a Auth) parseRefreshToken(tokenString string) (auth.RefreshSessionArgs, error) {
+	userClaims, err := a.parseToken(tokenString, refreshTokenType)
+	if err != nil {
+		return auth.RefreshSessionArgs{}, err
+	}
+
+	userID, errUser := uuid.Parse(userClaims.Subject)
+	sessionID, errSession := uuid.Parse(userClaims.SessionID)
+	tokenID, errToken := uuid.Parse(userClaims.ID)
+
+	if errUser != nil || errSession != nil || errToken != nil {
+		return auth.RefreshSessionArgs{}, domain.ErrInvalidToken
+	}
+
+	return auth.RefreshSessionArgs{
+		UserID:    userID,
+		SessionID: sessionID,
+		TokenID:   tokenID,
+	}, nil
 }
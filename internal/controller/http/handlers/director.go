@@ -34,7 +34,7 @@ func (h Director) AddDirector(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -60,27 +60,43 @@ func (h Director) AddDirector(c *gin.Context) {
 // DirectorByID get director by id.
 func (h Director) DirectorByID(c *gin.Context) { //nolint:revive // It's handler
 	var (
-		ctx        = c.Request.Context()
-		logger     = liblog.Must(ctx)
-		reqParam   = request.GetDirectorIDPathVar(c)
-		directorID uuid.UUID
-		err        error
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		reqParam    = request.GetDirectorIDPathVar(c)
+		schoolIDVar = request.GetSchoolIDHeader(c)
+		schoolID    uuid.UUID
+		directorID  uuid.UUID
+		err         error
 	)
 
-	logger = logger.WithFields(liblog.Fields{"request": liblog.Fields{"director_id": reqParam}})
+	logger = logger.WithFields(liblog.Fields{
+		"request": liblog.Fields{
+			"director_id": reqParam,
+			"school_id":   schoolIDVar,
+		},
+	})
 	ctx = liblog.With(ctx, logger)
 
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
 	if directorID, err = uuid.Parse(reqParam); err != nil {
 		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
-	directorEntity, err := h.directorService.DirectorByID(ctx, directorID)
+	directorEntity, err := h.directorService.DirectorByIDAndSchoolID(ctx, directorID, schoolID)
 	if err != nil {
 		logger.Errorf("failed to get director by id: %v", c.Error(err))
 		return
 	}
 
+	if notModified(c, directorEntity.UpdatedAt) {
+		return
+	}
+
 	c.JSON(http.StatusOK, response.NewDirector(directorEntity))
 }
 
@@ -93,19 +109,31 @@ func (h Director) DirectorList(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	schoolID, err := uuid.Parse(GetListSchoolID(c))
+	if err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
 	logger = logger.WithFields(liblog.Fields{"request": req})
 	ctx = liblog.With(ctx, logger)
 
+	listFilter, err := req.ListFilter.Fields(domain.DirectorListFields(), domain.NewPagination(req.Page, req.PerPage))
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	list, total, err := h.directorService.DirectorList(
 		ctx,
 		domain.NewDirectorListFilter(
 			domain.NewDateFilter(req.CreatedDate.DateFrom(), req.CreatedDate.DateTill()),
-			domain.NewListFilter(req.SortOrder, domain.NewPagination(req.Page, req.PerPage)),
-			req.SchoolUUIDs(),
+			listFilter,
+			[]uuid.UUID{schoolID},
 		),
 	)
 	if err != nil {
@@ -119,3 +147,59 @@ func (h Director) DirectorList(c *gin.Context) {
 		Total:   total,
 	}))
 }
+
+// UpdateDirector updates contacts of the director of the school.
+func (h Director) UpdateDirector(c *gin.Context) {
+	var (
+		ctx           = c.Request.Context()
+		logger        = liblog.Must(ctx)
+		req           request.UpdateDirector
+		schoolIDVar   = request.GetSchoolIDHeader(c)
+		directorIDVar = request.GetDirectorIDPathVar(c)
+		schoolID      uuid.UUID
+		directorID    uuid.UUID
+		err           error
+	)
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "director_id": directorIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if directorID, err = uuid.Parse(directorIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	version, err := updateVersion(c, req.Version)
+	if err != nil {
+		logger.Errorf("failed to get version: %v", c.Error(err))
+		return
+	}
+
+	directorEntity, err := h.directorService.UpdateDirector(
+		ctx,
+		director.UpdateDirectorArgs{
+			ID:        directorID,
+			SchoolID:  schoolID,
+			Phone:     req.Phone,
+			Email:     req.Email,
+			UpdatedAt: version,
+		},
+	)
+	if err != nil {
+		logger.Errorf("failed to update director: %v", c.Error(preconditionErr(c, err)))
+		return
+	}
+
+	setETag(c, directorEntity.UpdatedAt)
+	c.JSON(http.StatusOK, response.NewDirector(directorEntity))
+}
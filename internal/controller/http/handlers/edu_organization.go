@@ -34,7 +34,7 @@ func (s EduOrganization) CreateEduOrganization(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -81,6 +81,10 @@ func (s EduOrganization) EduOrganizationByID(c *gin.Context) {
 		return
 	}
 
+	if notModified(c, entity.UpdatedAt) {
+		return
+	}
+
 	c.JSON(http.StatusOK, response.NewEduOrganization(entity))
 }
 
@@ -101,26 +105,35 @@ func (s EduOrganization) UpdateEduOrganizationByID(c *gin.Context) {
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
 	logger = logger.WithFields(liblog.Fields{"request": req, "edu_organization_id": organizationID})
 	ctx = liblog.With(ctx, logger)
 
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		logger.Errorf("failed to get version: %v", c.Error(err))
+		return
+	}
+
 	entity, err := s.eduOrganizationService.UpdateEduOrganizationByID(
 		ctx,
 		eduorganization.UpdateEduOrganizationArgs{
 			ID:   organizationID,
 			Name: req.Name,
 			Logo: req.Logo,
+
+			Version: version,
 		},
 	)
 	if err != nil {
-		logger.Errorf("failed to update educational organization by id: %v", c.Error(err))
+		logger.Errorf("failed to update educational organization by id: %v", c.Error(preconditionErr(c, err)))
 		return
 	}
 
+	setETag(c, entity.UpdatedAt)
 	c.JSON(http.StatusOK, response.NewEduOrganization(entity))
 }
 
@@ -134,24 +147,25 @@ func (s EduOrganization) EduOrganizationList(c *gin.Context) {
 	)
 
 	if err = c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
 	logger = logger.WithFields(liblog.Fields{"request": req})
 	ctx = liblog.With(ctx, logger)
 
+	listFilter, err := req.ListFilter.Fields(
+		domain.EduOrganizationListFields(),
+		domain.NewPagination(req.Page, req.PerPage),
+	)
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	eduOrganizationEntities, count, err := s.eduOrganizationService.EduOrganizationList(
 		ctx,
-		domain.NewEduOrganizationFilters(
-			domain.NewListFilter(
-				req.SortOrder,
-				domain.NewPagination(
-					req.Pagination.Page,
-					req.Pagination.PerPage,
-				),
-			),
-		),
+		domain.NewEduOrganizationFilters(listFilter),
 	)
 	if err != nil {
 		logger.Errorf("failed to get a list of educational organizations: %v", c.Error(err))
@@ -167,3 +181,55 @@ func (s EduOrganization) EduOrganizationList(c *gin.Context) {
 		},
 	))
 }
+
+// DeleteEduOrganization deletes educational organization by id.
+func (s EduOrganization) DeleteEduOrganization(c *gin.Context) {
+	var (
+		ctx            = c.Request.Context()
+		logger         = liblog.Must(ctx)
+		id             = request.GetEduOrganizationPathVar(c)
+		organizationID uuid.UUID
+		err            error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"edu_organization_id": id})
+	ctx = liblog.With(ctx, logger)
+
+	if organizationID, err = uuid.Parse(id); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = s.eduOrganizationService.DeleteEduOrganization(ctx, organizationID); err != nil {
+		logger.Errorf("failed to delete educational organization: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RestoreEduOrganization restores deleted educational organization by id.
+func (s EduOrganization) RestoreEduOrganization(c *gin.Context) {
+	var (
+		ctx            = c.Request.Context()
+		logger         = liblog.Must(ctx)
+		id             = request.GetEduOrganizationPathVar(c)
+		organizationID uuid.UUID
+		err            error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"edu_organization_id": id})
+	ctx = liblog.With(ctx, logger)
+
+	if organizationID, err = uuid.Parse(id); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = s.eduOrganizationService.RestoreEduOrganization(ctx, organizationID); err != nil {
+		logger.Errorf("failed to restore educational organization: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
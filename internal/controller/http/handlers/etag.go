@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/domain"
+)
+
+const (
+	eTagHeader   = "ETag"
+	anyETag      = "*"
+	weakETagFlag = "W/"
+	eTagBase     = 36
+)
+
+// newETag returns the entity tag of the version of the entity, it's the time of the last update of the entity.
+func newETag(updatedAt time.Time) string {
+	return strconv.Quote(strconv.FormatInt(updatedAt.UnixNano(), eTagBase))
+}
+
+// parseETag returns the version of the entity from the strong entity tag.
+func parseETag(eTag string) (time.Time, bool) {
+	value, err := strconv.Unquote(eTag)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	nanoseconds, err := strconv.ParseInt(value, eTagBase, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(0, nanoseconds).UTC(), true
+}
+
+// setETag sets ETag header of the version of the entity.
+func setETag(c *gin.Context, updatedAt time.Time) {
+	c.Header(eTagHeader, newETag(updatedAt))
+}
+
+// notModified sets ETag header of the version of the entity and responds with 304 Not Modified
+// if the version is one of If-None-Match header, so the client can use its copy of the entity.
+func notModified(c *gin.Context, updatedAt time.Time) bool {
+	eTag := newETag(updatedAt)
+	c.Header(eTagHeader, eTag)
+
+	ifNoneMatch := strings.TrimSpace(request.GetIfNoneMatchHeader(c))
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	// If-None-Match uses the weak comparison, so weak entity tags match too.
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), weakETagFlag)
+		if tag == anyETag || tag == eTag {
+			c.Status(http.StatusNotModified)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasIfMatch reports whether the update is based on the version of If-Match header.
+func hasIfMatch(c *gin.Context) bool {
+	ifMatch := strings.TrimSpace(request.GetIfMatchHeader(c))
+
+	return ifMatch != "" && ifMatch != anyETag
+}
+
+// ifMatchVersion returns the version of the entity from If-Match header, nil if the header is absent or is "*".
+// If-Match uses the strong comparison, so weak, malformed or several entity tags never match the current version.
+func ifMatchVersion(c *gin.Context) (*time.Time, error) {
+	if !hasIfMatch(c) {
+		return nil, nil //nolint:nilnil // the update is not conditional
+	}
+
+	version, ok := parseETag(strings.TrimSpace(request.GetIfMatchHeader(c)))
+	if !ok {
+		return nil, domain.ErrPreconditionFailed
+	}
+
+	return &version, nil
+}
+
+// updateVersion returns the version of the entity the patch request is based on,
+// If-Match header takes precedence over updated_at field of the request.
+func updateVersion(c *gin.Context, req request.Version) (time.Time, error) {
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if version != nil {
+		return *version, nil
+	}
+
+	if req.UpdatedAt.IsZero() {
+		return time.Time{}, domain.ErrPreconditionRequired
+	}
+
+	return req.UpdatedAt, nil
+}
+
+// preconditionErr returns precondition failed error instead of outdated version one
+// if the update is based on the version of If-Match header.
+func preconditionErr(c *gin.Context, err error) error {
+	if !hasIfMatch(c) || !errors.Is(err, domain.ErrOutdatedVersion) {
+		return err
+	}
+
+	return fmt.Errorf("%w: %w", domain.ErrPreconditionFailed, err)
+}
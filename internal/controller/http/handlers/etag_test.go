@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/domain"
+	"bum-service/pkg/liblog"
+)
+
+//nolint:funlen // it's test function.
+func TestETag_ConditionalRequests(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	var (
+		updatedAt = time.Date(2025, 4, 2, 9, 0, 0, 123456000, time.UTC)
+		eTag      = newETag(updatedAt)
+		oldETag   = newETag(updatedAt.Add(-time.Second))
+	)
+
+	router := gin.New()
+	router.Use(LoggingEndpointMiddleware(liblog.NewDummyLogger()))
+	router.Use(ErrorHandlingMiddleware())
+
+	router.GET("/entity", func(c *gin.Context) {
+		if notModified(c, updatedAt) {
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"updated_at": updatedAt})
+	})
+
+	router.PATCH("/entity", func(c *gin.Context) {
+		version, err := updateVersion(c, request.Version{})
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		if err = domain.CheckVersion(updatedAt, version); err != nil {
+			_ = c.Error(preconditionErr(c, err))
+			return
+		}
+
+		setETag(c, updatedAt)
+		c.Status(http.StatusOK)
+	})
+
+	tests := []struct {
+		name     string
+		method   string
+		header   string
+		value    string
+		wantCode int
+	}{
+		{"get without If-None-Match", http.MethodGet, "", "", http.StatusOK},
+		{"get with current version", http.MethodGet, "If-None-Match", eTag, http.StatusNotModified},
+		{"get with weak current version", http.MethodGet, "If-None-Match", "W/" + eTag, http.StatusNotModified},
+		{"get with one of versions", http.MethodGet, "If-None-Match", oldETag + ", " + eTag, http.StatusNotModified},
+		{"get with any version", http.MethodGet, "If-None-Match", "*", http.StatusNotModified},
+		{"get with old version", http.MethodGet, "If-None-Match", oldETag, http.StatusOK},
+		{"update with current version", http.MethodPatch, "If-Match", eTag, http.StatusOK},
+		{"update with old version", http.MethodPatch, "If-Match", oldETag, http.StatusPreconditionFailed},
+		{"update with weak version", http.MethodPatch, "If-Match", "W/" + eTag, http.StatusPreconditionFailed},
+		{"update with malformed version", http.MethodPatch, "If-Match", "version", http.StatusPreconditionFailed},
+		{"update without version", http.MethodPatch, "", "", http.StatusPreconditionRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := http.NewRequestWithContext(context.Background(), tt.method, "/entity", http.NoBody)
+			require.NoError(t, err)
+
+			if tt.header != "" {
+				r.Header.Set(tt.header, tt.value)
+			}
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, r)
+
+			require.Equal(t, tt.wantCode, recorder.Code)
+
+			if tt.wantCode == http.StatusOK || tt.wantCode == http.StatusNotModified {
+				assert.Equal(t, eTag, recorder.Header().Get(eTagHeader))
+			}
+
+			if tt.wantCode == http.StatusNotModified {
+				assert.Empty(t, recorder.Body.String())
+			}
+		})
+	}
+}
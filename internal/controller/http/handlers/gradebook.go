@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/controller/http/handlers/response"
+	"bum-service/internal/domain"
+	"bum-service/internal/service/lesson"
+	"bum-service/pkg/liblog"
+)
+
+// Gradebook returns marks of the group students on the group subject lessons within the period.
+func (l *Lesson) Gradebook(c *gin.Context) {
+	var (
+		ctx               = c.Request.Context()
+		logger            = liblog.Must(ctx)
+		req               request.Gradebook
+		schoolIDHeaderVar = request.GetSchoolIDHeader(c)
+		schoolID          uuid.UUID
+		err               error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDHeaderVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindQuery(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req, "school_id": schoolID})
+	ctx = liblog.With(ctx, logger)
+
+	dateFrom, dateTill := req.Period()
+
+	gradebook, err := l.lessonService.Gradebook(ctx, lesson.GradebookArgs{
+		SchoolID:       schoolID,
+		GroupSubjectID: req.GroupSubjectID,
+		DateFrom:       dateFrom,
+		DateTill:       dateTill,
+		TermID:         req.TermID,
+	})
+	if err != nil {
+		logger.Errorf("failed to get gradebook: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewGradebook(gradebook))
+}
+
+// FinaliseGrades sets final grades of the group subject students for the period.
+func (l *Lesson) FinaliseGrades(c *gin.Context) {
+	var (
+		ctx               = c.Request.Context()
+		logger            = liblog.Must(ctx)
+		req               request.FinaliseGrades
+		schoolIDHeaderVar = request.GetSchoolIDHeader(c)
+		schoolID          uuid.UUID
+		err               error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDHeaderVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req, "school_id": schoolID})
+	ctx = liblog.With(ctx, logger)
+
+	dateFrom, dateTill := req.Period()
+
+	args := lesson.FinaliseGradesArgs{
+		GradebookArgs: lesson.GradebookArgs{
+			SchoolID:       schoolID,
+			GroupSubjectID: req.GroupSubjectID,
+			DateFrom:       dateFrom,
+			DateTill:       dateTill,
+			TermID:         req.TermID,
+		},
+		Lock:   req.Lock,
+		Grades: make([]lesson.StudentFinalGrade, 0, len(req.Grades)),
+	}
+
+	for _, grade := range req.Grades {
+		args.Grades = append(args.Grades, lesson.StudentFinalGrade{StudentID: grade.StudentID, Mark: grade.Mark})
+	}
+
+	gradebook, err := l.lessonService.FinaliseGrades(ctx, args)
+	if err != nil {
+		logger.Errorf("failed to finalise grades: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewGradebook(gradebook))
+}
@@ -36,7 +36,7 @@ func (h Grades) CreateGradeStandard(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -52,6 +52,7 @@ func (h Grades) CreateGradeStandard(c *gin.Context) {
 			Name:           req.Name,
 			EducationYears: req.EducationYears,
 			Description:    req.Description,
+			GradingScaleID: req.GradingScaleID,
 			Grades:         gradesArg,
 		})
 	if err != nil {
@@ -100,6 +101,10 @@ func (h Grades) GradeStandardByID(c *gin.Context) {
 		return
 	}
 
+	if notModified(c, gradeStandard.UpdatedAt) {
+		return
+	}
+
 	c.JSON(http.StatusOK, response.NewGradeStandard(gradeStandard))
 }
 
@@ -112,21 +117,25 @@ func (h Grades) GradeStandardList(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
 	logger = logger.WithFields(liblog.Fields{"request": req})
 	ctx = liblog.With(ctx, logger)
 
+	listFilter, err := req.ListFilter.Fields(
+		domain.GradeStandardListFields(),
+		domain.NewPagination(req.Page, req.PerPage),
+	)
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	list, total, err := h.gradesService.GradeStandardList(
 		ctx,
-		domain.NewGradeStandardListFilter(
-			domain.NewListFilter(
-				req.SortOrder,
-				domain.NewPagination(req.Page, req.PerPage),
-			),
-		),
+		domain.NewGradeStandardListFilter(listFilter),
 	)
 	if err != nil {
 		logger.Errorf("failed to get grade standard list: %v", c.Error(err))
@@ -145,3 +154,138 @@ func (h Grades) GradeStandardList(c *gin.Context) {
 		),
 	)
 }
+
+// CreateGradingScale creates a new grading scale of the organization.
+func (h Grades) CreateGradingScale(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		req    request.CreateGradingScale
+	)
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req})
+	ctx = liblog.With(ctx, logger)
+
+	values := make([]grades.GradingScaleValueArgs, 0, len(req.Values))
+	for _, value := range req.Values {
+		values = append(values, grades.GradingScaleValueArgs{Value: value.Value, Weight: value.Weight})
+	}
+
+	scale, err := h.gradesService.CreateGradingScale(ctx, grades.CreateGradingScaleArgs{
+		OrganizationID: &req.OrganizationID,
+		Name:           req.Name,
+		Kind:           req.Kind,
+		Values:         values,
+	})
+	if err != nil {
+		logger.Errorf("failed to create a new grading scale: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.NewGradingScale(scale))
+}
+
+// GradingScaleByID get grading scale by id.
+func (h Grades) GradingScaleByID(c *gin.Context) {
+	var (
+		ctx            = c.Request.Context()
+		logger         = liblog.Must(ctx)
+		reqParam       = request.GetGradingScaleIDPathVar(c)
+		gradingScaleID uuid.UUID
+		err            error
+	)
+
+	if gradingScaleID, err = uuid.Parse(reqParam); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	scale, err := h.gradesService.GradingScaleByID(ctx, gradingScaleID)
+	if err != nil {
+		logger.Errorf("failed to get grading scale by id: %v", c.Error(err))
+		return
+	}
+
+	if notModified(c, scale.UpdatedAt) {
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewGradingScale(scale))
+}
+
+// GradingScaleList returns preset grading scales and the ones of the organization.
+func (h Grades) GradingScaleList(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		req    request.GradingScaleList
+	)
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	list, err := h.gradesService.GradingScaleList(ctx, req.OrganizationID)
+	if err != nil {
+		logger.Errorf("failed to get grading scale list: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewGradingScales(list))
+}
+
+// UpdateGradeStandard updates grade standard by id.
+func (h Grades) UpdateGradeStandard(c *gin.Context) {
+	var (
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		req             request.UpdateGradeStandard
+		id              = request.GetGradeStandardIDPathVar(c)
+		gradeStandardID uuid.UUID
+		err             error
+	)
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"grade_standard_id": id})
+	ctx = liblog.With(ctx, logger)
+
+	if gradeStandardID, err = uuid.Parse(id); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	version, err := updateVersion(c, req.Version)
+	if err != nil {
+		logger.Errorf("failed to get version: %v", c.Error(err))
+		return
+	}
+
+	gradeStandard, err := h.gradesService.UpdateGradeStandard(
+		ctx,
+		grades.UpdateGradeStandardArgs{
+			ID:             gradeStandardID,
+			Name:           req.Name,
+			EducationYears: req.EducationYears,
+			Description:    req.Description,
+			GradingScaleID: req.GradingScaleID,
+			UpdatedAt:      version,
+		},
+	)
+	if err != nil {
+		logger.Errorf("failed to update grade standard: %v", c.Error(preconditionErr(c, err)))
+		return
+	}
+
+	setETag(c, gradeStandard.UpdatedAt)
+	c.JSON(http.StatusOK, response.NewGradeStandard(gradeStandard))
+}
@@ -35,7 +35,7 @@ func (h Headmaster) AddHeadmaster(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -64,24 +64,40 @@ func (h Headmaster) HeadmasterByID(c *gin.Context) { //nolint:revive // It's han
 		ctx          = c.Request.Context()
 		logger       = liblog.Must(ctx)
 		reqParam     = request.GetHeadmasterIDPathVar(c)
+		schoolIDVar  = request.GetSchoolIDHeader(c)
+		schoolID     uuid.UUID
 		headmasterID uuid.UUID
 		err          error
 	)
 
-	logger = logger.WithFields(liblog.Fields{"request": liblog.Fields{"headmaster_id": reqParam}})
+	logger = logger.WithFields(liblog.Fields{
+		"request": liblog.Fields{
+			"headmaster_id": reqParam,
+			"school_id":     schoolIDVar,
+		},
+	})
 	ctx = liblog.With(ctx, logger)
 
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
 	if headmasterID, err = uuid.Parse(reqParam); err != nil {
 		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
-	headmasterEntity, err := h.headmasterService.HeadmasterByID(ctx, headmasterID)
+	headmasterEntity, err := h.headmasterService.HeadmasterByIDAndSchoolID(ctx, headmasterID, schoolID)
 	if err != nil {
 		logger.Errorf("failed to get headmaster by id: %v", c.Error(err))
 		return
 	}
 
+	if notModified(c, headmasterEntity.UpdatedAt) {
+		return
+	}
+
 	c.JSON(http.StatusOK, response.NewHeadmaster(headmasterEntity))
 }
 
@@ -94,19 +110,31 @@ func (h Headmaster) HeadmasterList(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	schoolID, err := uuid.Parse(GetListSchoolID(c))
+	if err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
 	logger = logger.WithFields(liblog.Fields{"request": req})
 	ctx = liblog.With(ctx, logger)
 
+	listFilter, err := req.ListFilter.Fields(domain.HeadmasterListFields(), domain.NewPagination(req.Page, req.PerPage))
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	list, total, err := h.headmasterService.HeadmasterList(
 		ctx,
 		domain.NewHeadmasterListFilter(
 			domain.NewDateFilter(req.CreatedDate.DateFrom(), req.CreatedDate.DateTill()),
-			domain.NewListFilter(req.SortOrder, domain.NewPagination(req.Page, req.PerPage)),
-			req.SchoolUUIDs(),
+			listFilter,
+			[]uuid.UUID{schoolID},
 		),
 	)
 	if err != nil {
@@ -120,3 +148,59 @@ func (h Headmaster) HeadmasterList(c *gin.Context) {
 		Total:   total,
 	}))
 }
+
+// UpdateHeadmaster updates contacts of the headmaster of the school.
+func (h Headmaster) UpdateHeadmaster(c *gin.Context) {
+	var (
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		req             request.UpdateHeadmaster
+		schoolIDVar     = request.GetSchoolIDHeader(c)
+		headmasterIDVar = request.GetHeadmasterIDPathVar(c)
+		schoolID        uuid.UUID
+		headmasterID    uuid.UUID
+		err             error
+	)
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "headmaster_id": headmasterIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if headmasterID, err = uuid.Parse(headmasterIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	version, err := updateVersion(c, req.Version)
+	if err != nil {
+		logger.Errorf("failed to get version: %v", c.Error(err))
+		return
+	}
+
+	headmasterEntity, err := h.headmasterService.UpdateHeadmaster(
+		ctx,
+		headmaster.UpdateHeadmasterArgs{
+			ID:        headmasterID,
+			SchoolID:  schoolID,
+			Phone:     req.Phone,
+			Email:     req.Email,
+			UpdatedAt: version,
+		},
+	)
+	if err != nil {
+		logger.Errorf("failed to update headmaster: %v", c.Error(preconditionErr(c, err)))
+		return
+	}
+
+	setETag(c, headmasterEntity.UpdatedAt)
+	c.JSON(http.StatusOK, response.NewHeadmaster(headmasterEntity))
+}
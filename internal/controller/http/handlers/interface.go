@@ -2,10 +2,12 @@ package handlers
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
 	"bum-service/internal/domain"
+	"bum-service/internal/service/auth"
 	"bum-service/internal/service/director"
 	eduorganization "bum-service/internal/service/edu-organization"
 	grades "bum-service/internal/service/grade-standard"
@@ -13,6 +15,7 @@ import (
 	"bum-service/internal/service/lesson"
 	"bum-service/internal/service/owner"
 	"bum-service/internal/service/school"
+	"bum-service/internal/service/setup"
 	"bum-service/internal/service/student"
 	"bum-service/internal/service/subject"
 	"bum-service/internal/service/teacher"
@@ -40,6 +43,8 @@ type IEduOrganizationService interface {
 	EduOrganizationList(
 		ctx context.Context, filters domain.EduOrganizationFilters,
 	) (domain.EduOrganizations, int, error)
+	DeleteEduOrganization(ctx context.Context, id uuid.UUID) error
+	RestoreEduOrganization(ctx context.Context, id uuid.UUID) error
 }
 
 // ISchoolService is a school use case interface.
@@ -50,11 +55,15 @@ type ISchoolService interface {
 	SchoolByID(ctx context.Context, schoolID uuid.UUID) (domain.School, error)
 	UpdateSchool(ctx context.Context, args school.UpdateSchoolArgs) (domain.School, error)
 	SchoolList(ctx context.Context, filters domain.SchoolFilters) (domain.Schools, int, error)
+	DeleteSchool(ctx context.Context, id uuid.UUID) error
+	RestoreSchool(ctx context.Context, organizationID, id uuid.UUID) error
 
 	CreateGroup(ctx context.Context, arg school.CreateGroupArgs) (domain.Group, error)
-	GroupByID(ctx context.Context, groupID uuid.UUID) (domain.Group, error)
+	GroupByID(ctx context.Context, schoolID, groupID uuid.UUID) (domain.Group, error)
 	UpdateGroup(ctx context.Context, args school.UpdateGroupArgs) (domain.Group, error)
 	GroupList(ctx context.Context, schoolID uuid.UUID, filters domain.GroupFilters) (domain.Groups, int, error)
+	DeleteGroup(ctx context.Context, schoolID, id uuid.UUID) error
+	RestoreGroup(ctx context.Context, schoolID, id uuid.UUID) error
 
 	CreateSchoolSubject(ctx context.Context, args school.CreateSchoolSubjectArgs) (domain.SchoolSubject, error)
 	SchoolSubjectByIDAndSchoolID(
@@ -70,7 +79,7 @@ type ISchoolService interface {
 	AddGroupSubject(
 		ctx context.Context, schoolID, groupID uuid.UUID, args school.AddGroupSubjectArgs,
 	) (domain.GroupSubject, error)
-	GroupSubjectList(ctx context.Context, groupID uuid.UUID) (domain.GroupSubjects, error)
+	GroupSubjectList(ctx context.Context, schoolID, groupID uuid.UUID) (domain.GroupSubjects, error)
 
 	CreateAuditorium(
 		ctx context.Context,
@@ -79,24 +88,36 @@ type ISchoolService interface {
 	) (domain.Auditorium, error)
 	AuditoriumByIDAndSchoolID(ctx context.Context, id, schoolID uuid.UUID) (domain.Auditorium, error)
 	AuditoriumList(ctx context.Context, filters domain.AuditoriumListFilters) (domain.Auditoriums, int, error)
+	UpdateAuditorium(ctx context.Context, args school.UpdateAuditoriumArgs) (domain.Auditorium, error)
+	DeleteAuditorium(ctx context.Context, schoolID, id uuid.UUID) error
+	RestoreAuditorium(ctx context.Context, schoolID, id uuid.UUID) error
 
 	AssignStudyPlans(ctx context.Context, schoolID uuid.UUID, args []school.AddStudyPlanArgs) (domain.StudyPlans, error)
 	StudyPlanList(ctx context.Context, groupSubjectID uuid.UUID) (domain.StudyPlans, error)
 	StudyPlanChangeStatus(ctx context.Context, groupSubjectID, studyPlanID uuid.UUID, status string) error
+
+	CreateAcademicYear(ctx context.Context, args school.CreateAcademicYearArgs) (domain.AcademicYear, error)
+	AcademicYearByID(ctx context.Context, id, schoolID uuid.UUID) (domain.AcademicYear, error)
+	AcademicYearList(ctx context.Context, schoolID uuid.UUID) (domain.AcademicYears, error)
+	RollOverAcademicYear(ctx context.Context, id, schoolID uuid.UUID, name string) (domain.AcademicYear, error)
+
+	PromoteGroups(ctx context.Context, args school.PromoteGroupsArgs) (domain.GroupPromotions, error)
 }
 
 // IDirectorService is a director use case interface.
 type IDirectorService interface {
 	AddDirector(ctx context.Context, args director.AddDirectorArgs) (domain.Director, error)
-	DirectorByID(ctx context.Context, id uuid.UUID) (domain.Director, error)
+	DirectorByIDAndSchoolID(ctx context.Context, id, schoolID uuid.UUID) (domain.Director, error)
 	DirectorList(ctx context.Context, filters domain.DirectorListFilter) ([]domain.Director, int, error)
+	UpdateDirector(ctx context.Context, args director.UpdateDirectorArgs) (domain.Director, error)
 }
 
 // IHeadmasterService is a headmaster use case interface.
 type IHeadmasterService interface {
 	AddHeadmaster(ctx context.Context, args headmaster.AddHeadmasterArgs) (domain.Headmaster, error)
-	HeadmasterByID(ctx context.Context, id uuid.UUID) (domain.Headmaster, error)
+	HeadmasterByIDAndSchoolID(ctx context.Context, id, schoolID uuid.UUID) (domain.Headmaster, error)
 	HeadmasterList(ctx context.Context, filters domain.HeadmasterListFilter) ([]domain.Headmaster, int, error)
+	UpdateHeadmaster(ctx context.Context, args headmaster.UpdateHeadmasterArgs) (domain.Headmaster, error)
 }
 
 // ISubjectService is a subject use case interface.
@@ -104,13 +125,18 @@ type ISubjectService interface {
 	CreateSubject(ctx context.Context, args subject.CreateSubjectArgs) (domain.Subject, error)
 	SubjectByID(ctx context.Context, id uuid.UUID) (domain.Subject, error)
 	SubjectList(ctx context.Context, filters domain.SubjectListFilter) ([]domain.Subject, int, error)
+	UpdateSubject(ctx context.Context, args subject.UpdateSubjectArgs) (domain.Subject, error)
+	DeleteSubject(ctx context.Context, id uuid.UUID) error
+	RestoreSubject(ctx context.Context, id uuid.UUID) error
 }
 
 // IUserService is a user use case interface.
 type IUserService interface {
 	UserList(ctx context.Context, filters domain.UserListFilter) (domain.Users, int, error)
+	Search(ctx context.Context, filter domain.SearchFilter) (domain.SearchHits, error)
 	UserRoles(ctx context.Context, id uuid.UUID) (domain.UserRoles, error)
 	UserFullInfoByID(ctx context.Context, id uuid.UUID) (domain.User, error)
+	UserFullInfoByIDAndSchoolID(ctx context.Context, id, schoolID uuid.UUID) (domain.User, error)
 	UserByID(ctx context.Context, id uuid.UUID) (domain.User, error)
 	AddUser(ctx context.Context, args user.AddUserArgs) (newUser domain.User, err error)
 }
@@ -118,8 +144,11 @@ type IUserService interface {
 // ITeacherService is a teacher use case interface.
 type ITeacherService interface {
 	AddTeacher(ctx context.Context, args teacher.AddTeacherArgs) (domain.Teacher, error)
-	TeacherByID(ctx context.Context, id uuid.UUID) (domain.Teacher, error)
+	TeacherByIDAndSchoolID(ctx context.Context, id, schoolID uuid.UUID) (domain.Teacher, error)
 	TeacherList(ctx context.Context, filters domain.TeacherListFilter) (domain.Teachers, int, error)
+	UpdateTeacher(ctx context.Context, args teacher.UpdateTeacherArgs) (domain.Teacher, error)
+	DeleteTeacher(ctx context.Context, schoolID, id uuid.UUID) error
+	RestoreTeacher(ctx context.Context, schoolID, id uuid.UUID) error
 }
 
 // IGradesService is a grades use case interface.
@@ -127,35 +156,100 @@ type IGradesService interface {
 	CreateGradeStandard(ctx context.Context, arg grades.CreateGradeStandardArgs) (domain.GradeStandard, error)
 	GradeStandardByID(ctx context.Context, id uuid.UUID) (domain.GradeStandard, error)
 	GradeStandardList(ctx context.Context, filter domain.GradeStandardListFilter) (domain.GradeStandards, int, error)
+	UpdateGradeStandard(ctx context.Context, args grades.UpdateGradeStandardArgs) (domain.GradeStandard, error)
+
+	CreateGradingScale(ctx context.Context, args grades.CreateGradingScaleArgs) (domain.GradingScale, error)
+	GradingScaleByID(ctx context.Context, id uuid.UUID) (domain.GradingScale, error)
+	GradingScaleList(ctx context.Context, organizationID *uuid.UUID) (domain.GradingScales, error)
 }
 
 // IAuthService is an auth service interface.
 type IAuthService interface {
-	GetUserIDByEmailAndPassword(ctx context.Context, email, password string) (uuid.UUID, error)
+	GetUserIDByEmailAndPassword(ctx context.Context, args auth.LoginArgs) (uuid.UUID, error)
+	SendPhoneLoginCode(ctx context.Context, phone string) error
+	GetUserIDByPhoneAndCode(ctx context.Context, args auth.PhoneLoginArgs) (uuid.UUID, error)
+
+	StartSession(ctx context.Context, args auth.StartSessionArgs) (domain.AuthSession, error)
+	RefreshSession(ctx context.Context, args auth.RefreshSessionArgs) (domain.AuthSession, error)
+	SelectSessionRole(ctx context.Context, args auth.SelectSessionRoleArgs) (domain.AuthSession, error)
+	UserSessions(ctx context.Context, userID uuid.UUID) (domain.AuthSessions, error)
+	Logout(ctx context.Context, userID, sessionID uuid.UUID) error
+	LogoutAll(ctx context.Context, userID uuid.UUID) error
+	UnlockUserLogin(ctx context.Context, userID uuid.UUID) error
+
+	ChangePassword(ctx context.Context, args auth.ChangePasswordArgs) error
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	SendEmailVerification(ctx context.Context, userID uuid.UUID) error
+	VerifyEmail(ctx context.Context, token string) error
 }
 
 // IStudentService is student service interface.
 type IStudentService interface {
 	AddStudent(ctx context.Context, args student.AddStudentArgs) (newStudent domain.Student, err error)
-	StudentByID(ctx context.Context, studentID uuid.UUID) (domain.Student, error)
+	StudentByIDAndSchoolID(ctx context.Context, studentID, schoolID uuid.UUID) (domain.Student, error)
 	StudentList(ctx context.Context, filters domain.StudentListFilter) (domain.Students, int, error)
+	TransferStudent(ctx context.Context, args student.TransferStudentArgs) (domain.StudentMembership, error)
+	StudentMemberships(
+		ctx context.Context, studentID, schoolID uuid.UUID, date *time.Time,
+	) (domain.StudentMemberships, error)
+	UpdateStudent(ctx context.Context, args student.UpdateStudentArgs) (domain.Student, error)
+	DeleteStudent(ctx context.Context, schoolID, id uuid.UUID) error
+	RestoreStudent(ctx context.Context, schoolID, id uuid.UUID) error
+	ImportStudents(ctx context.Context, args student.ImportStudentsArgs) (domain.StudentImport, error)
 
-	StudentGuardians(ctx context.Context, studentID uuid.UUID) (domain.StudentGuardians, error)
+	StudentGuardians(ctx context.Context, studentID, schoolID uuid.UUID) (domain.StudentGuardians, error)
 	AssignStudentGuardian(ctx context.Context, args student.AssignStudentGuardianArgs) (domain.StudentGuardian, error)
 	StudentGuardianByUserID(ctx context.Context, userID uuid.UUID) (domain.Guardian, error)
 	StudentGuardianList(
 		ctx context.Context,
 		filters domain.StudentGuardianListFilter,
 	) (domain.StudentGuardians, int, error)
+	DeleteStudentGuardian(ctx context.Context, schoolID, studentID, id uuid.UUID) error
+	RestoreStudentGuardian(ctx context.Context, schoolID, studentID, id uuid.UUID) error
+}
+
+// ISetupService is school setup service interface.
+type ISetupService interface {
+	ImportSchoolSetup(ctx context.Context, args setup.ImportSchoolSetupArgs) (domain.SchoolSetupResult, error)
 }
 
 // ILessonService is lesson service interface.
 type ILessonService interface {
 	AssignLessons(ctx context.Context, args lesson.AddWeekLessonsArgs) (domain.Lessons, error)
 	LessonsList(ctx context.Context, filters domain.LessonsListFilter) (domain.Lessons, error)
+	GenerateTimetable(ctx context.Context, args lesson.GenerateTimetableArgs) (domain.Timetable, error)
+	CommitTimetable(ctx context.Context, args lesson.CommitTimetableArgs) (domain.Timetable, error)
+	OverrideLesson(ctx context.Context, args lesson.OverrideLessonArgs) (domain.Lesson, error)
+	DeleteLesson(ctx context.Context, schoolID, id uuid.UUID) error
+	RestoreLesson(ctx context.Context, schoolID, id uuid.UUID) error
+
+	CreateTimetableTemplate(
+		ctx context.Context, args lesson.CreateTimetableTemplateArgs,
+	) (domain.TimetableTemplate, error)
+	TimetableTemplateByID(ctx context.Context, id, schoolID uuid.UUID) (domain.TimetableTemplate, error)
+	TimetableTemplateList(
+		ctx context.Context, filters domain.TimetableTemplateFilters,
+	) (domain.TimetableTemplates, error)
+	MaterialiseTimetableTemplate(
+		ctx context.Context, args lesson.MaterialiseTimetableTemplateArgs,
+	) (domain.Lessons, error)
 
 	AddMark(ctx context.Context, args lesson.AddMarkArgs) (domain.Mark, error)
 	MarkByID(ctx context.Context, markID uuid.UUID) (domain.Mark, error)
+	MarkByIDAndLessonID(ctx context.Context, markID, lessonID uuid.UUID) (domain.Mark, error)
+	UpdateMark(ctx context.Context, args lesson.UpdateMarkArgs) (domain.Mark, error)
+	DeleteMark(ctx context.Context, lessonID, id uuid.UUID) error
+	RestoreMark(ctx context.Context, lessonID, id uuid.UUID) error
+
+	RecordAttendance(ctx context.Context, args lesson.RecordAttendanceArgs) (domain.Attendances, error)
+	LessonAttendances(ctx context.Context, lessonID uuid.UUID) (domain.Attendances, error)
+	AttendanceList(
+		ctx context.Context, filters domain.AttendanceFilters,
+	) (domain.Attendances, domain.AttendanceSummaries, error)
+
+	Gradebook(ctx context.Context, args lesson.GradebookArgs) (domain.Gradebook, error)
+	FinaliseGrades(ctx context.Context, args lesson.FinaliseGradesArgs) (domain.Gradebook, error)
 }
 
 // IOwnerService is owner service interface.
@@ -168,3 +262,13 @@ type IOwnerService interface {
 		filter domain.OwnerListFilter,
 	) (domain.Owners, int, error)
 }
+
+// IPolicyService is access policy service interface.
+type IPolicyService interface {
+	Actor(ctx context.Context, userID uuid.UUID) (domain.Actor, error)
+	AuthorizeRoles(ctx context.Context, actor domain.Actor, roles ...domain.Role) error
+	AuthorizeOrganization(ctx context.Context, actor domain.Actor, organizationID uuid.UUID, roles ...domain.Role) error
+	AuthorizeSchool(ctx context.Context, actor domain.Actor, schoolID uuid.UUID, roles ...domain.Role) error
+	AuthorizeLesson(ctx context.Context, actor domain.Actor, lessonID uuid.UUID) error
+	AuthorizeGroupSubject(ctx context.Context, actor domain.Actor, schoolID, groupSubjectID uuid.UUID) error
+}
@@ -44,7 +44,7 @@ func (l *Lesson) AssignWeekLessons(c *gin.Context) {
 	}
 
 	if err = c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -53,7 +53,7 @@ func (l *Lesson) AssignWeekLessons(c *gin.Context) {
 
 	addLesson, err := l.lessonService.AssignLessons(ctx, convertAssignWeekLessonsToServiceArgs(req, schoolID))
 	if err != nil {
-		logger.Errorf("failed to assign lessons: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to assign lessons: %v", c.Error(err))
 		return
 	}
 
@@ -92,20 +92,39 @@ func (l *Lesson) LessonsList(c *gin.Context) {
 	)
 
 	if err = c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	schoolID, err := uuid.Parse(GetListSchoolID(c))
+	if err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
 	logger = logger.WithFields(liblog.Fields{"request": req})
 	ctx = liblog.With(ctx, logger)
 
+	pagination, err := domain.NewCursorPagination(req.Cursor, req.Page, req.PerPage)
+	if err != nil {
+		logger.Errorf("failed to create pagination: %v", c.Error(err))
+		return
+	}
+
+	listFilter, err := req.ListFilter.Fields(domain.LessonListFields(), pagination)
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	list, err := l.lessonService.LessonsList(ctx,
 		domain.NewLessonsListFilter(
 			domain.NewDateFilter(req.Period.DateFrom(), req.Period.DateTill()),
-			domain.NewListFilter(req.SortOrder, domain.NewPagination(req.Page, req.PerPage)),
-			req.SchoolID,
+			listFilter,
+			&schoolID,
 			req.TeacherID,
 			req.GroupID,
+			req.TermID,
 		),
 	)
 	if err != nil {
@@ -113,5 +132,178 @@ func (l *Lesson) LessonsList(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, response.NewLessons(list))
+	page := domain.NewCursorPage(list, listFilter, domain.Lesson.Cursor)
+
+	// the list of lessons is not wrapped into the pagination, so the cursors are sent in headers.
+	setCursorHeaders(c, page.Next, page.Prev)
+	c.JSON(http.StatusOK, response.NewLessons(page.List))
+}
+
+// DeleteLesson deletes lesson of the school.
+func (l *Lesson) DeleteLesson(c *gin.Context) {
+	var (
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		schoolIDVar = request.GetSchoolIDHeader(c)
+		lessonIDVar = request.GetLessonIDPathVar(c)
+		schoolID    uuid.UUID
+		lessonID    uuid.UUID
+		err         error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "lesson_id": lessonIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if lessonID, err = uuid.Parse(lessonIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = l.lessonService.DeleteLesson(ctx, schoolID, lessonID); err != nil {
+		logger.Errorf("failed to delete lesson: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RestoreLesson restores deleted lesson of the school.
+func (l *Lesson) RestoreLesson(c *gin.Context) {
+	var (
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		schoolIDVar = request.GetSchoolIDHeader(c)
+		lessonIDVar = request.GetLessonIDPathVar(c)
+		schoolID    uuid.UUID
+		lessonID    uuid.UUID
+		err         error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "lesson_id": lessonIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if lessonID, err = uuid.Parse(lessonIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = l.lessonService.RestoreLesson(ctx, schoolID, lessonID); err != nil {
+		logger.Errorf("failed to restore lesson: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// GenerateTimetable generates a draft timetable of the school for the week.
+func (l *Lesson) GenerateTimetable(c *gin.Context) {
+	var (
+		ctx               = c.Request.Context()
+		logger            = liblog.Must(ctx)
+		req               request.GenerateTimetable
+		schoolIDHeaderVar = request.GetSchoolIDHeader(c)
+		schoolID          uuid.UUID
+		err               error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDHeaderVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req})
+	ctx = liblog.With(ctx, logger)
+
+	timetable, err := l.lessonService.GenerateTimetable(ctx, lesson.GenerateTimetableArgs{
+		SchoolID:         schoolID,
+		WeekDate:         req.WeekDate,
+		Bells:            req.BellSlots(),
+		Weekdays:         req.Weekdays,
+		MaxLessonsPerDay: req.MaxLessonsPerDay,
+		TeacherDaysOff:   req.TeacherDaysOffMap(),
+	})
+	if err != nil {
+		logger.Errorf("failed to generate timetable: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewTimetable(timetable))
+}
+
+// CommitTimetable assigns week lessons of several groups at once.
+func (l *Lesson) CommitTimetable(c *gin.Context) {
+	var (
+		ctx               = c.Request.Context()
+		logger            = liblog.Must(ctx)
+		req               request.CommitTimetable
+		schoolIDHeaderVar = request.GetSchoolIDHeader(c)
+		schoolID          uuid.UUID
+		err               error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDHeaderVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req})
+	ctx = liblog.With(ctx, logger)
+
+	timetable, err := l.lessonService.CommitTimetable(ctx, convertCommitTimetableToServiceArgs(req, schoolID))
+	if err != nil {
+		logger.Errorf("failed to commit timetable: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewTimetable(timetable))
+}
+
+func convertCommitTimetableToServiceArgs(r request.CommitTimetable, schoolID uuid.UUID) lesson.CommitTimetableArgs {
+	groups := make([]lesson.GroupLessons, 0, len(r.Groups))
+
+	for _, g := range r.Groups {
+		lessons := make([]lesson.Lesson, 0, len(g.Lessons))
+
+		for _, l := range g.Lessons {
+			lessons = append(lessons, lesson.Lesson{
+				GroupSubjectID: l.GroupSubjectID,
+				TeacherID:      l.TeacherID,
+				AuditoriumID:   l.AuditoriumID,
+				StartTime:      l.StartTime,
+				EndTime:        l.EndTime,
+				Description:    l.Description,
+			})
+		}
+
+		groups = append(groups, lesson.GroupLessons{
+			GroupID: g.GroupID,
+			Lessons: lessons,
+		})
+	}
+
+	return lesson.CommitTimetableArgs{
+		SchoolID: schoolID,
+		WeekDate: r.WeekDate,
+		Groups:   groups,
+	}
 }
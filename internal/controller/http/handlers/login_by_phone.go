@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/service/auth"
+	"bum-service/pkg/liblog"
+)
+
+// SendPhoneLoginCode sends a one-time login code to the phone.
+func (a Auth) SendPhoneLoginCode(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		req    request.PhoneLoginCode
+	)
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	if err := a.authService.SendPhoneLoginCode(ctx, req.Phone); err != nil {
+		logger.Errorf("failed to send phone login code: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// LoginByPhone creates a new user token by phone and the one-time login code sent to it.
+func (a Auth) LoginByPhone(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		req    request.LoginByPhone
+	)
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	userID, err := a.authService.GetUserIDByPhoneAndCode(ctx, auth.PhoneLoginArgs{
+		Phone: req.Phone,
+		Code:  req.Code,
+		IP:    c.ClientIP(),
+	})
+	if err != nil {
+		logger.Errorf("failed to check login code: %v", c.Error(err))
+		return
+	}
+
+	userToken, err := a.login(c, userID)
+	if err != nil {
+		logger.Errorf("failed to login: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, userToken)
+}
@@ -22,7 +22,7 @@ func (l *Lesson) AddMark(c *gin.Context) {
 	)
 
 	if err = c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -36,39 +36,49 @@ func (l *Lesson) AddMark(c *gin.Context) {
 		Description: req.Description,
 	})
 	if err != nil {
-		logger.Errorf("failed add marks: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed add marks: %v", c.Error(err))
 		return
 	}
 
 	c.JSON(http.StatusCreated, markEntity)
 }
 
-// MarkByID returns mark by id.
+// MarkByID returns mark of the lesson by id.
 func (l *Lesson) MarkByID(c *gin.Context) {
 	var (
-		ctx      = c.Request.Context()
-		logger   = liblog.Must(ctx)
-		markID   = request.GetMarkIDPathVar(c)
-		markUUID uuid.UUID
-		err      error
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		lessonIDVar = request.GetLessonIDPathVar(c)
+		markIDVar   = request.GetMarkIDPathVar(c)
+		lessonID    uuid.UUID
+		markID      uuid.UUID
+		err         error
 	)
 
-	markUUID, err = uuid.Parse(markID)
-	if err != nil {
-		logger.Errorf("failed to parce to uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+	logger = logger.WithFields(liblog.Fields{"lesson_id": lessonIDVar, "mark_id": markIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if lessonID, err = uuid.Parse(lessonIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
-	logger = logger.WithFields(liblog.Fields{"mark_id": markID})
-	ctx = liblog.With(ctx, logger)
+	if markID, err = uuid.Parse(markIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
 
-	markEntity, err := l.lessonService.MarkByID(ctx, markUUID)
+	markEntity, err := l.lessonService.MarkByIDAndLessonID(ctx, markID, lessonID)
 	if err != nil {
-		logger.Errorf("failed get mark by id: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed get mark by id: %v", c.Error(err))
 		return
 	}
 
-	c.JSON(http.StatusCreated, markEntity)
+	if notModified(c, markEntity.UpdatedAt) {
+		return
+	}
+
+	c.JSON(http.StatusOK, markEntity)
 }
 
 // MarkList returns list of marks.
@@ -92,9 +102,131 @@ func (l *Lesson) MarkList(c *gin.Context) {
 
 	markEntity, err := l.lessonService.MarkByID(ctx, markUUID)
 	if err != nil {
-		logger.Errorf("failed get mark by id: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed get mark by id: %v", c.Error(err))
 		return
 	}
 
 	c.JSON(http.StatusCreated, markEntity)
 }
+
+// DeleteMark deletes mark of the lesson.
+func (l *Lesson) DeleteMark(c *gin.Context) {
+	var (
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		lessonIDVar = request.GetLessonIDPathVar(c)
+		markIDVar   = request.GetMarkIDPathVar(c)
+		lessonID    uuid.UUID
+		markID      uuid.UUID
+		err         error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"lesson_id": lessonIDVar, "mark_id": markIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if lessonID, err = uuid.Parse(lessonIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if markID, err = uuid.Parse(markIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = l.lessonService.DeleteMark(ctx, lessonID, markID); err != nil {
+		logger.Errorf("failed to delete mark: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RestoreMark restores deleted mark of the lesson.
+func (l *Lesson) RestoreMark(c *gin.Context) {
+	var (
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		lessonIDVar = request.GetLessonIDPathVar(c)
+		markIDVar   = request.GetMarkIDPathVar(c)
+		lessonID    uuid.UUID
+		markID      uuid.UUID
+		err         error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"lesson_id": lessonIDVar, "mark_id": markIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if lessonID, err = uuid.Parse(lessonIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if markID, err = uuid.Parse(markIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = l.lessonService.RestoreMark(ctx, lessonID, markID); err != nil {
+		logger.Errorf("failed to restore mark: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// UpdateMark updates mark of the lesson.
+func (l *Lesson) UpdateMark(c *gin.Context) {
+	var (
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		req         request.UpdateMark
+		lessonIDVar = request.GetLessonIDPathVar(c)
+		markIDVar   = request.GetMarkIDPathVar(c)
+		lessonID    uuid.UUID
+		markID      uuid.UUID
+		err         error
+	)
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"lesson_id": lessonIDVar, "mark_id": markIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if lessonID, err = uuid.Parse(lessonIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if markID, err = uuid.Parse(markIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	version, err := updateVersion(c, req.Version)
+	if err != nil {
+		logger.Errorf("failed to get version: %v", c.Error(err))
+		return
+	}
+
+	markEntity, err := l.lessonService.UpdateMark(
+		ctx,
+		lesson.UpdateMarkArgs{
+			ID:          markID,
+			LessonID:    lessonID,
+			Mark:        req.Mark,
+			Description: req.Description,
+			UpdatedAt:   version,
+		},
+	)
+	if err != nil {
+		logger.Errorf("failed to update mark: %v", c.Error(preconditionErr(c, err)))
+		return
+	}
+
+	setETag(c, markEntity.UpdatedAt)
+	c.JSON(http.StatusOK, markEntity)
+}
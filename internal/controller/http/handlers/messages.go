@@ -0,0 +1,264 @@
+package handlers
+
+import "bum-service/pkg/libi18n"
+
+// messages are messages of errors by their codes and of failed validation rules of request fields.
+//
+//nolint:gochecknoglobals,lll // it's catalog of messages
+var messages = libi18n.Catalog{
+	// Errors
+	"error.BAD_REQUEST": {
+		libi18n.English: "Bad request",
+		libi18n.Russian: "Некорректный запрос",
+		libi18n.Uzbek:   "Noto‘g‘ri so‘rov",
+		libi18n.Tajik:   "Дархости нодуруст",
+	},
+	"error.NOT_FOUND": {
+		libi18n.English: "Not found",
+		libi18n.Russian: "Не найдено",
+		libi18n.Uzbek:   "Topilmadi",
+		libi18n.Tajik:   "Ёфт нашуд",
+	},
+	"error.CONFLICT": {
+		libi18n.English: "Already exists",
+		libi18n.Russian: "Уже существует",
+		libi18n.Uzbek:   "Allaqachon mavjud",
+		libi18n.Tajik:   "Аллакай мавҷуд аст",
+	},
+	"error.BAD_REQUEST: STUDENT_ROSTER": {
+		libi18n.English: "The student roster has invalid rows, fix them and upload it again",
+		libi18n.Russian: "В списке учеников есть некорректные строки, исправьте их и загрузите список снова",
+		libi18n.Uzbek:   "O‘quvchilar ro‘yxatida noto‘g‘ri qatorlar bor, ularni tuzatib, ro‘yxatni qayta yuklang",
+		libi18n.Tajik:   "Дар рӯйхати хонандагон сатрҳои нодуруст ҳастанд, онҳоро ислоҳ карда, рӯйхатро аз нав бор кунед",
+	},
+	"error.BAD_REQUEST: SCHOOL_SETUP": {
+		libi18n.English: "The school setup has invalid entries, fix them and upload it again",
+		libi18n.Russian: "В настройке школы есть некорректные записи, исправьте их и загрузите настройку снова",
+		libi18n.Uzbek:   "Maktab sozlamasida noto‘g‘ri yozuvlar bor, ularni tuzatib, sozlamani qayta yuklang",
+		libi18n.Tajik:   "Дар танзимоти мактаб сабтҳои нодуруст ҳастанд, онҳоро ислоҳ карда, танзимотро аз нав бор кунед",
+	},
+	"error.CONFLICT: HAS_DEPENDENTS": {
+		libi18n.English: "There are dependent entries, delete or move them first",
+		libi18n.Russian: "Есть зависимые записи, сначала удалите или перенесите их",
+		libi18n.Uzbek:   "Bog‘liq yozuvlar mavjud, avval ularni o‘chiring yoki ko‘chiring",
+		libi18n.Tajik:   "Сабтҳои вобаста мавҷуданд, аввал онҳоро нест ё интиқол диҳед",
+	},
+	"error.CONFLICT: OUTDATED_VERSION": {
+		libi18n.English: "The entry was changed by someone else, reload it and try again",
+		libi18n.Russian: "Запись была изменена кем-то другим, обновите её и повторите попытку",
+		libi18n.Uzbek:   "Yozuv boshqa foydalanuvchi tomonidan o‘zgartirilgan, uni qayta yuklab, yana urinib ko‘ring",
+		libi18n.Tajik:   "Сабтро каси дигар тағйир додааст, онро аз нав бор карда, боз кӯшиш кунед",
+	},
+	"error.PRECONDITION_FAILED": {
+		libi18n.English: "The entry was changed since the version in If-Match header, reload it and try again",
+		libi18n.Russian: "Запись изменилась после версии из заголовка If-Match, обновите её и повторите попытку",
+		libi18n.Uzbek:   "Yozuv If-Match sarlavhasidagi versiyadan keyin o‘zgargan, uni qayta yuklab, yana urinib ko‘ring",
+		libi18n.Tajik:   "Сабт пас аз версияи сарлавҳаи If-Match тағйир ёфтааст, онро аз нав бор карда, боз кӯшиш кунед",
+	},
+	"error.PRECONDITION_REQUIRED": {
+		libi18n.English: "If-Match header or updated_at field is required",
+		libi18n.Russian: "Требуется заголовок If-Match или поле updated_at",
+		libi18n.Uzbek:   "If-Match sarlavhasi yoki updated_at maydoni majburiy",
+		libi18n.Tajik:   "Сарлавҳаи If-Match ё майдони updated_at ҳатмист",
+	},
+	"error.INTERNAL_SERVER_ERROR": {
+		libi18n.English: "Internal server error",
+		libi18n.Russian: "Внутренняя ошибка сервера",
+		libi18n.Uzbek:   "Serverning ichki xatosi",
+		libi18n.Tajik:   "Хатогии дохилии сервер",
+	},
+	"error.UNAUTHORIZED": {
+		libi18n.English: "Unauthorized",
+		libi18n.Russian: "Требуется авторизация",
+		libi18n.Uzbek:   "Avtorizatsiya talab qilinadi",
+		libi18n.Tajik:   "Ворид шудан лозим аст",
+	},
+	"error.FORBIDDEN": {
+		libi18n.English: "Forbidden",
+		libi18n.Russian: "Доступ запрещён",
+		libi18n.Uzbek:   "Ruxsat berilmagan",
+		libi18n.Tajik:   "Дастрасӣ манъ аст",
+	},
+	"error.AUTH_HEADER_IS_EMPTY": {
+		libi18n.English: "Auth header is empty",
+		libi18n.Russian: "Не передан заголовок авторизации",
+		libi18n.Uzbek:   "Avtorizatsiya sarlavhasi yuborilmagan",
+		libi18n.Tajik:   "Сарлавҳаи авторизатсия фиристода нашудааст",
+	},
+	"error.TOKEN_IS_EXPIRED": {
+		libi18n.English: "Token is expired",
+		libi18n.Russian: "Срок действия токена истёк",
+		libi18n.Uzbek:   "Token muddati tugagan",
+		libi18n.Tajik:   "Мӯҳлати токен гузаштааст",
+	},
+	"error.INVALID_TOKEN": {
+		libi18n.English: "Invalid token",
+		libi18n.Russian: "Недействительный токен",
+		libi18n.Uzbek:   "Token yaroqsiz",
+		libi18n.Tajik:   "Токен нодуруст аст",
+	},
+	"error.REFRESH_TOKEN_REUSED": {
+		libi18n.English: "Refresh token is already used",
+		libi18n.Russian: "Токен обновления уже использован",
+		libi18n.Uzbek:   "Yangilash tokeni allaqachon ishlatilgan",
+		libi18n.Tajik:   "Токени навсозӣ аллакай истифода шудааст",
+	},
+	"error.INVALID_USER_OR_PASSWORD": {
+		libi18n.English: "Invalid user or password",
+		libi18n.Russian: "Неверный пользователь или пароль",
+		libi18n.Uzbek:   "Foydalanuvchi yoki parol noto‘g‘ri",
+		libi18n.Tajik:   "Корбар ё парол нодуруст аст",
+	},
+	"error.INVALID_LOGIN_CODE": {
+		libi18n.English: "Invalid or expired login code",
+		libi18n.Russian: "Неверный или просроченный код входа",
+		libi18n.Uzbek:   "Kirish kodi noto‘g‘ri yoki muddati o‘tgan",
+		libi18n.Tajik:   "Рамзи воридшавӣ нодуруст аст ё мӯҳлаташ гузаштааст",
+	},
+	"error.TOO_MANY_LOGIN_ATTEMPTS": {
+		libi18n.English: "Too many failed login attempts",
+		libi18n.Russian: "Слишком много неудачных попыток входа",
+		libi18n.Uzbek:   "Muvaffaqiyatsiz kirish urinishlari juda ko‘p",
+		libi18n.Tajik:   "Кӯшишҳои бемуваффақияти воридшавӣ хеле зиёданд",
+	},
+
+	// Validation rules
+	"validation.required": {
+		libi18n.English: "is required",
+		libi18n.Russian: "обязательное поле",
+		libi18n.Uzbek:   "majburiy maydon",
+		libi18n.Tajik:   "майдони ҳатмӣ",
+	},
+	"validation.required_with": {
+		libi18n.English: "is required when {0} is set",
+		libi18n.Russian: "обязательно, если указано {0}",
+		libi18n.Uzbek:   "{0} ko‘rsatilganda majburiy",
+		libi18n.Tajik:   "ҳангоми нишон додани {0} ҳатмист",
+	},
+	"validation.required_without": {
+		libi18n.English: "is required when {0} is not set",
+		libi18n.Russian: "обязательно, если не указано {0}",
+		libi18n.Uzbek:   "{0} ko‘rsatilmaganda majburiy",
+		libi18n.Tajik:   "ҳангоми нишон надодани {0} ҳатмист",
+	},
+	"validation.email": {
+		libi18n.English: "must be a valid email",
+		libi18n.Russian: "некорректный адрес электронной почты",
+		libi18n.Uzbek:   "elektron pochta manzili noto‘g‘ri",
+		libi18n.Tajik:   "суроғаи почтаи электронӣ нодуруст аст",
+	},
+	"validation.e164": {
+		libi18n.English: "must be a phone number in international format, e.g. +992900000000",
+		libi18n.Russian: "номер телефона должен быть в международном формате, например +992900000000",
+		libi18n.Uzbek:   "telefon raqami xalqaro formatda bo‘lishi kerak, masalan +992900000000",
+		libi18n.Tajik:   "рақами телефон бояд дар формати байналмилалӣ бошад, масалан +992900000000",
+	},
+	"validation.url": {
+		libi18n.English: "must be a valid URL",
+		libi18n.Russian: "некорректный URL",
+		libi18n.Uzbek:   "URL noto‘g‘ri",
+		libi18n.Tajik:   "URL нодуруст аст",
+	},
+	"validation.uuid": {
+		libi18n.English: "must be a valid identifier",
+		libi18n.Russian: "некорректный идентификатор",
+		libi18n.Uzbek:   "identifikator noto‘g‘ri",
+		libi18n.Tajik:   "идентификатор нодуруст аст",
+	},
+	"validation.min": {
+		libi18n.English: "must be at least {0}",
+		libi18n.Russian: "должно быть не меньше {0}",
+		libi18n.Uzbek:   "kamida {0} bo‘lishi kerak",
+		libi18n.Tajik:   "бояд на камтар аз {0} бошад",
+	},
+	"validation.gte": {
+		libi18n.English: "must be at least {0}",
+		libi18n.Russian: "должно быть не меньше {0}",
+		libi18n.Uzbek:   "kamida {0} bo‘lishi kerak",
+		libi18n.Tajik:   "бояд на камтар аз {0} бошад",
+	},
+	"validation.max": {
+		libi18n.English: "must be at most {0}",
+		libi18n.Russian: "должно быть не больше {0}",
+		libi18n.Uzbek:   "ko‘pi bilan {0} bo‘lishi kerak",
+		libi18n.Tajik:   "бояд на зиёдтар аз {0} бошад",
+	},
+	"validation.lte": {
+		libi18n.English: "must be at most {0}",
+		libi18n.Russian: "должно быть не больше {0}",
+		libi18n.Uzbek:   "ko‘pi bilan {0} bo‘lishi kerak",
+		libi18n.Tajik:   "бояд на зиёдтар аз {0} бошад",
+	},
+	"validation.gt": {
+		libi18n.English: "must be greater than {0}",
+		libi18n.Russian: "должно быть больше {0}",
+		libi18n.Uzbek:   "{0} dan katta bo‘lishi kerak",
+		libi18n.Tajik:   "бояд аз {0} зиёд бошад",
+	},
+	"validation.lt": {
+		libi18n.English: "must be less than {0}",
+		libi18n.Russian: "должно быть меньше {0}",
+		libi18n.Uzbek:   "{0} dan kichik bo‘lishi kerak",
+		libi18n.Tajik:   "бояд аз {0} кам бошад",
+	},
+	"validation.len": {
+		libi18n.English: "length must be {0}",
+		libi18n.Russian: "длина должна быть {0}",
+		libi18n.Uzbek:   "uzunligi {0} bo‘lishi kerak",
+		libi18n.Tajik:   "дарозӣ бояд {0} бошад",
+	},
+	"validation.oneof": {
+		libi18n.English: "must be one of: {0}",
+		libi18n.Russian: "должно быть одним из: {0}",
+		libi18n.Uzbek:   "quyidagilardan biri bo‘lishi kerak: {0}",
+		libi18n.Tajik:   "бояд яке аз инҳо бошад: {0}",
+	},
+	"validation.numeric": {
+		libi18n.English: "must be a number",
+		libi18n.Russian: "должно быть числом",
+		libi18n.Uzbek:   "son bo‘lishi kerak",
+		libi18n.Tajik:   "бояд рақам бошад",
+	},
+	"validation.datetime": {
+		libi18n.English: "must be a date in format {0}",
+		libi18n.Russian: "должно быть датой в формате {0}",
+		libi18n.Uzbek:   "{0} formatidagi sana bo‘lishi kerak",
+		libi18n.Tajik:   "бояд сана дар формати {0} бошад",
+	},
+	"validation.type": {
+		libi18n.English: "must be of type {0}",
+		libi18n.Russian: "должно иметь тип {0}",
+		libi18n.Uzbek:   "{0} turida bo‘lishi kerak",
+		libi18n.Tajik:   "бояд навъи {0} дошта бошад",
+	},
+	"validation.unique": {
+		libi18n.English: "is already taken",
+		libi18n.Russian: "уже занято",
+		libi18n.Uzbek:   "allaqachon band",
+		libi18n.Tajik:   "аллакай истифода шудааст",
+	},
+	"validation.password": {
+		libi18n.English: "is not correct",
+		libi18n.Russian: "неверный пароль",
+		libi18n.Uzbek:   "parol noto‘g‘ri",
+		libi18n.Tajik:   "парол нодуруст аст",
+	},
+	"validation.token": {
+		libi18n.English: "is invalid or expired",
+		libi18n.Russian: "недействителен или истёк",
+		libi18n.Uzbek:   "yaroqsiz yoki muddati o‘tgan",
+		libi18n.Tajik:   "нодуруст аст ё мӯҳлаташ гузаштааст",
+	},
+	"validation.code": {
+		libi18n.English: "is wrong or expired",
+		libi18n.Russian: "неверный или просроченный код",
+		libi18n.Uzbek:   "kod noto‘g‘ri yoki muddati o‘tgan",
+		libi18n.Tajik:   "рамз нодуруст аст ё мӯҳлаташ гузаштааст",
+	},
+	"validation.invalid": {
+		libi18n.English: "is invalid",
+		libi18n.Russian: "некорректное значение",
+		libi18n.Uzbek:   "qiymat noto‘g‘ri",
+		libi18n.Tajik:   "қимат нодуруст аст",
+	},
+}
@@ -15,8 +15,10 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 
+	"bum-service/internal/controller/http/handlers/request"
 	"bum-service/internal/domain"
 	"bum-service/pkg/liberror"
+	"bum-service/pkg/libi18n"
 	"bum-service/pkg/liblog"
 )
 
@@ -24,7 +26,9 @@ import (
 type ContextKey string
 
 const (
-	userIDContextKey ContextKey = "X-User-Id"
+	userIDContextKey    ContextKey = "X-User-Id"
+	sessionIDContextKey ContextKey = "X-Session-Id"
+	actorContextKey     ContextKey = "X-Actor"
 
 	tokenHeader     = "Authorization"
 	tokenHeaderType = "Bearer"
@@ -51,6 +55,7 @@ func LoggingEndpointMiddleware(logger liblog.Logger) gin.HandlerFunc {
 }
 
 // ErrorHandlingMiddleware middleware for handling errors.
+// Errors are responded in the language of the Accept-Language header.
 func ErrorHandlingMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Next()
@@ -59,7 +64,10 @@ func ErrorHandlingMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		err := c.Errors.Last().Err
+		language := libi18n.ParseAcceptLanguage(c.GetHeader(libi18n.AcceptLanguageHeader))
+		c.Header("Content-Language", string(language))
+
+		err := localizeError(c.Errors.Last().Err, language)
 
 		logger := liblog.Must(c.Request.Context())
 		if errorEncoder := liberror.ErrorEncoder(c.Request.Context(), err, c.Writer); errorEncoder != nil {
@@ -228,38 +236,15 @@ func (a Auth) AuthMiddleware(c *gin.Context) {
 		return
 	}
 
-	var (
-		tokenString = authTokens[1]
-		userClaims  *UserClaims
-	)
-
-	token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, func(_ *jwt.Token) (any, error) {
-		return a.jwtSecret, nil
-	})
+	userClaims, err := a.parseToken(authTokens[1], accessTokenType)
 	if err != nil {
-		switch {
-		case errors.Is(err, jwt.ErrTokenExpired) || errors.Is(err, jwt.ErrTokenNotValidYet):
-			logger.Errorf("token is expired: %v: %v\n", err, c.Error(domain.ErrTokenIsExpired).Error())
-			c.Abort()
-
-			return
-		default:
-			logger.Errorf("invalid token: %v: %v\n ", err, c.Error(domain.ErrInvalidToken).Error())
-			c.Abort()
-
-			return
-		}
-	}
-
-	userClaims, ok := token.Claims.(*UserClaims)
-	if userClaims == nil || !ok {
-		logger.Errorf("invalid token: %v\n", c.Error(domain.ErrInvalidToken).Error())
+		logger.Errorf("invalid token: %v\n", c.Error(err).Error())
 		c.Abort()
 
 		return
 	}
 
-	userUUID, err := uuid.Parse(userClaims.UserID)
+	userUUID, err := uuid.Parse(userClaims.Subject)
 	if err != nil {
 		logger.Errorf("invalid token: %v: %v\n", err, c.Error(domain.ErrInvalidToken).Error())
 		c.Abort()
@@ -267,7 +252,47 @@ func (a Auth) AuthMiddleware(c *gin.Context) {
 		return
 	}
 
-	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), userIDContextKey, userUUID))
+	ctx := context.WithValue(c.Request.Context(), userIDContextKey, userUUID)
+
+	if sessionUUID, errSession := uuid.Parse(userClaims.SessionID); errSession == nil {
+		ctx = context.WithValue(ctx, sessionIDContextKey, sessionUUID)
+	}
+
+	if userClaims.ActiveRole != nil {
+		ctx = domain.WithActiveRole(ctx, userClaims.ActiveRole.toDomain())
+	}
+
+	c.Request = c.Request.WithContext(ctx)
+}
+
+// parseToken checks the signature, the expiration, the issuer and the type of the token issued by us
+// and returns its claims.
+func (a Auth) parseToken(tokenString, tokenType string) (*UserClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, a.tokenConfig.Keys.Keyfunc,
+		jwt.WithValidMethods(a.tokenConfig.Keys.Methods()),
+		jwt.WithIssuer(a.tokenConfig.Issuer),
+		jwt.WithAudience(a.tokenConfig.Issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) || errors.Is(err, jwt.ErrTokenNotValidYet) {
+			return nil, fmt.Errorf("%w: %w", domain.ErrTokenIsExpired, err)
+		}
+
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidToken, err)
+	}
+
+	userClaims, ok := token.Claims.(*UserClaims)
+	if userClaims == nil || !ok {
+		return nil, domain.ErrInvalidToken
+	}
+
+	// access tokens can not refresh sessions and refresh tokens can not call APIs.
+	if userClaims.TokenType != tokenType {
+		return nil, domain.ErrInvalidToken
+	}
+
+	return userClaims, nil
 }
 
 // MustGetUserID gets userID from context.
@@ -276,3 +301,58 @@ func (a Auth) AuthMiddleware(c *gin.Context) {
 func MustGetUserID(c *gin.Context) uuid.UUID {
 	return c.Request.Context().Value(userIDContextKey).(uuid.UUID)
 }
+
+// GetSessionID gets auth session id from context.
+func GetSessionID(c *gin.Context) (uuid.UUID, bool) {
+	sessionID, ok := c.Request.Context().Value(sessionIDContextKey).(uuid.UUID)
+
+	return sessionID, ok
+}
+
+// GetActiveRole gets the role the user acts as from context, false if no role is selected.
+func GetActiveRole(c *gin.Context) (domain.ActiveRole, bool) {
+	return domain.ActiveRoleFromContext(c.Request.Context())
+}
+
+// MustGetActiveRole gets the role the user acts as from context.
+// It must be used only on routes which require a selected role.
+func MustGetActiveRole(c *gin.Context) domain.ActiveRole {
+	role, ok := GetActiveRole(c)
+	if !ok {
+		panic("active role is not selected")
+	}
+
+	return role
+}
+
+// GetActiveSchoolID gets the school of the role the user acts as from context,
+// false if no role is selected or the role is not within a school.
+func GetActiveSchoolID(c *gin.Context) (uuid.UUID, bool) {
+	role, ok := GetActiveRole(c)
+	if !ok || role.SchoolID == nil {
+		return uuid.Nil, false
+	}
+
+	return *role.SchoolID, true
+}
+
+// GetListSchoolID gets the school lists are scoped by from the school_id header,
+// the school of the active role is used when the header is absent.
+func GetListSchoolID(c *gin.Context) string {
+	if schoolID := request.GetSchoolIDHeader(c); schoolID != "" {
+		return schoolID
+	}
+
+	if schoolID, ok := GetActiveSchoolID(c); ok {
+		return schoolID.String()
+	}
+
+	return ""
+}
+
+// MustGetActor gets actor from context. It must be used after Policy middlewares.
+//
+//nolint:forcetypeassert // it's must method so it's ok here.
+func MustGetActor(c *gin.Context) domain.Actor {
+	return c.Request.Context().Value(actorContextKey).(domain.Actor)
+}
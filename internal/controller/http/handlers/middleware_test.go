@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"bum-service/internal/domain"
+)
+
+func TestGetListSchoolID(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	var (
+		headerSchoolID = uuid.New()
+		activeSchoolID = uuid.New()
+		activeRole     = domain.ActiveRole{RoleID: uuid.New(), Role: domain.RoleTeacher, SchoolID: &activeSchoolID}
+		ownerRole      = domain.ActiveRole{RoleID: uuid.New(), Role: domain.RoleOwner}
+	)
+
+	tests := []struct {
+		name       string
+		header     string
+		activeRole *domain.ActiveRole
+		want       string
+	}{
+		{"header without active role", headerSchoolID.String(), nil, headerSchoolID.String()},
+		{"header over active role", headerSchoolID.String(), &activeRole, headerSchoolID.String()},
+		{"active role without header", "", &activeRole, activeSchoolID.String()},
+		{"active role out of school", "", &ownerRole, ""},
+		{"neither header nor active role", "", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/students", nil)
+
+			if tt.header != "" {
+				c.Request.Header.Set("school_id", tt.header)
+			}
+
+			if tt.activeRole != nil {
+				c.Request = c.Request.WithContext(domain.WithActiveRole(c.Request.Context(), *tt.activeRole))
+			}
+
+			assert.Equal(t, tt.want, GetListSchoolID(c))
+		})
+	}
+}
@@ -102,6 +102,20 @@ func (mr *MockIEduOrganizationServiceMockRecorder) CreateEduOrganization(ctx, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEduOrganization", reflect.TypeOf((*MockIEduOrganizationService)(nil).CreateEduOrganization), ctx, args)
 }
 
+// DeleteEduOrganization mocks base method.
+func (m *MockIEduOrganizationService) DeleteEduOrganization(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEduOrganization", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEduOrganization indicates an expected call of DeleteEduOrganization.
+func (mr *MockIEduOrganizationServiceMockRecorder) DeleteEduOrganization(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEduOrganization", reflect.TypeOf((*MockIEduOrganizationService)(nil).DeleteEduOrganization), ctx, id)
+}
+
 // EduOrganizationByID mocks base method.
 func (m *MockIEduOrganizationService) EduOrganizationByID(ctx context.Context, id uuid.UUID) (domain.EduOrganization, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +147,20 @@ func (mr *MockIEduOrganizationServiceMockRecorder) EduOrganizationList(ctx, filt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EduOrganizationList", reflect.TypeOf((*MockIEduOrganizationService)(nil).EduOrganizationList), ctx, filters)
 }
 
+// RestoreEduOrganization mocks base method.
+func (m *MockIEduOrganizationService) RestoreEduOrganization(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreEduOrganization", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreEduOrganization indicates an expected call of RestoreEduOrganization.
+func (mr *MockIEduOrganizationServiceMockRecorder) RestoreEduOrganization(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEduOrganization", reflect.TypeOf((*MockIEduOrganizationService)(nil).RestoreEduOrganization), ctx, id)
+}
+
 // UpdateEduOrganizationByID mocks base method.
 func (m *MockIEduOrganizationService) UpdateEduOrganizationByID(ctx context.Context, args eduorganization.UpdateEduOrganizationArgs) (domain.EduOrganization, error) {
 	m.ctrl.T.Helper()
@@ -32,7 +32,7 @@ func (o *Owner) AddOwner(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -79,6 +79,10 @@ func (o *Owner) OwnerByID(c *gin.Context) { //nolint:revive // It's handler
 		return
 	}
 
+	if notModified(c, ownerEntity.UpdatedAt) {
+		return
+	}
+
 	c.JSON(http.StatusOK, response.NewOwner(ownerEntity))
 }
 
@@ -119,18 +123,24 @@ func (o *Owner) OwnerList(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
 	logger = logger.WithFields(liblog.Fields{"request": req})
 	ctx = liblog.With(ctx, logger)
 
+	listFilter, err := req.ListFilter.Fields(domain.OwnerListFields(), domain.NewPagination(req.Page, req.PerPage))
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	list, total, err := o.ownerService.OwnerList(
 		ctx,
 		domain.NewOwnerListFilter(
 			domain.NewDateFilter(req.CreatedDate.DateFrom(), req.CreatedDate.DateTill()),
-			domain.NewListFilter(req.SortOrder, domain.NewPagination(req.Page, req.PerPage)),
+			listFilter,
 			req.OrganizationUUIDs(),
 		),
 	)
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"bum-service/internal/domain"
+)
+
+const (
+	// NextCursorHeader is the header with the cursor of the next page of the list which is not wrapped
+	// into the pagination.
+	NextCursorHeader = "Next-Cursor"
+	// PrevCursorHeader is the header with the cursor of the previous page of the list which is not wrapped
+	// into the pagination.
+	PrevCursorHeader = "Prev-Cursor"
+)
+
+// setCursorHeaders sets the headers with the cursors of the adjacent pages, nil if there is no such page.
+func setCursorHeaders(c *gin.Context, next, prev *domain.Cursor) {
+	if next != nil {
+		c.Header(NextCursorHeader, next.String())
+	}
+
+	if prev != nil {
+		c.Header(PrevCursorHeader, prev.String())
+	}
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/service/auth"
+	"bum-service/pkg/liblog"
+)
+
+// ChangePassword changes password of the user, the other sessions of the user are revoked.
+func (a Auth) ChangePassword(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		userID = MustGetUserID(c)
+		req    request.ChangePassword
+	)
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	args := auth.ChangePasswordArgs{
+		UserID:          userID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	}
+
+	if sessionID, ok := GetSessionID(c); ok {
+		args.SessionID = &sessionID
+	}
+
+	if err := a.authService.ChangePassword(ctx, args); err != nil {
+		logger.Errorf("failed to change password: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// ForgotPassword sends a password reset link to the email.
+func (a Auth) ForgotPassword(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		req    request.ForgotPassword
+	)
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	if err := a.authService.ForgotPassword(ctx, req.Email); err != nil {
+		logger.Errorf("failed to send password reset link: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// ResetPassword sets a new password by the password reset token.
+func (a Auth) ResetPassword(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		req    request.ResetPassword
+	)
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	if err := a.authService.ResetPassword(ctx, req.Token, req.NewPassword); err != nil {
+		logger.Errorf("failed to reset password: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// SendEmailVerification sends an email verification link to the email of the user.
+func (a Auth) SendEmailVerification(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		userID = MustGetUserID(c)
+	)
+
+	if err := a.authService.SendEmailVerification(ctx, userID); err != nil {
+		logger.Errorf("failed to send email verification link: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// VerifyEmail verifies email by the email verification token.
+func (a Auth) VerifyEmail(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		req    request.VerifyEmail
+	)
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	if err := a.authService.VerifyEmail(ctx, req.Token); err != nil {
+		logger.Errorf("failed to verify email: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/liblog"
+)
+
+// Policy is access policy handler. It must be used after AuthMiddleware.
+type Policy struct {
+	policyService IPolicyService
+}
+
+// NewPolicy creates a new access policy handler.
+func NewPolicy(policyService IPolicyService) Policy {
+	return Policy{
+		policyService: policyService,
+	}
+}
+
+// Authorize allows only actors with one of the given roles.
+func (p Policy) Authorize(roles ...domain.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p.authorize(c, func(ctx context.Context, actor domain.Actor) error {
+			return p.policyService.AuthorizeRoles(ctx, actor, roles...)
+		})
+	}
+}
+
+// AuthorizeEduOrganization allows only actors with one of the given roles within the organization.
+func (p Policy) AuthorizeEduOrganization(
+	organizationID func(*gin.Context) string,
+	roles ...domain.Role,
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := p.parseID(c, organizationID, "organization id")
+		if !ok {
+			return
+		}
+
+		p.authorize(c, func(ctx context.Context, actor domain.Actor) error {
+			return p.policyService.AuthorizeOrganization(ctx, actor, id, roles...)
+		})
+	}
+}
+
+// AuthorizeSchool allows only actors with one of the given roles within the school.
+func (p Policy) AuthorizeSchool(schoolID func(*gin.Context) string, roles ...domain.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := p.parseID(c, schoolID, "school id")
+		if !ok {
+			return
+		}
+
+		p.authorize(c, func(ctx context.Context, actor domain.Actor) error {
+			return p.policyService.AuthorizeSchool(ctx, actor, id, roles...)
+		})
+	}
+}
+
+// AuthorizeLesson allows only the teacher of the lesson and the school management.
+func (p Policy) AuthorizeLesson(lessonID func(*gin.Context) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, ok := p.parseID(c, lessonID, "lesson id")
+		if !ok {
+			return
+		}
+
+		p.authorize(c, func(ctx context.Context, actor domain.Actor) error {
+			return p.policyService.AuthorizeLesson(ctx, actor, id)
+		})
+	}
+}
+
+// AuthorizeGroupSubject allows only the teacher of the group subject in the school and the school management.
+func (p Policy) AuthorizeGroupSubject(schoolID, groupSubjectID func(*gin.Context) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		schoolUUID, ok := p.parseID(c, schoolID, "school id")
+		if !ok {
+			return
+		}
+
+		groupSubjectUUID, ok := p.parseID(c, groupSubjectID, "group subject id")
+		if !ok {
+			return
+		}
+
+		p.authorize(c, func(ctx context.Context, actor domain.Actor) error {
+			return p.policyService.AuthorizeGroupSubject(ctx, actor, schoolUUID, groupSubjectUUID)
+		})
+	}
+}
+
+// parseID parses uuid with the given getter and aborts request if it is invalid.
+func (Policy) parseID(c *gin.Context, getter func(*gin.Context) string, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(getter(c))
+	if err != nil {
+		liblog.Must(c.Request.Context()).Errorf(
+			"failed to parse %s: %v", name, c.Error(domain.NewBadRequest(fmt.Sprintf("invalid %s", name))),
+		)
+		c.Abort()
+
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+// authorize loads actor of the request and checks access with the given check.
+func (p Policy) authorize(c *gin.Context, check func(ctx context.Context, actor domain.Actor) error) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+	)
+
+	actor, ok := ctx.Value(actorContextKey).(domain.Actor)
+	if !ok {
+		var err error
+
+		actor, err = p.policyService.Actor(ctx, MustGetUserID(c))
+		if err != nil {
+			logger.Errorf("failed to get actor: %v", c.Error(err))
+			c.Abort()
+
+			return
+		}
+
+		ctx = context.WithValue(ctx, actorContextKey, actor)
+		c.Request = c.Request.WithContext(ctx)
+	}
+
+	if err := check(ctx, actor); err != nil {
+		logger.Errorf("access denied: %v", c.Error(err))
+		c.Abort()
+
+		return
+	}
+
+	c.Next()
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/controller/http/handlers/response"
+	"bum-service/internal/domain"
+	"bum-service/internal/service/school"
+	"bum-service/pkg/liblog"
+)
+
+// PromoteGroups promotes groups of the school to the next academic year.
+func (s School) PromoteGroups(c *gin.Context) {
+	var (
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		req             request.PromoteGroups
+		schoolIDPathVar = request.GetSchoolIDPathVar(c)
+		schoolID        uuid.UUID
+		err             error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req, "school_id": schoolID})
+	ctx = liblog.With(ctx, logger)
+
+	promotions, err := s.schoolService.PromoteGroups(ctx, school.PromoteGroupsArgs{
+		SchoolID:           schoolID,
+		FromAcademicYearID: req.FromAcademicYearID,
+		ToAcademicYearID:   req.ToAcademicYearID,
+		HeldBack:           req.HeldBack,
+		Graduating:         req.Graduating,
+	})
+	if err != nil {
+		logger.Errorf("failed to promote groups: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.NewGroupPromotions(promotions))
+}
@@ -0,0 +1,36 @@
+package request
+
+import (
+	"time"
+)
+
+// CreateAcademicYear is a request to create an academic year of the school.
+type CreateAcademicYear struct {
+	Name     string               `json:"name" binding:"required,max=50"`
+	DateFrom string               `json:"date_from" binding:"required,datetime=2006-01-02"`
+	DateTill string               `json:"date_till" binding:"required,datetime=2006-01-02"`
+	Terms    []AcademicYearPeriod `json:"terms" binding:"required,min=1,dive"`
+	Holidays []AcademicYearPeriod `json:"holidays" binding:"omitempty,dive"`
+}
+
+// Period returns academic year period dates.
+func (c CreateAcademicYear) Period() (from, till time.Time) {
+	return parseDate(c.DateFrom), parseDate(c.DateTill)
+}
+
+// AcademicYearPeriod is a term or holidays of the academic year.
+type AcademicYearPeriod struct {
+	Name     string `json:"name" binding:"required,max=50"`
+	DateFrom string `json:"date_from" binding:"required,datetime=2006-01-02"`
+	DateTill string `json:"date_till" binding:"required,datetime=2006-01-02"`
+}
+
+// Period returns term or holidays period dates.
+func (a AcademicYearPeriod) Period() (from, till time.Time) {
+	return parseDate(a.DateFrom), parseDate(a.DateTill)
+}
+
+// RollOverAcademicYear is a request to create the next academic year from the given one.
+type RollOverAcademicYear struct {
+	Name string `json:"name" binding:"required,max=50"`
+}
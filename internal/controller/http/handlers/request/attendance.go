@@ -0,0 +1,28 @@
+package request
+
+import (
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// RecordAttendance is a request to record attendance of the lesson.
+type RecordAttendance struct {
+	Students []StudentAttendance `json:"students" binding:"required,min=1,dive"`
+}
+
+// StudentAttendance is attendance of a single student.
+type StudentAttendance struct {
+	StudentID   uuid.UUID               `json:"student_id" binding:"required,uuid"`
+	Status      domain.AttendanceStatus `json:"status" binding:"required,oneof=present absent late excused"`
+	Description *string                 `json:"description"`
+}
+
+// AttendanceList is a request for listing attendance of the school.
+type AttendanceList struct {
+	Period DateFilter
+
+	GroupID   *uuid.UUID `form:"group_id" binding:"omitempty,uuid"`
+	StudentID *uuid.UUID `form:"student_id" binding:"omitempty,uuid"`
+	TermID    *uuid.UUID `form:"term_id" binding:"omitempty,uuid"`
+}
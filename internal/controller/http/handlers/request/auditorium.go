@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 
 	"bum-service/pkg/liblog"
+	"bum-service/pkg/utils"
 )
 
 // CreateAuditorium is a request for CreateAuditorium.
@@ -26,3 +27,12 @@ func (c CreateAuditorium) LogFields() liblog.Fields {
 type AuditoriumList struct {
 	ListFilter
 }
+
+// UpdateAuditorium is a request to update auditorium with JSON merge patch.
+type UpdateAuditorium struct {
+	Name            *string                `json:"name" binding:"omitnil,min=1"`
+	SchoolSubjectID utils.Patch[uuid.UUID] `json:"school_subject_id"`
+	Description     utils.Patch[string]    `json:"description"`
+
+	Version
+}
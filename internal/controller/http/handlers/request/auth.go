@@ -1,7 +1,52 @@
 package request
 
+import "github.com/google/uuid"
+
 // LoginByEmail is struct for login by email.
 type LoginByEmail struct {
 	Email    string `json:"email" binding:"email,required"`
 	Password string `json:"password" binding:"required"`
 }
+
+// PhoneLoginCode is a request for sending a one-time login code to the phone.
+type PhoneLoginCode struct {
+	Phone string `json:"phone" binding:"required,e164"`
+}
+
+// LoginByPhone is a request for login by phone and the one-time login code sent to it.
+type LoginByPhone struct {
+	Phone string `json:"phone" binding:"required,e164"`
+	Code  string `json:"code" binding:"required,numeric,len=6"`
+}
+
+// RefreshToken is a request for exchanging the refresh token for new user tokens.
+type RefreshToken struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// SelectRole is a request for selecting the role the user acts as, empty role id clears the selection.
+type SelectRole struct {
+	RoleID *uuid.UUID `json:"role_id"`
+}
+
+// ChangePassword is a request for changing password of the user.
+type ChangePassword struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required,min=8"`
+}
+
+// ForgotPassword is a request for sending a password reset link.
+type ForgotPassword struct {
+	Email string `json:"email" binding:"email,required"`
+}
+
+// ResetPassword is a request for setting a new password by the password reset token.
+type ResetPassword struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=8"`
+}
+
+// VerifyEmail is a request for verifying email by the email verification token.
+type VerifyEmail struct {
+	Token string `json:"token" binding:"required"`
+}
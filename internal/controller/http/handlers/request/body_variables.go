@@ -0,0 +1,61 @@
+package request
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	schoolIDBodyVar       = "school_id"        // schoolIDBodyVar is school id body field.
+	organizationIDBodyVar = "organization_id"  // organizationIDBodyVar is organization id body field.
+	lessonIDBodyVar       = "lesson_id"        // lessonIDBodyVar is lesson id body field.
+	groupSubjectIDBodyVar = "group_subject_id" // groupSubjectIDBodyVar is group subject id body field.
+)
+
+// GetSchoolIDBodyVar gets school id from JSON request body.
+func GetSchoolIDBodyVar(c *gin.Context) string {
+	return bodyVar(c, schoolIDBodyVar)
+}
+
+// GetOrganizationIDBodyVar gets organization id from JSON request body.
+func GetOrganizationIDBodyVar(c *gin.Context) string {
+	return bodyVar(c, organizationIDBodyVar)
+}
+
+// GetLessonIDBodyVar gets lesson id from JSON request body.
+func GetLessonIDBodyVar(c *gin.Context) string {
+	return bodyVar(c, lessonIDBodyVar)
+}
+
+// GetGroupSubjectIDBodyVar gets group subject id from JSON request body.
+func GetGroupSubjectIDBodyVar(c *gin.Context) string {
+	return bodyVar(c, groupSubjectIDBodyVar)
+}
+
+// bodyVar reads a single string field from JSON request body.
+// The body is restored, so it can be bound again by the handler.
+func bodyVar(c *gin.Context, field string) string {
+	if c.Request.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return ""
+	}
+
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	var fields map[string]any
+
+	if err = json.Unmarshal(body, &fields); err != nil {
+		return ""
+	}
+
+	value, _ := fields[field].(string)
+
+	return value
+}
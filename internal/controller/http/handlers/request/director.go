@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 
 	"bum-service/pkg/liblog"
+	"bum-service/pkg/utils"
 )
 
 // AddDirector is a request to create a new director.
@@ -28,7 +29,13 @@ func (c AddDirector) LogFields() liblog.Fields {
 type DirectorList struct {
 	ListFilter
 
-	SchoolIDsFilter
-
 	CreatedDate DateFilter
 }
+
+// UpdateDirector is a request to update director contacts with JSON merge patch.
+type UpdateDirector struct {
+	Phone utils.Patch[string] `json:"phone" binding:"omitempty,e164"`
+	Email utils.Patch[string] `json:"email" binding:"omitempty,email"`
+
+	Version
+}
@@ -0,0 +1,41 @@
+package request
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Gradebook is a request for gradebook of the group subject, period is either dates or a term.
+type Gradebook struct {
+	GroupSubjectID uuid.UUID  `form:"group_subject_id" binding:"required,uuid"`
+	DateFrom       string     `form:"date_from" binding:"required_without=TermID,omitempty,datetime=2006-01-02"`
+	DateTill       string     `form:"date_till" binding:"required_without=TermID,omitempty,datetime=2006-01-02"`
+	TermID         *uuid.UUID `form:"term_id" binding:"omitnil,uuid"`
+}
+
+// Period returns gradebook period dates.
+func (g Gradebook) Period() (from, till time.Time) {
+	return parseDate(g.DateFrom), parseDate(g.DateTill)
+}
+
+// FinaliseGrades is a request to set final grades of the group subject for the period or the term.
+type FinaliseGrades struct {
+	GroupSubjectID uuid.UUID           `json:"group_subject_id" binding:"required,uuid"`
+	DateFrom       string              `json:"date_from" binding:"required_without=TermID,omitempty,datetime=2006-01-02"`
+	DateTill       string              `json:"date_till" binding:"required_without=TermID,omitempty,datetime=2006-01-02"`
+	TermID         *uuid.UUID          `json:"term_id" binding:"omitnil,uuid"`
+	Lock           bool                `json:"lock"`
+	Grades         []StudentFinalGrade `json:"grades" binding:"required,min=1,dive"`
+}
+
+// Period returns period dates of the final grades.
+func (f FinaliseGrades) Period() (from, till time.Time) {
+	return parseDate(f.DateFrom), parseDate(f.DateTill)
+}
+
+// StudentFinalGrade is final grade of a single student.
+type StudentFinalGrade struct {
+	StudentID uuid.UUID `json:"student_id" binding:"required,uuid"`
+	Mark      *string   `json:"mark" binding:"omitempty,max=16"`
+}
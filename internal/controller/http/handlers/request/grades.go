@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 
 	"bum-service/pkg/liblog"
+	"bum-service/pkg/utils"
 )
 
 // CreateGradeStandard is a request to create a new grade standard.
@@ -12,6 +13,7 @@ type CreateGradeStandard struct {
 	Name           string        `json:"name" binding:"required"`
 	EducationYears int8          `json:"education_years" binding:"required"`
 	Description    *string       `json:"description" binding:"required"`
+	GradingScaleID *uuid.UUID    `json:"grading_scale_id" binding:"omitempty,uuid"`
 	Grades         []CreateGrade `json:"grades" binding:"required,min=1"`
 }
 
@@ -24,11 +26,12 @@ type CreateGrade struct {
 // LogFields returns a list of fields for logging.
 func (c CreateGradeStandard) LogFields() liblog.Fields {
 	return liblog.Fields{
-		"organization_id": c.OrganizationID,
-		"name":            c.Name,
-		"education_years": c.EducationYears,
-		"description":     c.Description,
-		"grades":          c.Grades,
+		"organization_id":  c.OrganizationID,
+		"name":             c.Name,
+		"education_years":  c.EducationYears,
+		"description":      c.Description,
+		"grading_scale_id": c.GradingScaleID,
+		"grades":           c.Grades,
 	}
 }
 
@@ -36,3 +39,13 @@ func (c CreateGradeStandard) LogFields() liblog.Fields {
 type GradeStandardList struct {
 	ListFilter
 }
+
+// UpdateGradeStandard is a request to update grade standard with JSON merge patch.
+type UpdateGradeStandard struct {
+	Name           *string                `json:"name" binding:"omitnil,min=1"`
+	EducationYears *int8                  `json:"education_years" binding:"omitnil,min=1"`
+	Description    utils.Patch[string]    `json:"description"`
+	GradingScaleID utils.Patch[uuid.UUID] `json:"grading_scale_id"`
+
+	Version
+}
@@ -0,0 +1,26 @@
+package request
+
+import (
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// CreateGradingScale is a request to create a new grading scale of the organization.
+type CreateGradingScale struct {
+	OrganizationID uuid.UUID               `json:"organization_id" binding:"required,uuid"`
+	Name           string                  `json:"name" binding:"required,max=50"`
+	Kind           domain.GradingScaleKind `json:"kind" binding:"required"`
+	Values         []GradingScaleValue     `json:"values" binding:"omitempty,dive"`
+}
+
+// GradingScaleValue is a request of allowed mark of the grading scale.
+type GradingScaleValue struct {
+	Value  string  `json:"value" binding:"required,max=16"`
+	Weight float64 `json:"weight"`
+}
+
+// GradingScaleList request model for listing of grading scales.
+type GradingScaleList struct {
+	OrganizationID *uuid.UUID `form:"organization_id" binding:"omitempty,uuid"`
+}
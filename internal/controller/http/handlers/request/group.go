@@ -10,6 +10,8 @@ import (
 type CreateGroup struct {
 	Name    string    `json:"name" binding:"required"`
 	GradeID uuid.UUID `json:"grade_id" binding:"required"`
+
+	AcademicYearID *uuid.UUID `json:"academic_year_id,omitempty" binding:"omitnil,uuid"`
 }
 
 // LogFields returns a list of fields for logging.
@@ -17,6 +19,8 @@ func (c CreateGroup) LogFields() liblog.Fields {
 	return liblog.Fields{
 		"name":     c.Name,
 		"grade_id": c.GradeID,
+
+		"academic_year_id": c.AcademicYearID,
 	}
 }
 
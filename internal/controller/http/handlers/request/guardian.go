@@ -25,9 +25,9 @@ func (c AssignStudentGuardian) LogFields() liblog.Fields {
 // StudentGuardianList request model for listing of student guardians.
 type StudentGuardianList struct {
 	ListFilter
+	SearchFilter
 
 	GroupIDsFilter
-	SchoolIDsFilter
 	OrganizationIDsFilter
 
 	CreatedDate DateFilter
@@ -3,10 +3,22 @@ package request
 import "github.com/gin-gonic/gin"
 
 const (
-	schoolIDHeaderVar = "school_id" // schoolIDParam is school id param.
+	schoolIDHeaderVar    = "school_id"     // schoolIDParam is school id param.
+	ifMatchHeaderVar     = "If-Match"      // ifMatchHeaderVar is entity tag of the version the update is based on.
+	ifNoneMatchHeaderVar = "If-None-Match" // ifNoneMatchHeaderVar is entity tags of the versions the client has.
 )
 
 // GetSchoolIDHeader gets edu school id from header variable.
 func GetSchoolIDHeader(c *gin.Context) string {
 	return c.GetHeader(schoolIDHeaderVar)
 }
+
+// GetIfMatchHeader gets entity tag of the version the update is based on from header variable.
+func GetIfMatchHeader(c *gin.Context) string {
+	return c.GetHeader(ifMatchHeaderVar)
+}
+
+// GetIfNoneMatchHeader gets entity tags of the versions the client has from header variable.
+func GetIfNoneMatchHeader(c *gin.Context) string {
+	return c.GetHeader(ifNoneMatchHeaderVar)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 
 	"bum-service/pkg/liblog"
+	"bum-service/pkg/utils"
 )
 
 // AddHeadmaster is a request to create a new headmaster.
@@ -28,7 +29,13 @@ func (c AddHeadmaster) LogFields() liblog.Fields {
 type HeadmasterList struct {
 	ListFilter
 
-	SchoolIDsFilter
-
 	CreatedDate DateFilter
 }
+
+// UpdateHeadmaster is a request to update headmaster contacts with JSON merge patch.
+type UpdateHeadmaster struct {
+	Phone utils.Patch[string] `json:"phone" binding:"omitempty,e164"`
+	Email utils.Patch[string] `json:"email" binding:"omitempty,email"`
+
+	Version
+}
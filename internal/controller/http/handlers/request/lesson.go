@@ -4,6 +4,12 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+const (
+	bellTimeLayout = "15:04" // bellTimeLayout is layout of bell slot time.
 )
 
 // AssignWeekLessons is a request to add week lessons.
@@ -26,10 +32,96 @@ type LessonItem struct {
 // LessonsList request model for listing of lessons.
 type LessonsList struct {
 	ListFilter
+	CursorFilter
 
 	Period DateFilter
 
-	SchoolID  *uuid.UUID `form:"school_id" binding:"omitempty,uuid"`
 	TeacherID *uuid.UUID `form:"teacher_id" binding:"omitempty,uuid"`
 	GroupID   *uuid.UUID `form:"group_id" binding:"omitempty,uuid"`
+	TermID    *uuid.UUID `form:"term_id" binding:"omitempty,uuid"`
+}
+
+// GenerateTimetable is a request to generate a draft timetable of the school for a week.
+type GenerateTimetable struct {
+	WeekDate         time.Time        `json:"week_date" binding:"required"`
+	Bells            []BellSlot       `json:"bells" binding:"required,min=1,dive"`
+	Weekdays         []time.Weekday   `json:"weekdays" binding:"omitempty,dive,min=0,max=6"`
+	MaxLessonsPerDay int              `json:"max_lessons_per_day" binding:"omitempty,min=1"`
+	TeacherDaysOff   []TeacherDaysOff `json:"teacher_days_off" binding:"omitempty,dive"`
+}
+
+// BellSlot is a lesson slot of the school day in 15:04 format.
+type BellSlot struct {
+	Start string `json:"start" binding:"required,datetime=15:04"`
+	End   string `json:"end" binding:"required,datetime=15:04"`
+}
+
+// toDomain converts bell slot to offsets from the beginning of the day.
+//
+//nolint:errcheck // err always is nil because we already checked it before.
+func (b BellSlot) toDomain() domain.BellSlot {
+	start, _ := time.Parse(bellTimeLayout, b.Start)
+	end, _ := time.Parse(bellTimeLayout, b.End)
+
+	return domain.BellSlot{
+		Start: time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute,
+		End:   time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute,
+	}
+}
+
+// TeacherDaysOff is week days on which teacher is not available.
+type TeacherDaysOff struct {
+	TeacherID uuid.UUID      `json:"teacher_id" binding:"required,uuid"`
+	Weekdays  []time.Weekday `json:"weekdays" binding:"required,dive,min=0,max=6"`
+}
+
+// BellSlots converts bell slots to offsets from the beginning of the day.
+func (g GenerateTimetable) BellSlots() domain.BellSlots {
+	bells := make(domain.BellSlots, 0, len(g.Bells))
+
+	for _, bell := range g.Bells {
+		bells = append(bells, bell.toDomain())
+	}
+
+	return bells
+}
+
+// TeacherDaysOffMap converts teacher days off to map by teacher id.
+func (g GenerateTimetable) TeacherDaysOffMap() map[uuid.UUID][]time.Weekday {
+	daysOff := make(map[uuid.UUID][]time.Weekday, len(g.TeacherDaysOff))
+
+	for _, teacher := range g.TeacherDaysOff {
+		daysOff[teacher.TeacherID] = append(daysOff[teacher.TeacherID], teacher.Weekdays...)
+	}
+
+	return daysOff
+}
+
+// CommitTimetable is a request to assign week lessons of several groups at once.
+type CommitTimetable struct {
+	WeekDate time.Time        `json:"week_date" binding:"required"`
+	Groups   []TimetableGroup `json:"groups" binding:"required,min=1,dive"`
+}
+
+// TimetableGroup is week lessons of a group.
+type TimetableGroup struct {
+	GroupID uuid.UUID         `json:"group_id" binding:"required,uuid"`
+	Lessons []TimetableLesson `json:"lessons" binding:"dive"`
+}
+
+// TimetableLesson is lesson of a timetable group.
+type TimetableLesson struct {
+	GroupSubjectID uuid.UUID  `json:"group_subject_id" binding:"required,uuid"`
+	TeacherID      *uuid.UUID `json:"teacher_id" binding:"omitempty,uuid"`
+	AuditoriumID   uuid.UUID  `json:"auditorium_id" binding:"required,uuid"`
+	StartTime      time.Time  `json:"start_time" binding:"required"`
+	EndTime        time.Time  `json:"end_time" binding:"required"`
+	Description    *string    `json:"description"`
+}
+
+// OverrideLesson is a request to change a single lesson occurrence.
+type OverrideLesson struct {
+	TeacherID    *uuid.UUID `json:"teacher_id" binding:"omitempty,uuid"`
+	AuditoriumID *uuid.UUID `json:"auditorium_id" binding:"omitempty,uuid"`
+	Description  *string    `json:"description"`
 }
@@ -1,6 +1,10 @@
 package request
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+
+	"bum-service/pkg/utils"
+)
 
 // AddMark is mark request for adding mark.
 type AddMark struct {
@@ -9,3 +13,11 @@ type AddMark struct {
 	Mark        string    `json:"mark" binding:"required"`
 	Description *string   `json:"description"`
 }
+
+// UpdateMark is a request to update mark with JSON merge patch.
+type UpdateMark struct {
+	Mark        *string             `json:"mark" binding:"omitnil,min=1"`
+	Description utils.Patch[string] `json:"description"`
+
+	Version
+}
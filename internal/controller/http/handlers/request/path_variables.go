@@ -21,6 +21,10 @@ const (
 	studyPlanStatusPathVar   = "study_plan_status"   // studyPlanStatusPathVar is study plan status param.
 	studentIDPathVar         = "student_id"          // studentIDPathVar is student id param
 	markIDPathVar            = "mark_id"             // markIDPathVar is mark id param
+	lessonIDPathVar          = "lesson_id"           // lessonIDPathVar is lesson id param
+	templateIDPathVar        = "template_id"         // templateIDPathVar is timetable template id param
+	gradingScaleIDPathVar    = "grading_scale_id"    // gradingScaleIDPathVar is grading scale id param
+	academicYearIDPathVar    = "academic_year_id"    // academicYearIDPathVar is academic year id param
 )
 
 // GetEduOrganizationPathVar gets edu organization id from path variable.
@@ -108,3 +112,15 @@ func GetStudentIDPathVar(c *gin.Context) string { return c.Param(studentIDPathVa
 
 // GetMarkIDPathVar gets mark id from path variable.
 func GetMarkIDPathVar(c *gin.Context) string { return c.Param(markIDPathVar) }
+
+// GetLessonIDPathVar gets lesson id from path variable.
+func GetLessonIDPathVar(c *gin.Context) string { return c.Param(lessonIDPathVar) }
+
+// GetTemplateIDPathVar gets timetable template id from path variable.
+func GetTemplateIDPathVar(c *gin.Context) string { return c.Param(templateIDPathVar) }
+
+// GetGradingScaleIDPathVar gets grading scale id from path variable.
+func GetGradingScaleIDPathVar(c *gin.Context) string { return c.Param(gradingScaleIDPathVar) }
+
+// GetAcademicYearIDPathVar gets academic year id from path variable.
+func GetAcademicYearIDPathVar(c *gin.Context) string { return c.Param(academicYearIDPathVar) }
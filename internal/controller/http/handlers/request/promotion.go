@@ -0,0 +1,13 @@
+package request
+
+import (
+	"github.com/google/uuid"
+)
+
+// PromoteGroups is a request to promote groups of the school to the next academic year.
+type PromoteGroups struct {
+	FromAcademicYearID *uuid.UUID  `json:"from_academic_year_id" binding:"omitnil,uuid"`
+	ToAcademicYearID   uuid.UUID   `json:"to_academic_year_id" binding:"required,uuid"`
+	HeldBack           []uuid.UUID `json:"held_back_student_ids" binding:"omitempty,dive,uuid"`
+	Graduating         []uuid.UUID `json:"graduating_student_ids" binding:"omitempty,dive,uuid"`
+}
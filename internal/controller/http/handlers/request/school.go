@@ -44,6 +44,8 @@ type SchoolList struct {
 // GroupList is a request to get group list.
 type GroupList struct {
 	ListFilter
+
+	AcademicYearID *uuid.UUID `form:"academic_year_id" binding:"omitempty,uuid"`
 }
 
 // CreateSchoolSubject is a request to create a new subject for school.
@@ -71,6 +73,7 @@ type AssignStudyPlan struct {
 	ID          *uuid.UUID `json:"id,omitempty" binding:"omitnil,uuid"`
 	Title       string     `json:"title" binding:"required"`
 	Description *string    `json:"description,omitempty" binding:"omitnil"`
+	TermID      *uuid.UUID `json:"term_id,omitempty" binding:"omitnil,uuid"`
 }
 
 // UpdateSchool is request for updating school.
@@ -82,22 +85,3 @@ type UpdateSchool struct {
 
 	GradeStandardID *uuid.UUID `json:"grade_standard_id,omitempty" binding:"omitempty,uuid"`
 }
-
-// SchoolIDsFilter school ids filter for embedding.
-type SchoolIDsFilter struct {
-	SchoolIDs []string `form:"school_ids[]" binding:"omitempty,dive,uuid"`
-}
-
-// SchoolUUIDs converts SchoolIDs to list of uuid.
-func (s SchoolIDsFilter) SchoolUUIDs() []uuid.UUID {
-	if len(s.SchoolIDs) == 0 {
-		return []uuid.UUID{}
-	}
-
-	uuids := make([]uuid.UUID, len(s.SchoolIDs))
-	for idx := range s.SchoolIDs {
-		uuids[idx] = uuid.MustParse(s.SchoolIDs[idx])
-	}
-
-	return uuids
-}
@@ -0,0 +1,21 @@
+package request
+
+import "bum-service/internal/domain"
+
+// Search is a request of the global search of students, teachers, guardians and school staff.
+type Search struct {
+	Query string   `form:"q" binding:"required,max=100"`
+	Types []string `form:"types[]" binding:"omitempty,dive,oneof=student teacher guardian director headmaster"`
+	Limit int      `form:"limit,default=20" binding:"min=1,max=100"`
+}
+
+// HitTypes returns list of the kinds of the search hits.
+func (s Search) HitTypes() domain.SearchHitTypes {
+	types := make(domain.SearchHitTypes, 0, len(s.Types))
+
+	for _, hitType := range s.Types {
+		types = append(types, domain.SearchHitType(hitType))
+	}
+
+	return types
+}
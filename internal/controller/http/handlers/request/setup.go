@@ -0,0 +1,44 @@
+package request
+
+import (
+	"mime/multipart"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// ImportSchoolSetup is a request to import the school setup, either the YAML or JSON file
+// or the CSV or XLSX sheets of its sections.
+type ImportSchoolSetup struct {
+	// File is the YAML or JSON document of the whole setup.
+	File *multipart.FileHeader `form:"file"`
+
+	Teachers      *multipart.FileHeader `form:"teachers"`
+	Subjects      *multipart.FileHeader `form:"subjects"`
+	Groups        *multipart.FileHeader `form:"groups"`
+	GroupSubjects *multipart.FileHeader `form:"group_subjects"`
+
+	// AcademicYearID is the academic year the groups are searched in and created in.
+	AcademicYearID *uuid.UUID `form:"academic_year_id" binding:"omitnil,uuid"`
+	// DryRun only reports the changes of the setup, nothing is imported.
+	DryRun bool `form:"dry_run"`
+}
+
+// Sheets returns the uploaded sheets of the setup by their sections.
+func (i ImportSchoolSetup) Sheets() map[string]*multipart.FileHeader {
+	sheets := make(map[string]*multipart.FileHeader)
+
+	for section, sheet := range map[string]*multipart.FileHeader{
+		domain.SchoolSetupTeachers:      i.Teachers,
+		domain.SchoolSetupSubjects:      i.Subjects,
+		domain.SchoolSetupGroups:        i.Groups,
+		domain.SchoolSetupGroupSubjects: i.GroupSubjects,
+	} {
+		if sheet != nil {
+			sheets[section] = sheet
+		}
+	}
+
+	return sheets
+}
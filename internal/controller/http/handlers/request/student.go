@@ -1,6 +1,13 @@
 package request
 
-import "github.com/google/uuid"
+import (
+	"mime/multipart"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/pkg/utils"
+)
 
 // AddStudent is add student request.
 type AddStudent struct {
@@ -12,10 +19,65 @@ type AddStudent struct {
 // StudentList request model for listing of students.
 type StudentList struct {
 	ListFilter
+	CursorFilter
+	SearchFilter
 
 	GroupIDsFilter
-	SchoolIDsFilter
 	OrganizationIDsFilter
 
 	CreatedDate DateFilter
 }
+
+// ImportStudents is a request to import the students of the CSV or XLSX roster.
+type ImportStudents struct {
+	File *multipart.FileHeader `form:"file" binding:"required"`
+	// AcademicYearID is the academic year the groups of the roster are searched in.
+	AcademicYearID *uuid.UUID `form:"academic_year_id" binding:"omitnil,uuid"`
+	// DryRun only validates the roster, nothing is imported.
+	DryRun bool `form:"dry_run"`
+}
+
+// TransferStudent is a request to transfer the student into another group.
+type TransferStudent struct {
+	// SchoolID is the school the student is transferred from.
+	SchoolID uuid.UUID `json:"school_id" binding:"required,uuid"`
+	GroupID  uuid.UUID `json:"group_id" binding:"required,uuid"`
+	Date     *string   `json:"date,omitempty" binding:"omitnil,datetime=2006-01-02"`
+}
+
+// TransferDate returns the first day in the new group.
+func (t TransferStudent) TransferDate() *time.Time {
+	if t.Date == nil {
+		return nil
+	}
+
+	date := parseDate(*t.Date)
+
+	return &date
+}
+
+// StudentMemberships is a request for the student group membership history.
+type StudentMemberships struct {
+	Date *string `form:"date" binding:"omitnil,datetime=2006-01-02"`
+}
+
+// OnDate returns the date to get the group of the student on.
+func (s StudentMemberships) OnDate() *time.Time {
+	if s.Date == nil {
+		return nil
+	}
+
+	date := parseDate(*s.Date)
+
+	return &date
+}
+
+// UpdateStudent is a request to update personal info of the student with JSON merge patch.
+type UpdateStudent struct {
+	FirstName  *string             `json:"first_name" binding:"omitnil,min=1"`
+	LastName   *string             `json:"last_name" binding:"omitnil,min=1"`
+	MiddleName utils.Patch[string] `json:"middle_name"`
+	Gender     *string             `json:"gender" binding:"omitnil,min=1"`
+
+	Version
+}
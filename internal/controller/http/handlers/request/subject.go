@@ -1,6 +1,9 @@
 package request
 
-import "bum-service/pkg/liblog"
+import (
+	"bum-service/pkg/liblog"
+	"bum-service/pkg/utils"
+)
 
 // CreateSubject is a request for CreateSubject.
 type CreateSubject struct {
@@ -20,3 +23,11 @@ func (c CreateSubject) LogFields() liblog.Fields {
 type SubjectList struct {
 	ListFilter
 }
+
+// UpdateSubject is a request to update subject with JSON merge patch.
+type UpdateSubject struct {
+	Name        *string             `json:"name" binding:"omitnil,min=1"`
+	Description utils.Patch[string] `json:"description"`
+
+	Version
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 
 	"bum-service/pkg/liblog"
+	"bum-service/pkg/utils"
 )
 
 // AddTeacher is request for create teacher.
@@ -27,10 +28,18 @@ func (a AddTeacher) LogFields() liblog.Fields {
 // TeacherList request model for listing of teachers.
 type TeacherList struct {
 	ListFilter
+	SearchFilter
 
-	SchoolIDsFilter
 	GroupIDsFilter
 	OrganizationIDsFilter
 
 	CreatedDate DateFilter
 }
+
+// UpdateTeacher is a request to update teacher contacts with JSON merge patch.
+type UpdateTeacher struct {
+	Phone utils.Patch[string] `json:"phone" binding:"omitempty,e164"`
+	Email utils.Patch[string] `json:"email" binding:"omitempty,email"`
+
+	Version
+}
@@ -0,0 +1,76 @@
+package request
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// CreateTimetableTemplate is a request to create a recurring weekly timetable of the group.
+type CreateTimetableTemplate struct {
+	GroupID    uuid.UUID                    `json:"group_id" binding:"required,uuid"`
+	Name       string                       `json:"name" binding:"required,max=255"`
+	DateFrom   string                       `json:"date_from" binding:"required,datetime=2006-01-02"`
+	DateTill   string                       `json:"date_till" binding:"required,datetime=2006-01-02"`
+	Slots      []TimetableTemplateSlot      `json:"slots" binding:"required,min=1,dive"`
+	Exclusions []TimetableTemplateExclusion `json:"exclusions" binding:"omitempty,dive"`
+}
+
+// TimetableTemplateSlot is a weekly lesson of the timetable template.
+type TimetableTemplateSlot struct {
+	GroupSubjectID uuid.UUID    `json:"group_subject_id" binding:"required,uuid"`
+	TeacherID      *uuid.UUID   `json:"teacher_id" binding:"omitempty,uuid"`
+	AuditoriumID   uuid.UUID    `json:"auditorium_id" binding:"required,uuid"`
+	Weekday        time.Weekday `json:"weekday" binding:"min=0,max=6"`
+	Bell           BellSlot     `json:"bell" binding:"required"`
+	Description    *string      `json:"description"`
+}
+
+// TimetableTemplateExclusion is a period without lessons, e.g. holidays.
+type TimetableTemplateExclusion struct {
+	DateFrom string  `json:"date_from" binding:"required,datetime=2006-01-02"`
+	DateTill string  `json:"date_till" binding:"required,datetime=2006-01-02"`
+	Reason   *string `json:"reason" binding:"omitempty,max=255"`
+}
+
+// Period returns template period dates.
+func (c CreateTimetableTemplate) Period() (from, till time.Time) {
+	return parseDate(c.DateFrom), parseDate(c.DateTill)
+}
+
+// BellSlot returns slot bell as offsets from the beginning of the day.
+func (t TimetableTemplateSlot) BellSlot() domain.BellSlot {
+	return t.Bell.toDomain()
+}
+
+// Period returns exclusion period dates.
+func (e TimetableTemplateExclusion) Period() (from, till time.Time) {
+	return parseDate(e.DateFrom), parseDate(e.DateTill)
+}
+
+// TimetableTemplateList is a request to get timetable templates of the school.
+type TimetableTemplateList struct {
+	GroupID *uuid.UUID `form:"group_id" binding:"omitempty,uuid"`
+}
+
+// MaterialiseTimetableTemplate is a request to create template lessons for the period.
+type MaterialiseTimetableTemplate struct {
+	DateFrom string `json:"date_from" binding:"required,datetime=2006-01-02"`
+	DateTill string `json:"date_till" binding:"required,datetime=2006-01-02"`
+}
+
+// Period returns materialisation period dates.
+func (m MaterialiseTimetableTemplate) Period() (from, till time.Time) {
+	return parseDate(m.DateFrom), parseDate(m.DateTill)
+}
+
+// parseDate parses date in 2006-01-02 format.
+//
+//nolint:errcheck // err always is nil because we already checked it before.
+func parseDate(date string) time.Time {
+	t, _ := time.ParseInLocation(time.DateOnly, date, time.UTC)
+
+	return t
+}
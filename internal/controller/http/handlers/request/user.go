@@ -31,9 +31,10 @@ func (c AddUser) LogInfo() liblog.Fields {
 // UserList is a request for User list.
 type UserList struct {
 	ListFilter
+	CursorFilter
+	SearchFilter
 
 	OrganizationIDsFilter
-	SchoolIDsFilter
 	Roles []string `form:"roles[]" binding:"omitempty"`
 
 	Emails []string `form:"emails[]" binding:"omitempty,dive,email"`
@@ -1,6 +1,10 @@
 package request
 
-import "time"
+import (
+	"time"
+
+	"bum-service/internal/domain"
+)
 
 // Pagination is structure of pagination request.
 type Pagination struct {
@@ -9,11 +13,32 @@ type Pagination struct {
 }
 
 // ListFilter is structure of list request.
+// Sort is the comma separated fields the list is sorted by, descending ones are prefixed with "-",
+// e.g. "last_name,-created_at", it takes precedence over SortOrder. Every filter is the condition of one field,
+// e.g. "created_at>=2025-01-01", "gender=male" or "grade_id in (1, 2)".
 type ListFilter struct {
-	SortOrder string `form:"sort_order,default=DESC" binding:"oneof=DESC ASC"`
+	SortOrder string   `form:"sort_order,default=DESC" binding:"oneof=DESC ASC"`
+	Sort      string   `form:"sort" binding:"omitempty,max=256"`
+	Filters   []string `form:"filter[]" binding:"omitempty,dive,max=4096"`
 	Pagination
 }
 
+// Fields returns the list filter of the list sorted and filtered by its fields.
+func (f ListFilter) Fields(fields domain.ListFields, pagination domain.Pagination) (domain.ListFilter, error) {
+	return domain.NewFieldsListFilter(f.SortOrder, f.Sort, f.Filters, fields, pagination)
+}
+
+// CursorFilter is the cursor of the page from next_cursor or prev_cursor of the list response,
+// page is ignored if it's set. The list must be requested with the same filters and sort order.
+type CursorFilter struct {
+	Cursor string `form:"cursor" binding:"omitempty,max=512"`
+}
+
+// SearchFilter is the full-text and fuzzy search query of users by their names, email and phone.
+type SearchFilter struct {
+	Query string `form:"q" binding:"omitempty,max=100"`
+}
+
 // DateFilter date time filter for embedding.
 type DateFilter struct {
 	From *string `form:"date_from" binding:"omitempty" time_format:"2006-01-02"`
@@ -45,3 +70,9 @@ func (f DateFilter) DateTill() *time.Time {
 
 	return &t
 }
+
+// Version is the version of the entity the patch request is based on, it's the time of the last update
+// of the entity from its response. It's required unless the version is sent in If-Match header.
+type Version struct {
+	UpdatedAt time.Time `json:"updated_at"`
+}
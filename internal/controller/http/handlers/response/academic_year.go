@@ -0,0 +1,89 @@
+package response
+
+import (
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/utils"
+)
+
+// AcademicYear is academic year response.
+type AcademicYear struct {
+	ID       uuid.UUID `json:"id"`
+	SchoolID uuid.UUID `json:"school_id"`
+	Name     string    `json:"name"`
+	DateFrom string    `json:"date_from"`
+	DateTill string    `json:"date_till"`
+	Terms    []Term    `json:"terms"`
+	Holidays []Holiday `json:"holidays"`
+
+	CreatedAt utils.RFC3339Time  `json:"created_at"`
+	UpdatedAt utils.RFC3339Time  `json:"updated_at"`
+	DeletedAt *utils.RFC3339Time `json:"deleted_at,omitempty"`
+}
+
+// Term is academic year term response.
+type Term struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	Position int       `json:"position"`
+	DateFrom string    `json:"date_from"`
+	DateTill string    `json:"date_till"`
+}
+
+// Holiday is academic year holiday response.
+type Holiday struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	DateFrom string    `json:"date_from"`
+	DateTill string    `json:"date_till"`
+}
+
+// NewAcademicYear converts domain academic year into response.
+func NewAcademicYear(year domain.AcademicYear) AcademicYear {
+	resp := AcademicYear{
+		ID:       year.ID,
+		SchoolID: year.SchoolID,
+		Name:     year.Name,
+		DateFrom: year.DateFrom.Format(dateLayout),
+		DateTill: year.DateTill.Format(dateLayout),
+		Terms:    make([]Term, 0, len(year.Terms)),
+		Holidays: make([]Holiday, 0, len(year.Holidays)),
+
+		CreatedAt: utils.RFC3339Time(year.CreatedAt),
+		UpdatedAt: utils.RFC3339Time(year.UpdatedAt),
+		DeletedAt: (*utils.RFC3339Time)(year.DeletedAt),
+	}
+
+	for _, term := range year.Terms {
+		resp.Terms = append(resp.Terms, Term{
+			ID:       term.ID,
+			Name:     term.Name,
+			Position: term.Position,
+			DateFrom: term.DateFrom.Format(dateLayout),
+			DateTill: term.DateTill.Format(dateLayout),
+		})
+	}
+
+	for _, holiday := range year.Holidays {
+		resp.Holidays = append(resp.Holidays, Holiday{
+			ID:       holiday.ID,
+			Name:     holiday.Name,
+			DateFrom: holiday.DateFrom.Format(dateLayout),
+			DateTill: holiday.DateTill.Format(dateLayout),
+		})
+	}
+
+	return resp
+}
+
+// NewAcademicYears converts domain academic years into response.
+func NewAcademicYears(list domain.AcademicYears) []AcademicYear {
+	resp := make([]AcademicYear, 0, len(list))
+
+	for _, year := range list {
+		resp = append(resp, NewAcademicYear(year))
+	}
+
+	return resp
+}
@@ -0,0 +1,88 @@
+package response
+
+import (
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/utils"
+)
+
+// Attendance is attendance response.
+type Attendance struct {
+	ID          uuid.UUID               `json:"id"`
+	LessonID    uuid.UUID               `json:"lesson_id"`
+	StudentID   uuid.UUID               `json:"student_id"`
+	Status      domain.AttendanceStatus `json:"status"`
+	Description *string                 `json:"description"`
+
+	CreatedAt utils.RFC3339Time  `json:"created_at"`
+	UpdatedAt utils.RFC3339Time  `json:"updated_at"`
+	DeletedAt *utils.RFC3339Time `json:"deleted_at,omitempty"`
+}
+
+// AttendanceSummary is attendance summary of a student.
+type AttendanceSummary struct {
+	StudentID         uuid.UUID `json:"student_id"`
+	Lessons           int       `json:"lessons"`
+	Present           int       `json:"present"`
+	Absent            int       `json:"absent"`
+	Late              int       `json:"late"`
+	Excused           int       `json:"excused"`
+	Absences          int       `json:"absences"`
+	ExcusedPercentage float64   `json:"excused_percentage"`
+}
+
+// AttendanceList is attendance list response with summaries.
+type AttendanceList struct {
+	Items     []Attendance        `json:"items"`
+	Summaries []AttendanceSummary `json:"summaries"`
+}
+
+// NewAttendance converts domain attendance into response.
+func NewAttendance(attendance domain.Attendance) Attendance {
+	return Attendance{
+		ID:          attendance.ID,
+		LessonID:    attendance.LessonID,
+		StudentID:   attendance.StudentID,
+		Status:      attendance.Status,
+		Description: attendance.Description,
+
+		CreatedAt: utils.RFC3339Time(attendance.CreatedAt),
+		UpdatedAt: utils.RFC3339Time(attendance.UpdatedAt),
+		DeletedAt: (*utils.RFC3339Time)(attendance.DeletedAt),
+	}
+}
+
+// NewAttendances converts domain attendances into response.
+func NewAttendances(list domain.Attendances) []Attendance {
+	attendances := make([]Attendance, 0, len(list))
+
+	for _, attendance := range list {
+		attendances = append(attendances, NewAttendance(attendance))
+	}
+
+	return attendances
+}
+
+// NewAttendanceList converts domain attendances and summaries into response.
+func NewAttendanceList(list domain.Attendances, summaries domain.AttendanceSummaries) AttendanceList {
+	resp := AttendanceList{
+		Items:     NewAttendances(list),
+		Summaries: make([]AttendanceSummary, 0, len(summaries)),
+	}
+
+	for _, summary := range summaries {
+		resp.Summaries = append(resp.Summaries, AttendanceSummary{
+			StudentID:         summary.StudentID,
+			Lessons:           summary.Lessons,
+			Present:           summary.Present,
+			Absent:            summary.Absent,
+			Late:              summary.Late,
+			Excused:           summary.Excused,
+			Absences:          summary.Absences(),
+			ExcusedPercentage: summary.ExcusedPercentage(),
+		})
+	}
+
+	return resp
+}
@@ -1,5 +1,13 @@
 package response
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
 // UserToken represent user token response.
 type UserToken struct {
 	AccessToken  string `json:"access_token"`
@@ -13,3 +21,33 @@ func NewUserToken(accessToken, refreshToken string) UserToken {
 		RefreshToken: refreshToken,
 	}
 }
+
+// AuthSession is user auth session response.
+type AuthSession struct {
+	ID        uuid.UUID `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	IP        string    `json:"ip"`
+	Current   bool      `json:"current"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewAuthSessions converts domain auth sessions into response, currentID is the session of the request.
+func NewAuthSessions(sessions domain.AuthSessions, currentID uuid.UUID) []AuthSession {
+	resp := make([]AuthSession, 0, len(sessions))
+
+	for _, session := range sessions {
+		resp = append(resp, AuthSession{
+			ID:        session.ID,
+			UserAgent: session.UserAgent,
+			IP:        session.IP,
+			Current:   session.ID == currentID,
+			ExpiresAt: session.ExpiresAt,
+			CreatedAt: session.CreatedAt,
+			UpdatedAt: session.UpdatedAt,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,90 @@
+package response
+
+import (
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/utils"
+)
+
+// Gradebook is gradebook response.
+type Gradebook struct {
+	GroupSubjectID uuid.UUID      `json:"group_subject_id"`
+	DateFrom       string         `json:"date_from"`
+	DateTill       string         `json:"date_till"`
+	Lessons        []Lesson       `json:"lessons"`
+	Rows           []GradebookRow `json:"rows"`
+}
+
+// GradebookRow is marks of a student in the gradebook response.
+type GradebookRow struct {
+	StudentID  uuid.UUID   `json:"student_id"`
+	Marks      []Mark      `json:"marks"`
+	Average    float64     `json:"average"`
+	FinalGrade *FinalGrade `json:"final_grade,omitempty"`
+}
+
+// Mark is mark response.
+type Mark struct {
+	ID          uuid.UUID `json:"id"`
+	LessonID    uuid.UUID `json:"lesson_id"`
+	Mark        string    `json:"mark"`
+	Weight      float64   `json:"weight"`
+	Description *string   `json:"description"`
+}
+
+// FinalGrade is final grade response.
+type FinalGrade struct {
+	ID       uuid.UUID          `json:"id"`
+	Mark     string             `json:"mark"`
+	Weight   float64            `json:"weight"`
+	Average  float64            `json:"average"`
+	LockedAt *utils.RFC3339Time `json:"locked_at,omitempty"`
+}
+
+// NewGradebook converts domain gradebook into response.
+func NewGradebook(gradebook domain.Gradebook) Gradebook {
+	resp := Gradebook{
+		GroupSubjectID: gradebook.GroupSubjectID,
+		DateFrom:       gradebook.DateFrom.Format(dateLayout),
+		DateTill:       gradebook.DateTill.Format(dateLayout),
+		Lessons:        make([]Lesson, 0, len(gradebook.Lessons)),
+		Rows:           make([]GradebookRow, 0, len(gradebook.Rows)),
+	}
+
+	for _, lesson := range gradebook.Lessons {
+		resp.Lessons = append(resp.Lessons, NewLesson(lesson))
+	}
+
+	for _, row := range gradebook.Rows {
+		respRow := GradebookRow{
+			StudentID: row.StudentID,
+			Marks:     make([]Mark, 0, len(row.Marks)),
+			Average:   row.Average(),
+		}
+
+		for _, mark := range row.Marks {
+			respRow.Marks = append(respRow.Marks, Mark{
+				ID:          mark.ID,
+				LessonID:    mark.LessonID,
+				Mark:        mark.Mark,
+				Weight:      mark.Weight,
+				Description: mark.Description,
+			})
+		}
+
+		if row.FinalGrade != nil {
+			respRow.FinalGrade = &FinalGrade{
+				ID:       row.FinalGrade.ID,
+				Mark:     row.FinalGrade.Mark,
+				Weight:   row.FinalGrade.Weight,
+				Average:  row.FinalGrade.Average,
+				LockedAt: (*utils.RFC3339Time)(row.FinalGrade.LockedAt),
+			}
+		}
+
+		resp.Rows = append(resp.Rows, respRow)
+	}
+
+	return resp
+}
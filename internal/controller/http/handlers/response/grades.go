@@ -9,12 +9,14 @@ import (
 
 // GradeStandard is a structure of grade standard response.
 type GradeStandard struct {
-	ID             uuid.UUID  `json:"id"`
-	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
-	Name           string     `json:"name"`
-	EducationYears int8       `json:"education_years"`
-	Description    *string    `json:"description"`
-	Grades         []Grade    `json:"grades"`
+	ID             uuid.UUID     `json:"id"`
+	OrganizationID *uuid.UUID    `json:"organization_id,omitempty"`
+	Name           string        `json:"name"`
+	EducationYears int8          `json:"education_years"`
+	Description    *string       `json:"description"`
+	GradingScaleID *uuid.UUID    `json:"grading_scale_id,omitempty"`
+	GradingScale   *GradingScale `json:"grading_scale,omitempty"`
+	Grades         []Grade       `json:"grades"`
 
 	CreatedAt utils.RFC3339Time  `json:"created_at"`
 	UpdatedAt utils.RFC3339Time  `json:"updated_at"`
@@ -42,12 +44,21 @@ func NewGradeStandard(gs domain.GradeStandard) GradeStandard {
 		grades[i] = NewGrade(grade)
 	}
 
+	var scale *GradingScale
+
+	if gs.GradingScale != nil {
+		s := NewGradingScale(*gs.GradingScale)
+		scale = &s
+	}
+
 	return GradeStandard{
 		ID:             gs.ID,
 		OrganizationID: gs.OrganizationID,
 		Name:           gs.Name,
 		EducationYears: gs.EducationYears,
 		Description:    gs.Description,
+		GradingScaleID: gs.GradingScaleID,
+		GradingScale:   scale,
 		Grades:         grades,
 
 		CreatedAt: utils.RFC3339Time(gs.CreatedAt),
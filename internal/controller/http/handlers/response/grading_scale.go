@@ -0,0 +1,59 @@
+package response
+
+import (
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/utils"
+)
+
+// GradingScale is a structure of grading scale response.
+type GradingScale struct {
+	ID             uuid.UUID               `json:"id"`
+	OrganizationID *uuid.UUID              `json:"organization_id,omitempty"`
+	Name           string                  `json:"name"`
+	Kind           domain.GradingScaleKind `json:"kind"`
+	Values         []GradingScaleValue     `json:"values"`
+
+	CreatedAt utils.RFC3339Time  `json:"created_at"`
+	UpdatedAt utils.RFC3339Time  `json:"updated_at"`
+	DeletedAt *utils.RFC3339Time `json:"deleted_at,omitempty"`
+}
+
+// GradingScaleValue is a structure of grading scale value response.
+type GradingScaleValue struct {
+	Value  string  `json:"value"`
+	Weight float64 `json:"weight"`
+}
+
+// NewGradingScale creates a new grading scale response.
+func NewGradingScale(scale domain.GradingScale) GradingScale {
+	values := make([]GradingScaleValue, 0, len(scale.Values))
+
+	for _, value := range scale.Values {
+		values = append(values, GradingScaleValue{Value: value.Value, Weight: value.Weight})
+	}
+
+	return GradingScale{
+		ID:             scale.ID,
+		OrganizationID: scale.OrganizationID,
+		Name:           scale.Name,
+		Kind:           scale.Kind,
+		Values:         values,
+
+		CreatedAt: utils.RFC3339Time(scale.CreatedAt),
+		UpdatedAt: utils.RFC3339Time(scale.UpdatedAt),
+		DeletedAt: (*utils.RFC3339Time)(scale.DeletedAt),
+	}
+}
+
+// NewGradingScales creates a new grading scale list response.
+func NewGradingScales(list domain.GradingScales) []GradingScale {
+	scales := make([]GradingScale, 0, len(list))
+
+	for _, scale := range list {
+		scales = append(scales, NewGradingScale(scale))
+	}
+
+	return scales
+}
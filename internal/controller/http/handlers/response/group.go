@@ -14,6 +14,8 @@ type Group struct {
 	Name     string    `json:"name"`
 	GradeID  uuid.UUID `json:"grade_id"`
 
+	AcademicYearID *uuid.UUID `json:"academic_year_id"`
+
 	ClassTeacherID *uuid.UUID        `json:"class_teacher_id"`
 	ClassTeacher   *TeacherShortInfo `json:"class_teacher,omitempty"`
 
@@ -38,6 +40,8 @@ func NewGroup(group domain.Group) Group {
 		Name:     group.Name,
 		GradeID:  group.GradeID,
 
+		AcademicYearID: group.AcademicYearID,
+
 		ClassTeacherID: group.ClassTeacherID,
 		ClassTeacher:   NewTeacherShortInfo(group.ClassTeacher),
 
@@ -18,6 +18,9 @@ type Lesson struct {
 	StartTime      time.Time  `json:"start_time"`
 	EndTime        time.Time  `json:"end_time"`
 	Description    *string    `json:"description"`
+	TermID         *uuid.UUID `json:"term_id"`
+	TemplateSlotID *uuid.UUID `json:"template_slot_id,omitempty"`
+	Overridden     bool       `json:"is_overridden"`
 
 	CreatedAt utils.RFC3339Time  `json:"created_at"`
 	UpdatedAt utils.RFC3339Time  `json:"updated_at"`
@@ -34,6 +37,9 @@ func NewLesson(lesson domain.Lesson) Lesson {
 		StartTime:      lesson.StartTime,
 		EndTime:        lesson.EndTime,
 		Description:    lesson.Description,
+		TermID:         lesson.TermID,
+		TemplateSlotID: lesson.TemplateSlotID,
+		Overridden:     lesson.Overridden,
 
 		CreatedAt: utils.RFC3339Time(lesson.CreatedAt),
 		UpdatedAt: utils.RFC3339Time(lesson.UpdatedAt),
@@ -50,3 +56,51 @@ func NewLessons(list domain.Lessons) any {
 
 	return lessons
 }
+
+// Timetable is week timetable response.
+type Timetable struct {
+	SchoolID    uuid.UUID           `json:"school_id"`
+	WeekStart   utils.RFC3339Time   `json:"week_start"`
+	Groups      []GroupTimetable    `json:"groups"`
+	Unscheduled []UnscheduledLesson `json:"unscheduled"`
+}
+
+// GroupTimetable is week lessons of a group.
+type GroupTimetable struct {
+	GroupID uuid.UUID `json:"group_id"`
+	Lessons any       `json:"lessons"`
+}
+
+// UnscheduledLesson is a group subject which could not be fully scheduled.
+type UnscheduledLesson struct {
+	GroupID        uuid.UUID `json:"group_id"`
+	GroupSubjectID uuid.UUID `json:"group_subject_id"`
+	Count          int       `json:"count"`
+}
+
+// NewTimetable converts domain timetable into response timetable.
+func NewTimetable(timetable domain.Timetable) Timetable {
+	resp := Timetable{
+		SchoolID:    timetable.SchoolID,
+		WeekStart:   utils.RFC3339Time(timetable.WeekStart),
+		Groups:      make([]GroupTimetable, 0, len(timetable.Groups)),
+		Unscheduled: make([]UnscheduledLesson, 0, len(timetable.Unscheduled)),
+	}
+
+	for _, group := range timetable.Groups {
+		resp.Groups = append(resp.Groups, GroupTimetable{
+			GroupID: group.GroupID,
+			Lessons: NewLessons(group.Lessons),
+		})
+	}
+
+	for _, lesson := range timetable.Unscheduled {
+		resp.Unscheduled = append(resp.Unscheduled, UnscheduledLesson{
+			GroupID:        lesson.GroupID,
+			GroupSubjectID: lesson.GroupSubjectID,
+			Count:          lesson.Count,
+		})
+	}
+
+	return resp
+}
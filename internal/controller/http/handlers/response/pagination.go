@@ -1,8 +1,32 @@
 package response
 
+import "bum-service/internal/domain"
+
 // Pagination is structure of pagination response.
 type Pagination struct {
 	Page    int `json:"page"`
 	PerPage int `json:"per_page"`
 	Total   int `json:"total"`
+
+	// NextCursor and PrevCursor are cursors of the adjacent pages of the lists paginated by cursor.
+	NextCursor *string `json:"next_cursor,omitempty"`
+	PrevCursor *string `json:"prev_cursor,omitempty"`
+}
+
+// WithCursors returns the pagination with the cursors of the adjacent pages, nil if there is no such page.
+func (p Pagination) WithCursors(next, prev *domain.Cursor) Pagination {
+	p.NextCursor = cursorString(next)
+	p.PrevCursor = cursorString(prev)
+
+	return p
+}
+
+func cursorString(cursor *domain.Cursor) *string {
+	if cursor == nil {
+		return nil
+	}
+
+	value := cursor.String()
+
+	return &value
 }
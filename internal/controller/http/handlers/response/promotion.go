@@ -0,0 +1,56 @@
+package response
+
+import (
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// GroupPromotion is group promotion response.
+type GroupPromotion struct {
+	FromGroupID   uuid.UUID          `json:"from_group_id"`
+	ToGroup       *Group             `json:"to_group"`
+	HeldBackGroup *Group             `json:"held_back_group"`
+	Students      []StudentPromotion `json:"students"`
+}
+
+// StudentPromotion is student promotion response.
+type StudentPromotion struct {
+	StudentID uuid.UUID  `json:"student_id"`
+	ToGroupID *uuid.UUID `json:"to_group_id"`
+	Status    string     `json:"status"`
+}
+
+// NewGroupPromotions converts domain group promotions into response.
+func NewGroupPromotions(promotions domain.GroupPromotions) []GroupPromotion {
+	resp := make([]GroupPromotion, 0, len(promotions))
+
+	for _, promotion := range promotions {
+		item := GroupPromotion{
+			FromGroupID: promotion.FromGroup.ID,
+			Students:    make([]StudentPromotion, 0, len(promotion.Students)),
+		}
+
+		if promotion.ToGroup != nil {
+			toGroup := NewGroup(*promotion.ToGroup)
+			item.ToGroup = &toGroup
+		}
+
+		if promotion.HeldBackGroup != nil {
+			heldBackGroup := NewGroup(*promotion.HeldBackGroup)
+			item.HeldBackGroup = &heldBackGroup
+		}
+
+		for _, student := range promotion.Students {
+			item.Students = append(item.Students, StudentPromotion{
+				StudentID: student.StudentID,
+				ToGroupID: student.ToGroupID,
+				Status:    student.Status.String(),
+			})
+		}
+
+		resp = append(resp, item)
+	}
+
+	return resp
+}
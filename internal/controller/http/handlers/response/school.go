@@ -138,12 +138,13 @@ func NewSchoolSubjectsList(schoolSubjects domain.SchoolSubjects, pagination Pagi
 
 // StudyPlan is a response model for study plan.
 type StudyPlan struct {
-	ID           string  `json:"id"`
-	GroupSubject string  `json:"group_subject"`
-	Title        string  `json:"title"`
-	Description  *string `json:"description,omitempty"`
-	PlanOrder    int16   `json:"plan_order"`
-	Status       string  `json:"status"`
+	ID           string     `json:"id"`
+	GroupSubject string     `json:"group_subject"`
+	Title        string     `json:"title"`
+	Description  *string    `json:"description,omitempty"`
+	PlanOrder    int16      `json:"plan_order"`
+	Status       string     `json:"status"`
+	TermID       *uuid.UUID `json:"term_id"`
 }
 
 // NewStudyPlans creates a new StudyPlan response from domain study plans.
@@ -158,6 +159,7 @@ func NewStudyPlans(studyPlans domain.StudyPlans) []StudyPlan {
 			Description:  plan.Description,
 			PlanOrder:    plan.PlanOrder,
 			Status:       plan.Status.String(),
+			TermID:       plan.TermID,
 		}
 	}
 
@@ -173,5 +175,6 @@ func NewStudyPlan(studyPlan domain.StudyPlan) StudyPlan {
 		Description:  studyPlan.Description,
 		PlanOrder:    studyPlan.PlanOrder,
 		Status:       studyPlan.Status.String(),
+		TermID:       studyPlan.TermID,
 	}
 }
@@ -0,0 +1,40 @@
+package response
+
+import "bum-service/internal/domain"
+
+// SchoolSetupCounts are the numbers of the entries of the section of the school setup.
+type SchoolSetupCounts struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	Skipped int `json:"skipped"`
+}
+
+// newSchoolSetupCounts creates a new SchoolSetupCounts response.
+func newSchoolSetupCounts(counts domain.SchoolSetupCounts) SchoolSetupCounts {
+	return SchoolSetupCounts{
+		Created: counts.Created,
+		Updated: counts.Updated,
+		Skipped: counts.Skipped,
+	}
+}
+
+// SchoolSetup is school setup import response.
+type SchoolSetup struct {
+	DryRun bool `json:"dry_run"`
+
+	Teachers      SchoolSetupCounts `json:"teachers"`
+	Subjects      SchoolSetupCounts `json:"subjects"`
+	Groups        SchoolSetupCounts `json:"groups"`
+	GroupSubjects SchoolSetupCounts `json:"group_subjects"`
+}
+
+// NewSchoolSetup creates a new school setup import response.
+func NewSchoolSetup(result domain.SchoolSetupResult) SchoolSetup {
+	return SchoolSetup{
+		DryRun:        result.DryRun,
+		Teachers:      newSchoolSetupCounts(result.Teachers),
+		Subjects:      newSchoolSetupCounts(result.Subjects),
+		Groups:        newSchoolSetupCounts(result.Groups),
+		GroupSubjects: newSchoolSetupCounts(result.GroupSubjects),
+	}
+}
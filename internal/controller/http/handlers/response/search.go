@@ -0,0 +1,53 @@
+package response
+
+import (
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// SearchHit is a structure of the search hit response.
+type SearchHit struct {
+	Type     string    `json:"type"`
+	ID       uuid.UUID `json:"id"`
+	UserID   uuid.UUID `json:"user_id"`
+	SchoolID uuid.UUID `json:"school_id"`
+
+	FirstName  string  `json:"first_name"`
+	LastName   string  `json:"last_name"`
+	MiddleName *string `json:"middle_name,omitempty"`
+
+	Score float64 `json:"score"`
+}
+
+// NewSearchHit creates a new search hit response.
+func NewSearchHit(hit domain.SearchHit) SearchHit {
+	return SearchHit{
+		Type:     string(hit.Type),
+		ID:       hit.ID,
+		UserID:   hit.UserID,
+		SchoolID: hit.SchoolID,
+
+		FirstName:  hit.FirstName,
+		LastName:   hit.LastName,
+		MiddleName: hit.MiddleName,
+
+		Score: hit.Score,
+	}
+}
+
+// SearchHits is a list of SearchHit.
+type SearchHits struct {
+	Hits []SearchHit `json:"hits"`
+}
+
+// NewSearchHits creates a new search hits response from domain search hits.
+func NewSearchHits(hits domain.SearchHits) SearchHits {
+	responseHits := make([]SearchHit, 0, len(hits))
+
+	for index := range hits {
+		responseHits = append(responseHits, NewSearchHit(hits[index]))
+	}
+
+	return SearchHits{Hits: responseHits}
+}
@@ -0,0 +1,33 @@
+package response
+
+import "bum-service/internal/domain"
+
+// StudentImport is student roster import response.
+type StudentImport struct {
+	DryRun bool `json:"dry_run"`
+	Rows   int  `json:"rows"`
+
+	// NewUsers is the number of the users of the students and the guardians created by the import.
+	NewUsers int `json:"new_users"`
+	// ExistingGuardians is the number of the existing users who become guardians by the import.
+	ExistingGuardians int `json:"existing_guardians"`
+
+	Students []Student `json:"students"`
+}
+
+// NewStudentImport creates a new student roster import response.
+func NewStudentImport(result domain.StudentImport) StudentImport {
+	students := make([]Student, len(result.Students))
+
+	for i := range result.Students {
+		students[i] = NewStudent(result.Students[i])
+	}
+
+	return StudentImport{
+		DryRun:            result.DryRun,
+		Rows:              len(result.Rows),
+		NewUsers:          result.NewUsers(),
+		ExistingGuardians: result.ExistingGuardians(),
+		Students:          students,
+	}
+}
@@ -0,0 +1,46 @@
+package response
+
+import (
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// StudentMembership is student group membership response.
+type StudentMembership struct {
+	ID        uuid.UUID `json:"id"`
+	StudentID uuid.UUID `json:"student_id"`
+	GroupID   uuid.UUID `json:"group_id"`
+	SchoolID  uuid.UUID `json:"school_id"`
+	DateFrom  string    `json:"date_from"`
+	DateTill  *string   `json:"date_till"`
+}
+
+// NewStudentMembership converts domain student group membership into response.
+func NewStudentMembership(membership domain.StudentMembership) StudentMembership {
+	resp := StudentMembership{
+		ID:        membership.ID,
+		StudentID: membership.StudentID,
+		GroupID:   membership.GroupID,
+		SchoolID:  membership.SchoolID,
+		DateFrom:  membership.DateFrom.Format(dateLayout),
+	}
+
+	if membership.DateTill != nil {
+		dateTill := membership.DateTill.Format(dateLayout)
+		resp.DateTill = &dateTill
+	}
+
+	return resp
+}
+
+// NewStudentMemberships converts domain student group memberships into response.
+func NewStudentMemberships(memberships domain.StudentMemberships) []StudentMembership {
+	resp := make([]StudentMembership, 0, len(memberships))
+
+	for _, membership := range memberships {
+		resp = append(resp, NewStudentMembership(membership))
+	}
+
+	return resp
+}
@@ -0,0 +1,104 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/utils"
+)
+
+const (
+	bellTimeLayout = "15:04" // bellTimeLayout is layout of bell slot time.
+	dateLayout     = "2006-01-02"
+)
+
+// TimetableTemplate is timetable template response.
+type TimetableTemplate struct {
+	ID         uuid.UUID                    `json:"id"`
+	SchoolID   uuid.UUID                    `json:"school_id"`
+	GroupID    uuid.UUID                    `json:"group_id"`
+	Name       string                       `json:"name"`
+	DateFrom   string                       `json:"date_from"`
+	DateTill   string                       `json:"date_till"`
+	Slots      []TimetableTemplateSlot      `json:"slots"`
+	Exclusions []TimetableTemplateExclusion `json:"exclusions"`
+
+	CreatedAt utils.RFC3339Time  `json:"created_at"`
+	UpdatedAt utils.RFC3339Time  `json:"updated_at"`
+	DeletedAt *utils.RFC3339Time `json:"deleted_at,omitempty"`
+}
+
+// TimetableTemplateSlot is timetable template slot response.
+type TimetableTemplateSlot struct {
+	ID             uuid.UUID    `json:"id"`
+	GroupSubjectID uuid.UUID    `json:"group_subject_id"`
+	TeacherID      *uuid.UUID   `json:"teacher_id"`
+	AuditoriumID   uuid.UUID    `json:"auditorium_id"`
+	Weekday        time.Weekday `json:"weekday"`
+	Start          string       `json:"start"`
+	End            string       `json:"end"`
+	Description    *string      `json:"description"`
+}
+
+// TimetableTemplateExclusion is timetable template exclusion response.
+type TimetableTemplateExclusion struct {
+	ID       uuid.UUID `json:"id"`
+	DateFrom string    `json:"date_from"`
+	DateTill string    `json:"date_till"`
+	Reason   *string   `json:"reason"`
+}
+
+// NewTimetableTemplate converts domain timetable template into response.
+func NewTimetableTemplate(template domain.TimetableTemplate) TimetableTemplate {
+	resp := TimetableTemplate{
+		ID:         template.ID,
+		SchoolID:   template.SchoolID,
+		GroupID:    template.GroupID,
+		Name:       template.Name,
+		DateFrom:   template.DateFrom.Format(dateLayout),
+		DateTill:   template.DateTill.Format(dateLayout),
+		Slots:      make([]TimetableTemplateSlot, 0, len(template.Slots)),
+		Exclusions: make([]TimetableTemplateExclusion, 0, len(template.Exclusions)),
+
+		CreatedAt: utils.RFC3339Time(template.CreatedAt),
+		UpdatedAt: utils.RFC3339Time(template.UpdatedAt),
+		DeletedAt: (*utils.RFC3339Time)(template.DeletedAt),
+	}
+
+	for _, slot := range template.Slots {
+		resp.Slots = append(resp.Slots, TimetableTemplateSlot{
+			ID:             slot.ID,
+			GroupSubjectID: slot.GroupSubjectID,
+			TeacherID:      slot.TeacherID,
+			AuditoriumID:   slot.AuditoriumID,
+			Weekday:        slot.Weekday,
+			Start:          time.Time{}.Add(slot.Bell.Start).Format(bellTimeLayout),
+			End:            time.Time{}.Add(slot.Bell.End).Format(bellTimeLayout),
+			Description:    slot.Description,
+		})
+	}
+
+	for _, exclusion := range template.Exclusions {
+		resp.Exclusions = append(resp.Exclusions, TimetableTemplateExclusion{
+			ID:       exclusion.ID,
+			DateFrom: exclusion.DateFrom.Format(dateLayout),
+			DateTill: exclusion.DateTill.Format(dateLayout),
+			Reason:   exclusion.Reason,
+		})
+	}
+
+	return resp
+}
+
+// NewTimetableTemplates converts domain timetable templates into response.
+func NewTimetableTemplates(list domain.TimetableTemplates) []TimetableTemplate {
+	templates := make([]TimetableTemplate, 0, len(list))
+
+	for _, template := range list {
+		templates = append(templates, NewTimetableTemplate(template))
+	}
+
+	return templates
+}
@@ -18,6 +18,8 @@ type User struct {
 	Email      string     `json:"email"`
 	UserRoles  []UserRole `json:"user_roles,omitempty"`
 
+	EmailVerified bool `json:"email_verified"`
+
 	CreatedAt utils.RFC3339Time  `json:"created_at"`
 	UpdatedAt utils.RFC3339Time  `json:"updated_at"`
 	DeletedAt *utils.RFC3339Time `json:"deleted_at,omitempty"`
@@ -35,6 +37,8 @@ func NewUser(user domain.User) User {
 		Email:      user.Email,
 		UserRoles:  NewUserRoles(user.UserRoles),
 
+		EmailVerified: user.EmailVerifiedAt != nil,
+
 		CreatedAt: utils.RFC3339Time(user.CreatedAt),
 		UpdatedAt: utils.RFC3339Time(user.UpdatedAt),
 		DeletedAt: (*utils.RFC3339Time)(user.DeletedAt),
@@ -35,7 +35,7 @@ func (s School) AddSchool(c *gin.Context) {
 	)
 
 	if err = c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -84,6 +84,10 @@ func (s School) SchoolByID(c *gin.Context) { //nolint:dupl // It's ok.
 		return
 	}
 
+	if notModified(c, schoolEntity.UpdatedAt) {
+		return
+	}
+
 	c.JSON(http.StatusOK, response.NewSchool(schoolEntity))
 }
 
@@ -99,7 +103,7 @@ func (s School) UpdateSchool(c *gin.Context) {
 	)
 
 	if err = c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -111,6 +115,12 @@ func (s School) UpdateSchool(c *gin.Context) {
 	logger = logger.WithFields(liblog.Fields{"request": req})
 	ctx = liblog.With(ctx, logger)
 
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		logger.Errorf("failed to get version: %v", c.Error(err))
+		return
+	}
+
 	entity, err := s.schoolService.UpdateSchool(
 		ctx,
 		school.UpdateSchoolArgs{
@@ -120,13 +130,15 @@ func (s School) UpdateSchool(c *gin.Context) {
 			Phone:           req.Phone,
 			Email:           req.Email,
 			GradeStandardID: req.GradeStandardID,
+			Version:         version,
 		},
 	)
 	if err != nil {
-		logger.Errorf("failed to update school: %v", c.Error(err))
+		logger.Errorf("failed to update school: %v", c.Error(preconditionErr(c, err)))
 		return
 	}
 
+	setETag(c, entity.UpdatedAt)
 	c.JSON(http.StatusOK, response.NewSchool(entity))
 }
 
@@ -140,20 +152,27 @@ func (s School) SchoolList(c *gin.Context) {
 	)
 
 	if err = c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
 	logger = logger.WithFields(liblog.Fields{"request": req})
 	ctx = liblog.With(ctx, logger)
 
+	listFilter, err := req.ListFilter.Fields(domain.SchoolListFields(), domain.NewPagination(req.Page, req.PerPage))
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	list, total, err := s.schoolService.SchoolList(
 		ctx,
 		domain.NewSchoolFilters(
-			domain.NewListFilter(req.SortOrder, domain.NewPagination(req.Page, req.PerPage)),
+			listFilter,
 			req.Emails,
 			req.Phones,
 			req.OrganizationUUIDs(),
+			MustGetActor(c).SchoolScope(domain.RoleOwner, domain.RoleDirector, domain.RoleHeadmaster),
 		),
 	)
 	if err != nil {
@@ -188,7 +207,7 @@ func (s School) CreateGroup(c *gin.Context) {
 	}
 
 	if err = c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -201,6 +220,8 @@ func (s School) CreateGroup(c *gin.Context) {
 			SchoolID: schoolID,
 			Name:     req.Name,
 			GradeID:  req.GradeID,
+
+			AcademicYearID: req.AcademicYearID,
 		},
 	)
 	if err != nil {
@@ -218,6 +239,7 @@ func (s School) GroupByID(c *gin.Context) {
 		logger          = liblog.Must(ctx)
 		schoolIDPathVar = request.GetSchoolIDPathVar(c)
 		groupIDPathVar  = request.GetGroupIDPathVar(c)
+		schoolID        uuid.UUID
 		groupID         uuid.UUID
 		err             error
 	)
@@ -230,17 +252,26 @@ func (s School) GroupByID(c *gin.Context) {
 	})
 	ctx = liblog.With(ctx, logger)
 
+	if schoolID, err = uuid.Parse(schoolIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
 	if groupID, err = uuid.Parse(groupIDPathVar); err != nil {
 		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
-	groupDomain, err := s.schoolService.GroupByID(ctx, groupID)
+	groupDomain, err := s.schoolService.GroupByID(ctx, schoolID, groupID)
 	if err != nil {
 		logger.Errorf("failed to create a new group: %v", c.Error(err))
 		return
 	}
 
+	if notModified(c, groupDomain.UpdatedAt) {
+		return
+	}
+
 	c.JSON(http.StatusOK, response.NewGroup(groupDomain))
 }
 
@@ -265,15 +296,13 @@ func (s School) UpdateGroup(c *gin.Context) {
 		return
 	}
 
-	_ = schoolID
-
 	if groupID, err = uuid.Parse(groupIDPathVar); err != nil {
 		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
 	if err = c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -284,23 +313,33 @@ func (s School) UpdateGroup(c *gin.Context) {
 	})
 	ctx = liblog.With(ctx, logger)
 
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		logger.Errorf("failed to get version: %v", c.Error(err))
+		return
+	}
+
 	groupDomain, err := s.schoolService.UpdateGroup(
 		ctx,
 		school.UpdateGroupArgs{
-			ID:      groupID,
-			Name:    req.Name,
-			GradeID: req.GradeID,
+			ID:       groupID,
+			SchoolID: schoolID,
+			Name:     req.Name,
+			GradeID:  req.GradeID,
 
 			ClassTeacherID:         req.ClassTeacherID,
 			ClassPresidentID:       req.ClassPresidentID,
 			DeputyClassPresidentID: req.DeputyClassPresidentID,
+
+			Version: version,
 		},
 	)
 	if err != nil {
-		logger.Errorf("failed to update group: %v", c.Error(err))
+		logger.Errorf("failed to update group: %v", c.Error(preconditionErr(c, err)))
 		return
 	}
 
+	setETag(c, groupDomain.UpdatedAt)
 	c.JSON(http.StatusOK, response.NewGroup(groupDomain))
 }
 
@@ -321,17 +360,24 @@ func (s School) GroupList(c *gin.Context) {
 	}
 
 	if err = c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
 	logger = logger.WithFields(liblog.Fields{"request": req})
 	ctx = liblog.With(ctx, logger)
 
+	// the list of groups is not paginated.
+	listFilter, err := req.ListFilter.Fields(domain.GroupListFields(), domain.Pagination{})
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	list, total, err := s.schoolService.GroupList(
 		ctx,
 		schoolID,
-		domain.NewGroupFilters(),
+		domain.NewGroupFilters(listFilter, req.AcademicYearID),
 	)
 	if err != nil {
 		logger.Errorf("failed to get group list: %v", c.Error(err))
@@ -361,7 +407,7 @@ func (s School) AddSchoolSubject(c *gin.Context) {
 	}
 
 	if err = c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -416,6 +462,10 @@ func (s School) SchoolSubjectByIDAndSchoolID(c *gin.Context) {
 		return
 	}
 
+	if notModified(c, schoolSubjectDomain.UpdatedAt) {
+		return
+	}
+
 	c.JSON(http.StatusOK, response.NewSchoolSubject(schoolSubjectDomain))
 }
 
@@ -439,17 +489,26 @@ func (s School) SchoolSubjectList(c *gin.Context) {
 	}
 
 	if err = c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
 	logger = logger.WithFields(liblog.Fields{"request": req})
 	ctx = liblog.With(ctx, logger)
 
+	listFilter, err := req.ListFilter.Fields(
+		domain.SchoolSubjectListFields(),
+		domain.NewPagination(req.Page, req.PerPage),
+	)
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	list, total, err := s.schoolService.SchoolSubjectList(
 		ctx,
 		domain.NewSchoolSubjectFilters(
-			domain.NewListFilter(req.SortOrder, domain.NewPagination(req.Page, req.PerPage)),
+			listFilter,
 			schoolID,
 		),
 	)
@@ -468,24 +527,32 @@ func (s School) SchoolSubjectList(c *gin.Context) {
 // GroupSubjectList get group subject list.
 func (s School) GroupSubjectList(c *gin.Context) {
 	var (
-		ctx            = c.Request.Context()
-		logger         = liblog.Must(ctx)
-		groupIDPathVar = request.GetGroupIDPathVar(c)
-		groupID        uuid.UUID
-		err            error
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		schoolIDPathVar = request.GetSchoolIDPathVar(c)
+		groupIDPathVar  = request.GetGroupIDPathVar(c)
+		schoolID        uuid.UUID
+		groupID         uuid.UUID
+		err             error
 	)
 
 	logger = logger.WithFields(liblog.Fields{
-		"group_id": groupIDPathVar,
+		"school_id": schoolIDPathVar,
+		"group_id":  groupIDPathVar,
 	})
 	ctx = liblog.With(ctx, logger)
 
+	if schoolID, err = uuid.Parse(schoolIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
 	if groupID, err = uuid.Parse(groupIDPathVar); err != nil {
 		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
-	list, err := s.schoolService.GroupSubjectList(ctx, groupID)
+	list, err := s.schoolService.GroupSubjectList(ctx, schoolID, groupID)
 	if err != nil {
 		logger.Errorf("failed to get group subjects list: %v", c.Error(err))
 		return
@@ -518,7 +585,7 @@ func (s School) AddGroupSubject(c *gin.Context) {
 	}
 
 	if err = c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -559,7 +626,7 @@ func (s School) AssignStudyPlans(c *gin.Context) {
 	}
 
 	if err = c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -577,6 +644,7 @@ func (s School) AssignStudyPlans(c *gin.Context) {
 			Title:       sp.Title,
 			Description: sp.Description,
 			PlanOrder:   int16(i),
+			TermID:      sp.TermID,
 		})
 	}
 
@@ -655,3 +723,128 @@ func (s School) StudyPlanChangeStatus(c *gin.Context) {
 
 	c.Status(http.StatusOK)
 }
+
+// DeleteSchool deletes school by id.
+func (s School) DeleteSchool(c *gin.Context) {
+	var (
+		ctx      = c.Request.Context()
+		logger   = liblog.Must(ctx)
+		id       = request.GetSchoolIDPathVar(c)
+		schoolID uuid.UUID
+		err      error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"school_id": id})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(id); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = s.schoolService.DeleteSchool(ctx, schoolID); err != nil {
+		logger.Errorf("failed to delete school: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RestoreSchool restores deleted school of the educational organization.
+func (s School) RestoreSchool(c *gin.Context) {
+	var (
+		ctx               = c.Request.Context()
+		logger            = liblog.Must(ctx)
+		organizationIDVar = request.GetEduOrganizationPathVar(c)
+		schoolIDVar       = request.GetSchoolIDPathVar(c)
+		organizationID    uuid.UUID
+		schoolID          uuid.UUID
+		err               error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"edu_organization_id": organizationIDVar, "school_id": schoolIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if organizationID, err = uuid.Parse(organizationIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = s.schoolService.RestoreSchool(ctx, organizationID, schoolID); err != nil {
+		logger.Errorf("failed to restore school: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// DeleteGroup deletes group of the school.
+func (s School) DeleteGroup(c *gin.Context) {
+	var (
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		schoolIDVar = request.GetSchoolIDPathVar(c)
+		groupIDVar  = request.GetGroupIDPathVar(c)
+		schoolID    uuid.UUID
+		groupID     uuid.UUID
+		err         error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "group_id": groupIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if groupID, err = uuid.Parse(groupIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = s.schoolService.DeleteGroup(ctx, schoolID, groupID); err != nil {
+		logger.Errorf("failed to delete group: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RestoreGroup restores deleted group of the school.
+func (s School) RestoreGroup(c *gin.Context) {
+	var (
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		schoolIDVar = request.GetSchoolIDPathVar(c)
+		groupIDVar  = request.GetGroupIDPathVar(c)
+		schoolID    uuid.UUID
+		groupID     uuid.UUID
+		err         error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "group_id": groupIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if groupID, err = uuid.Parse(groupIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = s.schoolService.RestoreGroup(ctx, schoolID, groupID); err != nil {
+		logger.Errorf("failed to restore group: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/controller/http/handlers/response"
+	"bum-service/internal/domain"
+	"bum-service/pkg/liblog"
+)
+
+// Search finds students, teachers, guardians and school staff of the schools of the actor
+// by the names, email and phone of their users.
+func (u User) Search(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		logger = liblog.Must(ctx)
+		req    request.Search
+	)
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req})
+
+	filter, err := domain.NewSearchFilter(MustGetActor(c), req.Query, req.HitTypes(), req.Limit)
+	if err != nil {
+		logger.Errorf("failed to create search filter: %v", c.Error(err))
+		return
+	}
+
+	hits, err := u.userService.Search(ctx, filter)
+	if err != nil {
+		logger.Errorf("failed to search: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewSearchHits(hits))
+}
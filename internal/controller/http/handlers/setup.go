@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/controller/http/handlers/response"
+	"bum-service/internal/domain"
+	"bum-service/internal/service/setup"
+	"bum-service/pkg/liblog"
+	"bum-service/pkg/libsheet"
+)
+
+// Setup is a handler for the school setup.
+type Setup struct {
+	setupService ISetupService
+}
+
+// NewSetup creates a new Setup handler.
+func NewSetup(setupService ISetupService) *Setup {
+	return &Setup{
+		setupService: setupService,
+	}
+}
+
+// ImportSchoolSetup creates or updates the teachers, the subjects and the groups of the school
+// of the uploaded YAML or JSON setup or its CSV or XLSX sheets.
+func (s Setup) ImportSchoolSetup(c *gin.Context) {
+	var (
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		req         request.ImportSchoolSetup
+		schoolIDVar = request.GetSchoolIDPathVar(c)
+		schoolID    uuid.UUID
+		err         error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBind(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{
+		"school_id":        schoolID,
+		"academic_year_id": req.AcademicYearID,
+		"dry_run":          req.DryRun,
+	})
+	ctx = liblog.With(ctx, logger)
+
+	schoolSetup, err := readSchoolSetup(req)
+	if err != nil {
+		logger.Errorf("failed to read school setup: %v", c.Error(err))
+		return
+	}
+
+	result, err := s.setupService.ImportSchoolSetup(ctx, setup.ImportSchoolSetupArgs{
+		SchoolID:       schoolID,
+		AcademicYearID: req.AcademicYearID,
+		Setup:          schoolSetup,
+		DryRun:         req.DryRun,
+	})
+	if err != nil {
+		logger.Errorf("failed to import school setup: %v", c.Error(err))
+		return
+	}
+
+	status := http.StatusCreated
+	if result.DryRun {
+		status = http.StatusOK
+	}
+
+	c.JSON(status, response.NewSchoolSetup(result))
+}
+
+// readSchoolSetup reads the uploaded setup, either the document or the sheets of its sections.
+func readSchoolSetup(req request.ImportSchoolSetup) (domain.SchoolSetup, error) {
+	sheets := req.Sheets()
+
+	switch {
+	case req.File != nil && len(sheets) == 0:
+		return readSchoolSetupDocument(req.File)
+	case req.File == nil && len(sheets) > 0:
+		return readSchoolSetupSheets(sheets)
+	default:
+		return domain.SchoolSetup{}, domain.ErrInvalidSchoolSetupFile
+	}
+}
+
+// readSchoolSetupDocument reads the uploaded YAML or JSON document of the setup.
+func readSchoolSetupDocument(fileHeader *multipart.FileHeader) (domain.SchoolSetup, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return domain.SchoolSetup{}, fmt.Errorf("failed to open school setup: %w", err)
+	}
+
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, domain.SchoolSetupMaxSize+1))
+	if err != nil {
+		return domain.SchoolSetup{}, fmt.Errorf("failed to read school setup: %w", err)
+	}
+
+	if len(data) > domain.SchoolSetupMaxSize {
+		return domain.SchoolSetup{}, domain.ErrSchoolSetupTooLarge
+	}
+
+	return domain.ParseSchoolSetupDocument(data)
+}
+
+// readSchoolSetupSheets reads the uploaded CSV or XLSX sheets of the sections of the setup.
+func readSchoolSetupSheets(sheets map[string]*multipart.FileHeader) (domain.SchoolSetup, error) {
+	records := make(map[string][][]string, len(sheets))
+
+	for section, fileHeader := range sheets {
+		file, err := fileHeader.Open()
+		if err != nil {
+			return domain.SchoolSetup{}, fmt.Errorf("failed to open %s sheet: %w", section, err)
+		}
+
+		records[section], err = libsheet.Read(fileHeader.Filename, file, domain.SchoolSetupMaxSize)
+
+		file.Close()
+
+		switch {
+		case errors.Is(err, libsheet.ErrTooLarge):
+			return domain.SchoolSetup{}, fmt.Errorf("%w: %w", domain.ErrSchoolSetupTooLarge, err)
+		case errors.Is(err, libsheet.ErrUnknownFormat), errors.Is(err, libsheet.ErrInvalidFile):
+			return domain.SchoolSetup{}, fmt.Errorf("%w: %w", domain.ErrInvalidSchoolSetupFile, err)
+		case err != nil:
+			return domain.SchoolSetup{}, fmt.Errorf("failed to read %s sheet: %w", section, err)
+		}
+	}
+
+	schoolSetup, errs := domain.ParseSchoolSetupSheets(records)
+	if len(errs) > 0 {
+		return domain.SchoolSetup{}, errs.Err()
+	}
+
+	return schoolSetup, nil
+}
@@ -1,6 +1,9 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
+	"mime/multipart"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -11,6 +14,7 @@ import (
 	"bum-service/internal/domain"
 	"bum-service/internal/service/student"
 	"bum-service/pkg/liblog"
+	"bum-service/pkg/libsheet"
 )
 
 // Student is a handler for Students.
@@ -36,7 +40,7 @@ func (s Student) AddStudent(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -60,12 +64,19 @@ func (s Student) AddStudent(c *gin.Context) {
 // StudentByID get student by id.
 func (s Student) StudentByID(c *gin.Context) {
 	var (
-		ctx       = c.Request.Context()
-		logger    = liblog.Must(ctx)
-		studentID = request.GetStudentIDPathVar(c)
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		studentID   = request.GetStudentIDPathVar(c)
+		schoolIDVar = request.GetSchoolIDHeader(c)
 	)
 
-	logger = logger.WithFields(liblog.Fields{"student_id": studentID})
+	logger = logger.WithFields(liblog.Fields{"student_id": studentID, "school_id": schoolIDVar})
+
+	schoolID, err := uuid.Parse(schoolIDVar)
+	if err != nil {
+		logger.Errorf("failed to parse school id to uuid: %v %v", err, c.Error(domain.ErrBadRequest))
+		return
+	}
 
 	studentUUID, err := uuid.Parse(studentID)
 	if err != nil {
@@ -73,12 +84,16 @@ func (s Student) StudentByID(c *gin.Context) {
 		return
 	}
 
-	addedStudent, err := s.studentService.StudentByID(ctx, studentUUID)
+	addedStudent, err := s.studentService.StudentByIDAndSchoolID(ctx, studentUUID, schoolID)
 	if err != nil {
 		logger.Errorf("failed to create subject: %v", c.Error(err))
 		return
 	}
 
+	if notModified(c, addedStudent.UpdatedAt) {
+		return
+	}
+
 	c.JSON(http.StatusOK, response.NewStudent(addedStudent))
 }
 
@@ -91,7 +106,13 @@ func (s Student) StudentList(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	schoolID, err := uuid.Parse(GetListSchoolID(c))
+	if err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
@@ -99,15 +120,34 @@ func (s Student) StudentList(c *gin.Context) {
 		"request": req,
 	})
 
+	pagination, err := domain.NewCursorPagination(req.Cursor, req.Page, req.PerPage)
+	if err != nil {
+		logger.Errorf("failed to create pagination: %v", c.Error(err))
+		return
+	}
+
+	search, err := domain.NewSearchQuery(req.Query)
+	if err != nil {
+		logger.Errorf("failed to create search query: %v", c.Error(err))
+		return
+	}
+
+	listFilter, err := req.ListFilter.Fields(domain.StudentListFields(), pagination)
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	list, total, err := s.studentService.StudentList(
 		ctx,
 		domain.NewStudentListFilter(
 			domain.NewDateFilter(req.CreatedDate.DateFrom(), req.CreatedDate.DateTill()),
-			domain.NewListFilter(req.SortOrder, domain.NewPagination(req.Page, req.PerPage)),
+			listFilter,
 
 			req.GroupUUIDs(),
-			req.SchoolUUIDs(),
+			[]uuid.UUID{schoolID},
 			req.OrganizationUUIDs(),
+			search,
 		),
 	)
 	if err != nil {
@@ -115,11 +155,13 @@ func (s Student) StudentList(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, response.NewStudentList(list, response.Pagination{
+	page := domain.NewCursorPage(list, listFilter, domain.Student.Cursor)
+
+	c.JSON(http.StatusOK, response.NewStudentList(page.List, response.Pagination{
 		Page:    req.Page,
 		PerPage: req.PerPage,
 		Total:   total,
-	}))
+	}.WithCursors(page.Next, page.Prev)))
 }
 
 // AssignStudentGuardian assign guardian to student.
@@ -132,7 +174,7 @@ func (s Student) AssignStudentGuardian(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -165,12 +207,19 @@ func (s Student) AssignStudentGuardian(c *gin.Context) {
 // StudentGuardians get student guardians by student id.
 func (s Student) StudentGuardians(c *gin.Context) {
 	var (
-		ctx       = c.Request.Context()
-		logger    = liblog.Must(ctx)
-		studentID = request.GetStudentIDPathVar(c)
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		studentID   = request.GetStudentIDPathVar(c)
+		schoolIDVar = request.GetSchoolIDHeader(c)
 	)
 
-	logger = logger.WithFields(liblog.Fields{"student_id": studentID})
+	logger = logger.WithFields(liblog.Fields{"student_id": studentID, "school_id": schoolIDVar})
+
+	schoolID, err := uuid.Parse(schoolIDVar)
+	if err != nil {
+		logger.Errorf("failed to parse school id to uuid: %v %v", err, c.Error(domain.ErrBadRequest))
+		return
+	}
 
 	studentUUID, err := uuid.Parse(studentID)
 	if err != nil {
@@ -178,7 +227,7 @@ func (s Student) StudentGuardians(c *gin.Context) {
 		return
 	}
 
-	studentGuardians, err := s.studentService.StudentGuardians(ctx, studentUUID)
+	studentGuardians, err := s.studentService.StudentGuardians(ctx, studentUUID, schoolID)
 	if err != nil {
 		logger.Errorf("failed to get student guardians: %v", c.Error(err))
 		return
@@ -227,7 +276,13 @@ func (s Student) StudentGuardianList(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	schoolID, err := uuid.Parse(GetListSchoolID(c))
+	if err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
@@ -235,14 +290,30 @@ func (s Student) StudentGuardianList(c *gin.Context) {
 		"request": req,
 	})
 
+	search, err := domain.NewSearchQuery(req.Query)
+	if err != nil {
+		logger.Errorf("failed to create search query: %v", c.Error(err))
+		return
+	}
+
+	listFilter, err := req.ListFilter.Fields(
+		domain.StudentGuardianListFields(),
+		domain.NewPagination(req.Page, req.PerPage),
+	)
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	list, total, err := s.studentService.StudentGuardianList(
 		ctx,
 		domain.NewStudentGuardianListFilter(
-			domain.NewListFilter(req.SortOrder, domain.NewPagination(req.Page, req.PerPage)),
+			listFilter,
 			domain.NewDateFilter(req.CreatedDate.DateFrom(), req.CreatedDate.DateTill()),
 			req.GroupUUIDs(),
-			req.SchoolUUIDs(),
+			[]uuid.UUID{schoolID},
 			req.OrganizationUUIDs(),
+			search,
 		),
 	)
 	if err != nil {
@@ -256,3 +327,368 @@ func (s Student) StudentGuardianList(c *gin.Context) {
 		Total:   total,
 	}))
 }
+
+// TransferStudent transfers the student into another group.
+func (s Student) TransferStudent(c *gin.Context) {
+	var (
+		ctx       = c.Request.Context()
+		logger    = liblog.Must(ctx)
+		studentID = request.GetStudentIDPathVar(c)
+		req       request.TransferStudent
+	)
+
+	studentUUID, err := uuid.Parse(studentID)
+	if err != nil {
+		logger.Errorf("failed to parse student id to uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req, "student_id": studentUUID})
+	ctx = liblog.With(ctx, logger)
+
+	membership, err := s.studentService.TransferStudent(ctx, student.TransferStudentArgs{
+		StudentID: studentUUID,
+		SchoolID:  req.SchoolID,
+		GroupID:   req.GroupID,
+		Date:      req.TransferDate(),
+	})
+	if err != nil {
+		logger.Errorf("failed to transfer student: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.NewStudentMembership(membership))
+}
+
+// StudentMemberships returns group membership history of the student.
+func (s Student) StudentMemberships(c *gin.Context) {
+	var (
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		studentID   = request.GetStudentIDPathVar(c)
+		schoolIDVar = request.GetSchoolIDHeader(c)
+		req         request.StudentMemberships
+	)
+
+	schoolID, err := uuid.Parse(schoolIDVar)
+	if err != nil {
+		logger.Errorf("failed to parse school id to uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	studentUUID, err := uuid.Parse(studentID)
+	if err != nil {
+		logger.Errorf("failed to parse student id to uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindQuery(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req, "student_id": studentUUID, "school_id": schoolID})
+	ctx = liblog.With(ctx, logger)
+
+	memberships, err := s.studentService.StudentMemberships(ctx, studentUUID, schoolID, req.OnDate())
+	if err != nil {
+		logger.Errorf("failed to get student group memberships: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewStudentMemberships(memberships))
+}
+
+// DeleteStudent deletes student of the school.
+func (s Student) DeleteStudent(c *gin.Context) {
+	var (
+		ctx          = c.Request.Context()
+		logger       = liblog.Must(ctx)
+		schoolIDVar  = request.GetSchoolIDHeader(c)
+		studentIDVar = request.GetStudentIDPathVar(c)
+		schoolID     uuid.UUID
+		studentID    uuid.UUID
+		err          error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "student_id": studentIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if studentID, err = uuid.Parse(studentIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = s.studentService.DeleteStudent(ctx, schoolID, studentID); err != nil {
+		logger.Errorf("failed to delete student: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RestoreStudent restores deleted student of the school.
+func (s Student) RestoreStudent(c *gin.Context) {
+	var (
+		ctx          = c.Request.Context()
+		logger       = liblog.Must(ctx)
+		schoolIDVar  = request.GetSchoolIDHeader(c)
+		studentIDVar = request.GetStudentIDPathVar(c)
+		schoolID     uuid.UUID
+		studentID    uuid.UUID
+		err          error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "student_id": studentIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if studentID, err = uuid.Parse(studentIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = s.studentService.RestoreStudent(ctx, schoolID, studentID); err != nil {
+		logger.Errorf("failed to restore student: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// ImportStudents imports the students of the CSV or XLSX roster with their users and guardians.
+func (s Student) ImportStudents(c *gin.Context) {
+	var (
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		req         request.ImportStudents
+		schoolIDVar = request.GetSchoolIDHeader(c)
+		schoolID    uuid.UUID
+		err         error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBind(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{
+		"school_id":        schoolID,
+		"file":             req.File.Filename,
+		"academic_year_id": req.AcademicYearID,
+		"dry_run":          req.DryRun,
+	})
+	ctx = liblog.With(ctx, logger)
+
+	records, err := readStudentRoster(req.File)
+	if err != nil {
+		logger.Errorf("failed to read student roster: %v", c.Error(err))
+		return
+	}
+
+	result, err := s.studentService.ImportStudents(ctx, student.ImportStudentsArgs{
+		SchoolID:       schoolID,
+		AcademicYearID: req.AcademicYearID,
+		Records:        records,
+		DryRun:         req.DryRun,
+	})
+	if err != nil {
+		logger.Errorf("failed to import students: %v", c.Error(err))
+		return
+	}
+
+	status := http.StatusCreated
+	if result.DryRun {
+		status = http.StatusOK
+	}
+
+	c.JSON(status, response.NewStudentImport(result))
+}
+
+// readStudentRoster reads the rows of the uploaded student roster.
+func readStudentRoster(fileHeader *multipart.FileHeader) ([][]string, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open student roster: %w", err)
+	}
+
+	defer file.Close()
+
+	records, err := libsheet.Read(fileHeader.Filename, file, domain.StudentRosterMaxSize)
+
+	switch {
+	case errors.Is(err, libsheet.ErrTooLarge):
+		return nil, fmt.Errorf("%w: %w", domain.ErrStudentRosterTooLarge, err)
+	case errors.Is(err, libsheet.ErrUnknownFormat), errors.Is(err, libsheet.ErrInvalidFile):
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidStudentRosterFile, err)
+	case err != nil:
+		return nil, fmt.Errorf("failed to read student roster: %w", err)
+	}
+
+	return records, nil
+}
+
+// DeleteStudentGuardian deletes guardian of the student.
+func (s Student) DeleteStudentGuardian(c *gin.Context) {
+	var (
+		ctx           = c.Request.Context()
+		logger        = liblog.Must(ctx)
+		schoolIDVar   = request.GetSchoolIDHeader(c)
+		studentIDVar  = request.GetStudentIDPathVar(c)
+		guardianIDVar = request.GetGuardianIDPathVar(c)
+		schoolID      uuid.UUID
+		studentID     uuid.UUID
+		guardianID    uuid.UUID
+		err           error
+	)
+
+	logger = logger.WithFields(liblog.Fields{
+		"school_id":   schoolIDVar,
+		"student_id":  studentIDVar,
+		"guardian_id": guardianIDVar,
+	})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if studentID, err = uuid.Parse(studentIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if guardianID, err = uuid.Parse(guardianIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = s.studentService.DeleteStudentGuardian(ctx, schoolID, studentID, guardianID); err != nil {
+		logger.Errorf("failed to delete student guardian: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RestoreStudentGuardian restores deleted guardian of the student.
+func (s Student) RestoreStudentGuardian(c *gin.Context) {
+	var (
+		ctx           = c.Request.Context()
+		logger        = liblog.Must(ctx)
+		schoolIDVar   = request.GetSchoolIDHeader(c)
+		studentIDVar  = request.GetStudentIDPathVar(c)
+		guardianIDVar = request.GetGuardianIDPathVar(c)
+		schoolID      uuid.UUID
+		studentID     uuid.UUID
+		guardianID    uuid.UUID
+		err           error
+	)
+
+	logger = logger.WithFields(liblog.Fields{
+		"school_id":   schoolIDVar,
+		"student_id":  studentIDVar,
+		"guardian_id": guardianIDVar,
+	})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if studentID, err = uuid.Parse(studentIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if guardianID, err = uuid.Parse(guardianIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = s.studentService.RestoreStudentGuardian(ctx, schoolID, studentID, guardianID); err != nil {
+		logger.Errorf("failed to restore student guardian: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// UpdateStudent updates personal info of the student of the school.
+func (s Student) UpdateStudent(c *gin.Context) {
+	var (
+		ctx          = c.Request.Context()
+		logger       = liblog.Must(ctx)
+		req          request.UpdateStudent
+		schoolIDVar  = request.GetSchoolIDHeader(c)
+		studentIDVar = request.GetStudentIDPathVar(c)
+		schoolID     uuid.UUID
+		studentID    uuid.UUID
+		err          error
+	)
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "student_id": studentIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if studentID, err = uuid.Parse(studentIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	version, err := updateVersion(c, req.Version)
+	if err != nil {
+		logger.Errorf("failed to get version: %v", c.Error(err))
+		return
+	}
+
+	studentEntity, err := s.studentService.UpdateStudent(
+		ctx,
+		student.UpdateStudentArgs{
+			ID:         studentID,
+			SchoolID:   schoolID,
+			FirstName:  req.FirstName,
+			LastName:   req.LastName,
+			MiddleName: req.MiddleName,
+			Gender:     req.Gender,
+			UpdatedAt:  version,
+		},
+	)
+	if err != nil {
+		logger.Errorf("failed to update student: %v", c.Error(preconditionErr(c, err)))
+		return
+	}
+
+	setETag(c, studentEntity.UpdatedAt)
+	c.JSON(http.StatusOK, response.NewStudent(studentEntity))
+}
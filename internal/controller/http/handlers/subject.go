@@ -34,7 +34,7 @@ func (h Subject) CreateSubject(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -77,6 +77,10 @@ func (h Subject) SubjectByID(c *gin.Context) {
 		return
 	}
 
+	if notModified(c, subjectData.UpdatedAt) {
+		return
+	}
+
 	c.JSON(http.StatusOK, response.NewSubject(subjectData))
 }
 
@@ -89,23 +93,21 @@ func (h Subject) SubjectList(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
 	logger = logger.WithFields(liblog.Fields{"request": req})
 
+	listFilter, err := req.ListFilter.Fields(domain.SubjectListFields(), domain.NewPagination(req.Page, req.PerPage))
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	subjectList, count, err := h.subjectService.SubjectList(
 		ctx,
-		domain.NewSubjectListFilter(
-			domain.NewListFilter(
-				req.SortOrder,
-				domain.NewPagination(
-					req.Page,
-					req.PerPage,
-				),
-			),
-		),
+		domain.NewSubjectListFilter(listFilter),
 	)
 	if err != nil {
 		logger.Errorf("failed to get subject list: %v", c.Error(err))
@@ -118,3 +120,103 @@ func (h Subject) SubjectList(c *gin.Context) {
 		Total:   count,
 	}))
 }
+
+// DeleteSubject deletes subject by id.
+func (h Subject) DeleteSubject(c *gin.Context) {
+	var (
+		ctx       = c.Request.Context()
+		logger    = liblog.Must(ctx)
+		id        = request.GetSubjectIDPathVar(c)
+		subjectID uuid.UUID
+		err       error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"subject_id": id})
+	ctx = liblog.With(ctx, logger)
+
+	if subjectID, err = uuid.Parse(id); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = h.subjectService.DeleteSubject(ctx, subjectID); err != nil {
+		logger.Errorf("failed to delete subject: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RestoreSubject restores deleted subject by id.
+func (h Subject) RestoreSubject(c *gin.Context) {
+	var (
+		ctx       = c.Request.Context()
+		logger    = liblog.Must(ctx)
+		id        = request.GetSubjectIDPathVar(c)
+		subjectID uuid.UUID
+		err       error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"subject_id": id})
+	ctx = liblog.With(ctx, logger)
+
+	if subjectID, err = uuid.Parse(id); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = h.subjectService.RestoreSubject(ctx, subjectID); err != nil {
+		logger.Errorf("failed to restore subject: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// UpdateSubject updates subject by id.
+func (h Subject) UpdateSubject(c *gin.Context) {
+	var (
+		ctx       = c.Request.Context()
+		logger    = liblog.Must(ctx)
+		req       request.UpdateSubject
+		id        = request.GetSubjectIDPathVar(c)
+		subjectID uuid.UUID
+		err       error
+	)
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"subject_id": id})
+	ctx = liblog.With(ctx, logger)
+
+	if subjectID, err = uuid.Parse(id); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	version, err := updateVersion(c, req.Version)
+	if err != nil {
+		logger.Errorf("failed to get version: %v", c.Error(err))
+		return
+	}
+
+	subjectEntity, err := h.subjectService.UpdateSubject(
+		ctx,
+		subject.UpdateSubjectArgs{
+			ID:          subjectID,
+			Name:        req.Name,
+			Description: req.Description,
+			UpdatedAt:   version,
+		},
+	)
+	if err != nil {
+		logger.Errorf("failed to update subject: %v", c.Error(preconditionErr(c, err)))
+		return
+	}
+
+	setETag(c, subjectEntity.UpdatedAt)
+	c.JSON(http.StatusOK, response.NewSubject(subjectEntity))
+}
@@ -36,7 +36,7 @@ func (t Teacher) AddTeacher(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -62,27 +62,43 @@ func (t Teacher) AddTeacher(c *gin.Context) {
 // TeacherByID get teacher by id.
 func (t Teacher) TeacherByID(c *gin.Context) { //nolint:revive // It's handler
 	var (
-		ctx       = c.Request.Context()
-		logger    = liblog.Must(ctx)
-		reqParam  = request.GetTeacherIDPathVar(c)
-		teacherID uuid.UUID
-		err       error
+		ctx         = c.Request.Context()
+		logger      = liblog.Must(ctx)
+		reqParam    = request.GetTeacherIDPathVar(c)
+		schoolIDVar = request.GetSchoolIDHeader(c)
+		schoolID    uuid.UUID
+		teacherID   uuid.UUID
+		err         error
 	)
 
-	logger = logger.WithFields(liblog.Fields{"request": liblog.Fields{"teacher_id": reqParam}})
+	logger = logger.WithFields(liblog.Fields{
+		"request": liblog.Fields{
+			"teacher_id": reqParam,
+			"school_id":  schoolIDVar,
+		},
+	})
 	ctx = liblog.With(ctx, logger)
 
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
 	if teacherID, err = uuid.Parse(reqParam); err != nil {
 		logger.Errorf("failed to parse to uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
-	teacherEntity, err := t.teacherSvc.TeacherByID(ctx, teacherID)
+	teacherEntity, err := t.teacherSvc.TeacherByIDAndSchoolID(ctx, teacherID, schoolID)
 	if err != nil {
 		logger.Errorf("failed to get teacher by id: %v", c.Error(err))
 		return
 	}
 
+	if notModified(c, teacherEntity.UpdatedAt) {
+		return
+	}
+
 	c.JSON(http.StatusOK, response.NewTeacher(teacherEntity))
 }
 
@@ -95,7 +111,13 @@ func (t Teacher) ListTeacher(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	schoolID, err := uuid.Parse(GetListSchoolID(c))
+	if err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
@@ -103,14 +125,27 @@ func (t Teacher) ListTeacher(c *gin.Context) {
 		"request": req,
 	})
 
+	search, err := domain.NewSearchQuery(req.Query)
+	if err != nil {
+		logger.Errorf("failed to create search query: %v", c.Error(err))
+		return
+	}
+
+	listFilter, err := req.ListFilter.Fields(domain.TeacherListFields(), domain.NewPagination(req.Page, req.PerPage))
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	list, total, err := t.teacherSvc.TeacherList(
 		ctx,
 		domain.NewTeacherListFilter(
-			domain.NewListFilter(req.SortOrder, domain.NewPagination(req.Page, req.PerPage)),
+			listFilter,
 			domain.NewDateFilter(req.CreatedDate.DateFrom(), req.CreatedDate.DateTill()),
 			req.GroupUUIDs(),
-			req.SchoolUUIDs(),
+			[]uuid.UUID{schoolID},
 			req.OrganizationUUIDs(),
+			search,
 		),
 	)
 	if err != nil {
@@ -124,3 +159,125 @@ func (t Teacher) ListTeacher(c *gin.Context) {
 		Total:   total,
 	}))
 }
+
+// DeleteTeacher deletes teacher of the school.
+func (t Teacher) DeleteTeacher(c *gin.Context) {
+	var (
+		ctx          = c.Request.Context()
+		logger       = liblog.Must(ctx)
+		schoolIDVar  = request.GetSchoolIDHeader(c)
+		teacherIDVar = request.GetTeacherIDPathVar(c)
+		schoolID     uuid.UUID
+		teacherID    uuid.UUID
+		err          error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "teacher_id": teacherIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if teacherID, err = uuid.Parse(teacherIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = t.teacherSvc.DeleteTeacher(ctx, schoolID, teacherID); err != nil {
+		logger.Errorf("failed to delete teacher: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// RestoreTeacher restores deleted teacher of the school.
+func (t Teacher) RestoreTeacher(c *gin.Context) {
+	var (
+		ctx          = c.Request.Context()
+		logger       = liblog.Must(ctx)
+		schoolIDVar  = request.GetSchoolIDHeader(c)
+		teacherIDVar = request.GetTeacherIDPathVar(c)
+		schoolID     uuid.UUID
+		teacherID    uuid.UUID
+		err          error
+	)
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "teacher_id": teacherIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if teacherID, err = uuid.Parse(teacherIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = t.teacherSvc.RestoreTeacher(ctx, schoolID, teacherID); err != nil {
+		logger.Errorf("failed to restore teacher: %v", c.Error(err))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// UpdateTeacher updates contacts of the teacher of the school.
+func (t Teacher) UpdateTeacher(c *gin.Context) {
+	var (
+		ctx          = c.Request.Context()
+		logger       = liblog.Must(ctx)
+		req          request.UpdateTeacher
+		schoolIDVar  = request.GetSchoolIDHeader(c)
+		teacherIDVar = request.GetTeacherIDPathVar(c)
+		schoolID     uuid.UUID
+		teacherID    uuid.UUID
+		err          error
+	)
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"school_id": schoolIDVar, "teacher_id": teacherIDVar})
+	ctx = liblog.With(ctx, logger)
+
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if teacherID, err = uuid.Parse(teacherIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	version, err := updateVersion(c, req.Version)
+	if err != nil {
+		logger.Errorf("failed to get version: %v", c.Error(err))
+		return
+	}
+
+	teacherEntity, err := t.teacherSvc.UpdateTeacher(
+		ctx,
+		teacher.UpdateTeacherArgs{
+			ID:        teacherID,
+			SchoolID:  schoolID,
+			Phone:     req.Phone,
+			Email:     req.Email,
+			UpdatedAt: version,
+		},
+	)
+	if err != nil {
+		logger.Errorf("failed to update teacher: %v", c.Error(preconditionErr(c, err)))
+		return
+	}
+
+	setETag(c, teacherEntity.UpdatedAt)
+	c.JSON(http.StatusOK, response.NewTeacher(teacherEntity))
+}
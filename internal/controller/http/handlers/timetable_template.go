@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/controller/http/handlers/response"
+	"bum-service/internal/domain"
+	"bum-service/internal/service/lesson"
+	"bum-service/pkg/liblog"
+)
+
+// CreateTimetableTemplate creates a recurring weekly timetable of the group.
+func (l *Lesson) CreateTimetableTemplate(c *gin.Context) {
+	var (
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		req             request.CreateTimetableTemplate
+		schoolIDPathVar = request.GetSchoolIDPathVar(c)
+		schoolID        uuid.UUID
+		err             error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req, "school_id": schoolID})
+	ctx = liblog.With(ctx, logger)
+
+	template, err := l.lessonService.CreateTimetableTemplate(ctx, convertCreateTimetableTemplateToArgs(req, schoolID))
+	if err != nil {
+		logger.Errorf("failed to create timetable template: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.NewTimetableTemplate(template))
+}
+
+func convertCreateTimetableTemplateToArgs(
+	r request.CreateTimetableTemplate, schoolID uuid.UUID,
+) lesson.CreateTimetableTemplateArgs {
+	dateFrom, dateTill := r.Period()
+
+	args := lesson.CreateTimetableTemplateArgs{
+		SchoolID:   schoolID,
+		GroupID:    r.GroupID,
+		Name:       r.Name,
+		DateFrom:   dateFrom,
+		DateTill:   dateTill,
+		Slots:      make([]lesson.TemplateSlot, 0, len(r.Slots)),
+		Exclusions: make([]lesson.TemplateExclusion, 0, len(r.Exclusions)),
+	}
+
+	for _, slot := range r.Slots {
+		args.Slots = append(args.Slots, lesson.TemplateSlot{
+			GroupSubjectID: slot.GroupSubjectID,
+			TeacherID:      slot.TeacherID,
+			AuditoriumID:   slot.AuditoriumID,
+			Weekday:        slot.Weekday,
+			Bell:           slot.BellSlot(),
+			Description:    slot.Description,
+		})
+	}
+
+	for _, exclusion := range r.Exclusions {
+		from, till := exclusion.Period()
+
+		args.Exclusions = append(args.Exclusions, lesson.TemplateExclusion{
+			DateFrom: from,
+			DateTill: till,
+			Reason:   exclusion.Reason,
+		})
+	}
+
+	return args
+}
+
+// TimetableTemplateByID returns timetable template of the school.
+func (l *Lesson) TimetableTemplateByID(c *gin.Context) {
+	var (
+		ctx               = c.Request.Context()
+		logger            = liblog.Must(ctx)
+		schoolIDPathVar   = request.GetSchoolIDPathVar(c)
+		templateIDPathVar = request.GetTemplateIDPathVar(c)
+		schoolID          uuid.UUID
+		templateID        uuid.UUID
+		err               error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if templateID, err = uuid.Parse(templateIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	template, err := l.lessonService.TimetableTemplateByID(ctx, templateID, schoolID)
+	if err != nil {
+		logger.Errorf("failed to get timetable template by id: %v", c.Error(err))
+		return
+	}
+
+	if notModified(c, template.UpdatedAt) {
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewTimetableTemplate(template))
+}
+
+// TimetableTemplateList returns timetable templates of the school.
+func (l *Lesson) TimetableTemplateList(c *gin.Context) {
+	var (
+		ctx             = c.Request.Context()
+		logger          = liblog.Must(ctx)
+		req             request.TimetableTemplateList
+		schoolIDPathVar = request.GetSchoolIDPathVar(c)
+		schoolID        uuid.UUID
+		err             error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindQuery(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	list, err := l.lessonService.TimetableTemplateList(
+		ctx, domain.NewTimetableTemplateFilters(schoolID, req.GroupID),
+	)
+	if err != nil {
+		logger.Errorf("failed to get timetable template list: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewTimetableTemplates(list))
+}
+
+// MaterialiseTimetableTemplate creates lessons of the timetable template for the period.
+func (l *Lesson) MaterialiseTimetableTemplate(c *gin.Context) {
+	var (
+		ctx               = c.Request.Context()
+		logger            = liblog.Must(ctx)
+		req               request.MaterialiseTimetableTemplate
+		schoolIDPathVar   = request.GetSchoolIDPathVar(c)
+		templateIDPathVar = request.GetTemplateIDPathVar(c)
+		schoolID          uuid.UUID
+		templateID        uuid.UUID
+		err               error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if templateID, err = uuid.Parse(templateIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req, "template_id": templateID})
+	ctx = liblog.With(ctx, logger)
+
+	dateFrom, dateTill := req.Period()
+
+	lessons, err := l.lessonService.MaterialiseTimetableTemplate(ctx, lesson.MaterialiseTimetableTemplateArgs{
+		SchoolID:   schoolID,
+		TemplateID: templateID,
+		DateFrom:   dateFrom,
+		DateTill:   dateTill,
+	})
+	if err != nil {
+		logger.Errorf("failed to materialise timetable template: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewLessons(lessons))
+}
+
+// OverrideLesson changes a single lesson occurrence, e.g. sets substitute teacher.
+func (l *Lesson) OverrideLesson(c *gin.Context) {
+	var (
+		ctx               = c.Request.Context()
+		logger            = liblog.Must(ctx)
+		req               request.OverrideLesson
+		schoolIDHeaderVar = request.GetSchoolIDHeader(c)
+		lessonIDPathVar   = request.GetLessonIDPathVar(c)
+		schoolID          uuid.UUID
+		lessonID          uuid.UUID
+		err               error
+	)
+
+	if schoolID, err = uuid.Parse(schoolIDHeaderVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if lessonID, err = uuid.Parse(lessonIDPathVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	if err = c.ShouldBindJSON(&req); err != nil {
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	logger = logger.WithFields(liblog.Fields{"request": req, "lesson_id": lessonID})
+	ctx = liblog.With(ctx, logger)
+
+	updated, err := l.lessonService.OverrideLesson(ctx, lesson.OverrideLessonArgs{
+		SchoolID:     schoolID,
+		LessonID:     lessonID,
+		TeacherID:    req.TeacherID,
+		AuditoriumID: req.AuditoriumID,
+		Description:  req.Description,
+	})
+	if err != nil {
+		logger.Errorf("failed to override lesson: %v", c.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewLesson(updated))
+}
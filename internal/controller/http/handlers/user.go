@@ -32,20 +32,27 @@ func (u User) UserFullInfoByID(c *gin.Context) {
 		ctx    = c.Request.Context()
 		logger = liblog.Must(ctx)
 
-		reqParam = request.GetUserIDPathVar(c)
-		userID   uuid.UUID
+		reqParam    = request.GetUserIDPathVar(c)
+		schoolIDVar = request.GetSchoolIDHeader(c)
+		userID      uuid.UUID
+		schoolID    uuid.UUID
 
 		err error
 	)
 
-	logger = logger.WithFields(liblog.Fields{"user_id": userID})
+	logger = logger.WithFields(liblog.Fields{"user_id": reqParam, "school_id": schoolIDVar})
 
 	if userID, err = uuid.Parse(reqParam); err != nil {
 		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
-	userFullInfo, err := u.userService.UserFullInfoByID(ctx, userID)
+	if schoolID, err = uuid.Parse(schoolIDVar); err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
+		return
+	}
+
+	userFullInfo, err := u.userService.UserFullInfoByIDAndSchoolID(ctx, userID, schoolID)
 	if err != nil {
 		logger.Errorf("failed to get user full info by id: %v", c.Error(err))
 		return
@@ -82,7 +89,7 @@ func (u User) AddUser(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
 		return
 	}
 
@@ -118,22 +125,47 @@ func (u User) UserList(c *gin.Context) {
 	)
 
 	if err := c.ShouldBindQuery(&req); err != nil {
-		logger.Errorf("failed to bind: %v", c.Error(domain.NewBadRequest(err.Error())))
+		logger.Errorf("failed to bind: %v", c.Error(newBindingErr(err)))
+		return
+	}
+
+	schoolID, err := uuid.Parse(GetListSchoolID(c))
+	if err != nil {
+		logger.Errorf("failed to parse uuid: %v", c.Error(domain.NewBadRequest(err.Error())))
 		return
 	}
 
 	logger = logger.WithFields(liblog.Fields{"request": req})
 
+	pagination, err := domain.NewCursorPagination(req.Cursor, req.Page, req.PerPage)
+	if err != nil {
+		logger.Errorf("failed to create pagination: %v", c.Error(err))
+		return
+	}
+
+	search, err := domain.NewSearchQuery(req.Query)
+	if err != nil {
+		logger.Errorf("failed to create search query: %v", c.Error(err))
+		return
+	}
+
+	listFilter, err := req.ListFilter.Fields(domain.UserListFields(), pagination)
+	if err != nil {
+		logger.Errorf("failed to create list filter: %v", c.Error(err))
+		return
+	}
+
 	filters, err := domain.NewUserListFilter(
 		req.OrganizationUUIDs(),
-		req.SchoolUUIDs(),
+		[]uuid.UUID{schoolID},
 
 		req.UserRoles(),
 
 		req.Emails,
 		req.Phones,
+		search,
 
-		domain.NewListFilter(req.SortOrder, domain.NewPagination(req.Page, req.PerPage)),
+		listFilter,
 	)
 	if err != nil {
 		logger.Errorf("failed to create user filter: %v", c.Error(err))
@@ -146,11 +178,13 @@ func (u User) UserList(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, response.NewUserList(userList,
+	page := domain.NewCursorPage(userList, listFilter, domain.User.Cursor)
+
+	c.JSON(http.StatusOK, response.NewUserList(page.List,
 		response.Pagination{
 			Page:    req.Page,
 			PerPage: req.PerPage,
 			Total:   count,
-		},
+		}.WithCursors(page.Next, page.Prev),
 	))
 }
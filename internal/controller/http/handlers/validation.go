@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/liberror"
+	"bum-service/pkg/libi18n"
+	"bum-service/pkg/utils"
+)
+
+// RegisterValidatorFieldNames makes validation errors refer to fields by their names in the request
+// instead of the names of the go struct fields.
+func RegisterValidatorFieldNames() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(requestFieldName)
+	}
+}
+
+// RegisterValidatorPatchTypes makes the rules of nullable fields of patch requests validate the values
+// of the fields, the rules are skipped for the absent and null fields with omitempty.
+func RegisterValidatorPatchTypes() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterCustomTypeFunc(patchValue, utils.Patch[string]{}, utils.Patch[uuid.UUID]{})
+	}
+}
+
+// patchValue returns the value of the patch field for validation.
+func patchValue(field reflect.Value) any {
+	if patch, ok := field.Interface().(interface{ ValidationValue() any }); ok {
+		return patch.ValidationValue()
+	}
+
+	return nil
+}
+
+// requestFieldName returns the name of the field from its json, form or uri tag.
+func requestFieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "form", "uri"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+
+		if name == "-" {
+			return ""
+		}
+
+		if name != "" {
+			return name
+		}
+	}
+
+	return field.Name
+}
+
+// newBindingErr converts an error of binding the request to a validation error listing the invalid fields.
+func newBindingErr(err error) *liberror.Error {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		fields := make([]liberror.FieldError, 0, len(validationErrs))
+
+		for _, fieldErr := range validationErrs {
+			fields = append(fields, liberror.FieldError{
+				Field:  fieldPath(fieldErr.Namespace()),
+				Rule:   fieldErr.Tag(),
+				Params: strings.Fields(fieldErr.Param()),
+			})
+		}
+
+		return domain.ErrValidation.WithFields(fields...)
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return domain.ErrValidation.WithFields(liberror.FieldError{
+			Field:  typeErr.Field,
+			Rule:   "type",
+			Params: []string{typeErr.Type.Kind().String()},
+		})
+	}
+
+	return domain.NewBadRequest(err.Error())
+}
+
+// fieldPath removes the name of the request struct from the namespace of the field.
+func fieldPath(namespace string) string {
+	if _, path, ok := strings.Cut(namespace, "."); ok {
+		return path
+	}
+
+	return namespace
+}
+
+// domainFieldErrors are domain errors caused by a single field of the request.
+//
+//nolint:gochecknoglobals // it's list of errors
+var domainFieldErrors = []struct {
+	err   error
+	field liberror.FieldError
+}{
+	{err: domain.ErrUserEmailAlreadyExists, field: liberror.FieldError{Field: "email", Rule: "unique"}},
+	{err: domain.ErrUserPhoneAlreadyExists, field: liberror.FieldError{Field: "phone", Rule: "unique"}},
+	{err: domain.ErrSchoolEmailAlreadyExists, field: liberror.FieldError{Field: "email", Rule: "unique"}},
+	{err: domain.ErrSchoolPhoneAlreadyExists, field: liberror.FieldError{Field: "phone", Rule: "unique"}},
+	{err: domain.ErrHeadmasterEmailAlreadyExists, field: liberror.FieldError{Field: "email", Rule: "unique"}},
+	{err: domain.ErrHeadmasterPhoneAlreadyExists, field: liberror.FieldError{Field: "phone", Rule: "unique"}},
+	{err: domain.ErrEduOrganizationAlreadyExists, field: liberror.FieldError{Field: "name", Rule: "unique"}},
+	{err: domain.ErrSchoolAlreadyExists, field: liberror.FieldError{Field: "name", Rule: "unique"}},
+	{err: domain.ErrSubjectNameAlreadyExists, field: liberror.FieldError{Field: "name", Rule: "unique"}},
+	{err: domain.ErrGradeNameAlreadyExists, field: liberror.FieldError{Field: "name", Rule: "unique"}},
+	{err: domain.ErrGradeStandardNameAlreadyExists, field: liberror.FieldError{Field: "name", Rule: "unique"}},
+	{err: domain.ErrGradingScaleAlreadyExists, field: liberror.FieldError{Field: "name", Rule: "unique"}},
+	{err: domain.ErrAuditoriumAlreadyExists, field: liberror.FieldError{Field: "name", Rule: "unique"}},
+	{err: domain.ErrGroupAlreadyExists, field: liberror.FieldError{Field: "name", Rule: "unique"}},
+	{err: domain.ErrAcademicYearAlreadyExists, field: liberror.FieldError{Field: "name", Rule: "unique"}},
+	{err: domain.ErrWrongCurrentPassword, field: liberror.FieldError{Field: "current_password", Rule: "password"}},
+	{err: domain.ErrInvalidUserToken, field: liberror.FieldError{Field: "token", Rule: "token"}},
+	{err: domain.ErrInvalidLoginCode, field: liberror.FieldError{Field: "code", Rule: "code"}},
+	{err: domain.ErrPreconditionRequired, field: liberror.FieldError{Field: "updated_at", Rule: "required"}},
+	{err: domain.ErrInvalidCursor, field: liberror.FieldError{Field: "cursor", Rule: "invalid"}},
+	{err: domain.ErrInvalidSort, field: liberror.FieldError{Field: "sort", Rule: "invalid"}},
+	{err: domain.ErrInvalidFilter, field: liberror.FieldError{Field: "filter", Rule: "invalid"}},
+	{
+		err:   domain.ErrSearchQueryTooShort,
+		field: liberror.FieldError{Field: "q", Rule: "min", Params: []string{strconv.Itoa(domain.SearchQueryMinLength)}},
+	},
+	{err: domain.ErrInvalidStudentRosterFile, field: liberror.FieldError{Field: "file", Rule: "invalid"}},
+	{
+		err: domain.ErrStudentRosterTooLarge,
+		field: liberror.FieldError{
+			Field:  "file",
+			Rule:   "max",
+			Params: []string{strconv.Itoa(domain.StudentRosterMaxSize>>20) + " MB"},
+		},
+	},
+	{err: domain.ErrInvalidSchoolSetupFile, field: liberror.FieldError{Field: "file", Rule: "invalid"}},
+	{
+		err: domain.ErrSchoolSetupTooLarge,
+		field: liberror.FieldError{
+			Field:  "file",
+			Rule:   "max",
+			Params: []string{strconv.Itoa(domain.SchoolSetupMaxSize>>20) + " MB"},
+		},
+	},
+	{err: domain.ErrSearchTypeBadRequest, field: liberror.FieldError{Field: "types", Rule: "invalid"}},
+}
+
+// localizeError returns a copy of the error with the message and the field errors in the language.
+// The fields of domain errors caused by a single field are added to the error.
+func localizeError(err error, language libi18n.Language) error {
+	var customErr *liberror.Error
+	if !errors.As(err, &customErr) {
+		return err
+	}
+
+	localized := *customErr
+
+	// English messages of errors are more detailed than the translations, so they are kept.
+	if language != libi18n.English {
+		if message, ok := errorMessage(language, customErr.Code); ok {
+			localized.Err = message
+		}
+	}
+
+	if len(localized.Fields) == 0 {
+		for _, domainErr := range domainFieldErrors {
+			if errors.Is(customErr, domainErr.err) {
+				localized.Fields = []liberror.FieldError{domainErr.field}
+
+				break
+			}
+		}
+	}
+
+	fields := make([]liberror.FieldError, 0, len(localized.Fields))
+
+	for _, field := range localized.Fields {
+		field.Message = fieldMessage(language, field.Rule, field.Params)
+		fields = append(fields, field)
+	}
+
+	localized.Fields = fields
+
+	return &localized
+}
+
+// errorMessage returns the message of the error with the code, the error family message
+// is returned for codes with the entity, e.g. NOT_FOUND: USER.
+func errorMessage(language libi18n.Language, code string) (string, bool) {
+	if message, ok := messages.Message(language, "error."+code); ok {
+		return message, true
+	}
+
+	family, _, _ := strings.Cut(code, ":")
+
+	return messages.Message(language, "error."+family)
+}
+
+// fieldMessage returns the message of the failed validation rule.
+func fieldMessage(language libi18n.Language, rule string, params []string) string {
+	if message, ok := messages.Message(language, "validation."+rule, strings.Join(params, ", ")); ok {
+		return message
+	}
+
+	message, _ := messages.Message(language, "validation.invalid")
+
+	return message
+}
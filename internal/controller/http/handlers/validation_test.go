@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/domain"
+	"bum-service/pkg/liberror"
+	"bum-service/pkg/libi18n"
+	"bum-service/pkg/liblog"
+)
+
+//nolint:funlen // it's test function.
+func TestErrorHandlingMiddleware_FieldErrors(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	RegisterValidatorFieldNames()
+
+	router := gin.New()
+	router.Use(LoggingEndpointMiddleware(liblog.NewDummyLogger()))
+	router.Use(ErrorHandlingMiddleware())
+
+	router.POST("/login", func(c *gin.Context) {
+		var req request.LoginByEmail
+
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(newBindingErr(err))
+			return
+		}
+
+		_ = c.Error(domain.ErrInvalidUser)
+	})
+
+	router.POST("/users", func(c *gin.Context) {
+		_ = c.Error(domain.ErrUserEmailAlreadyExists)
+	})
+
+	tests := []struct {
+		name        string
+		path        string
+		body        string
+		language    string
+		wantCode    int
+		wantMessage string
+		wantFields  []liberror.FieldError
+	}{
+		{
+			name:        "validation errors in russian",
+			path:        "/login",
+			body:        `{"email":"not an email"}`,
+			language:    "ru-RU,ru;q=0.9,en;q=0.8",
+			wantCode:    http.StatusBadRequest,
+			wantMessage: "Некорректный запрос",
+			wantFields: []liberror.FieldError{
+				{Field: "email", Rule: "email", Message: "некорректный адрес электронной почты"},
+				{Field: "password", Rule: "required", Message: "обязательное поле"},
+			},
+		},
+		{
+			name:        "wrong type in english",
+			path:        "/login",
+			body:        `{"email":1,"password":"secret"}`,
+			wantCode:    http.StatusBadRequest,
+			wantMessage: domain.ErrValidation.Err,
+			wantFields: []liberror.FieldError{
+				{Field: "email", Rule: "type", Params: []string{"string"}, Message: "must be of type string"},
+			},
+		},
+		{
+			name:        "domain error in tajik",
+			path:        "/login",
+			body:        `{"email":"user@example.com","password":"secret"}`,
+			language:    string(libi18n.Tajik),
+			wantCode:    http.StatusUnauthorized,
+			wantMessage: "Корбар ё парол нодуруст аст",
+		},
+		{
+			name:        "domain field error in uzbek",
+			path:        "/users",
+			language:    string(libi18n.Uzbek),
+			wantCode:    http.StatusConflict,
+			wantMessage: "Allaqachon mavjud",
+			wantFields: []liberror.FieldError{
+				{Field: "email", Rule: "unique", Message: "allaqachon band"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := http.NewRequestWithContext(context.Background(), http.MethodPost, tt.path, strings.NewReader(tt.body))
+			require.NoError(t, err)
+
+			r.Header.Set("Content-Type", "application/json")
+			r.Header.Set(libi18n.AcceptLanguageHeader, tt.language)
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, r)
+
+			require.Equal(t, tt.wantCode, recorder.Code)
+
+			var resp liberror.Error
+
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+
+			assert.Equal(t, tt.wantMessage, resp.Err)
+			assert.Equal(t, tt.wantFields, resp.Fields)
+		})
+	}
+}
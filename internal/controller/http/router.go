@@ -1,12 +1,12 @@
 package http
 
 import (
-	"time"
-
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 
 	"bum-service/internal/controller/http/handlers"
+	"bum-service/internal/controller/http/handlers/request"
+	"bum-service/internal/domain"
 	"bum-service/pkg/liblog"
 )
 
@@ -15,9 +15,7 @@ func RegisterHandlers(
 	router *gin.Engine,
 	logger liblog.Logger,
 
-	jwtSecret string,
-	accessTokenExp time.Duration,
-	refreshTokenExp time.Duration,
+	tokenConfig handlers.TokenConfig,
 
 	authService handlers.IAuthService,
 	systemService handlers.ISystemService,
@@ -32,7 +30,12 @@ func RegisterHandlers(
 	gradesService handlers.IGradesService,
 	studentService handlers.IStudentService,
 	lessonService handlers.ILessonService,
+	policyService handlers.IPolicyService,
+	setupService handlers.ISetupService,
 ) error {
+	handlers.RegisterValidatorFieldNames()
+	handlers.RegisterValidatorPatchTypes()
+
 	router.Use(gin.Logger())
 	router.Use(handlers.LoggingEndpointMiddleware(logger))
 	router.Use(handlers.ErrorHandlingMiddleware())
@@ -40,37 +43,49 @@ func RegisterHandlers(
 
 	corsConfig := cors.DefaultConfig()
 	corsConfig.AllowAllOrigins = true
-	corsConfig.AddAllowHeaders("Authorization")
+	corsConfig.AddAllowHeaders("Authorization", "If-Match", "If-None-Match")
+	corsConfig.AddExposeHeaders("ETag", handlers.NextCursorHeader, handlers.PrevCursorHeader)
 
 	router.Use(cors.New(corsConfig))
 
 	routerV1 := router.Group("/v1")
 
-	auth := registerAuthHandlers(routerV1, jwtSecret, accessTokenExp, refreshTokenExp, authService, userService)
+	auth := registerAuthHandlers(routerV1, tokenConfig, authService, userService)
+
+	// public keys of the user tokens are published for other services.
+	router.GET("/.well-known/jwks.json", auth.JWKS)
 
 	registerSystemHandlers(routerV1, systemService)
 
-	registerEduOrganizationHandlers(routerV1, eduOrganizationService)
+	// all routes below require an authenticated user and are checked by access policies.
+	authorized := routerV1.Group("", auth.AuthMiddleware)
+	policy := handlers.NewPolicy(policyService)
+
+	registerSessionHandlers(authorized, policy, auth)
+
+	registerEduOrganizationHandlers(authorized, policy, eduOrganizationService)
 
-	registerOwnerHandlers(routerV1, ownerService)
+	registerOwnerHandlers(authorized, policy, ownerService)
 
-	registerSchoolHandlers(routerV1, schoolService)
+	registerSchoolHandlers(authorized, policy, schoolService)
 
-	registerDirectorHandlers(routerV1, directorService)
+	registerDirectorHandlers(authorized, policy, directorService)
 
-	registerHeadmasterHandlers(routerV1, headmasterService)
+	registerHeadmasterHandlers(authorized, policy, headmasterService)
 
-	registerSubjectHandlers(routerV1, subjectService)
+	registerSubjectHandlers(authorized, policy, subjectService)
 
-	registerUserHandlers(routerV1, auth, userService)
+	registerUserHandlers(authorized, policy, userService)
 
-	registerTeacherHandlers(routerV1, teacherService)
+	registerTeacherHandlers(authorized, policy, teacherService)
 
-	registerStudentsHandlers(routerV1, studentService)
+	registerStudentsHandlers(authorized, policy, studentService)
 
-	registerGradesHandlers(routerV1, gradesService)
+	registerGradesHandlers(authorized, policy, gradesService)
 
-	registerLessonsHandlers(routerV1, lessonService)
+	registerLessonsHandlers(authorized, policy, lessonService)
+
+	registerSetupHandlers(authorized, policy, setupService)
 
 	return nil
 }
@@ -85,9 +100,7 @@ func registerSystemHandlers(router *gin.RouterGroup, systemService handlers.ISys
 // registerAuthHandlers registers all Auth handlers.
 func registerAuthHandlers(
 	router *gin.RouterGroup,
-	jwtSecret string,
-	accessTokenExp time.Duration,
-	refreshTokenExp time.Duration,
+	tokenConfig handlers.TokenConfig,
 	authService handlers.IAuthService,
 	userService handlers.IUserService,
 ) *handlers.Auth {
@@ -95,157 +108,423 @@ func registerAuthHandlers(
 		authService,
 		userService,
 
-		jwtSecret,
-		accessTokenExp,
-		refreshTokenExp,
+		tokenConfig,
 	)
 
 	router.POST("/login/email", h.LoginByEmail)
-	router.POST("/login/refresh", h.AuthMiddleware, h.RefreshToken)
+	router.POST("/login/phone/request", h.SendPhoneLoginCode)
+	router.POST("/login/phone/verify", h.LoginByPhone)
+	router.POST("/login/refresh", h.RefreshToken)
+
+	router.POST("/password/forgot", h.ForgotPassword)
+	router.POST("/password/reset", h.ResetPassword)
+	router.POST("/email/verify", h.VerifyEmail)
 
 	return h
 }
 
-func registerUserHandlers(router *gin.RouterGroup, auth *handlers.Auth, userService handlers.IUserService) {
+// registerSessionHandlers registers auth session and account handlers of authenticated users.
+func registerSessionHandlers(router *gin.RouterGroup, policy handlers.Policy, h *handlers.Auth) {
+	router.POST("/login/context", h.SelectRole)
+	router.GET("/sessions", h.Sessions)
+	router.POST("/logout", h.Logout)
+	router.POST("/logout/all", h.LogoutAll)
+	router.DELETE("/users/:user_id/sessions", policy.Authorize(domain.RoleAdmin), h.RevokeUserSessions)
+	router.DELETE("/users/:user_id/login-lock", policy.Authorize(domain.RoleAdmin), h.UnlockUserLogin)
+
+	router.PUT("/user/password", h.ChangePassword)
+	router.POST("/user/email/verification", h.SendEmailVerification)
+}
+
+func registerUserHandlers(router *gin.RouterGroup, policy handlers.Policy, userService handlers.IUserService) {
 	h := handlers.NewUser(userService)
 
-	router.POST("/users", h.AddUser)
-	router.GET("/user/full-info", auth.AuthMiddleware, h.UserFullInfoFromToken)
-	router.GET("/user/:user_id/full-info", h.UserFullInfoByID)
-	router.GET("/users", h.UserList)
+	router.POST("/users", policy.Authorize(schoolStaffRoles()...), h.AddUser)
+	router.GET("/user/full-info", h.UserFullInfoFromToken)
+	router.GET(
+		"/user/:user_id/full-info",
+		policy.AuthorizeSchool(request.GetSchoolIDHeader, schoolMemberRoles()...),
+		h.UserFullInfoByID,
+	)
+	router.GET("/users", policy.AuthorizeSchool(handlers.GetListSchoolID, schoolStaffRoles()...), h.UserList)
+
+	// SEARCH
+	router.GET("/search", policy.Authorize(schoolTeachingRoles()...), h.Search)
 }
 
 // registerEduOrganizationHandlers registers all educational organization handlers.
-func registerEduOrganizationHandlers(router *gin.RouterGroup, eduOrganizationService handlers.IEduOrganizationService) {
+func registerEduOrganizationHandlers(
+	router *gin.RouterGroup,
+	policy handlers.Policy,
+	eduOrganizationService handlers.IEduOrganizationService,
+) {
 	h := handlers.NewEduOrganization(eduOrganizationService)
 
-	router.POST("/edu-organizations", h.CreateEduOrganization)
-	router.GET("/edu-organizations/:edu_organization_id", h.EduOrganizationByID)
-	router.PUT("/edu-organizations/:edu_organization_id", h.UpdateEduOrganizationByID)
-	router.GET("/edu-organizations", h.EduOrganizationList)
+	organizationOwner := policy.AuthorizeEduOrganization(request.GetEduOrganizationPathVar, domain.RoleOwner)
+
+	router.POST("/edu-organizations", policy.Authorize(domain.RoleAdmin), h.CreateEduOrganization)
+	router.GET("/edu-organizations/:edu_organization_id", organizationOwner, h.EduOrganizationByID)
+	router.PUT("/edu-organizations/:edu_organization_id", organizationOwner, h.UpdateEduOrganizationByID)
+	router.GET("/edu-organizations", policy.Authorize(domain.RoleAdmin), h.EduOrganizationList)
+	router.DELETE("/edu-organizations/:edu_organization_id", policy.Authorize(domain.RoleAdmin), h.DeleteEduOrganization)
+	router.POST(
+		"/edu-organizations/:edu_organization_id/restore",
+		policy.Authorize(domain.RoleAdmin),
+		h.RestoreEduOrganization,
+	)
 }
 
 // registerSchoolHandlers registers all school handlers.
 func registerSchoolHandlers(
 	router *gin.RouterGroup,
+	policy handlers.Policy,
 
 	schoolService handlers.ISchoolService,
 ) {
 	schoolHandlers := handlers.NewSchool(schoolService)
 
+	var (
+		schoolStaff   = policy.AuthorizeSchool(request.GetSchoolIDPathVar, schoolStaffRoles()...)
+		schoolMembers = policy.AuthorizeSchool(request.GetSchoolIDPathVar, schoolTeachingRoles()...)
+	)
+
 	// SCHOOL
-	router.POST("/schools", schoolHandlers.AddSchool)
-	router.GET("/schools/:school_id", schoolHandlers.SchoolByID)
-	router.PUT("/schools/:school_id", schoolHandlers.UpdateSchool)
-	router.GET("/schools", schoolHandlers.SchoolList)
+	router.POST(
+		"/schools",
+		policy.AuthorizeEduOrganization(request.GetOrganizationIDBodyVar, domain.RoleOwner),
+		schoolHandlers.AddSchool,
+	)
+	router.GET("/schools/:school_id", schoolMembers, schoolHandlers.SchoolByID)
+	router.PUT(
+		"/schools/:school_id",
+		policy.AuthorizeSchool(request.GetSchoolIDPathVar, domain.RoleOwner, domain.RoleDirector),
+		schoolHandlers.UpdateSchool,
+	)
+	router.GET("/schools", policy.Authorize(schoolStaffRoles()...), schoolHandlers.SchoolList)
+	router.DELETE(
+		"/schools/:school_id",
+		policy.AuthorizeSchool(request.GetSchoolIDPathVar, domain.RoleOwner),
+		schoolHandlers.DeleteSchool,
+	)
+	router.POST(
+		"/edu-organizations/:edu_organization_id/schools/:school_id/restore",
+		policy.AuthorizeEduOrganization(request.GetEduOrganizationPathVar, domain.RoleOwner),
+		schoolHandlers.RestoreSchool,
+	)
 
 	// GROUPS
-	router.POST("/schools/:school_id/groups", schoolHandlers.CreateGroup)
-	router.GET("/schools/:school_id/groups/:group_id", schoolHandlers.GroupByID)
-	router.PUT("/schools/:school_id/groups/:group_id", schoolHandlers.UpdateGroup)
-	router.GET("/schools/:school_id/groups", schoolHandlers.GroupList)
+	router.POST("/schools/:school_id/groups", schoolStaff, schoolHandlers.CreateGroup)
+	router.GET("/schools/:school_id/groups/:group_id", schoolMembers, schoolHandlers.GroupByID)
+	router.PUT("/schools/:school_id/groups/:group_id", schoolStaff, schoolHandlers.UpdateGroup)
+	router.GET("/schools/:school_id/groups", schoolMembers, schoolHandlers.GroupList)
+	router.DELETE("/schools/:school_id/groups/:group_id", schoolStaff, schoolHandlers.DeleteGroup)
+	router.POST("/schools/:school_id/groups/:group_id/restore", schoolStaff, schoolHandlers.RestoreGroup)
 
 	// GROUPS SUBJECTS
-	router.POST("/schools/:school_id/groups/:group_id/subjects", schoolHandlers.AddGroupSubject)
-	router.GET("/schools/:school_id/groups/:group_id/subjects", schoolHandlers.GroupSubjectList)
+	router.POST("/schools/:school_id/groups/:group_id/subjects", schoolStaff, schoolHandlers.AddGroupSubject)
+	router.GET("/schools/:school_id/groups/:group_id/subjects", schoolMembers, schoolHandlers.GroupSubjectList)
 
 	// SCHOOL SUBJECTS
-	router.POST("/schools/:school_id/subjects", schoolHandlers.AddSchoolSubject)
-	router.GET("/schools/:school_id/subjects/:school_subject_id", schoolHandlers.SchoolSubjectByIDAndSchoolID)
-	router.GET("/schools/:school_id/subjects", schoolHandlers.SchoolSubjectList)
+	router.POST("/schools/:school_id/subjects", schoolStaff, schoolHandlers.AddSchoolSubject)
+	router.GET(
+		"/schools/:school_id/subjects/:school_subject_id",
+		schoolMembers,
+		schoolHandlers.SchoolSubjectByIDAndSchoolID,
+	)
+	router.GET("/schools/:school_id/subjects", schoolMembers, schoolHandlers.SchoolSubjectList)
 
 	// AUDITORIUMS
-	router.POST("/schools/:school_id/auditoriums", schoolHandlers.CreateAuditorium)
-	router.GET("/schools/:school_id/auditoriums", schoolHandlers.AuditoriumList)
-	router.GET("/schools/:school_id/auditoriums/:auditorium_id", schoolHandlers.AuditoriumByIDAndSchoolID)
+	router.POST("/schools/:school_id/auditoriums", schoolStaff, schoolHandlers.CreateAuditorium)
+	router.GET("/schools/:school_id/auditoriums", schoolMembers, schoolHandlers.AuditoriumList)
+	router.GET(
+		"/schools/:school_id/auditoriums/:auditorium_id",
+		schoolMembers,
+		schoolHandlers.AuditoriumByIDAndSchoolID,
+	)
+	router.PATCH("/schools/:school_id/auditoriums/:auditorium_id", schoolStaff, schoolHandlers.UpdateAuditorium)
+	router.DELETE("/schools/:school_id/auditoriums/:auditorium_id", schoolStaff, schoolHandlers.DeleteAuditorium)
+	router.POST(
+		"/schools/:school_id/auditoriums/:auditorium_id/restore",
+		schoolStaff,
+		schoolHandlers.RestoreAuditorium,
+	)
 
 	// STUDY PLAN
-	router.PUT("/schools/:school_id/group_subjects/:group_subject_id/study-plan", schoolHandlers.AssignStudyPlans)
-	router.GET("/schools/:school_id/group_subjects/:group_subject_id/study-plan", schoolHandlers.StudyPlanList)
+	router.PUT(
+		"/schools/:school_id/group_subjects/:group_subject_id/study-plan",
+		schoolStaff,
+		schoolHandlers.AssignStudyPlans,
+	)
+	router.GET(
+		"/schools/:school_id/group_subjects/:group_subject_id/study-plan",
+		schoolMembers,
+		schoolHandlers.StudyPlanList,
+	)
 	router.PATCH(
 		"/schools/:school_id/group_subjects/:group_subject_id/study-plan/:study_plan_id/status/:study_plan_status",
+		schoolMembers,
 		schoolHandlers.StudyPlanChangeStatus,
 	)
+
+	// ACADEMIC YEARS
+	router.POST("/schools/:school_id/academic-years", schoolStaff, schoolHandlers.CreateAcademicYear)
+	router.GET("/schools/:school_id/academic-years", schoolMembers, schoolHandlers.AcademicYearList)
+	router.GET(
+		"/schools/:school_id/academic-years/:academic_year_id",
+		schoolMembers,
+		schoolHandlers.AcademicYearByID,
+	)
+	router.POST(
+		"/schools/:school_id/academic-years/:academic_year_id/rollover",
+		schoolStaff,
+		schoolHandlers.RollOverAcademicYear,
+	)
+
+	// PROMOTIONS
+	router.POST("/schools/:school_id/promotions", schoolStaff, schoolHandlers.PromoteGroups)
 }
 
 // registerOwnerHandlers registers all owner handlers.
-func registerOwnerHandlers(router *gin.RouterGroup, ownerService handlers.IOwnerService) {
+func registerOwnerHandlers(router *gin.RouterGroup, policy handlers.Policy, ownerService handlers.IOwnerService) {
 	h := handlers.NewOwner(ownerService)
 
-	router.POST("/owners", h.AddOwner)
-	router.GET("/owners/:owner_id", h.OwnerByID)
-	router.GET("/owners/me", h.OwnerByUserIDAndSchoolID)
-	router.GET("/owners", h.OwnerList)
+	router.POST(
+		"/owners",
+		policy.AuthorizeEduOrganization(request.GetOrganizationIDBodyVar, domain.RoleOwner),
+		h.AddOwner,
+	)
+	router.GET("/owners/:owner_id", policy.Authorize(domain.RoleOwner), h.OwnerByID)
+	router.GET("/owners/me", policy.Authorize(domain.RoleOwner), h.OwnerByUserIDAndSchoolID)
+	router.GET("/owners", policy.Authorize(domain.RoleOwner), h.OwnerList)
 }
 
 // registerDirectorHandlers registers all director handlers.
-func registerDirectorHandlers(router *gin.RouterGroup, directorService handlers.IDirectorService) {
+func registerDirectorHandlers(
+	router *gin.RouterGroup,
+	policy handlers.Policy,
+	directorService handlers.IDirectorService,
+) {
 	h := handlers.NewDirector(directorService)
 
-	router.POST("/directors", h.AddDirector)
-	router.GET("/directors/:director_id", h.DirectorByID)
-	router.GET("/directors", h.DirectorList)
+	router.POST(
+		"/directors",
+		policy.AuthorizeSchool(request.GetSchoolIDBodyVar, domain.RoleOwner),
+		h.AddDirector,
+	)
+
+	schoolStaff := policy.AuthorizeSchool(request.GetSchoolIDHeader, schoolStaffRoles()...)
+
+	router.GET("/directors/:director_id", schoolStaff, h.DirectorByID)
+	router.GET("/directors", policy.AuthorizeSchool(handlers.GetListSchoolID, schoolStaffRoles()...), h.DirectorList)
+	router.PATCH("/directors/:director_id", schoolStaff, h.UpdateDirector)
 }
 
 // registerHeadmasterHandlers registers all headmaster handlers.
-func registerHeadmasterHandlers(router *gin.RouterGroup, headmasterService handlers.IHeadmasterService) {
+func registerHeadmasterHandlers(
+	router *gin.RouterGroup,
+	policy handlers.Policy,
+	headmasterService handlers.IHeadmasterService,
+) {
 	h := handlers.NewHeadmaster(headmasterService)
 
-	router.POST("/headmasters", h.AddHeadmaster)
-	router.GET("/headmasters/:headmaster_id", h.HeadmasterByID)
-	router.GET("/headmasters", h.HeadmasterList)
+	router.POST(
+		"/headmasters",
+		policy.AuthorizeSchool(request.GetSchoolIDBodyVar, domain.RoleOwner, domain.RoleDirector),
+		h.AddHeadmaster,
+	)
+
+	schoolStaff := policy.AuthorizeSchool(request.GetSchoolIDHeader, schoolStaffRoles()...)
+
+	router.GET("/headmasters/:headmaster_id", schoolStaff, h.HeadmasterByID)
+	router.GET("/headmasters", policy.AuthorizeSchool(handlers.GetListSchoolID, schoolStaffRoles()...), h.HeadmasterList)
+	router.PATCH("/headmasters/:headmaster_id", schoolStaff, h.UpdateHeadmaster)
 }
 
-func registerSubjectHandlers(router *gin.RouterGroup, subjectService handlers.ISubjectService) {
+func registerSubjectHandlers(router *gin.RouterGroup, policy handlers.Policy, subjectService handlers.ISubjectService) {
 	h := handlers.NewSubject(subjectService)
 
-	router.POST("/subjects", h.CreateSubject)
+	router.POST("/subjects", policy.Authorize(domain.RoleAdmin), h.CreateSubject)
 	router.GET("/subjects/:subject_id", h.SubjectByID)
 	router.GET("/subjects", h.SubjectList)
+	router.PATCH("/subjects/:subject_id", policy.Authorize(domain.RoleAdmin), h.UpdateSubject)
+	router.DELETE("/subjects/:subject_id", policy.Authorize(domain.RoleAdmin), h.DeleteSubject)
+	router.POST("/subjects/:subject_id/restore", policy.Authorize(domain.RoleAdmin), h.RestoreSubject)
 }
 
-func registerTeacherHandlers(router *gin.RouterGroup, teachersUseCase handlers.ITeacherService) {
+func registerTeacherHandlers(
+	router *gin.RouterGroup,
+	policy handlers.Policy,
+	teachersUseCase handlers.ITeacherService,
+) {
 	h := handlers.NewTeacher(teachersUseCase)
 
-	router.POST("/teachers", h.AddTeacher)
-	router.GET("/teachers/:teacher_id", h.TeacherByID)
-	router.GET("/teachers", h.ListTeacher)
+	router.POST("/teachers", policy.AuthorizeSchool(request.GetSchoolIDBodyVar, schoolStaffRoles()...), h.AddTeacher)
+
+	var (
+		schoolStaff   = policy.AuthorizeSchool(request.GetSchoolIDHeader, schoolStaffRoles()...)
+		schoolReaders = policy.AuthorizeSchool(request.GetSchoolIDHeader, schoolTeachingRoles()...)
+	)
+
+	router.GET("/teachers/:teacher_id", schoolReaders, h.TeacherByID)
+	router.GET("/teachers", policy.AuthorizeSchool(handlers.GetListSchoolID, schoolTeachingRoles()...), h.ListTeacher)
+
+	router.PATCH("/teachers/:teacher_id", schoolStaff, h.UpdateTeacher)
+	router.DELETE("/teachers/:teacher_id", schoolStaff, h.DeleteTeacher)
+	router.POST("/teachers/:teacher_id/restore", schoolStaff, h.RestoreTeacher)
 }
 
-func registerStudentsHandlers(router *gin.RouterGroup, studentService handlers.IStudentService) {
+func registerStudentsHandlers(
+	router *gin.RouterGroup,
+	policy handlers.Policy,
+	studentService handlers.IStudentService,
+) {
 	studentHandlers := handlers.NewStudent(studentService)
 
+	var (
+		schoolStaff       = policy.AuthorizeSchool(request.GetSchoolIDBodyVar, schoolStaffRoles()...)
+		schoolStaffHeader = policy.AuthorizeSchool(request.GetSchoolIDHeader, schoolStaffRoles()...)
+		schoolReaders     = policy.AuthorizeSchool(request.GetSchoolIDHeader, schoolTeachingRoles()...)
+		listReaders       = policy.AuthorizeSchool(handlers.GetListSchoolID, schoolTeachingRoles()...)
+	)
+
 	// STUDENTS
-	router.POST("/students", studentHandlers.AddStudent)
-	router.GET("/students/:student_id", studentHandlers.StudentByID)
-	router.GET("/students", studentHandlers.StudentList)
+	router.POST("/students", schoolStaff, studentHandlers.AddStudent)
+	router.GET("/students/:student_id", schoolReaders, studentHandlers.StudentByID)
+	router.GET("/students", listReaders, studentHandlers.StudentList)
+	router.PATCH("/students/:student_id", schoolStaffHeader, studentHandlers.UpdateStudent)
+	router.DELETE("/students/:student_id", schoolStaffHeader, studentHandlers.DeleteStudent)
+	router.POST("/students/:student_id/restore", schoolStaffHeader, studentHandlers.RestoreStudent)
+	router.POST("/students/import", schoolStaffHeader, studentHandlers.ImportStudents)
+
+	// STUDENT GROUP MEMBERSHIPS
+	router.POST("/students/:student_id/transfers", schoolStaff, studentHandlers.TransferStudent)
+	router.GET("/students/:student_id/memberships", schoolReaders, studentHandlers.StudentMemberships)
 
 	// STUDENT GUARDIANS
-	router.POST("/students/:student_id/guardians", studentHandlers.AssignStudentGuardian)
-	router.GET("/students/:student_id/guardians", studentHandlers.StudentGuardians)
-	router.GET("/students/guardians/:user_id", studentHandlers.StudentGuardianByUserID)
-	router.GET("/students/guardians", studentHandlers.StudentGuardianList)
+	router.POST("/students/:student_id/guardians", schoolStaff, studentHandlers.AssignStudentGuardian)
+	router.GET("/students/:student_id/guardians", schoolReaders, studentHandlers.StudentGuardians)
+	router.DELETE(
+		"/students/:student_id/guardians/:guardian_id",
+		schoolStaffHeader,
+		studentHandlers.DeleteStudentGuardian,
+	)
+	router.POST(
+		"/students/:student_id/guardians/:guardian_id/restore",
+		schoolStaffHeader,
+		studentHandlers.RestoreStudentGuardian,
+	)
+	router.GET(
+		"/students/guardians/:user_id",
+		policy.Authorize(append(schoolTeachingRoles(), domain.RoleGuardian)...),
+		studentHandlers.StudentGuardianByUserID,
+	)
+	router.GET("/students/guardians", listReaders, studentHandlers.StudentGuardianList)
 }
 
 // registerGradesHandlers registers all grade-standard handlers.
-func registerGradesHandlers(router *gin.RouterGroup, gradesUseCase handlers.IGradesService) {
+func registerGradesHandlers(router *gin.RouterGroup, policy handlers.Policy, gradesUseCase handlers.IGradesService) {
 	h := handlers.NewGrades(gradesUseCase)
 
-	router.POST("/grade-standards", h.CreateGradeStandard)
+	router.POST("/grade-standards", policy.Authorize(domain.RoleOwner), h.CreateGradeStandard)
 	router.GET("/grade-standards/:grade_standard_id", h.GradeStandardByID)
 	router.GET("/grade-standards", h.GradeStandardList)
+	router.PATCH("/grade-standards/:grade_standard_id", policy.Authorize(domain.RoleOwner), h.UpdateGradeStandard)
+
+	router.POST(
+		"/grading-scales",
+		policy.AuthorizeEduOrganization(request.GetOrganizationIDBodyVar, domain.RoleOwner),
+		h.CreateGradingScale,
+	)
+	router.GET("/grading-scales/:grading_scale_id", h.GradingScaleByID)
+	router.GET("/grading-scales", h.GradingScaleList)
 }
 
 // registerLessonsHandlers registers all lessons handlers.
-func registerLessonsHandlers(router *gin.RouterGroup, lessonService handlers.ILessonService) {
+func registerLessonsHandlers(router *gin.RouterGroup, policy handlers.Policy, lessonService handlers.ILessonService) {
 	h := handlers.NewLesson(lessonService)
 
+	var (
+		schoolStaff   = policy.AuthorizeSchool(request.GetSchoolIDHeader, schoolStaffRoles()...)
+		schoolReaders = policy.AuthorizeSchool(handlers.GetListSchoolID, schoolMemberRoles()...)
+	)
+
 	// LESSONS
-	router.PUT("/lessons", h.AssignWeekLessons)
-	router.GET("/lessons", h.LessonsList)
+	router.PUT("/lessons", schoolStaff, h.AssignWeekLessons)
+	router.GET("/lessons", schoolReaders, h.LessonsList)
+	router.DELETE("/lessons/:lesson_id", schoolStaff, h.DeleteLesson)
+	router.POST("/lessons/:lesson_id/restore", schoolStaff, h.RestoreLesson)
+
+	// TIMETABLE
+	router.POST("/lessons/timetable/draft", schoolStaff, h.GenerateTimetable)
+	router.PUT("/lessons/timetable", schoolStaff, h.CommitTimetable)
+	router.PATCH("/lessons/:lesson_id", schoolStaff, h.OverrideLesson)
+
+	// TIMETABLE TEMPLATES
+	var (
+		templateStaff   = policy.AuthorizeSchool(request.GetSchoolIDPathVar, schoolStaffRoles()...)
+		templateReaders = policy.AuthorizeSchool(request.GetSchoolIDPathVar, schoolMemberRoles()...)
+	)
+
+	router.POST("/schools/:school_id/timetable-templates", templateStaff, h.CreateTimetableTemplate)
+	router.GET("/schools/:school_id/timetable-templates", templateReaders, h.TimetableTemplateList)
+	router.GET("/schools/:school_id/timetable-templates/:template_id", templateReaders, h.TimetableTemplateByID)
+	router.POST(
+		"/schools/:school_id/timetable-templates/:template_id/lessons",
+		templateStaff,
+		h.MaterialiseTimetableTemplate,
+	)
 
 	// STUDENT MARKS
-	router.POST("lessons/marks", h.AddMark)
-	router.GET("lessons/marks/:mark_id", h.MarkByID)
-	router.GET("lessons/marks", h.AddMark)
+	router.POST("lessons/marks", policy.AuthorizeLesson(request.GetLessonIDBodyVar), h.AddMark)
+	router.GET("/lessons/:lesson_id/marks/:mark_id", policy.AuthorizeLesson(request.GetLessonIDPathVar), h.MarkByID)
+	router.PATCH("/lessons/:lesson_id/marks/:mark_id", policy.AuthorizeLesson(request.GetLessonIDPathVar), h.UpdateMark)
+	router.DELETE("/lessons/:lesson_id/marks/:mark_id", policy.AuthorizeLesson(request.GetLessonIDPathVar), h.DeleteMark)
+	router.POST(
+		"/lessons/:lesson_id/marks/:mark_id/restore",
+		policy.AuthorizeLesson(request.GetLessonIDPathVar),
+		h.RestoreMark,
+	)
+
+	// ATTENDANCE
+	router.PUT("/lessons/:lesson_id/attendance", policy.AuthorizeLesson(request.GetLessonIDPathVar), h.RecordAttendance)
+	router.GET("/lessons/:lesson_id/attendance", policy.AuthorizeLesson(request.GetLessonIDPathVar), h.LessonAttendances)
+	router.GET(
+		"/lessons/attendance",
+		policy.AuthorizeSchool(handlers.GetListSchoolID, schoolTeachingRoles()...),
+		h.AttendanceList,
+	)
+
+	// GRADEBOOK
+	schoolTeachers := policy.AuthorizeSchool(request.GetSchoolIDHeader, schoolTeachingRoles()...)
+
+	router.GET("/gradebook", schoolTeachers, h.Gradebook)
+	router.PUT(
+		"/gradebook/final-grades",
+		policy.AuthorizeGroupSubject(request.GetSchoolIDHeader, request.GetGroupSubjectIDBodyVar),
+		h.FinaliseGrades,
+	)
+}
+
+// schoolStaffRoles returns roles which manage a school.
+func schoolStaffRoles() []domain.Role {
+	return []domain.Role{domain.RoleOwner, domain.RoleDirector, domain.RoleHeadmaster}
+}
+
+// schoolTeachingRoles returns roles which manage a school or teach in it.
+func schoolTeachingRoles() []domain.Role {
+	return append(schoolStaffRoles(), domain.RoleTeacher)
+}
+
+// schoolMemberRoles returns all roles which belong to a school.
+func schoolMemberRoles() []domain.Role {
+	return append(schoolTeachingRoles(), domain.RoleStudent, domain.RoleGuardian)
+}
+
+// registerSetupHandlers registers the school setup handlers.
+func registerSetupHandlers(router *gin.RouterGroup, policy handlers.Policy, setupService handlers.ISetupService) {
+	h := handlers.NewSetup(setupService)
+
+	schoolStaff := policy.AuthorizeSchool(request.GetSchoolIDPathVar, schoolStaffRoles()...)
+
+	router.POST("/schools/:school_id/setup", schoolStaff, h.ImportSchoolSetup)
 }
@@ -0,0 +1,221 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AcademicYear is a school year split into terms with holidays.
+type AcademicYear struct {
+	ID       uuid.UUID
+	SchoolID uuid.UUID
+	Name     string
+	DateFrom time.Time
+	DateTill time.Time
+	Terms    Terms
+	Holidays Holidays
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// NewAcademicYear creates a new AcademicYear domain.
+func NewAcademicYear(
+	schoolID uuid.UUID,
+	name string,
+	dateFrom, dateTill time.Time,
+
+	nowFunc func() time.Time,
+) AcademicYear {
+	now := nowFunc()
+
+	return AcademicYear{
+		ID:       uuid.New(),
+		SchoolID: schoolID,
+		Name:     name,
+		DateFrom: dateFrom,
+		DateTill: dateTill,
+
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// AddTerm adds a term to the academic year, terms are numbered in order of adding.
+func (a *AcademicYear) AddTerm(name string, dateFrom, dateTill time.Time, nowFunc func() time.Time) {
+	now := nowFunc()
+
+	a.Terms = append(a.Terms, Term{
+		ID:             uuid.New(),
+		AcademicYearID: a.ID,
+		Name:           name,
+		Position:       len(a.Terms) + 1,
+		DateFrom:       dateFrom,
+		DateTill:       dateTill,
+
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+}
+
+// AddHoliday adds holidays to the academic year.
+func (a *AcademicYear) AddHoliday(name string, dateFrom, dateTill time.Time, nowFunc func() time.Time) {
+	a.Holidays = append(a.Holidays, Holiday{
+		ID:             uuid.New(),
+		AcademicYearID: a.ID,
+		Name:           name,
+		DateFrom:       dateFrom,
+		DateTill:       dateTill,
+
+		CreatedAt: nowFunc(),
+	})
+}
+
+// Validate checks that terms and holidays are within the year and terms follow each other without overlapping.
+func (a AcademicYear) Validate() error {
+	if !a.DateTill.After(a.DateFrom) {
+		return ErrInvalidAcademicYearPeriod
+	}
+
+	for i, term := range a.Terms {
+		if term.DateTill.Before(term.DateFrom) || term.DateFrom.Before(a.DateFrom) || term.DateTill.After(a.DateTill) {
+			return ErrInvalidAcademicYearPeriod
+		}
+
+		if i > 0 && !term.DateFrom.After(a.Terms[i-1].DateTill) {
+			return ErrInvalidAcademicYearPeriod
+		}
+	}
+
+	for _, holiday := range a.Holidays {
+		if holiday.DateTill.Before(holiday.DateFrom) ||
+			holiday.DateFrom.Before(a.DateFrom) ||
+			holiday.DateTill.After(a.DateTill) {
+			return ErrInvalidAcademicYearPeriod
+		}
+	}
+
+	return nil
+}
+
+// IsHoliday checks whether the date is within holidays of the academic year.
+func (a AcademicYear) IsHoliday(date time.Time) bool {
+	for _, holiday := range a.Holidays {
+		if coversDate(holiday.DateFrom, holiday.DateTill, date) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RollOver creates the next academic year with the same terms and holidays shifted by the given years.
+func (a AcademicYear) RollOver(name string, years int, nowFunc func() time.Time) AcademicYear {
+	next := NewAcademicYear(a.SchoolID, name, a.DateFrom.AddDate(years, 0, 0), a.DateTill.AddDate(years, 0, 0), nowFunc)
+
+	for _, term := range a.Terms {
+		next.AddTerm(term.Name, term.DateFrom.AddDate(years, 0, 0), term.DateTill.AddDate(years, 0, 0), nowFunc)
+	}
+
+	for _, holiday := range a.Holidays {
+		next.AddHoliday(holiday.Name, holiday.DateFrom.AddDate(years, 0, 0), holiday.DateTill.AddDate(years, 0, 0), nowFunc)
+	}
+
+	return next
+}
+
+// AcademicYears is list of AcademicYear.
+type AcademicYears []AcademicYear
+
+// IDs returns ids of academic years.
+func (a AcademicYears) IDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(a))
+
+	for _, year := range a {
+		ids = append(ids, year.ID)
+	}
+
+	return ids
+}
+
+// IsHoliday checks whether the date is within holidays of any of the academic years.
+func (a AcademicYears) IsHoliday(date time.Time) bool {
+	for _, year := range a {
+		if year.IsHoliday(date) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Overlaps checks whether the period of the academic year intersects with any of the academic years.
+func (a AcademicYears) Overlaps(year AcademicYear) bool {
+	for _, other := range a {
+		if other.ID != year.ID && !year.DateFrom.After(other.DateTill) && !other.DateFrom.After(year.DateTill) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetTerms sets terms and holidays to academic years.
+func (a AcademicYears) SetTerms(terms Terms, holidays Holidays) {
+	for i := range a {
+		a[i].Terms = Terms{}
+		a[i].Holidays = Holidays{}
+
+		for _, term := range terms {
+			if term.AcademicYearID == a[i].ID {
+				a[i].Terms = append(a[i].Terms, term)
+			}
+		}
+
+		for _, holiday := range holidays {
+			if holiday.AcademicYearID == a[i].ID {
+				a[i].Holidays = append(a[i].Holidays, holiday)
+			}
+		}
+	}
+}
+
+// Term is a part of the academic year, e.g. quarter or semester.
+type Term struct {
+	ID             uuid.UUID
+	AcademicYearID uuid.UUID
+	Name           string
+	Position       int
+	DateFrom       time.Time
+	DateTill       time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// Terms is list of Term.
+type Terms []Term
+
+// Holiday is a period of the academic year without lessons.
+type Holiday struct {
+	ID             uuid.UUID
+	AcademicYearID uuid.UUID
+	Name           string
+	DateFrom       time.Time
+	DateTill       time.Time
+
+	CreatedAt time.Time
+}
+
+// Holidays is list of Holiday.
+type Holidays []Holiday
+
+// coversDate checks whether the day of the date is within the inclusive period of days.
+func coversDate(from, till, date time.Time) bool {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, from.Location())
+
+	return !day.Before(from) && !day.After(till)
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestAcademicYearValidate(t *testing.T) {
+	var (
+		from    = time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+		till    = time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)
+		nowFunc = func() time.Time { return from }
+	)
+
+	tests := []struct {
+		name     string
+		terms    [][2]time.Time
+		holidays [][2]time.Time
+		wantErr  error
+	}{
+		{
+			name: "valid",
+			terms: [][2]time.Time{
+				{from, time.Date(2024, 12, 27, 0, 0, 0, 0, time.UTC)},
+				{time.Date(2025, 1, 9, 0, 0, 0, 0, time.UTC), till},
+			},
+			holidays: [][2]time.Time{
+				{time.Date(2024, 12, 28, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name: "overlapping terms",
+			terms: [][2]time.Time{
+				{from, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)},
+				{time.Date(2025, 1, 9, 0, 0, 0, 0, time.UTC), till},
+			},
+			wantErr: ErrInvalidAcademicYearPeriod,
+		},
+		{
+			name:    "term out of the year",
+			terms:   [][2]time.Time{{from, till.AddDate(0, 0, 1)}},
+			wantErr: ErrInvalidAcademicYearPeriod,
+		},
+		{
+			name:     "holidays out of the year",
+			terms:    [][2]time.Time{{from, till}},
+			holidays: [][2]time.Time{{from.AddDate(0, 0, -7), from}},
+			wantErr:  ErrInvalidAcademicYearPeriod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year := NewAcademicYear(uuid.New(), "2024/2025", from, till, nowFunc)
+
+			for _, term := range tt.terms {
+				year.AddTerm("term", term[0], term[1], nowFunc)
+			}
+
+			for _, holiday := range tt.holidays {
+				year.AddHoliday("holidays", holiday[0], holiday[1], nowFunc)
+			}
+
+			if err := year.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestAcademicYearRollOver(t *testing.T) {
+	var (
+		from    = time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+		till    = time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)
+		nowFunc = func() time.Time { return from }
+	)
+
+	year := NewAcademicYear(uuid.New(), "2024/2025", from, till, nowFunc)
+	year.AddTerm("1st semester", from, time.Date(2024, 12, 27, 0, 0, 0, 0, time.UTC), nowFunc)
+	year.AddTerm("2nd semester", time.Date(2025, 1, 9, 0, 0, 0, 0, time.UTC), till, nowFunc)
+	year.AddHoliday(
+		"winter holidays",
+		time.Date(2024, 12, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC),
+		nowFunc,
+	)
+
+	if !year.IsHoliday(time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the last day of holidays to be a holiday")
+	}
+
+	next := year.RollOver("2025/2026", 1, nowFunc)
+
+	if next.ID == year.ID || next.SchoolID != year.SchoolID || next.Name != "2025/2026" {
+		t.Fatalf("unexpected rolled over year: %+v", next)
+	}
+
+	if len(next.Terms) != 2 || len(next.Holidays) != 1 {
+		t.Fatalf("expected terms and holidays to be copied, got %d terms and %d holidays",
+			len(next.Terms), len(next.Holidays))
+	}
+
+	for i, term := range next.Terms {
+		if term.AcademicYearID != next.ID || !term.DateFrom.Equal(year.Terms[i].DateFrom.AddDate(1, 0, 0)) {
+			t.Errorf("term %d is not moved to the next year: %+v", i, term)
+		}
+	}
+
+	if err := next.Validate(); err != nil {
+		t.Errorf("rolled over year is invalid: %v", err)
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestAcademicYearsOverlaps(t *testing.T) {
+	var (
+		schoolID = uuid.New()
+		nowFunc  = func() time.Time { return time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC) }
+		date     = func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+		years    = AcademicYears{NewAcademicYear(schoolID, "2024/2025", date(2024, 9, 1), date(2025, 5, 31), nowFunc)}
+	)
+
+	tests := []struct {
+		name     string
+		from     time.Time
+		till     time.Time
+		overlaps bool
+	}{
+		{name: "next year", from: date(2025, 9, 1), till: date(2026, 5, 31), overlaps: false},
+		{name: "starts on the last day", from: date(2025, 5, 31), till: date(2026, 5, 31), overlaps: true},
+		{name: "within the year", from: date(2024, 10, 1), till: date(2025, 3, 1), overlaps: true},
+		{name: "covers the year", from: date(2024, 1, 1), till: date(2025, 12, 31), overlaps: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year := NewAcademicYear(schoolID, tt.name, tt.from, tt.till, nowFunc)
+
+			if got := years.Overlaps(year); got != tt.overlaps {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.overlaps)
+			}
+		})
+	}
+}
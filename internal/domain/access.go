@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// Actor is the authenticated user on whose behalf the request is performed.
+type Actor struct {
+	UserID uuid.UUID
+	// Roles are the roles access is granted by, only the active role if it is selected.
+	Roles UserRoles
+	// ActiveRole is the role the user acts as in the request, nil if no role is selected.
+	ActiveRole *ActiveRole
+}
+
+// NewActor creates a new Actor domain.
+func NewActor(userID uuid.UUID, roles UserRoles) Actor {
+	return Actor{
+		UserID: userID,
+		Roles:  roles,
+	}
+}
+
+// SetActiveRole sets the role the user acts as if the user still has it,
+// the actor has access granted only by that role then.
+func (a *Actor) SetActiveRole(role ActiveRole) {
+	if userRole, ok := a.Roles.ByID(role.RoleID); ok {
+		a.ActiveRole = &role
+		a.Roles = UserRoles{userRole}
+	}
+}
+
+// ActiveSchoolID returns the school of the active role, false if no role is selected
+// or the role is not within a school.
+func (a Actor) ActiveSchoolID() (uuid.UUID, bool) {
+	if a.ActiveRole == nil || a.ActiveRole.SchoolID == nil {
+		return uuid.Nil, false
+	}
+
+	return *a.ActiveRole.SchoolID, true
+}
+
+// IsAdmin checks whether actor is a platform administrator.
+func (a Actor) IsAdmin() bool {
+	return a.Roles.HasRole(RoleAdmin)
+}
+
+// HasAnyRole checks whether actor has at least one of the given roles.
+// Administrator has access to everything.
+func (a Actor) HasAnyRole(roles ...Role) bool {
+	if a.IsAdmin() {
+		return true
+	}
+
+	for _, role := range roles {
+		if a.Roles.HasRole(role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanAccessOrganization checks whether actor has one of the given roles within the organization.
+func (a Actor) CanAccessOrganization(organizationID uuid.UUID, roles ...Role) bool {
+	if a.IsAdmin() {
+		return true
+	}
+
+	for _, userRole := range a.Roles {
+		if userRole.OrganizationID != nil &&
+			*userRole.OrganizationID == organizationID &&
+			userRole.Role.In(roles...) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanAccessSchool checks whether actor has one of the given roles within the school.
+// Owner of the school organization is allowed when RoleOwner is in the given roles.
+func (a Actor) CanAccessSchool(schoolID, organizationID uuid.UUID, roles ...Role) bool {
+	if a.IsAdmin() {
+		return true
+	}
+
+	for _, userRole := range a.Roles {
+		if !userRole.Role.In(roles...) {
+			continue
+		}
+
+		if userRole.SchoolID != nil && *userRole.SchoolID == schoolID {
+			return true
+		}
+
+		if userRole.Role == RoleOwner &&
+			userRole.OrganizationID != nil &&
+			*userRole.OrganizationID == organizationID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SchoolScope is the schools the actor has access to.
+type SchoolScope struct {
+	// Unscoped is true if all schools are accessible, otherwise only the schools of SchoolIDs
+	// and the schools of the organizations of OrganizationIDs are accessible.
+	Unscoped        bool
+	SchoolIDs       []uuid.UUID
+	OrganizationIDs []uuid.UUID
+}
+
+// SchoolScope returns the schools where actor has one of the given roles.
+// Owner has access to all schools of the organization when RoleOwner is in the given roles.
+func (a Actor) SchoolScope(roles ...Role) SchoolScope {
+	if a.IsAdmin() {
+		return SchoolScope{Unscoped: true}
+	}
+
+	var scope SchoolScope
+
+	for _, userRole := range a.Roles {
+		if !userRole.Role.In(roles...) {
+			continue
+		}
+
+		switch {
+		case userRole.Role == RoleOwner && userRole.OrganizationID != nil:
+			scope.OrganizationIDs = append(scope.OrganizationIDs, *userRole.OrganizationID)
+		case userRole.SchoolID != nil:
+			scope.SchoolIDs = append(scope.SchoolIDs, *userRole.SchoolID)
+		}
+	}
+
+	return scope
+}
+
+// HasRole checks whether there is a given role in the list.
+func (u UserRoles) HasRole(role Role) bool {
+	for _, userRole := range u {
+		if userRole.Role == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// In checks whether role is one of the given roles.
+func (r Role) In(roles ...Role) bool {
+	for _, role := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestActor_CanAccessSchool(t *testing.T) {
+	var (
+		schoolID      = uuid.New()
+		otherSchoolID = uuid.New()
+		orgID         = uuid.New()
+	)
+
+	tests := []struct {
+		name     string
+		roles    UserRoles
+		schoolID uuid.UUID
+		allowed  []Role
+		want     bool
+	}{
+		{
+			name:     "admin",
+			roles:    UserRoles{{Role: RoleAdmin}},
+			schoolID: otherSchoolID,
+			allowed:  []Role{RoleDirector},
+			want:     true,
+		},
+		{
+			name:     "director of the school",
+			roles:    UserRoles{{Role: RoleDirector, SchoolID: &schoolID, OrganizationID: &orgID}},
+			schoolID: schoolID,
+			allowed:  []Role{RoleDirector},
+			want:     true,
+		},
+		{
+			name:     "director of another school",
+			roles:    UserRoles{{Role: RoleDirector, SchoolID: &otherSchoolID, OrganizationID: &orgID}},
+			schoolID: schoolID,
+			allowed:  []Role{RoleDirector},
+			want:     false,
+		},
+		{
+			name:     "teacher is not allowed",
+			roles:    UserRoles{{Role: RoleTeacher, SchoolID: &schoolID, OrganizationID: &orgID}},
+			schoolID: schoolID,
+			allowed:  []Role{RoleDirector, RoleHeadmaster},
+			want:     false,
+		},
+		{
+			name:     "owner of the organization",
+			roles:    UserRoles{{Role: RoleOwner, OrganizationID: &orgID}},
+			schoolID: schoolID,
+			allowed:  []Role{RoleOwner},
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actor := NewActor(uuid.New(), tt.roles)
+			if got := actor.CanAccessSchool(tt.schoolID, orgID, tt.allowed...); got != tt.want {
+				t.Errorf("CanAccessSchool() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestActor_SchoolScope(t *testing.T) {
+	var (
+		schoolID = uuid.New()
+		orgID    = uuid.New()
+	)
+
+	tests := []struct {
+		name  string
+		roles UserRoles
+		want  SchoolScope
+	}{
+		{
+			name:  "admin",
+			roles: UserRoles{{Role: RoleAdmin}},
+			want:  SchoolScope{Unscoped: true},
+		},
+		{
+			name: "owner and director",
+			roles: UserRoles{
+				{Role: RoleOwner, OrganizationID: &orgID},
+				{Role: RoleDirector, SchoolID: &schoolID, OrganizationID: &orgID},
+			},
+			want: SchoolScope{SchoolIDs: []uuid.UUID{schoolID}, OrganizationIDs: []uuid.UUID{orgID}},
+		},
+		{
+			name:  "teacher is not allowed",
+			roles: UserRoles{{Role: RoleTeacher, SchoolID: &schoolID, OrganizationID: &orgID}},
+			want:  SchoolScope{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actor := NewActor(uuid.New(), tt.roles)
+			if got := actor.SchoolScope(RoleOwner, RoleDirector); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SchoolScope() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ActiveRole is the role of the user the request is performed as.
+// A user may have several roles in different schools and selects one of them for the auth session.
+type ActiveRole struct {
+	RoleID         uuid.UUID
+	Role           Role
+	SchoolID       *uuid.UUID
+	OrganizationID *uuid.UUID
+}
+
+// NewActiveRole creates a new ActiveRole domain.
+func NewActiveRole(userRole UserRole) ActiveRole {
+	return ActiveRole{
+		RoleID:         userRole.ID,
+		Role:           userRole.Role,
+		SchoolID:       userRole.SchoolID,
+		OrganizationID: userRole.OrganizationID,
+	}
+}
+
+// activeRoleContextKey is context key of the active role.
+type activeRoleContextKey struct{}
+
+// WithActiveRole returns a copy of the context with the active role of the request.
+func WithActiveRole(ctx context.Context, role ActiveRole) context.Context {
+	return context.WithValue(ctx, activeRoleContextKey{}, role)
+}
+
+// ActiveRoleFromContext returns the active role of the request, false if no role is selected.
+func ActiveRoleFromContext(ctx context.Context) (ActiveRole, bool) {
+	role, ok := ctx.Value(activeRoleContextKey{}).(ActiveRole)
+
+	return role, ok
+}
+
+// ByID returns the role with the id.
+func (u UserRoles) ByID(id uuid.UUID) (UserRole, bool) {
+	for _, userRole := range u {
+		if userRole.ID == id {
+			return userRole, true
+		}
+	}
+
+	return UserRole{}, false
+}
+
+// Active returns the selected role if the user still has it, otherwise the only role of the user.
+func (u UserRoles) Active(selectedRoleID *uuid.UUID) (UserRole, bool) {
+	if selectedRoleID != nil {
+		if userRole, ok := u.ByID(*selectedRoleID); ok {
+			return userRole, true
+		}
+	}
+
+	if len(u) == 1 {
+		return u[0], true
+	}
+
+	return UserRole{}, false
+}
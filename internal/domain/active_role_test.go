@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestUserRolesActive(t *testing.T) {
+	var (
+		schoolID      = uuid.New()
+		otherSchoolID = uuid.New()
+		teacher       = UserRole{ID: uuid.New(), Role: RoleTeacher, SchoolID: &schoolID}
+		guardian      = UserRole{ID: uuid.New(), Role: RoleGuardian, SchoolID: &otherSchoolID}
+		removedRoleID = uuid.New()
+	)
+
+	tests := []struct {
+		name     string
+		roles    UserRoles
+		selected *uuid.UUID
+		want     *UserRole
+	}{
+		{name: "selected role", roles: UserRoles{teacher, guardian}, selected: &guardian.ID, want: &guardian},
+		{name: "nothing selected", roles: UserRoles{teacher, guardian}},
+		{name: "only role", roles: UserRoles{teacher}, want: &teacher},
+		{name: "removed role", roles: UserRoles{teacher, guardian}, selected: &removedRoleID},
+		{name: "removed role falls back to the only role", roles: UserRoles{teacher}, selected: &removedRoleID, want: &teacher},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.roles.Active(tt.selected)
+			if ok != (tt.want != nil) {
+				t.Fatalf("Active() ok = %v, want %v", ok, tt.want != nil)
+			}
+
+			if ok && got.ID != tt.want.ID {
+				t.Errorf("Active() = %v, want %v", got.ID, tt.want.ID)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestActorSetActiveRole(t *testing.T) {
+	var (
+		schoolID = uuid.New()
+		teacher  = UserRole{ID: uuid.New(), Role: RoleTeacher, SchoolID: &schoolID}
+		actor    = NewActor(uuid.New(), UserRoles{teacher})
+	)
+
+	actor.SetActiveRole(ActiveRole{RoleID: uuid.New(), Role: RoleDirector, SchoolID: &schoolID})
+
+	if _, ok := actor.ActiveSchoolID(); ok || actor.ActiveRole != nil || len(actor.Roles) != 1 {
+		t.Fatalf("expected a role the user does not have not to be active")
+	}
+
+	ctx := WithActiveRole(context.Background(), NewActiveRole(teacher))
+
+	activeRole, ok := ActiveRoleFromContext(ctx)
+	if !ok {
+		t.Fatalf("expected the active role in context")
+	}
+
+	actor.SetActiveRole(activeRole)
+
+	if actor.ActiveRole == nil || actor.ActiveRole.RoleID != teacher.ID {
+		t.Errorf("ActiveRole = %v, want %v", actor.ActiveRole, teacher.ID)
+	}
+
+	if got, ok := actor.ActiveSchoolID(); !ok || got != schoolID {
+		t.Errorf("ActiveSchoolID() = %v, %v, want %v", got, ok, schoolID)
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestActorSetActiveRoleNarrowsAccess(t *testing.T) {
+	var (
+		schoolID      = uuid.New()
+		otherSchoolID = uuid.New()
+		orgID         = uuid.New()
+		teacher       = UserRole{ID: uuid.New(), Role: RoleTeacher, SchoolID: &schoolID, OrganizationID: &orgID}
+		director      = UserRole{ID: uuid.New(), Role: RoleDirector, SchoolID: &otherSchoolID, OrganizationID: &orgID}
+		actor         = NewActor(uuid.New(), UserRoles{teacher, director})
+	)
+
+	actor.SetActiveRole(NewActiveRole(teacher))
+
+	if actor.CanAccessSchool(otherSchoolID, orgID, RoleDirector) {
+		t.Errorf("CanAccessSchool() = true, want the director role not to be used while acting as teacher")
+	}
+
+	if !actor.CanAccessSchool(schoolID, orgID, RoleTeacher) {
+		t.Errorf("CanAccessSchool() = false, want the active teacher role to be used")
+	}
+}
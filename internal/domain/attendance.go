@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttendanceStatus is status of student presence on a lesson.
+type AttendanceStatus string
+
+const (
+	// AttendancePresent student was present on the lesson.
+	AttendancePresent AttendanceStatus = "present"
+	// AttendanceAbsent student was absent without a reason.
+	AttendanceAbsent AttendanceStatus = "absent"
+	// AttendanceLate student was late for the lesson.
+	AttendanceLate AttendanceStatus = "late"
+	// AttendanceExcused student was absent for a valid reason.
+	AttendanceExcused AttendanceStatus = "excused"
+)
+
+// Attendance is student attendance of a lesson.
+type Attendance struct {
+	ID          uuid.UUID
+	LessonID    uuid.UUID
+	StudentID   uuid.UUID
+	Status      AttendanceStatus
+	Description *string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// NewAttendance creates a new Attendance domain.
+func NewAttendance(
+	lessonID uuid.UUID,
+	studentID uuid.UUID,
+	status AttendanceStatus,
+	description *string,
+
+	nowFunc func() time.Time,
+) Attendance {
+	now := nowFunc()
+
+	return Attendance{
+		ID:          uuid.New(),
+		LessonID:    lessonID,
+		StudentID:   studentID,
+		Status:      status,
+		Description: description,
+
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Attendances is slice of Attendance.
+type Attendances []Attendance
+
+// StudentIDs returns ids of students.
+func (a Attendances) StudentIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(a))
+
+	for _, attendance := range a {
+		ids = append(ids, attendance.StudentID)
+	}
+
+	return ids
+}
+
+// Summaries returns attendance summary of each student, ordered by first appearance.
+func (a Attendances) Summaries() AttendanceSummaries {
+	var (
+		summaries = make(AttendanceSummaries, 0)
+		indexes   = make(map[uuid.UUID]int)
+	)
+
+	for _, attendance := range a {
+		i, ok := indexes[attendance.StudentID]
+		if !ok {
+			i = len(summaries)
+			indexes[attendance.StudentID] = i
+
+			summaries = append(summaries, AttendanceSummary{StudentID: attendance.StudentID})
+		}
+
+		summaries[i].add(attendance.Status)
+	}
+
+	return summaries
+}
+
+// AttendanceSummary is aggregated attendance of a student.
+type AttendanceSummary struct {
+	StudentID uuid.UUID
+	Lessons   int
+	Present   int
+	Absent    int
+	Late      int
+	Excused   int
+}
+
+func (s *AttendanceSummary) add(status AttendanceStatus) {
+	s.Lessons++
+
+	switch status {
+	case AttendancePresent:
+		s.Present++
+	case AttendanceAbsent:
+		s.Absent++
+	case AttendanceLate:
+		s.Late++
+	case AttendanceExcused:
+		s.Excused++
+	}
+}
+
+// Absences returns count of missed lessons, both excused and not.
+func (s AttendanceSummary) Absences() int {
+	return s.Absent + s.Excused
+}
+
+// ExcusedPercentage returns percentage of excused absences among all absences.
+func (s AttendanceSummary) ExcusedPercentage() float64 {
+	if s.Absences() == 0 {
+		return 0
+	}
+
+	const hundred = 100
+
+	return float64(s.Excused) * hundred / float64(s.Absences())
+}
+
+// AttendanceSummaries is slice of AttendanceSummary.
+type AttendanceSummaries []AttendanceSummary
+
+// AttendanceFilters is filters of attendance list.
+type AttendanceFilters struct {
+	Period    DateFilter
+	SchoolID  uuid.UUID
+	GroupID   *uuid.UUID
+	StudentID *uuid.UUID
+	TermID    *uuid.UUID
+}
+
+// NewAttendanceFilters creates a new AttendanceFilters domain.
+func NewAttendanceFilters(
+	period DateFilter,
+	schoolID uuid.UUID,
+	groupID *uuid.UUID,
+	studentID *uuid.UUID,
+	termID *uuid.UUID,
+) AttendanceFilters {
+	return AttendanceFilters{
+		Period:    period,
+		SchoolID:  schoolID,
+		GroupID:   groupID,
+		StudentID: studentID,
+		TermID:    termID,
+	}
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestAttendances_Summaries(t *testing.T) {
+	var (
+		first   = uuid.New()
+		second  = uuid.New()
+		nowFunc = func() time.Time { return time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC) }
+	)
+
+	attendances := Attendances{
+		NewAttendance(uuid.New(), first, AttendancePresent, nil, nowFunc),
+		NewAttendance(uuid.New(), first, AttendanceAbsent, nil, nowFunc),
+		NewAttendance(uuid.New(), second, AttendanceLate, nil, nowFunc),
+		NewAttendance(uuid.New(), first, AttendanceExcused, nil, nowFunc),
+		NewAttendance(uuid.New(), first, AttendanceExcused, nil, nowFunc),
+		NewAttendance(uuid.New(), first, AttendanceExcused, nil, nowFunc),
+	}
+
+	tests := []struct {
+		name               string
+		wantStudentID      uuid.UUID
+		wantLessons        int
+		wantAbsences       int
+		wantLate           int
+		wantExcusedPercent float64
+	}{
+		{
+			name:               "student with absences",
+			wantStudentID:      first,
+			wantLessons:        5,
+			wantAbsences:       4,
+			wantExcusedPercent: 75,
+		},
+		{
+			name:          "student without absences",
+			wantStudentID: second,
+			wantLessons:   1,
+			wantLate:      1,
+		},
+	}
+
+	summaries := attendances.Summaries()
+	if len(summaries) != len(tests) {
+		t.Fatalf("expected %d summaries, got %d", len(tests), len(summaries))
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := summaries[i]
+
+			if summary.StudentID != tt.wantStudentID {
+				t.Fatalf("unexpected student %s", summary.StudentID)
+			}
+
+			if summary.Lessons != tt.wantLessons {
+				t.Errorf("expected %d lessons, got %d", tt.wantLessons, summary.Lessons)
+			}
+
+			if summary.Absences() != tt.wantAbsences {
+				t.Errorf("expected %d absences, got %d", tt.wantAbsences, summary.Absences())
+			}
+
+			if summary.Late != tt.wantLate {
+				t.Errorf("expected %d late, got %d", tt.wantLate, summary.Late)
+			}
+
+			if summary.ExcusedPercentage() != tt.wantExcusedPercent {
+				t.Errorf("expected %v excused percentage, got %v", tt.wantExcusedPercent, summary.ExcusedPercentage())
+			}
+		})
+	}
+}
@@ -57,3 +57,27 @@ func NewAuditorium(
 		UpdatedAt: now,
 	}
 }
+
+// Update updates auditorium.
+func (a *Auditorium) Update(
+	name string,
+	schoolSubjectID *uuid.UUID,
+	description *string,
+	nowFunc func() time.Time,
+) {
+	a.Name = name
+	a.SchoolSubjectID = schoolSubjectID
+	a.Description = description
+
+	a.UpdatedAt = nowFunc()
+}
+
+// AuditoriumListFields returns the fields the list of auditoriums is sorted and filtered by.
+func AuditoriumListFields() ListFields {
+	return ListFields{
+		"created_at":        ListFieldTime,
+		"updated_at":        ListFieldTime,
+		"name":              ListFieldString,
+		"school_subject_id": ListFieldUUID,
+	}
+}
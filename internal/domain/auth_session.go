@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuthSession is a refresh token session of the user on a device.
+// TokenID is the id of the only refresh token of the session that can be used,
+// it is changed on every refresh so a refresh token can be used only once.
+type AuthSession struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TokenID   uuid.UUID
+	UserAgent string
+	IP        string
+	ExpiresAt time.Time
+	// ActiveRoleID is the role of the user selected for the session, empty if no role is selected.
+	ActiveRoleID *uuid.UUID
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	RevokedAt *time.Time
+}
+
+// NewAuthSession creates a new AuthSession domain.
+func NewAuthSession(
+	userID uuid.UUID,
+	userAgent string,
+	ip string,
+	expiresAt time.Time,
+
+	nowFunc func() time.Time,
+) AuthSession {
+	now := nowFunc()
+
+	return AuthSession{
+		ID:        uuid.New(),
+		UserID:    userID,
+		TokenID:   uuid.New(),
+		UserAgent: userAgent,
+		IP:        ip,
+		ExpiresAt: expiresAt,
+
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsActive checks whether the session is neither revoked nor expired.
+func (a AuthSession) IsActive(now time.Time) bool {
+	return a.RevokedAt == nil && now.Before(a.ExpiresAt)
+}
+
+// Rotate issues a new refresh token of the session in exchange for the token with tokenID.
+// ErrRefreshTokenReused is returned when the token was already exchanged,
+// it means that the token was stolen and the whole session must be revoked.
+func (a *AuthSession) Rotate(tokenID uuid.UUID, expiresAt time.Time, nowFunc func() time.Time) error {
+	now := nowFunc()
+
+	if !a.IsActive(now) {
+		return ErrInvalidToken
+	}
+
+	if a.TokenID != tokenID {
+		return ErrRefreshTokenReused
+	}
+
+	a.TokenID = uuid.New()
+	a.ExpiresAt = expiresAt
+	a.UpdatedAt = now
+
+	return nil
+}
+
+// SelectRole sets the role of the user the session acts as, nil clears the selection.
+func (a *AuthSession) SelectRole(roleID *uuid.UUID, nowFunc func() time.Time) error {
+	now := nowFunc()
+
+	if !a.IsActive(now) {
+		return ErrInvalidToken
+	}
+
+	a.ActiveRoleID = roleID
+	a.UpdatedAt = now
+
+	return nil
+}
+
+// AuthSessions is list of AuthSession.
+type AuthSessions []AuthSession
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestAuthSessionRotate(t *testing.T) {
+	var (
+		now     = time.Date(2025, 3, 28, 10, 0, 0, 0, time.UTC)
+		nowFunc = func() time.Time { return now }
+		revoked = now.Add(-time.Minute)
+	)
+
+	newSession := func() AuthSession {
+		return NewAuthSession(uuid.New(), "Mozilla/5.0", "127.0.0.1", now.Add(time.Hour), nowFunc)
+	}
+
+	tests := []struct {
+		name    string
+		session func() AuthSession
+		tokenID func(AuthSession) uuid.UUID
+		wantErr error
+	}{
+		{
+			name:    "current token",
+			session: newSession,
+			tokenID: func(s AuthSession) uuid.UUID { return s.TokenID },
+		},
+		{
+			name:    "reused token",
+			session: newSession,
+			tokenID: func(AuthSession) uuid.UUID { return uuid.New() },
+			wantErr: ErrRefreshTokenReused,
+		},
+		{
+			name: "revoked session",
+			session: func() AuthSession {
+				s := newSession()
+				s.RevokedAt = &revoked
+
+				return s
+			},
+			tokenID: func(s AuthSession) uuid.UUID { return s.TokenID },
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name: "expired session",
+			session: func() AuthSession {
+				s := newSession()
+				s.ExpiresAt = now
+
+				return s
+			},
+			tokenID: func(s AuthSession) uuid.UUID { return s.TokenID },
+			wantErr: ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := tt.session()
+			previousTokenID := session.TokenID
+			expiresAt := now.Add(2 * time.Hour)
+
+			err := session.Rotate(tt.tokenID(session), expiresAt, nowFunc)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Rotate() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				if session.TokenID != previousTokenID {
+					t.Errorf("expected the token not to be rotated on error")
+				}
+
+				return
+			}
+
+			if session.TokenID == previousTokenID {
+				t.Errorf("expected a new token id")
+			}
+
+			if !session.ExpiresAt.Equal(expiresAt) {
+				t.Errorf("expected the session to expire at %v, got %v", expiresAt, session.ExpiresAt)
+			}
+
+			if err = session.Rotate(previousTokenID, expiresAt, nowFunc); !errors.Is(err, ErrRefreshTokenReused) {
+				t.Errorf("expected the previous token to be rejected as reused, got %v", err)
+			}
+		})
+	}
+}
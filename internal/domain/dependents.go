@@ -0,0 +1,42 @@
+package domain
+
+// Kinds of entities which depend on other entities.
+const (
+	DependentSchools        = "schools"
+	DependentGroups         = "groups"
+	DependentGroupSubjects  = "group_subjects"
+	DependentSchoolSubjects = "school_subjects"
+	DependentTeachers       = "teachers"
+	DependentStudents       = "students"
+	DependentLessons        = "lessons"
+	DependentMarks          = "marks"
+	DependentAttendances    = "attendances"
+)
+
+// Dependents is a number of not deleted entities of each kind which depend on an entity.
+// The entity can be deleted only when nothing depends on it, e.g. a group can be deleted
+// after all its students are moved to other groups.
+type Dependents map[string]int
+
+// Blocking returns the kinds of entities which have at least one dependent entity.
+func (d Dependents) Blocking() Dependents {
+	blocking := make(Dependents, len(d))
+
+	for kind, count := range d {
+		if count > 0 {
+			blocking[kind] = count
+		}
+	}
+
+	return blocking
+}
+
+// CheckDelete returns an error listing the dependent entities if there are any.
+func (d Dependents) CheckDelete() error {
+	blocking := d.Blocking()
+	if len(blocking) == 0 {
+		return nil
+	}
+
+	return NewHasDependentsErr(blocking)
+}
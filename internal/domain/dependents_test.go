@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"errors"
+	"maps"
+	"testing"
+
+	"bum-service/pkg/liberror"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestDependentsCheckDelete(t *testing.T) {
+	tests := []struct {
+		name       string
+		dependents Dependents
+		want       Dependents
+	}{
+		{name: "nothing depends", dependents: Dependents{}},
+		{name: "no dependents of any kind", dependents: Dependents{DependentStudents: 0, DependentLessons: 0}},
+		{
+			name:       "students block deletion",
+			dependents: Dependents{DependentStudents: 25, DependentLessons: 0},
+			want:       Dependents{DependentStudents: 25},
+		},
+		{
+			name:       "all blocking kinds are listed",
+			dependents: Dependents{DependentStudents: 2, DependentLessons: 3},
+			want:       Dependents{DependentStudents: 2, DependentLessons: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.dependents.CheckDelete()
+			if (err != nil) != (tt.want != nil) {
+				t.Fatalf("CheckDelete() error = %v, want error %v", err, tt.want != nil)
+			}
+
+			if err == nil {
+				return
+			}
+
+			if !errors.Is(err, ErrHasDependents) {
+				t.Fatalf("CheckDelete() error = %v, want %v", err, ErrHasDependents)
+			}
+
+			var customErr *liberror.Error
+			if !errors.As(err, &customErr) {
+				t.Fatalf("CheckDelete() error is not liberror.Error")
+			}
+
+			details, ok := customErr.Details.(Dependents)
+			if !ok || !maps.Equal(details, tt.want) {
+				t.Errorf("CheckDelete() details = %v, want %v", customErr.Details, tt.want)
+			}
+		})
+	}
+}
@@ -44,6 +44,14 @@ func NewDirector(
 	}
 }
 
+// Update updates contacts of the director.
+func (d *Director) Update(phone, email *string, nowFunc func() time.Time) {
+	d.Phone = phone
+	d.Email = email
+
+	d.UpdatedAt = nowFunc()
+}
+
 // SetUser sets User info in Director model.
 func (d *Director) SetUser(user User) {
 	d.User = user
@@ -118,3 +126,15 @@ func (d Directors) SetShortSchools(schoolShorts SchoolShortInfos) {
 		d[index].SetShortSchool(mapOfSchoolShort[d[index].SchoolID])
 	}
 }
+
+// DirectorListFields returns the fields the list of directors is sorted and filtered by.
+func DirectorListFields() ListFields {
+	return ListFields{
+		"created_at": ListFieldTime,
+		"updated_at": ListFieldTime,
+		"school_id":  ListFieldUUID,
+		"user_id":    ListFieldUUID,
+		"email":      ListFieldString,
+		"phone":      ListFieldString,
+	}
+}
@@ -78,3 +78,12 @@ type EduOrganizationShortInfo struct {
 
 // EduOrganizationShortInfos is list of EduOrganizationShortInfo.
 type EduOrganizationShortInfos []EduOrganizationShortInfo
+
+// EduOrganizationListFields returns the fields the list of educational organizations is sorted and filtered by.
+func EduOrganizationListFields() ListFields {
+	return ListFields{
+		"created_at": ListFieldTime,
+		"updated_at": ListFieldTime,
+		"name":       ListFieldString,
+	}
+}
@@ -0,0 +1,8 @@
+package domain
+
+// Email is an email message sent to a user.
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+}
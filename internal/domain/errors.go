@@ -2,8 +2,10 @@ package domain
 
 import (
 	"fmt"
+	"math"
 	"net/http"
 	"strings"
+	"time"
 
 	"bum-service/pkg/liberror"
 )
@@ -52,6 +54,13 @@ var (
 		HTTPCode: http.StatusUnauthorized,
 	}
 
+	// ErrRefreshTokenReused represents an error when already used refresh token is used again.
+	ErrRefreshTokenReused = &liberror.Error{
+		Err:      "refresh token is already used",
+		Code:     "REFRESH_TOKEN_REUSED",
+		HTTPCode: http.StatusUnauthorized,
+	}
+
 	// ErrUnauthorized represents an error when user is Unauthorized.
 	ErrUnauthorized = &liberror.Error{
 		Err:      "Unauthorized",
@@ -59,6 +68,16 @@ var (
 		HTTPCode: http.StatusUnauthorized,
 	}
 
+	// ErrForbidden represents an error when user has no access to the resource.
+	ErrForbidden = &liberror.Error{
+		Err:      "Forbidden",
+		Code:     liberror.ErrForbidden.Code,
+		HTTPCode: liberror.ErrForbidden.HTTPCode,
+	}
+
+	// ErrValidation represents an error when fields of the request are not valid, the fields are listed in the error.
+	ErrValidation = NewBadRequest("request validation failed")
+
 	// ErrInvalidUser represents an error when user email or password is not correct.
 	ErrInvalidUser = &liberror.Error{
 		Err:      "invalid user or password",
@@ -85,10 +104,50 @@ var (
 	// and role is already exists.
 	ErrUserRoleInSchoolAndOrganizationAlreadyExists = NewConflictErr("user role in school and organization")
 
+	// ErrUserRoleNotFound represents an error when user role is not found among the roles of the user.
+	ErrUserRoleNotFound = NewNotFoundErr("user role")
+
 	// ErrUserGenderBadRequest  represents an error when user gender is not valid.
 	ErrUserGenderBadRequest = NewBadRequest("user gender")
+
+	// ErrAuthSessionNotFound represents an error when user auth session is not found.
+	ErrAuthSessionNotFound = NewNotFoundErr("auth session")
+
+	// ErrInvalidUserToken represents an error when password reset or email verification token
+	// is unknown, expired or already used.
+	ErrInvalidUserToken = NewBadRequest("token is invalid or expired")
+
+	// ErrWrongCurrentPassword represents an error when current password of the user is not correct.
+	ErrWrongCurrentPassword = NewBadRequest("current password is not correct")
+
+	// ErrEmailAlreadyVerified represents an error when user email is already verified.
+	ErrEmailAlreadyVerified = NewBadRequest("email is already verified")
+
+	// ErrInvalidLoginCode represents an error when one-time login code is wrong, expired or already used.
+	ErrInvalidLoginCode = &liberror.Error{
+		Err:      "invalid or expired login code",
+		Code:     "INVALID_LOGIN_CODE",
+		HTTPCode: http.StatusUnauthorized,
+	}
+
+	// ErrLoginLocked represents an error when logins are delayed or locked after too many failed attempts.
+	ErrLoginLocked = &liberror.Error{
+		Err:      "too many failed login attempts",
+		Code:     "TOO_MANY_LOGIN_ATTEMPTS",
+		HTTPCode: http.StatusTooManyRequests,
+	}
 )
 
+// NewLoginLockedErr creates a new login locked error with the time logins are allowed after.
+func NewLoginLockedErr(retryAfter time.Duration) *liberror.Error {
+	err := *ErrLoginLocked
+	err.Details = map[string]int64{
+		"retry_after_seconds": int64(math.Ceil(retryAfter.Seconds())),
+	}
+
+	return &err
+}
+
 // EDUCATIONAL ORGANIZATIONS.
 var (
 	// ErrEduOrganizationAlreadyExists represents an error when educational organization name is already exists.
@@ -153,6 +212,23 @@ var (
 var (
 	// ErrStudentNotFound represents an error when student not found.
 	ErrStudentNotFound = NewNotFoundErr("student")
+
+	// ErrStudentMembershipNotFound represents an error when student group membership is not found.
+	ErrStudentMembershipNotFound = NewNotFoundErr("student group membership")
+
+	// ErrStudentMembershipConflict represents an error when the student is transferred concurrently.
+	ErrStudentMembershipConflict = NewConflictErr("student group membership")
+
+	// ErrStudentAlreadyInGroup represents an error when the student is transferred into the current group.
+	ErrStudentAlreadyInGroup = NewBadRequest("student is already in the group")
+
+	// ErrInvalidTransferDate represents an error when the transfer is dated before the student joined
+	// the current group or in the future.
+	ErrInvalidTransferDate = NewBadRequest("transfer date is out of the current group membership")
+
+	// ErrTransferOutOfOrganization represents an error when the student is transferred into a school
+	// of another organization.
+	ErrTransferOutOfOrganization = NewBadRequest("student can be transferred only within the organization")
 )
 
 // GUARDIANS.
@@ -166,6 +242,9 @@ var (
 	// ErrStudentGuardianAlreadyExists represents an error when student guardian already exists.
 	ErrStudentGuardianAlreadyExists = NewConflictErr("student guardian")
 
+	// ErrStudentGuardianNotFound represents an error when student guardian not found.
+	ErrStudentGuardianNotFound = NewNotFoundErr("student guardian")
+
 	// ErrStudentGuardianRelationBadRequest represents an error when student guardian relation is not valid.
 	ErrStudentGuardianRelationBadRequest = NewBadRequest("student guardian relation")
 )
@@ -201,6 +280,23 @@ var (
 
 	// ErrGroupNotFound represents an error when group is not found.
 	ErrGroupNotFound = NewNotFoundErr("group")
+
+	// ErrGroupAlreadyPromoted represents an error when group is already promoted to the next academic year.
+	ErrGroupAlreadyPromoted = &liberror.Error{
+		Err:      "group is already promoted",
+		Code:     "CONFLICT: GROUP_ALREADY_PROMOTED",
+		HTTPCode: http.StatusConflict,
+	}
+
+	// ErrNoGroupsToPromote represents an error when there are no groups of the academic year to promote.
+	ErrNoGroupsToPromote = NewBadRequest("there are no groups to promote")
+
+	// ErrPromotionStudentNotFound represents an error when held back or graduating student
+	// does not study in the promoted groups.
+	ErrPromotionStudentNotFound = NewBadRequest("held back or graduating student is not in the promoted groups")
+
+	// ErrGradeHasNoEducationYear represents an error when next grade can not be found without education year.
+	ErrGradeHasNoEducationYear = NewBadRequest("grade of the group has no education year")
 )
 
 // GROUP SUBJECTS.
@@ -234,14 +330,196 @@ var (
 var (
 	// ErrLessonNotFound represents an error when lesson is not found.
 	ErrLessonNotFound = NewNotFoundErr("lesson")
+	// ErrInvalidBellSlots represents an error when bell slots overlap or end before they start.
+	ErrInvalidBellSlots = NewBadRequest("bell slots must end after they start and must not overlap")
+	// ErrLessonScheduleConflict represents an error when lessons conflict with each other or with booked lessons.
+	ErrLessonScheduleConflict = &liberror.Error{
+		Err:      "lesson schedule conflict",
+		Code:     "CONFLICT: LESSON_SCHEDULE",
+		HTTPCode: http.StatusConflict,
+	}
+)
+
+// NewLessonScheduleConflictErr creates a new lesson schedule conflict error listing all conflicts.
+func NewLessonScheduleConflictErr(conflicts LessonConflicts) *liberror.Error {
+	err := *ErrLessonScheduleConflict
+	err.Details = conflicts.details()
+
+	return &err
+}
+
+// TIMETABLE TEMPLATES.
+var (
+	// ErrTimetableTemplateNotFound represents an error when timetable template is not found.
+	ErrTimetableTemplateNotFound = NewNotFoundErr("timetable template")
+	// ErrInvalidTimetableTemplatePeriod represents an error when period ends before it starts.
+	ErrInvalidTimetableTemplatePeriod = NewBadRequest("period must end after it starts")
 )
 
 // MARKS.
 var (
 	// ErrMarkAlreadyExists represents an error when mark name is already exists.
 	ErrMarkAlreadyExists = NewConflictErr("mark")
+	// ErrMarkNotFound represents an error when mark is not found.
+	ErrMarkNotFound = NewNotFoundErr("mark")
+	// ErrInvalidMark represents an error when mark is not allowed by the grading scale.
+	ErrInvalidMark = NewBadRequest("mark is not allowed by the grading scale")
 )
 
+// ACADEMIC YEARS.
+var (
+	// ErrAcademicYearNotFound represents an error when academic year is not found.
+	ErrAcademicYearNotFound = NewNotFoundErr("academic year")
+	// ErrAcademicYearAlreadyExists represents an error when academic year name is already exists.
+	ErrAcademicYearAlreadyExists = NewConflictErr("academic year")
+	// ErrAcademicYearOverlaps represents an error when academic year intersects with another year of the school.
+	ErrAcademicYearOverlaps = &liberror.Error{
+		Err:      "academic year overlaps with another academic year of the school",
+		Code:     "CONFLICT: ACADEMIC_YEAR_OVERLAPS",
+		HTTPCode: http.StatusConflict,
+	}
+	// ErrTermNotFound represents an error when term is not found.
+	ErrTermNotFound = NewNotFoundErr("term")
+	// ErrInvalidAcademicYearPeriod represents an error when terms or holidays do not fit into the academic year.
+	ErrInvalidAcademicYearPeriod = NewBadRequest("terms and holidays must be ordered periods within the academic year")
+)
+
+// GRADEBOOK.
+var (
+	// ErrFinalGradeLocked represents an error when final grade or marks of its period are changed after locking.
+	ErrFinalGradeLocked = &liberror.Error{
+		Err:      "final grade is locked",
+		Code:     "CONFLICT: FINAL_GRADE_LOCKED",
+		HTTPCode: http.StatusConflict,
+	}
+	// ErrInvalidGradebookPeriod represents an error when gradebook period ends before it starts.
+	ErrInvalidGradebookPeriod = NewBadRequest("period must end after it starts")
+)
+
+// DELETION.
+var (
+	// ErrHasDependents represents an error when an entity is deleted while other entities depend on it.
+	ErrHasDependents = &liberror.Error{
+		Err:      "entity has dependent entities, delete or move them first",
+		Code:     "CONFLICT: HAS_DEPENDENTS",
+		HTTPCode: http.StatusConflict,
+	}
+)
+
+// NewHasDependentsErr creates a new has dependents error with the number of dependent entities by their kind.
+func NewHasDependentsErr(dependents Dependents) *liberror.Error {
+	err := *ErrHasDependents
+	err.Details = dependents
+
+	return &err
+}
+
+// PAGINATION.
+var (
+	// ErrInvalidCursor represents an error when the cursor of the list request is not the one of the list response.
+	ErrInvalidCursor = NewBadRequest("invalid cursor")
+	// ErrInvalidSort represents an error when the list is sorted by the unknown or repeated fields.
+	ErrInvalidSort = NewBadRequest("invalid sort")
+	// ErrInvalidFilter represents an error when the list is filtered by the malformed condition
+	// or by the condition of the unknown field.
+	ErrInvalidFilter = NewBadRequest("invalid filter")
+)
+
+// SEARCH.
+var (
+	// ErrSearchQueryTooShort represents an error when the search query is shorter than SearchQueryMinLength.
+	ErrSearchQueryTooShort = NewBadRequest("search query is too short")
+	// ErrSearchTypeBadRequest represents an error when the kind of the search hits is not valid.
+	ErrSearchTypeBadRequest = NewBadRequest("search type")
+)
+
+// UPDATES.
+var (
+	// ErrOutdatedVersion represents an error when an entity is updated with the version which is changed
+	// by another update since it was read.
+	ErrOutdatedVersion = &liberror.Error{
+		Err:      "entity was changed by another update, get it again and retry",
+		Code:     "CONFLICT: OUTDATED_VERSION",
+		HTTPCode: http.StatusConflict,
+	}
+	// ErrPreconditionFailed represents an error when the version of If-Match header of the update
+	// is not the current version of the entity.
+	ErrPreconditionFailed = &liberror.Error{
+		Err:      "entity was changed since the version of If-Match header, get it again and retry",
+		Code:     "PRECONDITION_FAILED",
+		HTTPCode: http.StatusPreconditionFailed,
+	}
+	// ErrPreconditionRequired represents an error when the update has neither If-Match header
+	// nor updated_at field with the version of the entity.
+	ErrPreconditionRequired = &liberror.Error{
+		Err:      "If-Match header or updated_at field with the version of the entity is required",
+		Code:     "PRECONDITION_REQUIRED",
+		HTTPCode: http.StatusPreconditionRequired,
+	}
+)
+
+// GRADING SCALES.
+var (
+	// ErrGradingScaleNotFound represents an error when grading scale is not found.
+	ErrGradingScaleNotFound = NewNotFoundErr("grading scale")
+	// ErrGradingScaleAlreadyExists represents an error when grading scale name is already exists.
+	ErrGradingScaleAlreadyExists = NewConflictErr("grading scale")
+	// ErrInvalidGradingScale represents an error when grading scale values are not valid.
+	ErrInvalidGradingScale = NewBadRequest("grading scale kind is unknown or values are not unique")
+)
+
+// ATTENDANCES.
+var (
+	// ErrStudentNotInLessonGroup represents an error when student does not belong to the group of the lesson.
+	ErrStudentNotInLessonGroup = NewBadRequest("student does not belong to the lesson group")
+	// ErrDuplicateAttendance represents an error when student attendance is passed more than once.
+	ErrDuplicateAttendance = NewBadRequest("student attendance is duplicated")
+)
+
+// STUDENT IMPORT.
+var (
+	// ErrInvalidStudentRoster represents an error when the rows of the student roster are not valid.
+	ErrInvalidStudentRoster = &liberror.Error{
+		Err:      "student roster has invalid rows",
+		Code:     "BAD_REQUEST: STUDENT_ROSTER",
+		HTTPCode: http.StatusBadRequest,
+	}
+	// ErrInvalidStudentRosterFile represents an error when the student roster can not be read.
+	ErrInvalidStudentRosterFile = NewBadRequest("student roster must be a CSV or XLSX file")
+	// ErrStudentRosterTooLarge represents an error when the student roster is larger than StudentRosterMaxSize.
+	ErrStudentRosterTooLarge = NewBadRequest("student roster is too large")
+)
+
+// NewInvalidStudentRosterErr creates a new invalid student roster error with the errors of its rows.
+func NewInvalidStudentRosterErr(errs StudentImportErrors) *liberror.Error {
+	err := *ErrInvalidStudentRoster
+	err.Details = errs
+
+	return &err
+}
+
+// SCHOOL SETUP.
+var (
+	// ErrInvalidSchoolSetup represents an error when the entries of the school setup are not valid.
+	ErrInvalidSchoolSetup = &liberror.Error{
+		Err:      "school setup has invalid entries",
+		Code:     "BAD_REQUEST: SCHOOL_SETUP",
+		HTTPCode: http.StatusBadRequest,
+	}
+	// ErrInvalidSchoolSetupFile represents an error when the school setup can not be read.
+	ErrInvalidSchoolSetupFile = NewBadRequest("school setup must be a YAML or JSON file or CSV or XLSX sheets")
+	// ErrSchoolSetupTooLarge represents an error when the school setup is larger than SchoolSetupMaxSize.
+	ErrSchoolSetupTooLarge = NewBadRequest("school setup is too large")
+)
+
+// NewInvalidSchoolSetupErr creates a new invalid school setup error with the errors of its entries.
+func NewInvalidSchoolSetupErr(errs SchoolSetupErrors) *liberror.Error {
+	err := *ErrInvalidSchoolSetup
+	err.Details = errs
+
+	return &err
+}
+
 // NewNotFoundErr creates a new NotFound error with the given entity.
 func NewNotFoundErr(entity string) *liberror.Error {
 	return &liberror.Error{
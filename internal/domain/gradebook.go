@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FinalGrade is a student grade of the group subject for a period, e.g. term or year.
+type FinalGrade struct {
+	ID             uuid.UUID
+	GroupSubjectID uuid.UUID
+	StudentID      uuid.UUID
+	DateFrom       time.Time
+	DateTill       time.Time
+	Mark           string
+	Weight         float64
+	Average        float64
+	LockedAt       *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// NewFinalGrade creates a new FinalGrade domain.
+func NewFinalGrade(
+	groupSubjectID uuid.UUID,
+	studentID uuid.UUID,
+	dateFrom, dateTill time.Time,
+
+	nowFunc func() time.Time,
+) FinalGrade {
+	now := nowFunc()
+
+	return FinalGrade{
+		ID:             uuid.New(),
+		GroupSubjectID: groupSubjectID,
+		StudentID:      studentID,
+		DateFrom:       dateFrom,
+		DateTill:       dateTill,
+
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsLocked checks whether final grade can not be changed anymore.
+func (f FinalGrade) IsLocked() bool {
+	return f.LockedAt != nil
+}
+
+// Finalise sets final mark of the student, error if grade is already locked.
+func (f *FinalGrade) Finalise(mark string, weight, average float64, lock bool, nowFunc func() time.Time) error {
+	if f.IsLocked() {
+		return ErrFinalGradeLocked
+	}
+
+	now := nowFunc()
+
+	f.Mark = mark
+	f.Weight = weight
+	f.Average = average
+	f.UpdatedAt = now
+
+	if lock {
+		f.LockedAt = &now
+	}
+
+	return nil
+}
+
+// Covers checks whether the date is within period of the final grade.
+func (f FinalGrade) Covers(date time.Time) bool {
+	return coversDate(f.DateFrom, f.DateTill, date)
+}
+
+// FinalGrades is slice of FinalGrade.
+type FinalGrades []FinalGrade
+
+// ByStudentID returns final grade of the student, false if there is no one.
+func (f FinalGrades) ByStudentID(studentID uuid.UUID) (FinalGrade, bool) {
+	for _, grade := range f {
+		if grade.StudentID == studentID {
+			return grade, true
+		}
+	}
+
+	return FinalGrade{}, false
+}
+
+// Gradebook is a matrix of the group students and the group subject lessons with marks.
+type Gradebook struct {
+	GroupSubjectID uuid.UUID
+	DateFrom       time.Time
+	DateTill       time.Time
+	Lessons        Lessons
+	Rows           []GradebookRow
+}
+
+// GradebookRow is marks of a student in the gradebook.
+type GradebookRow struct {
+	StudentID  uuid.UUID
+	Marks      Marks
+	FinalGrade *FinalGrade
+}
+
+// Average returns average weight of the student marks.
+func (g GradebookRow) Average() float64 {
+	return g.Marks.Average()
+}
+
+// NewGradebook creates a new Gradebook domain, rows are ordered as student ids.
+func NewGradebook(
+	groupSubjectID uuid.UUID,
+	dateFrom, dateTill time.Time,
+	studentIDs []uuid.UUID,
+	lessons Lessons,
+	marks Marks,
+	finalGrades FinalGrades,
+) Gradebook {
+	marksByStudent := make(map[uuid.UUID]Marks, len(studentIDs))
+	for _, mark := range marks {
+		marksByStudent[mark.StudentID] = append(marksByStudent[mark.StudentID], mark)
+	}
+
+	rows := make([]GradebookRow, 0, len(studentIDs))
+
+	for _, studentID := range studentIDs {
+		row := GradebookRow{StudentID: studentID, Marks: marksByStudent[studentID]}
+
+		if grade, ok := finalGrades.ByStudentID(studentID); ok {
+			row.FinalGrade = &grade
+		}
+
+		rows = append(rows, row)
+	}
+
+	return Gradebook{
+		GroupSubjectID: groupSubjectID,
+		DateFrom:       dateFrom,
+		DateTill:       dateTill,
+		Lessons:        lessons,
+		Rows:           rows,
+	}
+}
+
+// Row returns gradebook row of the student, false if student is not in the gradebook.
+func (g Gradebook) Row(studentID uuid.UUID) (GradebookRow, bool) {
+	for _, row := range g.Rows {
+		if row.StudentID == studentID {
+			return row, true
+		}
+	}
+
+	return GradebookRow{}, false
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestNewGradebook(t *testing.T) {
+	var (
+		from    = time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+		till    = time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+		nowFunc = func() time.Time { return from }
+		first   = uuid.New()
+		second  = uuid.New()
+		lesson  = uuid.New()
+	)
+
+	final := NewFinalGrade(uuid.New(), second, from, till, nowFunc)
+
+	gradebook := NewGradebook(
+		uuid.New(),
+		from,
+		till,
+		[]uuid.UUID{first, second},
+		Lessons{{ID: lesson}},
+		Marks{
+			NewMark(lesson, first, "5", 5, nil, nowFunc),
+			NewMark(lesson, first, "4", 4, nil, nowFunc),
+		},
+		FinalGrades{final},
+	)
+
+	row, ok := gradebook.Row(first)
+	if !ok {
+		t.Fatalf("expected row of the first student")
+	}
+
+	if row.Average() != 4.5 {
+		t.Errorf("expected average 4.5, got %v", row.Average())
+	}
+
+	if row.FinalGrade != nil {
+		t.Errorf("expected no final grade of the first student")
+	}
+
+	row, ok = gradebook.Row(second)
+	if !ok || row.FinalGrade == nil || row.FinalGrade.ID != final.ID {
+		t.Fatalf("expected final grade of the second student")
+	}
+
+	if row.Average() != 0 {
+		t.Errorf("expected zero average without marks, got %v", row.Average())
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestFinalGrade_Finalise(t *testing.T) {
+	nowFunc := func() time.Time { return time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC) }
+
+	grade := NewFinalGrade(uuid.New(), uuid.New(), nowFunc(), nowFunc(), nowFunc)
+
+	if err := grade.Finalise("4", 4, 4.3, false, nowFunc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if grade.IsLocked() {
+		t.Fatalf("expected grade not to be locked")
+	}
+
+	if err := grade.Finalise("5", 5, 4.6, true, nowFunc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := grade.Finalise("3", 3, 3, false, nowFunc); !errors.Is(err, ErrFinalGradeLocked) {
+		t.Fatalf("expected locked error, got %v", err)
+	}
+
+	if grade.Mark != "5" {
+		t.Errorf("expected locked mark 5, got %s", grade.Mark)
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestGradingScale_Nearest(t *testing.T) {
+	nowFunc := func() time.Time { return time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC) }
+
+	fivePoint := NewGradingScale(nil, "5-point", GradingScaleFivePoint, nowFunc)
+	for i := 1; i <= 5; i++ {
+		fivePoint.Values = append(fivePoint.Values, NewGradingScaleValue(fivePoint.ID, string(rune('0'+i)), float64(i), i))
+	}
+
+	tests := []struct {
+		name     string
+		scale    GradingScale
+		weight   float64
+		wantMark string
+	}{
+		{name: "rounds down", scale: fivePoint, weight: 4.3, wantMark: "4"},
+		{name: "half rounds up", scale: fivePoint, weight: 4.5, wantMark: "5"},
+		{name: "percentage", scale: NewGradingScale(nil, "%", GradingScalePercentage, nowFunc), weight: 87.6, wantMark: "88"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if mark, _ := tt.scale.Nearest(tt.weight); mark != tt.wantMark {
+				t.Errorf("expected mark %s, got %s", tt.wantMark, mark)
+			}
+		})
+	}
+}
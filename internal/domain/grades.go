@@ -14,6 +14,8 @@ type GradeStandard struct {
 	Name           string
 	EducationYears int8
 	Description    *string
+	GradingScaleID *uuid.UUID
+	GradingScale   *GradingScale
 	Grades         Grades
 
 	CreatedAt time.Time
@@ -27,6 +29,7 @@ func NewGradeStandard(
 	name string,
 	educationYears int8,
 	description *string,
+	gradingScaleID *uuid.UUID,
 	nowFunc func() time.Time,
 ) GradeStandard {
 	now := nowFunc()
@@ -37,16 +40,38 @@ func NewGradeStandard(
 		Name:           name,
 		EducationYears: educationYears,
 		Description:    description,
+		GradingScaleID: gradingScaleID,
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
 }
 
+// Update updates grade standard.
+func (gs *GradeStandard) Update(
+	name string,
+	educationYears int8,
+	description *string,
+	gradingScaleID *uuid.UUID,
+	nowFunc func() time.Time,
+) {
+	gs.Name = name
+	gs.EducationYears = educationYears
+	gs.Description = description
+	gs.GradingScaleID = gradingScaleID
+
+	gs.UpdatedAt = nowFunc()
+}
+
 // SetGrades set grade standard grades.
 func (gs *GradeStandard) SetGrades(grades Grades) {
 	gs.Grades = grades
 }
 
+// SetGradingScale sets grading scale of the grade standard.
+func (gs *GradeStandard) SetGradingScale(scale GradingScale) {
+	gs.GradingScale = &scale
+}
+
 // GradeStandards are collection of GradeStandard.
 type GradeStandards []GradeStandard
 
@@ -109,3 +134,15 @@ func NewGradeStandardListFilter(list ListFilter) GradeStandardListFilter {
 		ListFilter: list,
 	}
 }
+
+// GradeStandardListFields returns the fields the list of grade standards is sorted and filtered by.
+func GradeStandardListFields() ListFields {
+	return ListFields{
+		"created_at":       ListFieldTime,
+		"updated_at":       ListFieldTime,
+		"name":             ListFieldString,
+		"education_years":  ListFieldInt,
+		"organization_id":  ListFieldUUID,
+		"grading_scale_id": ListFieldUUID,
+	}
+}
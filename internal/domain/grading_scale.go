@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GradingScaleKind is kind of grading scale.
+type GradingScaleKind string
+
+const (
+	// GradingScaleFivePoint is a scale with marks from 1 to 5.
+	GradingScaleFivePoint GradingScaleKind = "five_point"
+	// GradingScaleTenPoint is a scale with marks from 1 to 10.
+	GradingScaleTenPoint GradingScaleKind = "ten_point"
+	// GradingScaleLetter is a scale with marks from A to F.
+	GradingScaleLetter GradingScaleKind = "letter"
+	// GradingScalePassFail is a scale with pass and fail marks.
+	GradingScalePassFail GradingScaleKind = "pass_fail"
+	// GradingScalePercentage is a scale with any mark from 0 to 100.
+	GradingScalePercentage GradingScaleKind = "percentage"
+)
+
+const (
+	minPercentage = 0
+	maxPercentage = 100
+)
+
+// DefaultGradingScaleID returns id of the preset five-point grading scale,
+// it is used when grade standard of the school has no grading scale.
+func DefaultGradingScaleID() uuid.UUID {
+	return uuid.MustParse("7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c01")
+}
+
+// GradingScale is a set of allowed marks with their numeric weights.
+type GradingScale struct {
+	ID             uuid.UUID
+	OrganizationID *uuid.UUID
+	Name           string
+	Kind           GradingScaleKind
+	Values         GradingScaleValues
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// NewGradingScale creates a new GradingScale domain.
+func NewGradingScale(
+	organizationID *uuid.UUID,
+	name string,
+	kind GradingScaleKind,
+
+	nowFunc func() time.Time,
+) GradingScale {
+	now := nowFunc()
+
+	return GradingScale{
+		ID:             uuid.New(),
+		OrganizationID: organizationID,
+		Name:           name,
+		Kind:           kind,
+
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Validate checks that scale kind is known and scale has values unless it is a percentage one.
+func (g GradingScale) Validate() error {
+	switch g.Kind {
+	case GradingScaleFivePoint, GradingScaleTenPoint, GradingScaleLetter, GradingScalePassFail:
+	case GradingScalePercentage:
+		if len(g.Values) != 0 {
+			return ErrInvalidGradingScale
+		}
+
+		return nil
+	default:
+		return ErrInvalidGradingScale
+	}
+
+	const minValues = 2
+	if len(g.Values) < minValues {
+		return ErrInvalidGradingScale
+	}
+
+	seen := make(map[string]struct{}, len(g.Values))
+
+	for _, value := range g.Values {
+		if _, ok := seen[value.Value]; ok || value.Value == "" {
+			return ErrInvalidGradingScale
+		}
+
+		seen[value.Value] = struct{}{}
+	}
+
+	return nil
+}
+
+// Weight returns numeric weight of the mark, error if mark is not allowed by the scale.
+func (g GradingScale) Weight(mark string) (float64, error) {
+	if g.Kind == GradingScalePercentage {
+		weight, err := strconv.ParseFloat(mark, 64)
+		if err != nil || weight < minPercentage || weight > maxPercentage {
+			return 0, ErrInvalidMark
+		}
+
+		return weight, nil
+	}
+
+	for _, value := range g.Values {
+		if value.Value == mark {
+			return value.Weight, nil
+		}
+	}
+
+	return 0, ErrInvalidMark
+}
+
+// Nearest returns the mark of the scale which weight is the closest to the given one,
+// e.g. to suggest a final grade by the average.
+func (g GradingScale) Nearest(weight float64) (string, float64) {
+	if g.Kind == GradingScalePercentage {
+		rounded := math.Round(weight)
+
+		return strconv.FormatFloat(rounded, 'f', -1, 64), rounded
+	}
+
+	var (
+		mark    string
+		nearest float64
+		delta   = math.Inf(1)
+	)
+
+	for _, value := range g.Values {
+		if d := math.Abs(value.Weight - weight); d < delta || (d == delta && value.Weight > nearest) {
+			mark, nearest, delta = value.Value, value.Weight, d
+		}
+	}
+
+	return mark, nearest
+}
+
+// SetValues sets values of the grading scale.
+func (g *GradingScale) SetValues(values GradingScaleValues) {
+	g.Values = values
+}
+
+// GradingScales is list of GradingScale.
+type GradingScales []GradingScale
+
+// IDs returns ids of grading scales.
+func (g GradingScales) IDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(g))
+
+	for _, scale := range g {
+		ids = append(ids, scale.ID)
+	}
+
+	return ids
+}
+
+// SetValues sets values to grading scales.
+func (g GradingScales) SetValues(values GradingScaleValues) {
+	for i := range g {
+		g[i].Values = GradingScaleValues{}
+
+		for _, value := range values {
+			if value.GradingScaleID == g[i].ID {
+				g[i].Values = append(g[i].Values, value)
+			}
+		}
+	}
+}
+
+// GradingScaleValue is allowed mark of the grading scale.
+type GradingScaleValue struct {
+	ID             uuid.UUID
+	GradingScaleID uuid.UUID
+	Value          string
+	Weight         float64
+	Position       int
+}
+
+// NewGradingScaleValue creates a new GradingScaleValue domain.
+func NewGradingScaleValue(gradingScaleID uuid.UUID, value string, weight float64, position int) GradingScaleValue {
+	return GradingScaleValue{
+		ID:             uuid.New(),
+		GradingScaleID: gradingScaleID,
+		Value:          value,
+		Weight:         weight,
+		Position:       position,
+	}
+}
+
+// GradingScaleValues is list of GradingScaleValue.
+type GradingScaleValues []GradingScaleValue
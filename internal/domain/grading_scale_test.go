@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestGradingScale_Weight(t *testing.T) {
+	nowFunc := func() time.Time { return time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC) }
+
+	letter := NewGradingScale(nil, "A-F", GradingScaleLetter, nowFunc)
+	letter.SetValues(GradingScaleValues{
+		NewGradingScaleValue(letter.ID, "F", 0, 1),
+		NewGradingScaleValue(letter.ID, "A", 4, 2),
+	})
+
+	percentage := NewGradingScale(nil, "Percentage", GradingScalePercentage, nowFunc)
+
+	tests := []struct {
+		name    string
+		scale   GradingScale
+		mark    string
+		want    float64
+		wantErr error
+	}{
+		{name: "letter value", scale: letter, mark: "A", want: 4},
+		{name: "letter unknown value", scale: letter, mark: "B", wantErr: ErrInvalidMark},
+		{name: "percentage value", scale: percentage, mark: "87.5", want: 87.5},
+		{name: "percentage out of range", scale: percentage, mark: "101", wantErr: ErrInvalidMark},
+		{name: "percentage not a number", scale: percentage, mark: "A", wantErr: ErrInvalidMark},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.scale.Weight(tt.mark)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+
+			if got != tt.want {
+				t.Errorf("expected weight %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestGradingScale_Validate(t *testing.T) {
+	nowFunc := func() time.Time { return time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC) }
+
+	newScale := func(kind GradingScaleKind, values ...string) GradingScale {
+		scale := NewGradingScale(nil, "scale", kind, nowFunc)
+
+		list := make(GradingScaleValues, 0, len(values))
+		for i, value := range values {
+			list = append(list, NewGradingScaleValue(scale.ID, value, float64(i), i+1))
+		}
+
+		scale.SetValues(list)
+
+		return scale
+	}
+
+	tests := []struct {
+		name    string
+		scale   GradingScale
+		wantErr error
+	}{
+		{name: "valid pass fail", scale: newScale(GradingScalePassFail, "fail", "pass")},
+		{name: "valid percentage", scale: newScale(GradingScalePercentage)},
+		{name: "percentage with values", scale: newScale(GradingScalePercentage, "1"), wantErr: ErrInvalidGradingScale},
+		{name: "single value", scale: newScale(GradingScaleFivePoint, "5"), wantErr: ErrInvalidGradingScale},
+		{name: "duplicated values", scale: newScale(GradingScaleTenPoint, "1", "1"), wantErr: ErrInvalidGradingScale},
+		{name: "unknown kind", scale: newScale("stars", "1", "2"), wantErr: ErrInvalidGradingScale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.scale.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
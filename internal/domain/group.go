@@ -13,6 +13,11 @@ type Group struct {
 	Name     string
 	GradeID  uuid.UUID
 
+	// AcademicYearID is academic year the group studies in, nil for groups created before academic years.
+	AcademicYearID *uuid.UUID
+	// PreviousGroupID is the group of the previous academic year the group was promoted from.
+	PreviousGroupID *uuid.UUID
+
 	ClassTeacherID *uuid.UUID
 	ClassTeacher   *Teacher
 
@@ -36,6 +41,7 @@ func NewGroup(
 	schoolID uuid.UUID,
 	name string,
 	gradeID uuid.UUID,
+	academicYearID *uuid.UUID,
 	nowFunc func() time.Time,
 ) Group {
 	now := nowFunc()
@@ -46,6 +52,8 @@ func NewGroup(
 		Name:     name,
 		GradeID:  gradeID,
 
+		AcademicYearID: academicYearID,
+
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
@@ -102,16 +110,45 @@ func (g *Group) Update(
 // GroupFilters is structure of Group filters.
 type GroupFilters struct {
 	ListFilter
+	AcademicYearID *uuid.UUID
 }
 
 // NewGroupFilters creates a new GroupFilters domain.
-func NewGroupFilters() GroupFilters {
-	return GroupFilters{}
+func NewGroupFilters(list ListFilter, academicYearID *uuid.UUID) GroupFilters {
+	return GroupFilters{
+		ListFilter:     list,
+		AcademicYearID: academicYearID,
+	}
 }
 
 // Groups is list of Group.
 type Groups []Group
 
+// IDs returns a list of Group IDs.
+func (g Groups) IDs() []uuid.UUID {
+	res := make([]uuid.UUID, 0, len(g))
+
+	for _, group := range g {
+		res = append(res, group.ID)
+	}
+
+	return res
+}
+
+// ByName returns the groups with the name, the names are compared case-insensitively
+// and regardless of the repeated spaces, e.g. "5 a" is the name of the group "5  A".
+func (g Groups) ByName(name string) Groups {
+	var res Groups
+
+	for _, group := range g {
+		if SameName(group.Name, name) {
+			res = append(res, group)
+		}
+	}
+
+	return res
+}
+
 // GradeIDs returns a list of Group grades IDs.
 func (g Groups) GradeIDs() []uuid.UUID {
 	res := make([]uuid.UUID, 0, len(g))
@@ -134,3 +171,15 @@ func (g Groups) SetGrades(grades Grades) {
 		g[index].SetGrade(mapOfGradesByID[g[index].GradeID])
 	}
 }
+
+// GroupListFields returns the fields the list of groups is sorted and filtered by.
+func GroupListFields() ListFields {
+	return ListFields{
+		"created_at":       ListFieldTime,
+		"updated_at":       ListFieldTime,
+		"name":             ListFieldString,
+		"grade_id":         ListFieldUUID,
+		"academic_year_id": ListFieldUUID,
+		"class_teacher_id": ListFieldUUID,
+	}
+}
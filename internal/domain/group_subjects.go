@@ -45,6 +45,14 @@ func NewGroupSubject(
 	}
 }
 
+// Update updates the teacher and the number of the lessons of the group subject.
+func (g *GroupSubject) Update(teacherID *uuid.UUID, count *int16, nowFunc func() time.Time) {
+	g.TeacherID = teacherID
+	g.Count = count
+
+	g.UpdatedAt = nowFunc()
+}
+
 // HasTeacher checks whether teacher is assigned to subject.
 func (g *GroupSubject) HasTeacher() bool {
 	return g.TeacherID != nil
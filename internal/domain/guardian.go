@@ -22,6 +22,7 @@ type StudentGuardian struct {
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt *time.Time
 }
 
 // NewStudentGuardian creates a new StudentGuardian domain.
@@ -158,6 +159,8 @@ type StudentGuardianListFilter struct {
 	GroupIDs        []uuid.UUID
 	SchoolIDs       []uuid.UUID
 	OrganizationIDs []uuid.UUID
+	// Search is the normalised search query of the guardian users, empty if the list is not searched.
+	Search string
 }
 
 // NewStudentGuardianListFilter creates a new StudentGuardianListFilter domain.
@@ -167,6 +170,7 @@ func NewStudentGuardianListFilter(
 	groupIDs []uuid.UUID,
 	schoolIDs []uuid.UUID,
 	organizationIDs []uuid.UUID,
+	search string,
 ) StudentGuardianListFilter {
 	return StudentGuardianListFilter{
 		ListFilter:      list,
@@ -174,5 +178,18 @@ func NewStudentGuardianListFilter(
 		GroupIDs:        groupIDs,
 		SchoolIDs:       schoolIDs,
 		OrganizationIDs: organizationIDs,
+		Search:          search,
+	}
+}
+
+// StudentGuardianListFields returns the fields the list of student guardians is sorted and filtered by.
+func StudentGuardianListFields() ListFields {
+	return ListFields{
+		"created_at": ListFieldTime,
+		"updated_at": ListFieldTime,
+		"student_id": ListFieldUUID,
+		"user_id":    ListFieldUUID,
+		"school_id":  ListFieldUUID,
+		"relation":   ListFieldString,
 	}
 }
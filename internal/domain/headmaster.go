@@ -46,6 +46,14 @@ func NewHeadmaster(
 	}
 }
 
+// Update updates contacts of the headmaster.
+func (h *Headmaster) Update(phone, email *string, nowFunc func() time.Time) {
+	h.Phone = phone
+	h.Email = email
+
+	h.UpdatedAt = nowFunc()
+}
+
 // SetUser sets User info in Headmaster model.
 func (h *Headmaster) SetUser(user User) {
 	h.User = user
@@ -120,3 +128,15 @@ func (h Headmasters) SetShortSchools(schoolShorts SchoolShortInfos) {
 		h[index].SetShortSchool(mapOfSchoolShort[h[index].SchoolID])
 	}
 }
+
+// HeadmasterListFields returns the fields the list of headmasters is sorted and filtered by.
+func HeadmasterListFields() ListFields {
+	return ListFields{
+		"created_at": ListFieldTime,
+		"updated_at": ListFieldTime,
+		"school_id":  ListFieldUUID,
+		"user_id":    ListFieldUUID,
+		"email":      ListFieldString,
+		"phone":      ListFieldString,
+	}
+}
@@ -16,6 +16,13 @@ type Lesson struct {
 	StartTime      time.Time
 	EndTime        time.Time
 	Description    *string
+	// TermID is term of the academic year the lesson date belongs to.
+	TermID *uuid.UUID
+
+	// TemplateSlotID is timetable template slot the lesson was materialised from.
+	TemplateSlotID *uuid.UUID
+	// Overridden is true when a single occurrence of the template was changed.
+	Overridden bool
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -57,9 +64,43 @@ func NewLesson(
 	}
 }
 
+// Override changes a single lesson occurrence, so it is not replaced by the timetable template anymore.
+func (l *Lesson) Override(teacherID, auditoriumID *uuid.UUID, description *string, nowFunc func() time.Time) {
+	if teacherID != nil {
+		l.TeacherID = teacherID
+	}
+
+	if auditoriumID != nil {
+		l.AuditoriumID = *auditoriumID
+	}
+
+	if description != nil {
+		l.Description = description
+	}
+
+	l.Overridden = true
+	l.UpdatedAt = nowFunc()
+}
+
+// Cursor returns the position of the lesson in the list sorted by the start time.
+func (l Lesson) Cursor() Cursor {
+	return Cursor{Key: l.StartTime, ID: l.ID}
+}
+
 // Lessons are list of lessons.
 type Lessons []Lesson
 
+// IDs returns ids of lessons.
+func (l Lessons) IDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(l))
+
+	for _, lesson := range l {
+		ids = append(ids, lesson.ID)
+	}
+
+	return ids
+}
+
 // LessonsListFilter filter for the list of Lessons.
 type LessonsListFilter struct {
 	Period DateFilter
@@ -67,6 +108,7 @@ type LessonsListFilter struct {
 	SchoolID  *uuid.UUID
 	TeacherID *uuid.UUID
 	GroupID   *uuid.UUID
+	TermID    *uuid.UUID
 }
 
 // NewLessonsListFilter creates a new LessonsListFilter domain.
@@ -76,6 +118,7 @@ func NewLessonsListFilter(
 	schoolID *uuid.UUID,
 	teacherID *uuid.UUID,
 	groupID *uuid.UUID,
+	termID *uuid.UUID,
 ) LessonsListFilter {
 	return LessonsListFilter{
 		Period:     period,
@@ -83,5 +126,19 @@ func NewLessonsListFilter(
 		SchoolID:   schoolID,
 		TeacherID:  teacherID,
 		GroupID:    groupID,
+		TermID:     termID,
+	}
+}
+
+// LessonListFields returns the fields the list of lessons is sorted and filtered by.
+func LessonListFields() ListFields {
+	return ListFields{
+		"start_time":       ListFieldTime,
+		"end_time":         ListFieldTime,
+		"created_at":       ListFieldTime,
+		"group_subject_id": ListFieldUUID,
+		"teacher_id":       ListFieldUUID,
+		"auditorium_id":    ListFieldUUID,
+		"term_id":          ListFieldUUID,
 	}
 }
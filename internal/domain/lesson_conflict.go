@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LessonConflictType is type of lesson scheduling conflict.
+type LessonConflictType string
+
+const (
+	// LessonConflictInvalidTime is when lesson ends before it starts.
+	LessonConflictInvalidTime LessonConflictType = "invalid_time"
+	// LessonConflictGroupOverlap is when lessons of the group overlap each other.
+	LessonConflictGroupOverlap LessonConflictType = "group_overlap"
+	// LessonConflictTeacherBusy is when teacher already has a lesson at the same time.
+	LessonConflictTeacherBusy LessonConflictType = "teacher_busy"
+	// LessonConflictAuditoriumBusy is when auditorium is already booked at the same time.
+	LessonConflictAuditoriumBusy LessonConflictType = "auditorium_busy"
+)
+
+// LessonConflict is a scheduling conflict between a lesson and another lesson.
+// LessonIndex and ConflictingLessonIndex are positions of lessons in the checked list,
+// ConflictingLesson is already scheduled lesson of another group.
+type LessonConflict struct {
+	Type                   LessonConflictType
+	LessonIndex            int
+	ConflictingLessonIndex *int
+	ConflictingLesson      *Lesson
+}
+
+// LessonConflicts is list of LessonConflict.
+type LessonConflicts []LessonConflict
+
+// Overlaps checks whether lessons take place at the same time.
+func (l Lesson) Overlaps(other Lesson) bool {
+	return l.StartTime.Before(other.EndTime) && other.StartTime.Before(l.EndTime)
+}
+
+// Conflicts returns scheduling conflicts between lessons of the same group.
+func (l Lessons) Conflicts() LessonConflicts {
+	var conflicts LessonConflicts
+
+	for i := range l {
+		if !l[i].EndTime.After(l[i].StartTime) {
+			conflicts = append(conflicts, LessonConflict{
+				Type:        LessonConflictInvalidTime,
+				LessonIndex: i,
+			})
+
+			continue
+		}
+
+		for j := i + 1; j < len(l); j++ {
+			if l[j].EndTime.After(l[j].StartTime) && l[i].Overlaps(l[j]) {
+				conflictingIndex := j
+
+				conflicts = append(conflicts, LessonConflict{
+					Type:                   LessonConflictGroupOverlap,
+					LessonIndex:            i,
+					ConflictingLessonIndex: &conflictingIndex,
+				})
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// ConflictsWith returns teacher and auditorium conflicts between lessons and already booked lessons.
+func (l Lessons) ConflictsWith(booked Lessons) LessonConflicts {
+	var conflicts LessonConflicts
+
+	for i := range l {
+		for j := range booked {
+			if !l[i].Overlaps(booked[j]) {
+				continue
+			}
+
+			if l[i].TeacherID != nil && booked[j].TeacherID != nil && *l[i].TeacherID == *booked[j].TeacherID {
+				conflicts = append(conflicts, LessonConflict{
+					Type:              LessonConflictTeacherBusy,
+					LessonIndex:       i,
+					ConflictingLesson: &booked[j],
+				})
+			}
+
+			if l[i].AuditoriumID == booked[j].AuditoriumID {
+				conflicts = append(conflicts, LessonConflict{
+					Type:              LessonConflictAuditoriumBusy,
+					LessonIndex:       i,
+					ConflictingLesson: &booked[j],
+				})
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// TeacherIDs returns list of unique teacher ids of lessons.
+func (l Lessons) TeacherIDs() []uuid.UUID {
+	var (
+		ids  = make([]uuid.UUID, 0, len(l))
+		seen = make(map[uuid.UUID]struct{}, len(l))
+	)
+
+	for _, lesson := range l {
+		if lesson.TeacherID == nil {
+			continue
+		}
+
+		if _, ok := seen[*lesson.TeacherID]; ok {
+			continue
+		}
+
+		seen[*lesson.TeacherID] = struct{}{}
+		ids = append(ids, *lesson.TeacherID)
+	}
+
+	return ids
+}
+
+// AuditoriumIDs returns list of unique auditorium ids of lessons.
+func (l Lessons) AuditoriumIDs() []uuid.UUID {
+	var (
+		ids  = make([]uuid.UUID, 0, len(l))
+		seen = make(map[uuid.UUID]struct{}, len(l))
+	)
+
+	for _, lesson := range l {
+		if _, ok := seen[lesson.AuditoriumID]; ok {
+			continue
+		}
+
+		seen[lesson.AuditoriumID] = struct{}{}
+		ids = append(ids, lesson.AuditoriumID)
+	}
+
+	return ids
+}
+
+// Period returns the earliest start time and the latest end time of lessons.
+func (l Lessons) Period() (from, till time.Time) {
+	for i, lesson := range l {
+		if i == 0 || lesson.StartTime.Before(from) {
+			from = lesson.StartTime
+		}
+
+		if i == 0 || lesson.EndTime.After(till) {
+			till = lesson.EndTime
+		}
+	}
+
+	return from, till
+}
+
+// lessonConflictDetail is a JSON representation of LessonConflict for error details.
+type lessonConflictDetail struct {
+	Type                   LessonConflictType `json:"type"`
+	LessonIndex            int                `json:"lesson_index"`
+	ConflictingLessonIndex *int               `json:"conflicting_lesson_index,omitempty"`
+	ConflictingLessonID    *uuid.UUID         `json:"conflicting_lesson_id,omitempty"`
+	StartTime              *time.Time         `json:"conflicting_start_time,omitempty"`
+	EndTime                *time.Time         `json:"conflicting_end_time,omitempty"`
+}
+
+// details returns JSON representation of conflicts.
+func (c LessonConflicts) details() []lessonConflictDetail {
+	details := make([]lessonConflictDetail, 0, len(c))
+
+	for _, conflict := range c {
+		detail := lessonConflictDetail{
+			Type:                   conflict.Type,
+			LessonIndex:            conflict.LessonIndex,
+			ConflictingLessonIndex: conflict.ConflictingLessonIndex,
+		}
+
+		if conflict.ConflictingLesson != nil {
+			detail.ConflictingLessonID = &conflict.ConflictingLesson.ID
+			detail.StartTime = &conflict.ConflictingLesson.StartTime
+			detail.EndTime = &conflict.ConflictingLesson.EndTime
+		}
+
+		details = append(details, detail)
+	}
+
+	return details
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestLessons_Conflicts(t *testing.T) {
+	var (
+		start        = time.Date(2024, 9, 2, 8, 0, 0, 0, time.UTC)
+		teacherID    = uuid.New()
+		auditoriumID = uuid.New()
+	)
+
+	lesson := func(from, till time.Duration) Lesson {
+		return Lesson{
+			ID:           uuid.New(),
+			TeacherID:    &teacherID,
+			AuditoriumID: auditoriumID,
+			StartTime:    start.Add(from),
+			EndTime:      start.Add(till),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		lessons Lessons
+		booked  Lessons
+		want    []LessonConflictType
+	}{
+		{
+			name:    "no conflicts",
+			lessons: Lessons{lesson(0, 45*time.Minute), lesson(time.Hour, 105*time.Minute)},
+			want:    nil,
+		},
+		{
+			name:    "end time before start time",
+			lessons: Lessons{lesson(time.Hour, 0)},
+			want:    []LessonConflictType{LessonConflictInvalidTime},
+		},
+		{
+			name:    "group lessons overlap",
+			lessons: Lessons{lesson(0, 45*time.Minute), lesson(30*time.Minute, 75*time.Minute)},
+			want:    []LessonConflictType{LessonConflictGroupOverlap},
+		},
+		{
+			name:    "teacher and auditorium are booked",
+			lessons: Lessons{lesson(0, 45*time.Minute)},
+			booked:  Lessons{lesson(15*time.Minute, time.Hour)},
+			want:    []LessonConflictType{LessonConflictTeacherBusy, LessonConflictAuditoriumBusy},
+		},
+		{
+			name:    "booked lesson right after",
+			lessons: Lessons{lesson(0, 45*time.Minute)},
+			booked:  Lessons{lesson(45*time.Minute, 90*time.Minute)},
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conflicts := append(tt.lessons.Conflicts(), tt.lessons.ConflictsWith(tt.booked)...)
+			if len(conflicts) != len(tt.want) {
+				t.Fatalf("Conflicts() = %v, want %v", conflicts, tt.want)
+			}
+
+			for i := range conflicts {
+				if conflicts[i].Type != tt.want[i] {
+					t.Errorf("Conflicts()[%d] = %v, want %v", i, conflicts[i].Type, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -1,9 +1,26 @@
 package domain
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
 // ListFilter is filter for list.
 type ListFilter struct {
 	SortOrder string
 	Pagination
+
+	// Sort is the fields the list is sorted by, the list is sorted by its default field
+	// with SortOrder if it's empty.
+	Sort []SortField
+	// Conditions are the conditions of the fields of the list entities.
+	Conditions []FieldCondition
 }
 
 const (
@@ -13,6 +30,15 @@ const (
 	SortOrderASC = "ASC"
 )
 
+const (
+	// ListSortMaxFields is the maximum number of the fields the list is sorted by.
+	ListSortMaxFields = 3
+	// ListFilterMaxConditions is the maximum number of the field conditions of the list.
+	ListFilterMaxConditions = 10
+	// ListFilterMaxValues is the maximum number of the values of the in condition.
+	ListFilterMaxValues = 100
+)
+
 // NewListFilter creates a new List Filter domain.
 func NewListFilter(sortOrder string, pagination Pagination) ListFilter {
 	return ListFilter{
@@ -20,3 +46,244 @@ func NewListFilter(sortOrder string, pagination Pagination) ListFilter {
 		Pagination: pagination,
 	}
 }
+
+// NewFieldsListFilter creates a new List Filter domain sorted and filtered by the fields of the list.
+// The sort is the comma separated fields, descending ones are prefixed with "-", e.g. "last_name,-created_at".
+// Every filter is the condition of one field, e.g. "created_at>=2025-01-01", "gender=male"
+// or "grade_id in (1, 2)".
+func NewFieldsListFilter(
+	sortOrder string,
+	sort string,
+	filters []string,
+	fields ListFields,
+	pagination Pagination,
+) (ListFilter, error) {
+	list := NewListFilter(sortOrder, pagination)
+
+	var err error
+
+	if list.Sort, err = ParseSort(sort, fields); err != nil {
+		return ListFilter{}, err
+	}
+
+	// the cursor is the position in the list sorted by its default field only.
+	if list.Cursor != nil && len(list.Sort) > 0 {
+		return ListFilter{}, fmt.Errorf("%w: the list sorted by fields is paginated by pages", ErrInvalidCursor)
+	}
+
+	if len(filters) > ListFilterMaxConditions {
+		return ListFilter{}, fmt.Errorf("%w: more than %d conditions", ErrInvalidFilter, ListFilterMaxConditions)
+	}
+
+	for _, filter := range filters {
+		condition, err := ParseFieldCondition(filter, fields)
+		if err != nil {
+			return ListFilter{}, err
+		}
+
+		list.Conditions = append(list.Conditions, condition)
+	}
+
+	return list, nil
+}
+
+// ListFieldType is the type of the values of the list field.
+type ListFieldType int
+
+const (
+	// ListFieldString is the text field.
+	ListFieldString ListFieldType = iota
+	// ListFieldInt is the integer field.
+	ListFieldInt
+	// ListFieldTime is the date and time field, its values are RFC 3339 date times or dates.
+	ListFieldTime
+	// ListFieldUUID is the identifier field, it's compared for equality only.
+	ListFieldUUID
+)
+
+// ListFields are the fields the list is sorted and filtered by with the types of their values.
+type ListFields map[string]ListFieldType
+
+// SortField is the field the list is sorted by.
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// ParseSort parses the comma separated fields the list is sorted by, descending ones are prefixed with "-".
+func ParseSort(sort string, fields ListFields) ([]SortField, error) {
+	if strings.TrimSpace(sort) == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(sort, ",")
+	if len(parts) > ListSortMaxFields {
+		return nil, fmt.Errorf("%w: more than %d fields", ErrInvalidSort, ListSortMaxFields)
+	}
+
+	sortFields := make([]SortField, 0, len(parts))
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+
+		sortField := SortField{
+			Field: strings.TrimPrefix(part, "-"),
+			Desc:  strings.HasPrefix(part, "-"),
+		}
+
+		if _, ok := fields[sortField.Field]; !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidSort, sortField.Field)
+		}
+
+		if slices.ContainsFunc(sortFields, func(f SortField) bool { return f.Field == sortField.Field }) {
+			return nil, fmt.Errorf("%w: field %q is repeated", ErrInvalidSort, sortField.Field)
+		}
+
+		sortFields = append(sortFields, sortField)
+	}
+
+	return sortFields, nil
+}
+
+// FilterOperator is the comparison operator of the field condition.
+type FilterOperator string
+
+const (
+	// FilterEqual matches the values equal to the value.
+	FilterEqual FilterOperator = "="
+	// FilterNotEqual matches the values not equal to the value.
+	FilterNotEqual FilterOperator = "!="
+	// FilterGreater matches the values greater than the value.
+	FilterGreater FilterOperator = ">"
+	// FilterGreaterOrEqual matches the values greater than or equal to the value.
+	FilterGreaterOrEqual FilterOperator = ">="
+	// FilterLess matches the values less than the value.
+	FilterLess FilterOperator = "<"
+	// FilterLessOrEqual matches the values less than or equal to the value.
+	FilterLessOrEqual FilterOperator = "<="
+	// FilterIn matches the values equal to one of the values.
+	FilterIn FilterOperator = "in"
+)
+
+// comparisonOperators are the operators of the conditions with the only value,
+// the longer ones go first to be matched before their prefixes.
+//
+//nolint:gochecknoglobals // it's list of operators
+var comparisonOperators = []FilterOperator{
+	FilterGreaterOrEqual,
+	FilterLessOrEqual,
+	FilterNotEqual,
+	FilterEqual,
+	FilterGreater,
+	FilterLess,
+}
+
+// FieldCondition is the condition of the field of the list entities,
+// there is the only value unless the operator is FilterIn.
+type FieldCondition struct {
+	Field    string
+	Operator FilterOperator
+	Values   []any
+}
+
+// ParseFieldCondition parses the condition of the field, e.g. "created_at>=2025-01-01" or "grade_id in (1, 2)".
+func ParseFieldCondition(filter string, fields ListFields) (FieldCondition, error) {
+	filter = strings.TrimSpace(filter)
+
+	end := strings.IndexFunc(filter, func(r rune) bool {
+		return r != '_' && (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	})
+	if end <= 0 {
+		return FieldCondition{}, fmt.Errorf("%w: %q has no field or operator", ErrInvalidFilter, filter)
+	}
+
+	condition := FieldCondition{Field: filter[:end]}
+
+	fieldType, ok := fields[condition.Field]
+	if !ok {
+		return FieldCondition{}, fmt.Errorf("%w: unknown field %q", ErrInvalidFilter, condition.Field)
+	}
+
+	operator, values, err := parseFilterOperator(strings.TrimSpace(filter[end:]))
+	if err != nil {
+		return FieldCondition{}, fmt.Errorf("%w: field %q: %w", ErrInvalidFilter, condition.Field, err)
+	}
+
+	if fieldType == ListFieldUUID && !operator.In(FilterEqual, FilterNotEqual, FilterIn) {
+		return FieldCondition{}, fmt.Errorf(
+			"%w: field %q is not compared by %q", ErrInvalidFilter, condition.Field, operator,
+		)
+	}
+
+	condition.Operator = operator
+	condition.Values = make([]any, 0, len(values))
+
+	for _, value := range values {
+		parsed, err := fieldType.parse(value)
+		if err != nil {
+			return FieldCondition{}, fmt.Errorf("%w: field %q: %w", ErrInvalidFilter, condition.Field, err)
+		}
+
+		condition.Values = append(condition.Values, parsed)
+	}
+
+	return condition, nil
+}
+
+// parseFilterOperator parses the operator and the values of the condition after the field.
+func parseFilterOperator(expression string) (FilterOperator, []string, error) {
+	if list, ok := strings.CutPrefix(strings.ToLower(expression), string(FilterIn)); ok &&
+		strings.HasPrefix(strings.TrimSpace(list), "(") {
+		list = strings.TrimSpace(expression[len(FilterIn):])
+
+		if !strings.HasSuffix(list, ")") {
+			return "", nil, fmt.Errorf("values of %q are not enclosed in parentheses", FilterIn)
+		}
+
+		values := strings.Split(list[1:len(list)-1], ",")
+		if len(values) > ListFilterMaxValues {
+			return "", nil, fmt.Errorf("more than %d values", ListFilterMaxValues)
+		}
+
+		for i := range values {
+			values[i] = strings.TrimSpace(values[i])
+		}
+
+		return FilterIn, values, nil
+	}
+
+	for _, operator := range comparisonOperators {
+		if value, ok := strings.CutPrefix(expression, string(operator)); ok {
+			return operator, []string{strings.TrimSpace(value)}, nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("unknown operator of %q", expression)
+}
+
+// In checks whether operator is one of the given operators.
+func (o FilterOperator) In(operators ...FilterOperator) bool {
+	return slices.Contains(operators, o)
+}
+
+// parse parses the value of the field of the type.
+func (t ListFieldType) parse(value string) (any, error) {
+	if value == "" {
+		return nil, errors.New("empty value")
+	}
+
+	switch t {
+	case ListFieldInt:
+		return strconv.Atoi(value)
+	case ListFieldTime:
+		if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+			return parsed, nil
+		}
+
+		return time.ParseInLocation(time.DateOnly, value, time.UTC)
+	case ListFieldUUID:
+		return uuid.Parse(value)
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestParseSort(t *testing.T) {
+	fields := ListFields{"created_at": ListFieldTime, "last_name": ListFieldString, "group_id": ListFieldUUID}
+
+	tests := []struct {
+		name    string
+		sort    string
+		want    []SortField
+		wantErr error
+	}{
+		{name: "empty sort", sort: ""},
+		{
+			name: "ascending and descending fields",
+			sort: "last_name, -created_at",
+			want: []SortField{{Field: "last_name"}, {Field: "created_at", Desc: true}},
+		},
+		{name: "unknown field", sort: "password", wantErr: ErrInvalidSort},
+		{name: "repeated field", sort: "last_name,-last_name", wantErr: ErrInvalidSort},
+		{name: "too many fields", sort: "last_name,created_at,group_id,last_name", wantErr: ErrInvalidSort},
+		{name: "empty field", sort: "last_name,", wantErr: ErrInvalidSort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSort(tt.sort, fields)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseSort() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSort() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestParseFieldCondition(t *testing.T) {
+	var (
+		fields = ListFields{
+			"created_at":      ListFieldTime,
+			"gender":          ListFieldString,
+			"education_years": ListFieldInt,
+			"grade_id":        ListFieldUUID,
+		}
+
+		firstID  = uuid.New()
+		secondID = uuid.New()
+	)
+
+	tests := []struct {
+		name    string
+		filter  string
+		want    FieldCondition
+		wantErr error
+	}{
+		{
+			name:   "date greater or equal",
+			filter: "created_at>=2025-01-01",
+			want: FieldCondition{
+				Field:    "created_at",
+				Operator: FilterGreaterOrEqual,
+				Values:   []any{time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:   "date time less",
+			filter: "created_at < 2025-01-01T10:00:00Z",
+			want: FieldCondition{
+				Field:    "created_at",
+				Operator: FilterLess,
+				Values:   []any{time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:   "string equal",
+			filter: "gender=male",
+			want:   FieldCondition{Field: "gender", Operator: FilterEqual, Values: []any{"male"}},
+		},
+		{
+			name:   "int not equal",
+			filter: "education_years!=11",
+			want:   FieldCondition{Field: "education_years", Operator: FilterNotEqual, Values: []any{11}},
+		},
+		{
+			name:   "uuid in list",
+			filter: "grade_id in (" + firstID.String() + ", " + secondID.String() + ")",
+			want:   FieldCondition{Field: "grade_id", Operator: FilterIn, Values: []any{firstID, secondID}},
+		},
+		{name: "unknown field", filter: "password=secret", wantErr: ErrInvalidFilter},
+		{name: "no operator", filter: "gender", wantErr: ErrInvalidFilter},
+		{name: "no field", filter: "=male", wantErr: ErrInvalidFilter},
+		{name: "unknown operator", filter: "gender~male", wantErr: ErrInvalidFilter},
+		{name: "empty value", filter: "gender=", wantErr: ErrInvalidFilter},
+		{name: "invalid date", filter: "created_at>=yesterday", wantErr: ErrInvalidFilter},
+		{name: "invalid int", filter: "education_years=eleven", wantErr: ErrInvalidFilter},
+		{name: "uuid compared by order", filter: "grade_id>" + firstID.String(), wantErr: ErrInvalidFilter},
+		{name: "in list without parentheses", filter: "grade_id in (" + firstID.String(), wantErr: ErrInvalidFilter},
+		{name: "sql injection", filter: "gender=male' OR '1'='1", want: FieldCondition{
+			Field: "gender", Operator: FilterEqual, Values: []any{"male' OR '1'='1"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFieldCondition(tt.filter, fields)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseFieldCondition() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFieldCondition() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestNewFieldsListFilter(t *testing.T) {
+	fields := ListFields{"created_at": ListFieldTime, "gender": ListFieldString}
+
+	tests := []struct {
+		name       string
+		sort       string
+		filters    []string
+		pagination Pagination
+		wantErr    error
+	}{
+		{name: "sorted and filtered list", sort: "-created_at", filters: []string{"gender=male"}},
+		{name: "cursor of the list sorted by default", pagination: Pagination{Cursor: &Cursor{ID: uuid.New()}}},
+		{
+			name:       "cursor of the list sorted by fields",
+			sort:       "gender",
+			pagination: Pagination{Cursor: &Cursor{ID: uuid.New()}},
+			wantErr:    ErrInvalidCursor,
+		},
+		{
+			name: "too many conditions",
+			filters: []string{"gender=a", "gender=b", "gender=c", "gender=d", "gender=e", "gender=f",
+				"gender=g", "gender=h", "gender=i", "gender=j", "gender=k"},
+			wantErr: ErrInvalidFilter,
+		},
+		{name: "invalid sort", sort: "password", wantErr: ErrInvalidSort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFieldsListFilter(SortOrderDesc, tt.sort, tt.filters, fields, tt.pagination)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewFieldsListFilter() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr == nil && len(got.Conditions) != len(tt.filters) {
+				t.Errorf("NewFieldsListFilter() got %d conditions, want %d", len(got.Conditions), len(tt.filters))
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// loginCodeDigits is the number of digits of one-time login codes.
+const loginCodeDigits = 6
+
+// LoginCode is a short-lived one-time code sent to the phone of the user for logging in.
+// Only the hash of the code is stored and the code can be guessed only a limited number of times.
+type LoginCode struct {
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	Phone    string
+	CodeHash string
+	// Attempts is the number of times the code was tried.
+	Attempts  int
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+
+	CreatedAt time.Time
+}
+
+// NewLoginCode creates a new LoginCode domain and returns the code to be sent to the phone.
+func NewLoginCode(
+	userID uuid.UUID,
+	phone string,
+	ttl time.Duration,
+
+	nowFunc func() time.Time,
+) (loginCode LoginCode, code string, err error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(pow10(loginCodeDigits)))
+	if err != nil {
+		return LoginCode{}, "", fmt.Errorf("failed to generate login code: %w", err)
+	}
+
+	var (
+		now = nowFunc()
+		id  = uuid.New()
+	)
+
+	code = fmt.Sprintf("%0*d", loginCodeDigits, n.Int64())
+
+	return LoginCode{
+		ID:        id,
+		UserID:    userID,
+		Phone:     phone,
+		CodeHash:  hashLoginCode(id, code),
+		ExpiresAt: now.Add(ttl),
+
+		CreatedAt: now,
+	}, code, nil
+}
+
+// ResendAfter returns how long a new code must not be sent after this one, zero if it can be sent now.
+func (l LoginCode) ResendAfter(interval time.Duration, now time.Time) time.Duration {
+	next := l.CreatedAt.Add(interval)
+	if l.UsedAt != nil || !now.Before(next) {
+		return 0
+	}
+
+	return next.Sub(now)
+}
+
+// Verify checks the code and marks the login code as used if it is correct.
+// Every check is counted, the code is rejected after maxAttempts checks even if it is correct.
+func (l *LoginCode) Verify(code string, maxAttempts int, nowFunc func() time.Time) error {
+	now := nowFunc()
+
+	if l.UsedAt != nil || !now.Before(l.ExpiresAt) || l.Attempts >= maxAttempts {
+		return ErrInvalidLoginCode
+	}
+
+	l.Attempts++
+
+	if hashLoginCode(l.ID, code) != l.CodeHash {
+		return ErrInvalidLoginCode
+	}
+
+	l.UsedAt = &now
+
+	return nil
+}
+
+// hashLoginCode returns the hash of the code, the id is added because codes are short and often repeat.
+func hashLoginCode(id uuid.UUID, code string) string {
+	return HashUserToken(id.String() + ":" + code)
+}
+
+// pow10 returns 10 to the power of n.
+func pow10(n int) int64 {
+	result := int64(1)
+
+	for i := 0; i < n; i++ {
+		result *= 10
+	}
+
+	return result
+}
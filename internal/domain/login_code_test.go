@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestLoginCodeVerify(t *testing.T) {
+	var (
+		now     = time.Date(2025, 3, 31, 10, 0, 0, 0, time.UTC)
+		nowFunc = func() time.Time { return now }
+	)
+
+	loginCode, code, err := NewLoginCode(uuid.New(), "+992900000000", 5*time.Minute, nowFunc)
+	if err != nil {
+		t.Fatalf("NewLoginCode() error = %v", err)
+	}
+
+	if len(code) != loginCodeDigits {
+		t.Fatalf("expected a code of %d digits, got %q", loginCodeDigits, code)
+	}
+
+	wrongCode := "000000"
+	if code == wrongCode {
+		wrongCode = "111111"
+	}
+
+	tests := []struct {
+		name         string
+		loginCode    func() LoginCode
+		code         string
+		wantErr      error
+		wantAttempts int
+	}{
+		{
+			name:         "correct code",
+			loginCode:    func() LoginCode { return loginCode },
+			code:         code,
+			wantAttempts: 1,
+		},
+		{
+			name:         "wrong code",
+			loginCode:    func() LoginCode { return loginCode },
+			code:         wrongCode,
+			wantErr:      ErrInvalidLoginCode,
+			wantAttempts: 1,
+		},
+		{
+			name: "attempts exceeded",
+			loginCode: func() LoginCode {
+				c := loginCode
+				c.Attempts = 3
+
+				return c
+			},
+			code:         code,
+			wantErr:      ErrInvalidLoginCode,
+			wantAttempts: 3,
+		},
+		{
+			name: "expired code",
+			loginCode: func() LoginCode {
+				c := loginCode
+				c.ExpiresAt = now
+
+				return c
+			},
+			code:    code,
+			wantErr: ErrInvalidLoginCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.loginCode()
+
+			err := c.Verify(tt.code, 3, nowFunc)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if c.Attempts != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, c.Attempts)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if err = c.Verify(tt.code, 3, nowFunc); !errors.Is(err, ErrInvalidLoginCode) {
+				t.Errorf("expected the code to be single-use, got %v", err)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestLoginCodeResendAfter(t *testing.T) {
+	var (
+		now     = time.Date(2025, 3, 31, 10, 0, 0, 0, time.UTC)
+		nowFunc = func() time.Time { return now }
+	)
+
+	loginCode, _, err := NewLoginCode(uuid.New(), "+992900000000", 5*time.Minute, nowFunc)
+	if err != nil {
+		t.Fatalf("NewLoginCode() error = %v", err)
+	}
+
+	if got := loginCode.ResendAfter(time.Minute, now.Add(20*time.Second)); got != 40*time.Second {
+		t.Errorf("ResendAfter() = %v, want %v", got, 40*time.Second)
+	}
+
+	if got := loginCode.ResendAfter(time.Minute, now.Add(time.Minute)); got != 0 {
+		t.Errorf("ResendAfter() = %v, want 0", got)
+	}
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginThrottlePolicy is a policy of delaying and locking logins after failed attempts.
+type LoginThrottlePolicy struct {
+	// DelayAfter is number of failures after which every next attempt is delayed,
+	// the delay starts from BaseDelay and doubles with every failure up to MaxDelay.
+	DelayAfter int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	// LockoutAfter is number of failures after which logins are locked for LockoutDuration.
+	LockoutAfter    int
+	LockoutDuration time.Duration
+	// Window is the time after the last failure the failures are forgotten.
+	Window time.Duration
+}
+
+// delay returns the time the next attempt is delayed for after the failures.
+func (p LoginThrottlePolicy) delay(failures int) time.Duration {
+	if p.DelayAfter <= 0 || failures < p.DelayAfter || p.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := p.BaseDelay
+
+	for i := p.DelayAfter; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	return delay
+}
+
+// LoginFailures is a counter of failed logins of an account or an IP address.
+type LoginFailures struct {
+	Key          string
+	Failures     int
+	LastFailedAt time.Time
+	LockedUntil  *time.Time
+}
+
+// AccountLoginFailuresKey returns the key failed logins to the account with the email are counted by.
+func AccountLoginFailuresKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// PhoneLoginFailuresKey returns the key failed logins by one-time codes sent to the phone are counted by.
+func PhoneLoginFailuresKey(phone string) string {
+	return "phone:" + strings.TrimSpace(phone)
+}
+
+// IPLoginFailuresKey returns the key failed logins from the IP address are counted by.
+func IPLoginFailuresKey(ip string) string {
+	return "ip:" + ip
+}
+
+// NewLoginFailures creates a new LoginFailures domain without failures.
+func NewLoginFailures(key string) LoginFailures {
+	return LoginFailures{
+		Key: key,
+	}
+}
+
+// RetryAfter returns how long logins are not allowed by the policy, zero if a login is allowed now.
+func (l LoginFailures) RetryAfter(policy LoginThrottlePolicy, now time.Time) time.Duration {
+	if l.LockedUntil != nil && now.Before(*l.LockedUntil) {
+		return l.LockedUntil.Sub(now)
+	}
+
+	if l.forgotten(policy, now) {
+		return 0
+	}
+
+	next := l.LastFailedAt.Add(policy.delay(l.Failures))
+	if now.Before(next) {
+		return next.Sub(now)
+	}
+
+	return 0
+}
+
+// Fail counts a failed login and locks logins when the policy limit is reached.
+func (l *LoginFailures) Fail(policy LoginThrottlePolicy, now time.Time) {
+	if l.forgotten(policy, now) || (l.LockedUntil != nil && !now.Before(*l.LockedUntil)) {
+		l.Failures = 0
+		l.LockedUntil = nil
+	}
+
+	l.Failures++
+	l.LastFailedAt = now
+
+	if policy.LockoutAfter > 0 && l.Failures >= policy.LockoutAfter {
+		lockedUntil := now.Add(policy.LockoutDuration)
+		l.LockedUntil = &lockedUntil
+	}
+}
+
+// forgotten reports whether the last failure is older than the policy window.
+func (l LoginFailures) forgotten(policy LoginThrottlePolicy, now time.Time) bool {
+	return l.Failures == 0 || (policy.Window > 0 && now.Sub(l.LastFailedAt) > policy.Window)
+}
+
+// LoginFailureReason is a reason a login attempt failed.
+type LoginFailureReason string
+
+const (
+	// LoginFailureInvalidCredentials is a login with unknown email or wrong password.
+	LoginFailureInvalidCredentials LoginFailureReason = "invalid_credentials"
+	// LoginFailureLocked is a login rejected because of too many failed attempts.
+	LoginFailureLocked LoginFailureReason = "locked"
+)
+
+// FailedLoginAttempt is an audit record of a failed login.
+type FailedLoginAttempt struct {
+	ID    uuid.UUID
+	Email string
+	// UserID is empty if there is no user with the email.
+	UserID    *uuid.UUID
+	IP        string
+	UserAgent string
+	Reason    LoginFailureReason
+
+	CreatedAt time.Time
+}
+
+// NewFailedLoginAttempt creates a new FailedLoginAttempt domain.
+func NewFailedLoginAttempt(
+	email string,
+	userID *uuid.UUID,
+	ip string,
+	userAgent string,
+	reason LoginFailureReason,
+
+	nowFunc func() time.Time,
+) FailedLoginAttempt {
+	return FailedLoginAttempt{
+		ID:        uuid.New(),
+		Email:     email,
+		UserID:    userID,
+		IP:        ip,
+		UserAgent: userAgent,
+		Reason:    reason,
+
+		CreatedAt: nowFunc(),
+	}
+}
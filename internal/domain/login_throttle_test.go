@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestLoginFailuresRetryAfter(t *testing.T) {
+	var (
+		start  = time.Date(2025, 3, 30, 10, 0, 0, 0, time.UTC)
+		policy = LoginThrottlePolicy{
+			DelayAfter:      2,
+			BaseDelay:       time.Second,
+			MaxDelay:        4 * time.Second,
+			LockoutAfter:    5,
+			LockoutDuration: 10 * time.Minute,
+			Window:          time.Hour,
+		}
+	)
+
+	tests := []struct {
+		name      string
+		failures  int
+		after     time.Duration
+		wantRetry time.Duration
+	}{
+		{name: "no failures", failures: 0, wantRetry: 0},
+		{name: "below delay", failures: 1, wantRetry: 0},
+		{name: "first delay", failures: 2, wantRetry: time.Second},
+		{name: "doubled delay", failures: 3, wantRetry: 2 * time.Second},
+		{name: "max delay", failures: 4, after: time.Second, wantRetry: 3 * time.Second},
+		{name: "delay passed", failures: 4, after: 5 * time.Second, wantRetry: 0},
+		{name: "locked", failures: 5, after: time.Minute, wantRetry: 9 * time.Minute},
+		{name: "lockout passed", failures: 5, after: 10 * time.Minute, wantRetry: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failures := NewLoginFailures(AccountLoginFailuresKey("User@Example.com "))
+
+			for i := 0; i < tt.failures; i++ {
+				failures.Fail(policy, start)
+			}
+
+			if got := failures.RetryAfter(policy, start.Add(tt.after)); got != tt.wantRetry {
+				t.Errorf("RetryAfter() = %v, want %v", got, tt.wantRetry)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestLoginFailuresFail(t *testing.T) {
+	var (
+		start  = time.Date(2025, 3, 30, 10, 0, 0, 0, time.UTC)
+		policy = LoginThrottlePolicy{
+			LockoutAfter:    2,
+			LockoutDuration: 10 * time.Minute,
+			Window:          time.Hour,
+		}
+	)
+
+	failures := NewLoginFailures(IPLoginFailuresKey("127.0.0.1"))
+
+	failures.Fail(policy, start)
+	failures.Fail(policy, start)
+
+	if failures.LockedUntil == nil || !failures.LockedUntil.Equal(start.Add(10*time.Minute)) {
+		t.Fatalf("expected logins to be locked until %v, got %v", start.Add(10*time.Minute), failures.LockedUntil)
+	}
+
+	failures.Fail(policy, start.Add(11*time.Minute))
+
+	if failures.Failures != 1 || failures.LockedUntil != nil {
+		t.Errorf("expected failures to restart after the lockout, got %d locked until %v",
+			failures.Failures, failures.LockedUntil)
+	}
+
+	failures.Fail(policy, start.Add(3*time.Hour))
+
+	if failures.Failures != 1 {
+		t.Errorf("expected failures out of the window to be forgotten, got %d", failures.Failures)
+	}
+}
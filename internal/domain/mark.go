@@ -12,7 +12,10 @@ type Mark struct {
 	LessonID    uuid.UUID
 	StudentID   uuid.UUID
 	Mark        string
+	Weight      float64
 	Description *string
+	// TermID is term of the mark lesson.
+	TermID *uuid.UUID
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -24,6 +27,7 @@ func NewMark(
 	lessonID uuid.UUID,
 	studentID uuid.UUID,
 	mark string,
+	weight float64,
 	description *string,
 
 	nowFunc func() time.Time,
@@ -35,6 +39,7 @@ func NewMark(
 		LessonID:    lessonID,
 		StudentID:   studentID,
 		Mark:        mark,
+		Weight:      weight,
 		Description: description,
 
 		CreatedAt: now,
@@ -42,5 +47,28 @@ func NewMark(
 	}
 }
 
+// Update updates mark, the weight must be of the mark by the grading scale of the lesson school.
+func (m *Mark) Update(mark string, weight float64, description *string, nowFunc func() time.Time) {
+	m.Mark = mark
+	m.Weight = weight
+	m.Description = description
+
+	m.UpdatedAt = nowFunc()
+}
+
 // Marks is slice of mark.
 type Marks []Mark
+
+// Average returns average weight of the marks, zero if there are no marks.
+func (m Marks) Average() float64 {
+	if len(m) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, mark := range m {
+		sum += mark.Weight
+	}
+
+	return sum / float64(len(m))
+}
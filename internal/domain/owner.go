@@ -93,3 +93,15 @@ func (o Owners) SetUsers(users Users) {
 		o[index].SetUser(mapOfUsers[o[index].UserID])
 	}
 }
+
+// OwnerListFields returns the fields the list of owners is sorted and filtered by.
+func OwnerListFields() ListFields {
+	return ListFields{
+		"created_at":      ListFieldTime,
+		"updated_at":      ListFieldTime,
+		"organization_id": ListFieldUUID,
+		"user_id":         ListFieldUUID,
+		"email":           ListFieldString,
+		"phone":           ListFieldString,
+	}
+}
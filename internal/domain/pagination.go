@@ -1,9 +1,20 @@
 package domain
 
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
 // Pagination is structure of pagination.
 type Pagination struct {
 	Limit  int
 	Offset int
+
+	// Cursor is the position in the list the page starts from, Offset is ignored if it's set.
+	Cursor *Cursor
 }
 
 const (
@@ -20,3 +31,119 @@ func NewPagination(page, perPage int) Pagination {
 		Offset: (page - 1) * perPage,
 	}
 }
+
+// NewCursorPagination creates a new Pagination domain by the cursor, by the page if the cursor is empty.
+func NewCursorPagination(cursor string, page, perPage int) (Pagination, error) {
+	pagination := NewPagination(page, perPage)
+
+	if cursor == "" {
+		return pagination, nil
+	}
+
+	parsed, err := ParseCursor(cursor)
+	if err != nil {
+		return Pagination{}, err
+	}
+
+	pagination.Offset = 0
+	pagination.Cursor = &parsed
+
+	return pagination, nil
+}
+
+// Cursor is the position in the list sorted by the key and then by the id,
+// it's the key and the id of the edge entity of the adjacent page.
+type Cursor struct {
+	Key time.Time `json:"k"`
+	ID  uuid.UUID `json:"id"`
+	// Before is true if the page is before the position, i.e. it's the previous page.
+	Before bool `json:"b,omitempty"`
+}
+
+// ParseCursor parses the opaque cursor of the list response.
+func ParseCursor(cursor string) (Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var parsed Cursor
+	if err = json.Unmarshal(data, &parsed); err != nil || parsed.ID == uuid.Nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return parsed, nil
+}
+
+// String returns the opaque cursor for the list response.
+func (c Cursor) String() string {
+	data, _ := json.Marshal(c) //nolint:errchkjson // it's always marshalled
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// CursorPage is the page of the list with the cursors of the adjacent pages, nil if there is no such page.
+type CursorPage[T any] struct {
+	List []T
+	Next *Cursor
+	Prev *Cursor
+}
+
+// NewCursorPage creates a new CursorPage of the list fetched with one extra entity beyond the limit,
+// so it's known whether there are more entities. The list fetched before the cursor is in the list order too.
+// The list sorted by fields has no cursors, it's paginated by pages only.
+func NewCursorPage[T any](list []T, filter ListFilter, cursor func(T) Cursor) CursorPage[T] {
+	pagination := filter.Pagination
+
+	if len(filter.Sort) > 0 {
+		if len(list) > pagination.Limit {
+			list = list[:pagination.Limit]
+		}
+
+		return CursorPage[T]{List: list}
+	}
+
+	var (
+		before      = pagination.Cursor != nil && pagination.Cursor.Before
+		after       = pagination.Cursor != nil && !pagination.Cursor.Before
+		afterOffset = pagination.Cursor == nil && pagination.Offset > 0
+		hasMore     = len(list) > pagination.Limit
+	)
+
+	if hasMore && before {
+		list = list[len(list)-pagination.Limit:]
+	} else if hasMore {
+		list = list[:pagination.Limit]
+	}
+
+	page := CursorPage[T]{List: list}
+
+	if len(list) == 0 {
+		// The position of the cursor is still the edge of the adjacent page.
+		if pagination.Cursor != nil {
+			edge := *pagination.Cursor
+			edge.Before = !edge.Before
+
+			if before {
+				page.Next = &edge
+			} else {
+				page.Prev = &edge
+			}
+		}
+
+		return page
+	}
+
+	if hasMore || before {
+		next := cursor(list[len(list)-1])
+		page.Next = &next
+	}
+
+	if (hasMore && before) || after || afterOffset {
+		prev := cursor(list[0])
+		prev.Before = true
+		page.Prev = &prev
+	}
+
+	return page
+}
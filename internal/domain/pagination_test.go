@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestParseCursor(t *testing.T) {
+	cursor := Cursor{Key: time.Date(2025, 4, 3, 9, 0, 0, 123456000, time.UTC), ID: uuid.New(), Before: true}
+
+	tests := []struct {
+		name    string
+		cursor  string
+		want    Cursor
+		wantErr error
+	}{
+		{name: "cursor of the list response", cursor: cursor.String(), want: cursor},
+		{name: "not encoded cursor", cursor: "cursor", wantErr: ErrInvalidCursor},
+		{name: "cursor without id", cursor: Cursor{Key: cursor.Key}.String(), wantErr: ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCursor(tt.cursor)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseCursor() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+
+			if !got.Key.Equal(tt.want.Key) || got.ID != tt.want.ID || got.Before != tt.want.Before {
+				t.Errorf("ParseCursor() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestNewCursorPage(t *testing.T) {
+	cursors := make([]Cursor, 0, 5)
+	for i := range 5 {
+		cursors = append(cursors, Cursor{Key: time.Date(2025, 4, 3, 9, i, 0, 0, time.UTC), ID: uuid.New()})
+	}
+
+	before := func(cursor Cursor) *Cursor {
+		cursor.Before = true
+		return &cursor
+	}
+
+	tests := []struct {
+		name   string
+		list   []Cursor
+		filter ListFilter
+		want   CursorPage[Cursor]
+	}{
+		{
+			name:   "first page with more entities",
+			list:   cursors[:3],
+			filter: ListFilter{Pagination: Pagination{Limit: 2}},
+			want:   CursorPage[Cursor]{List: cursors[:2], Next: &cursors[1]},
+		},
+		{
+			name:   "last page by offset",
+			list:   cursors[2:4],
+			filter: ListFilter{Pagination: Pagination{Limit: 2, Offset: 2}},
+			want:   CursorPage[Cursor]{List: cursors[2:4], Prev: before(cursors[2])},
+		},
+		{
+			name:   "page after cursor with more entities",
+			list:   cursors[2:5],
+			filter: ListFilter{Pagination: Pagination{Limit: 2, Cursor: &cursors[1]}},
+			want:   CursorPage[Cursor]{List: cursors[2:4], Next: &cursors[3], Prev: before(cursors[2])},
+		},
+		{
+			name:   "page before cursor with more entities",
+			list:   cursors[:3],
+			filter: ListFilter{Pagination: Pagination{Limit: 2, Cursor: before(cursors[3])}},
+			want:   CursorPage[Cursor]{List: cursors[1:3], Next: &cursors[2], Prev: before(cursors[1])},
+		},
+		{
+			name:   "first page before cursor",
+			list:   cursors[:2],
+			filter: ListFilter{Pagination: Pagination{Limit: 2, Cursor: before(cursors[2])}},
+			want:   CursorPage[Cursor]{List: cursors[:2], Next: &cursors[1]},
+		},
+		{
+			name:   "empty page after cursor",
+			list:   []Cursor{},
+			filter: ListFilter{Pagination: Pagination{Limit: 2, Cursor: &cursors[4]}},
+			want:   CursorPage[Cursor]{List: []Cursor{}, Prev: before(cursors[4])},
+		},
+		{
+			name:   "page of the list sorted by fields",
+			list:   cursors[:3],
+			filter: ListFilter{Pagination: Pagination{Limit: 2}, Sort: []SortField{{Field: "name"}}},
+			want:   CursorPage[Cursor]{List: cursors[:2]},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewCursorPage(tt.list, tt.filter, func(cursor Cursor) Cursor { return cursor })
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewCursorPage() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
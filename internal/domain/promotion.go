@@ -0,0 +1,255 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StudentPromotionStatus is result of the year end promotion for a student.
+type StudentPromotionStatus string
+
+const (
+	Promoted  StudentPromotionStatus = "promoted"  // Promoted is a status of student moved to the next grade.
+	HeldBack  StudentPromotionStatus = "held_back" // HeldBack is a status of student repeating the grade.
+	Graduated StudentPromotionStatus = "graduated" // Graduated is a status of student finished the school.
+)
+
+// String returns string representation of StudentPromotionStatus.
+func (s StudentPromotionStatus) String() string {
+	return string(s)
+}
+
+// StudentPromotion is a record of the student promotion, it keeps the group the student studied in.
+type StudentPromotion struct {
+	ID             uuid.UUID
+	StudentID      uuid.UUID
+	FromGroupID    uuid.UUID
+	ToGroupID      *uuid.UUID
+	AcademicYearID uuid.UUID
+	Status         StudentPromotionStatus
+
+	CreatedAt time.Time
+}
+
+// StudentPromotions is list of StudentPromotion.
+type StudentPromotions []StudentPromotion
+
+// GroupPromotion is a promotion of the group to the next grade of the grade standard.
+type GroupPromotion struct {
+	FromGroup Group
+	// ToGroup is the next year group, nil when the group graduates.
+	ToGroup *Group
+	// HeldBackGroup is the next year group of the same grade created for held back students
+	// when no group is promoted into it.
+	HeldBackGroup *Group
+	Students      StudentPromotions
+}
+
+// GroupPromotions is list of GroupPromotion.
+type GroupPromotions []GroupPromotion
+
+// gradeGroup identifies a group of the academic year by its grade and name.
+type gradeGroup struct {
+	gradeID uuid.UUID
+	name    string
+}
+
+// NewGroupPromotions promotes groups to the next grade of the academic year.
+// New groups keep the name, the class teacher and the class presidents who are promoted.
+// Groups of the last grade graduate. Held back students move to the next year group of the same grade
+// and name, which is created when no group is promoted into it. Graduating students leave the school
+// even if their group is promoted. Held back and graduating students must study in the promoted groups.
+func NewGroupPromotions(
+	groups Groups,
+	grades Grades,
+	students Students,
+	academicYearID uuid.UUID,
+	heldBack []uuid.UUID,
+	graduating []uuid.UUID,
+
+	nowFunc func() time.Time,
+) (GroupPromotions, error) {
+	var (
+		now           = nowFunc()
+		heldBackSet   = uuidSet(heldBack)
+		graduatingSet = uuidSet(graduating)
+		promotions    = make(GroupPromotions, 0, len(groups))
+		// nextYearGroups are ids of the next year groups by grade and name.
+		nextYearGroups = make(map[gradeGroup]uuid.UUID, len(groups))
+	)
+
+	for _, ids := range [][]uuid.UUID{heldBack, graduating} {
+		for _, id := range ids {
+			if !students.contains(id) {
+				return nil, ErrPromotionStudentNotFound
+			}
+		}
+	}
+
+	for _, group := range groups {
+		next, ok, err := grades.NextGrade(group.GradeID)
+		if err != nil {
+			return nil, err
+		}
+
+		promotion := GroupPromotion{FromGroup: group, Students: StudentPromotions{}}
+
+		if ok {
+			toGroup := NewGroup(group.SchoolID, group.Name, next.ID, &academicYearID, nowFunc)
+			toGroup.PreviousGroupID = &group.ID
+			toGroup.ClassTeacherID = group.ClassTeacherID
+
+			promotion.ToGroup = &toGroup
+			nextYearGroups[gradeGroup{gradeID: toGroup.GradeID, name: toGroup.Name}] = toGroup.ID
+		}
+
+		promotions = append(promotions, promotion)
+	}
+
+	for i := range promotions {
+		promotion := &promotions[i]
+		group := promotion.FromGroup
+
+		for _, student := range students {
+			if student.GroupID != group.ID {
+				continue
+			}
+
+			studentPromotion := StudentPromotion{
+				ID:             uuid.New(),
+				StudentID:      student.ID,
+				FromGroupID:    group.ID,
+				AcademicYearID: academicYearID,
+				Status:         Promoted,
+				CreatedAt:      now,
+			}
+
+			_, isHeldBack := heldBackSet[student.ID]
+			_, isGraduating := graduatingSet[student.ID]
+
+			switch {
+			case isHeldBack:
+				key := gradeGroup{gradeID: group.GradeID, name: group.Name}
+
+				if _, ok := nextYearGroups[key]; !ok {
+					heldBackGroup := NewGroup(group.SchoolID, group.Name, group.GradeID, &academicYearID, nowFunc)
+
+					promotion.HeldBackGroup = &heldBackGroup
+					nextYearGroups[key] = heldBackGroup.ID
+				}
+
+				toGroupID := nextYearGroups[key]
+
+				studentPromotion.Status = HeldBack
+				studentPromotion.ToGroupID = &toGroupID
+			case isGraduating || promotion.ToGroup == nil:
+				studentPromotion.Status = Graduated
+			default:
+				studentPromotion.ToGroupID = &promotion.ToGroup.ID
+			}
+
+			promotion.Students = append(promotion.Students, studentPromotion)
+		}
+
+		if promotion.ToGroup != nil {
+			promotion.ToGroup.ClassPresidentID = promotion.Students.promoted(group.ClassPresidentID)
+			promotion.ToGroup.DeputyClassPresidentID = promotion.Students.promoted(group.DeputyClassPresidentID)
+		}
+	}
+
+	return promotions, nil
+}
+
+// NewGroups returns next year groups of the promotions.
+func (g GroupPromotions) NewGroups() Groups {
+	groups := make(Groups, 0, len(g))
+
+	for _, promotion := range g {
+		if promotion.ToGroup != nil {
+			groups = append(groups, *promotion.ToGroup)
+		}
+
+		if promotion.HeldBackGroup != nil {
+			groups = append(groups, *promotion.HeldBackGroup)
+		}
+	}
+
+	return groups
+}
+
+// StudentPromotions returns promotions of all the students.
+func (g GroupPromotions) StudentPromotions() StudentPromotions {
+	list := make(StudentPromotions, 0)
+
+	for _, promotion := range g {
+		list = append(list, promotion.Students...)
+	}
+
+	return list
+}
+
+// promoted returns the student id when the student is moved to the next year group.
+func (s StudentPromotions) promoted(studentID *uuid.UUID) *uuid.UUID {
+	if studentID == nil {
+		return nil
+	}
+
+	for _, promotion := range s {
+		if promotion.StudentID == *studentID && promotion.Status == Promoted {
+			return studentID
+		}
+	}
+
+	return nil
+}
+
+// NextGrade returns grade of the next education year, false when the grade is the last one.
+func (g Grades) NextGrade(gradeID uuid.UUID) (Grade, bool, error) {
+	var current *Grade
+
+	for i := range g {
+		if g[i].ID == gradeID {
+			current = &g[i]
+			break
+		}
+	}
+
+	if current == nil {
+		return Grade{}, false, ErrGradeNotFound
+	}
+
+	if current.EducationYear == nil {
+		return Grade{}, false, ErrGradeHasNoEducationYear
+	}
+
+	for _, grade := range g {
+		if grade.EducationYear != nil && *grade.EducationYear == *current.EducationYear+1 {
+			return grade, true, nil
+		}
+	}
+
+	return Grade{}, false, nil
+}
+
+// contains checks whether the student is in the list.
+func (s Students) contains(studentID uuid.UUID) bool {
+	for _, student := range s {
+		if student.ID == studentID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// uuidSet converts list of ids into set.
+func uuidSet(ids []uuid.UUID) map[uuid.UUID]struct{} {
+	set := make(map[uuid.UUID]struct{}, len(ids))
+
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+
+	return set
+}
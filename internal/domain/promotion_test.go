@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestNewGroupPromotions(t *testing.T) {
+	var (
+		now     = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+		nowFunc = func() time.Time { return now }
+		year    = func(y int8) *int8 { return &y }
+
+		schoolID       = uuid.New()
+		academicYearID = uuid.New()
+		teacherID      = uuid.New()
+
+		grades = Grades{
+			{ID: uuid.New(), EducationYear: year(10)},
+			{ID: uuid.New(), EducationYear: year(11)},
+			{ID: uuid.New(), EducationYear: year(9)},
+		}
+
+		group9  = NewGroup(schoolID, "А", grades[2].ID, nil, nowFunc)
+		group10 = NewGroup(schoolID, "А", grades[0].ID, nil, nowFunc)
+		group11 = NewGroup(schoolID, "Б", grades[1].ID, nil, nowFunc)
+
+		promoted      = Student{ID: uuid.New(), GroupID: group10.ID}
+		heldBack      = Student{ID: uuid.New(), GroupID: group10.ID}
+		graduating    = Student{ID: uuid.New(), GroupID: group10.ID}
+		lastGrade     = Student{ID: uuid.New(), GroupID: group11.ID}
+		heldBackLast  = Student{ID: uuid.New(), GroupID: group11.ID}
+		promotedNinth = Student{ID: uuid.New(), GroupID: group9.ID}
+	)
+
+	group10.ClassTeacherID = &teacherID
+	group10.ClassPresidentID = &heldBack.ID
+	group10.DeputyClassPresidentID = &promoted.ID
+
+	students := Students{promoted, heldBack, graduating, lastGrade, heldBackLast, promotedNinth}
+
+	promotions, err := NewGroupPromotions(
+		Groups{group10, group11, group9},
+		grades,
+		students,
+		academicYearID,
+		[]uuid.UUID{heldBack.ID, heldBackLast.ID},
+		[]uuid.UUID{graduating.ID},
+		nowFunc,
+	)
+	if err != nil {
+		t.Fatalf("NewGroupPromotions() error = %v", err)
+	}
+
+	if len(promotions) != 3 {
+		t.Fatalf("expected 3 promotions, got %d", len(promotions))
+	}
+
+	toGroup := promotions[0].ToGroup
+	if toGroup == nil {
+		t.Fatalf("expected the 10th grade group to be promoted")
+	}
+
+	if toGroup.GradeID != grades[1].ID || toGroup.Name != group10.Name ||
+		*toGroup.AcademicYearID != academicYearID || *toGroup.PreviousGroupID != group10.ID {
+		t.Errorf("unexpected promoted group: %+v", toGroup)
+	}
+
+	if toGroup.ClassTeacherID == nil || *toGroup.ClassTeacherID != teacherID {
+		t.Errorf("expected the class teacher to be kept")
+	}
+
+	if toGroup.ClassPresidentID != nil {
+		t.Errorf("expected held back class president to be dropped")
+	}
+
+	if toGroup.DeputyClassPresidentID == nil || *toGroup.DeputyClassPresidentID != promoted.ID {
+		t.Errorf("expected promoted deputy class president to be kept")
+	}
+
+	heldBackGroup := promotions[1].HeldBackGroup
+	if heldBackGroup == nil || heldBackGroup.GradeID != grades[1].ID || heldBackGroup.Name != group11.Name {
+		t.Fatalf("expected the 11th grade group to be created for held back students, got %+v", heldBackGroup)
+	}
+
+	want := map[uuid.UUID]struct {
+		status  StudentPromotionStatus
+		toGroup *uuid.UUID
+	}{
+		promoted.ID:      {Promoted, &toGroup.ID},
+		heldBack.ID:      {HeldBack, &promotions[2].ToGroup.ID},
+		graduating.ID:    {Graduated, nil},
+		lastGrade.ID:     {Graduated, nil},
+		heldBackLast.ID:  {HeldBack, &heldBackGroup.ID},
+		promotedNinth.ID: {Promoted, &promotions[2].ToGroup.ID},
+	}
+
+	for _, promotion := range promotions.StudentPromotions() {
+		w := want[promotion.StudentID]
+
+		if promotion.Status != w.status {
+			t.Errorf("student %s status = %s, want %s", promotion.StudentID, promotion.Status, w.status)
+		}
+
+		if (w.toGroup == nil) != (promotion.ToGroupID == nil) || w.toGroup != nil && *w.toGroup != *promotion.ToGroupID {
+			t.Errorf("student %s has unexpected group %v", promotion.StudentID, promotion.ToGroupID)
+		}
+	}
+
+	if promotions[1].ToGroup != nil {
+		t.Errorf("expected the last grade group to graduate")
+	}
+
+	if len(promotions.NewGroups()) != 3 {
+		t.Errorf("expected 3 new groups, got %d", len(promotions.NewGroups()))
+	}
+
+	_, err = NewGroupPromotions(
+		Groups{group10}, grades, students, academicYearID, []uuid.UUID{uuid.New()}, nil, nowFunc,
+	)
+	if !errors.Is(err, ErrPromotionStudentNotFound) {
+		t.Errorf("NewGroupPromotions() error = %v, want %v", err, ErrPromotionStudentNotFound)
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestGradesNextGrade(t *testing.T) {
+	grades := Grades{{ID: uuid.New()}}
+
+	if _, _, err := grades.NextGrade(uuid.New()); !errors.Is(err, ErrGradeNotFound) {
+		t.Errorf("NextGrade() error = %v, want %v", err, ErrGradeNotFound)
+	}
+
+	if _, _, err := grades.NextGrade(grades[0].ID); !errors.Is(err, ErrGradeHasNoEducationYear) {
+		t.Errorf("NextGrade() error = %v, want %v", err, ErrGradeHasNoEducationYear)
+	}
+}
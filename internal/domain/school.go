@@ -112,15 +112,24 @@ type SchoolFilters struct {
 	Emails          []string
 	Phones          []string
 	OrganizationIDs []uuid.UUID
+
+	// Scope is the schools the list is limited to.
+	Scope SchoolScope
 }
 
-// NewSchoolFilters creates a new SchoolFilters domain.
-func NewSchoolFilters(filter ListFilter, emails, phones []string, organizationIDs []uuid.UUID) SchoolFilters {
+// NewSchoolFilters creates a new SchoolFilters domain limited to the schools of the scope.
+func NewSchoolFilters(
+	filter ListFilter,
+	emails, phones []string,
+	organizationIDs []uuid.UUID,
+	scope SchoolScope,
+) SchoolFilters {
 	return SchoolFilters{
 		ListFilter:      filter,
 		Emails:          emails,
 		Phones:          phones,
 		OrganizationIDs: organizationIDs,
+		Scope:           scope,
 	}
 }
 
@@ -180,6 +189,15 @@ func NewSchoolSubject(
 	}
 }
 
+// Update updates school subject.
+func (s *SchoolSubject) Update(subjectID uuid.UUID, name string, description *string, nowFunc func() time.Time) {
+	s.SubjectID = subjectID
+	s.Name = name
+	s.Description = description
+
+	s.UpdatedAt = nowFunc()
+}
+
 // SchoolSubjects is a collection of SchoolSubject.
 type SchoolSubjects []SchoolSubject
 
@@ -206,6 +224,7 @@ type StudyPlan struct {
 	Description    *string
 	PlanOrder      int16
 	Status         StudyPlanStatus
+	TermID         *uuid.UUID
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -243,6 +262,7 @@ func NewStudyPlan(
 	title string,
 	desc *string,
 	order int16,
+	termID *uuid.UUID,
 	nowFunc func() time.Time,
 ) StudyPlan {
 	now := nowFunc()
@@ -254,6 +274,7 @@ func NewStudyPlan(
 		Description:    desc,
 		PlanOrder:      order,
 		Status:         Planned, // default status is planned
+		TermID:         termID,
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
@@ -267,3 +288,26 @@ func NewStudyPlan(
 
 // StudyPlans is a collection of StudyPlan.
 type StudyPlans []StudyPlan
+
+// SchoolListFields returns the fields the list of schools is sorted and filtered by.
+func SchoolListFields() ListFields {
+	return ListFields{
+		"created_at":        ListFieldTime,
+		"updated_at":        ListFieldTime,
+		"name":              ListFieldString,
+		"organization_id":   ListFieldUUID,
+		"grade_standard_id": ListFieldUUID,
+		"email":             ListFieldString,
+		"phone":             ListFieldString,
+	}
+}
+
+// SchoolSubjectListFields returns the fields the list of school subjects is sorted and filtered by.
+func SchoolSubjectListFields() ListFields {
+	return ListFields{
+		"created_at": ListFieldTime,
+		"updated_at": ListFieldTime,
+		"name":       ListFieldString,
+		"subject_id": ListFieldUUID,
+	}
+}
@@ -0,0 +1,617 @@
+package domain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// SchoolSetupMaxSize is the maximum size of the school setup file in bytes.
+	SchoolSetupMaxSize = 5 << 20
+	// SchoolSetupMaxEntries is the maximum number of the entries of the section of the school setup.
+	SchoolSetupMaxEntries = 5000
+
+	// schoolSubjectNameMaxLength is the maximum length of the names of the school subjects.
+	schoolSubjectNameMaxLength = 255
+	// groupNameMaxLength is the maximum length of the names of the groups.
+	groupNameMaxLength = 10
+)
+
+// Sections of the school setup, the CSV or XLSX sheets of the setup are named after them.
+const (
+	SchoolSetupTeachers      = "teachers"
+	SchoolSetupSubjects      = "subjects"
+	SchoolSetupGroups        = "groups"
+	SchoolSetupGroupSubjects = "group_subjects"
+)
+
+// SchoolSetup is the setup of the school: its teachers, subjects, groups and the subjects of the groups.
+// The entries are identified by their natural keys, so the setup is imported again to update the school:
+// the teachers by the email, the subjects by the name and the groups by the name and the grade.
+type SchoolSetup struct {
+	Teachers []SchoolSetupTeacher `yaml:"teachers"`
+	Subjects []SchoolSetupSubject `yaml:"subjects"`
+	Groups   []SchoolSetupGroup   `yaml:"groups"`
+}
+
+// SchoolSetupTeacher is the teacher of the school setup.
+type SchoolSetupTeacher struct {
+	Line int `yaml:"-"`
+
+	FirstName  string  `yaml:"first_name"`
+	LastName   string  `yaml:"last_name"`
+	MiddleName *string `yaml:"middle_name"`
+	Gender     Gender  `yaml:"gender"`
+	Email      string  `yaml:"email"`
+	Phone      *string `yaml:"phone"`
+}
+
+// SchoolSetupSubject is the school subject of the school setup.
+type SchoolSetupSubject struct {
+	Line int `yaml:"-"`
+
+	Name string `yaml:"name"`
+	// Subject is the name of the subject of the catalog, the name of the school subject is used if it's empty.
+	Subject     string  `yaml:"subject"`
+	Description *string `yaml:"description"`
+}
+
+// SchoolSetupGroup is the group of the school setup.
+type SchoolSetupGroup struct {
+	Line int `yaml:"-"`
+
+	Name  string `yaml:"name"`
+	Grade string `yaml:"grade"`
+	// ClassTeacher is the email of the class teacher, nil if the class teacher is not changed.
+	ClassTeacher *string `yaml:"class_teacher"`
+
+	Subjects []SchoolSetupGroupSubject `yaml:"subjects"`
+}
+
+// SchoolSetupGroupSubject is the subject of the group of the school setup.
+type SchoolSetupGroupSubject struct {
+	Line int `yaml:"-"`
+
+	// Subject is the name of the school subject.
+	Subject string `yaml:"subject"`
+	// Teacher is the email of the teacher, nil if the teacher is not changed.
+	Teacher *string `yaml:"teacher"`
+	// Count is the number of the lessons a week, nil if the count is not changed.
+	Count *int16 `yaml:"count"`
+}
+
+// SchoolSetupError is the error of the entry of the school setup.
+type SchoolSetupError struct {
+	Section string `json:"section"`
+	Line    int    `json:"line"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// SchoolSetupErrors is list of SchoolSetupError.
+type SchoolSetupErrors []SchoolSetupError
+
+// Add adds the error of the field of the entry in the line, the field is empty for the errors of the whole entry.
+func (e *SchoolSetupErrors) Add(section string, line int, field, message string) {
+	*e = append(*e, SchoolSetupError{Section: section, Line: line, Field: field, Message: message})
+}
+
+// Err returns the error listing all errors of the setup, nil if there are no errors.
+func (e SchoolSetupErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return NewInvalidSchoolSetupErr(e)
+}
+
+// SchoolSetupCounts are the numbers of the entries of the section created, updated and left unchanged by the import.
+type SchoolSetupCounts struct {
+	Created int
+	Updated int
+	Skipped int
+}
+
+// SchoolSetupResult is the result of the import of the school setup.
+type SchoolSetupResult struct {
+	DryRun bool
+
+	Teachers      SchoolSetupCounts
+	Subjects      SchoolSetupCounts
+	Groups        SchoolSetupCounts
+	GroupSubjects SchoolSetupCounts
+}
+
+// ParseSchoolSetupDocument parses the YAML or JSON document of the school setup.
+func ParseSchoolSetupDocument(data []byte) (SchoolSetup, error) {
+	var (
+		setup   SchoolSetup
+		root    yaml.Node
+		decoder = yaml.NewDecoder(bytes.NewReader(data))
+	)
+
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&setup); err != nil && !errors.Is(err, io.EOF) {
+		return SchoolSetup{}, fmt.Errorf("%w: %w", ErrInvalidSchoolSetupFile, err)
+	}
+
+	// the document is valid, so only the lines of its entries are taken from its nodes.
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return SchoolSetup{}, fmt.Errorf("%w: %w", ErrInvalidSchoolSetupFile, err)
+	}
+
+	if len(root.Content) > 0 {
+		setup.setLines(root.Content[0])
+	}
+
+	return setup, nil
+}
+
+// setLines sets the lines of the entries of the setup from the nodes of its document.
+func (s *SchoolSetup) setLines(document *yaml.Node) {
+	for index, node := range yamlSequence(document, SchoolSetupTeachers) {
+		s.Teachers[index].Line = node.Line
+	}
+
+	for index, node := range yamlSequence(document, SchoolSetupSubjects) {
+		s.Subjects[index].Line = node.Line
+	}
+
+	for index, node := range yamlSequence(document, SchoolSetupGroups) {
+		s.Groups[index].Line = node.Line
+
+		for i, subjectNode := range yamlSequence(node, "subjects") {
+			s.Groups[index].Subjects[i].Line = subjectNode.Line
+		}
+	}
+}
+
+// yamlSequence returns the items of the sequence of the mapping node by the key.
+func yamlSequence(node *yaml.Node, key string) []*yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key && node.Content[i+1].Kind == yaml.SequenceNode {
+			return node.Content[i+1].Content
+		}
+	}
+
+	return nil
+}
+
+// schoolSetupColumns are the columns of the CSV or XLSX sheets of the school setup, the required ones go first.
+//
+//nolint:gochecknoglobals // it's list of columns
+var schoolSetupColumns = map[string]struct {
+	required []string
+	optional []string
+}{
+	SchoolSetupTeachers: {
+		required: []string{"first_name", "last_name", "gender", "email"},
+		optional: []string{"middle_name", "phone"},
+	},
+	SchoolSetupSubjects: {
+		required: []string{"name"},
+		optional: []string{"subject", "description"},
+	},
+	SchoolSetupGroups: {
+		required: []string{"name", "grade"},
+		optional: []string{"class_teacher"},
+	},
+	SchoolSetupGroupSubjects: {
+		required: []string{"group", "grade", "subject"},
+		optional: []string{"teacher", "count"},
+	},
+}
+
+// ParseSchoolSetupSheets parses the CSV or XLSX sheets of the school setup by their sections.
+// The first row of the sheet is the header with the names of the columns, the empty values are omitted.
+// The subjects of the groups are added to the groups, the groups which are not in the groups sheet are added too.
+func ParseSchoolSetupSheets(sheets map[string][][]string) (SchoolSetup, SchoolSetupErrors) {
+	var (
+		setup SchoolSetup
+		errs  SchoolSetupErrors
+	)
+
+	sections := make([]string, 0, len(sheets))
+	for section := range sheets {
+		sections = append(sections, section)
+	}
+
+	slices.Sort(sections)
+
+	for _, section := range sections {
+		if _, ok := schoolSetupColumns[section]; !ok {
+			errs.Add(section, 0, "", "unknown section")
+		}
+	}
+
+	for _, section := range []string{
+		SchoolSetupTeachers, SchoolSetupSubjects, SchoolSetupGroups, SchoolSetupGroupSubjects,
+	} {
+		records, ok := sheets[section]
+		if !ok {
+			continue
+		}
+
+		for _, entry := range parseSchoolSetupSheet(section, records, &errs) {
+			setup.addSheetEntry(section, entry, &errs)
+		}
+	}
+
+	return setup, errs
+}
+
+// schoolSetupEntry is the row of the sheet of the school setup with the values by the columns.
+type schoolSetupEntry struct {
+	line   int
+	values map[string]string
+}
+
+// value returns the value of the column, nil if it's empty.
+func (e schoolSetupEntry) value(column string) *string {
+	if value := e.values[column]; value != "" {
+		return &value
+	}
+
+	return nil
+}
+
+// parseSchoolSetupSheet returns the rows of the sheet, the empty rows are skipped.
+func parseSchoolSetupSheet(section string, records [][]string, errs *SchoolSetupErrors) []schoolSetupEntry {
+	if len(records) == 0 {
+		errs.Add(section, 1, "", "sheet has no header")
+
+		return nil
+	}
+
+	var (
+		columns = schoolSetupColumns[section]
+		header  = make([]string, 0, len(records[0]))
+		seen    = make(map[string]bool, len(records[0]))
+		count   = len(*errs)
+	)
+
+	for _, name := range records[0] {
+		name = strings.ToLower(strings.Join(strings.Fields(name), "_"))
+
+		switch {
+		case name == "":
+		case !slices.Contains(columns.required, name) && !slices.Contains(columns.optional, name):
+			errs.Add(section, 1, name, "unknown column")
+
+			name = ""
+		case seen[name]:
+			errs.Add(section, 1, name, "column is repeated")
+		}
+
+		seen[name] = true
+		header = append(header, name)
+	}
+
+	for _, column := range columns.required {
+		if !seen[column] {
+			errs.Add(section, 1, column, "column is missing")
+		}
+	}
+
+	if len(*errs) > count {
+		return nil
+	}
+
+	entries := make([]schoolSetupEntry, 0, len(records)-1)
+
+	for index, record := range records[1:] {
+		entry := schoolSetupEntry{line: index + 2, values: make(map[string]string, len(header))}
+
+		for i, column := range header {
+			if i < len(record) && column != "" {
+				if value := strings.TrimSpace(record[i]); value != "" {
+					entry.values[column] = value
+				}
+			}
+		}
+
+		if len(entry.values) > 0 {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// addSheetEntry adds the entry of the sheet to its section.
+func (s *SchoolSetup) addSheetEntry(section string, entry schoolSetupEntry, errs *SchoolSetupErrors) {
+	switch section {
+	case SchoolSetupTeachers:
+		s.Teachers = append(s.Teachers, SchoolSetupTeacher{
+			Line:       entry.line,
+			FirstName:  entry.values["first_name"],
+			LastName:   entry.values["last_name"],
+			MiddleName: entry.value("middle_name"),
+			Gender:     Gender(strings.ToLower(entry.values["gender"])),
+			Email:      entry.values["email"],
+			Phone:      entry.value("phone"),
+		})
+	case SchoolSetupSubjects:
+		s.Subjects = append(s.Subjects, SchoolSetupSubject{
+			Line:        entry.line,
+			Name:        entry.values["name"],
+			Subject:     entry.values["subject"],
+			Description: entry.value("description"),
+		})
+	case SchoolSetupGroups:
+		s.Groups = append(s.Groups, SchoolSetupGroup{
+			Line:         entry.line,
+			Name:         entry.values["name"],
+			Grade:        entry.values["grade"],
+			ClassTeacher: entry.value("class_teacher"),
+		})
+	case SchoolSetupGroupSubjects:
+		groupSubject := SchoolSetupGroupSubject{
+			Line:    entry.line,
+			Subject: entry.values["subject"],
+			Teacher: entry.value("teacher"),
+		}
+
+		if count := entry.value("count"); count != nil {
+			if value, err := strconv.ParseInt(*count, 10, 16); err != nil {
+				errs.Add(section, entry.line, "count", "must be a number")
+			} else {
+				groupSubject.Count = newSchoolSetupCount(value)
+			}
+		}
+
+		// the group of the subject is added to the groups, so its key is checked here.
+		for _, column := range []string{"group", "grade"} {
+			if entry.values[column] == "" {
+				errs.Add(section, entry.line, column, "value is required")
+			}
+		}
+
+		if entry.values["group"] == "" || entry.values["grade"] == "" {
+			return
+		}
+
+		group := s.group(entry.values["group"], entry.values["grade"], entry.line)
+		group.Subjects = append(group.Subjects, groupSubject)
+	}
+}
+
+// newSchoolSetupCount returns the pointer to the count.
+func newSchoolSetupCount(count int64) *int16 {
+	value := int16(count)
+
+	return &value
+}
+
+// group returns the group with the name and the grade, the group is added if there is no such group.
+func (s *SchoolSetup) group(name, grade string, line int) *SchoolSetupGroup {
+	for index := range s.Groups {
+		if SameName(s.Groups[index].Name, name) && SameName(s.Groups[index].Grade, grade) {
+			return &s.Groups[index]
+		}
+	}
+
+	s.Groups = append(s.Groups, SchoolSetupGroup{Line: line, Name: name, Grade: grade})
+
+	return &s.Groups[len(s.Groups)-1]
+}
+
+// Validate validates the entries of the school setup and returns the errors of all of them.
+func (s *SchoolSetup) Validate() SchoolSetupErrors {
+	var errs SchoolSetupErrors
+
+	for _, section := range []struct {
+		name  string
+		count int
+	}{
+		{name: SchoolSetupTeachers, count: len(s.Teachers)},
+		{name: SchoolSetupSubjects, count: len(s.Subjects)},
+		{name: SchoolSetupGroups, count: len(s.Groups)},
+	} {
+		if section.count > SchoolSetupMaxEntries {
+			errs.Add(section.name, 0, "", fmt.Sprintf("section has more than %d entries", SchoolSetupMaxEntries))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	s.validateTeachers(&errs)
+	s.validateSubjects(&errs)
+	s.validateGroups(&errs)
+
+	return errs
+}
+
+// validateTeachers validates the teachers, their emails and phones must be unique.
+func (s *SchoolSetup) validateTeachers(errs *SchoolSetupErrors) {
+	var (
+		emails = make(map[string]int, len(s.Teachers))
+		phones = make(map[string]int, len(s.Teachers))
+	)
+
+	for index := range s.Teachers {
+		teacher := &s.Teachers[index]
+		teacher.Email = strings.ToLower(strings.TrimSpace(teacher.Email))
+		teacher.Gender = Gender(strings.ToLower(string(teacher.Gender)))
+
+		for _, name := range []struct {
+			field string
+			value string
+		}{
+			{field: "first_name", value: teacher.FirstName},
+			{field: "last_name", value: teacher.LastName},
+			{field: "middle_name", value: stringValue(teacher.MiddleName)},
+		} {
+			switch {
+			case strings.TrimSpace(name.value) == "" && name.field != "middle_name":
+				errs.Add(SchoolSetupTeachers, teacher.Line, name.field, "value is required")
+			case utf8.RuneCountInString(name.value) > importNameMaxLength:
+				errs.Add(SchoolSetupTeachers, teacher.Line, name.field,
+					fmt.Sprintf("must be at most %d characters", importNameMaxLength))
+			}
+		}
+
+		if !teacher.Gender.Validate() {
+			errs.Add(SchoolSetupTeachers, teacher.Line, "gender", "must be one of male, female")
+		}
+
+		switch line, ok := emails[teacher.Email]; {
+		case !isImportEmail(teacher.Email):
+			errs.Add(SchoolSetupTeachers, teacher.Line, "email", "must be a valid email")
+		case ok:
+			errs.Add(SchoolSetupTeachers, teacher.Line, "email", fmt.Sprintf("value is already used in line %d", line))
+		default:
+			emails[teacher.Email] = teacher.Line
+		}
+
+		if teacher.Phone == nil {
+			continue
+		}
+
+		phone := normalizeImportPhone(*teacher.Phone)
+		teacher.Phone = &phone
+
+		switch line, ok := phones[phone]; {
+		case !importPhone.MatchString(phone):
+			errs.Add(SchoolSetupTeachers, teacher.Line, "phone", "must be a valid phone in international format")
+		case ok:
+			errs.Add(SchoolSetupTeachers, teacher.Line, "phone", fmt.Sprintf("value is already used in line %d", line))
+		default:
+			phones[phone] = teacher.Line
+		}
+	}
+}
+
+// validateSubjects validates the school subjects, their names must be unique.
+func (s *SchoolSetup) validateSubjects(errs *SchoolSetupErrors) {
+	names := make(map[string]int, len(s.Subjects))
+
+	for index := range s.Subjects {
+		subject := &s.Subjects[index]
+		subject.Name = strings.TrimSpace(subject.Name)
+		subject.Subject = strings.TrimSpace(subject.Subject)
+
+		if subject.Subject == "" {
+			subject.Subject = subject.Name
+		}
+
+		switch line, ok := names[normalizeName(subject.Name)]; {
+		case subject.Name == "":
+			errs.Add(SchoolSetupSubjects, subject.Line, "name", "value is required")
+		case utf8.RuneCountInString(subject.Name) > schoolSubjectNameMaxLength:
+			errs.Add(SchoolSetupSubjects, subject.Line, "name",
+				fmt.Sprintf("must be at most %d characters", schoolSubjectNameMaxLength))
+		case ok:
+			errs.Add(SchoolSetupSubjects, subject.Line, "name", fmt.Sprintf("value is already used in line %d", line))
+		default:
+			names[normalizeName(subject.Name)] = subject.Line
+		}
+	}
+}
+
+// validateGroups validates the groups and their subjects, the names and the grades of the groups must be unique,
+// and so must be the subjects of the group.
+func (s *SchoolSetup) validateGroups(errs *SchoolSetupErrors) {
+	keys := make(map[string]int, len(s.Groups))
+
+	for index := range s.Groups {
+		group := &s.Groups[index]
+		group.Name = strings.TrimSpace(group.Name)
+		group.Grade = strings.TrimSpace(group.Grade)
+		key := normalizeName(group.Name) + "\x00" + normalizeName(group.Grade)
+
+		switch line, ok := keys[key]; {
+		case group.Name == "":
+			errs.Add(SchoolSetupGroups, group.Line, "name", "value is required")
+		case utf8.RuneCountInString(group.Name) > groupNameMaxLength:
+			errs.Add(SchoolSetupGroups, group.Line, "name", fmt.Sprintf("must be at most %d characters", groupNameMaxLength))
+		case ok:
+			errs.Add(SchoolSetupGroups, group.Line, "name", fmt.Sprintf("group is already used in line %d", line))
+		default:
+			keys[key] = group.Line
+		}
+
+		if group.Grade == "" {
+			errs.Add(SchoolSetupGroups, group.Line, "grade", "value is required")
+		}
+
+		if group.ClassTeacher != nil {
+			email := strings.ToLower(strings.TrimSpace(*group.ClassTeacher))
+			group.ClassTeacher = &email
+
+			if !isImportEmail(email) {
+				errs.Add(SchoolSetupGroups, group.Line, "class_teacher", "must be a valid email")
+			}
+		}
+
+		validateGroupSubjects(group, errs)
+	}
+}
+
+// validateGroupSubjects validates the subjects of the group.
+func validateGroupSubjects(group *SchoolSetupGroup, errs *SchoolSetupErrors) {
+	subjects := make(map[string]int, len(group.Subjects))
+
+	for index := range group.Subjects {
+		subject := &group.Subjects[index]
+		subject.Subject = strings.TrimSpace(subject.Subject)
+
+		switch line, ok := subjects[normalizeName(subject.Subject)]; {
+		case subject.Subject == "":
+			errs.Add(SchoolSetupGroupSubjects, subject.Line, "subject", "value is required")
+		case ok:
+			errs.Add(SchoolSetupGroupSubjects, subject.Line, "subject",
+				fmt.Sprintf("subject of the group is already used in line %d", line))
+		default:
+			subjects[normalizeName(subject.Subject)] = subject.Line
+		}
+
+		if subject.Teacher != nil {
+			email := strings.ToLower(strings.TrimSpace(*subject.Teacher))
+			subject.Teacher = &email
+
+			if !isImportEmail(email) {
+				errs.Add(SchoolSetupGroupSubjects, subject.Line, "teacher", "must be a valid email")
+			}
+		}
+
+		if subject.Count != nil && *subject.Count < 1 {
+			errs.Add(SchoolSetupGroupSubjects, subject.Line, "count", "must be at least 1")
+		}
+	}
+}
+
+// SameName checks whether the names are the same, the names are compared case-insensitively
+// and regardless of the repeated spaces, e.g. "5 a" is the same name as "5  A".
+func SameName(a, b string) bool {
+	return normalizeName(a) == normalizeName(b)
+}
+
+// normalizeName returns the name in lower case with single spaces.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// stringValue returns the value of the string, empty if it's nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
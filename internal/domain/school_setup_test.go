@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestParseSchoolSetupDocument(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    SchoolSetup
+		wantErr error
+	}{
+		{
+			name: "yaml with lines",
+			data: `teachers:
+  - first_name: Ali
+    last_name: Valiev
+    gender: male
+    email: ali@example.com
+groups:
+  - name: 5A
+    grade: "5"
+    subjects:
+      - subject: Math
+        count: 4
+`,
+			want: SchoolSetup{
+				Teachers: []SchoolSetupTeacher{
+					{Line: 2, FirstName: "Ali", LastName: "Valiev", Gender: UserGenderTypeMale, Email: "ali@example.com"},
+				},
+				Groups: []SchoolSetupGroup{
+					{
+						Line: 7, Name: "5A", Grade: "5",
+						Subjects: []SchoolSetupGroupSubject{{Line: 10, Subject: "Math", Count: newSchoolSetupCount(4)}},
+					},
+				},
+			},
+		},
+		{
+			name: "json",
+			data: `{"subjects": [{"name": "Algebra", "subject": "Math"}]}`,
+			want: SchoolSetup{Subjects: []SchoolSetupSubject{{Line: 1, Name: "Algebra", Subject: "Math"}}},
+		},
+		{
+			name: "empty",
+			data: "",
+			want: SchoolSetup{},
+		},
+		{
+			name:    "unknown field",
+			data:    "teachers:\n  - first_name: Ali\n    age: 30\n",
+			wantErr: ErrInvalidSchoolSetupFile,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSchoolSetupDocument([]byte(tt.data))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseSchoolSetupDocument() err = %v, want %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSchoolSetupDocument() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestParseSchoolSetupSheets(t *testing.T) {
+	tests := []struct {
+		name     string
+		sheets   map[string][][]string
+		want     SchoolSetup
+		wantErrs SchoolSetupErrors
+	}{
+		{
+			name: "group subjects are merged into groups",
+			sheets: map[string][][]string{
+				SchoolSetupGroups: {{"Name", "Grade", "Class Teacher"}, {"5A", "5", "ali@example.com"}},
+				SchoolSetupGroupSubjects: {
+					{"group", "grade", "subject", "count"},
+					{"5a", "5", "Math", "4"},
+					nil,
+					{"6B", "6", "Physics", ""},
+				},
+			},
+			want: SchoolSetup{
+				Groups: []SchoolSetupGroup{
+					{
+						Line: 2, Name: "5A", Grade: "5", ClassTeacher: stringPtr("ali@example.com"),
+						Subjects: []SchoolSetupGroupSubject{{Line: 2, Subject: "Math", Count: newSchoolSetupCount(4)}},
+					},
+					{
+						Line: 4, Name: "6B", Grade: "6",
+						Subjects: []SchoolSetupGroupSubject{{Line: 4, Subject: "Physics"}},
+					},
+				},
+			},
+		},
+		{
+			name: "unknown sections and columns",
+			sheets: map[string][][]string{
+				"rooms":             {{"name"}},
+				SchoolSetupSubjects: {{"name", "hours"}},
+				SchoolSetupTeachers: {{"first_name", "email"}},
+			},
+			wantErrs: SchoolSetupErrors{
+				{Section: "rooms", Message: "unknown section"},
+				{Section: SchoolSetupTeachers, Line: 1, Field: "last_name", Message: "column is missing"},
+				{Section: SchoolSetupTeachers, Line: 1, Field: "gender", Message: "column is missing"},
+				{Section: SchoolSetupSubjects, Line: 1, Field: "hours", Message: "unknown column"},
+			},
+		},
+		{
+			name: "invalid group subjects",
+			sheets: map[string][][]string{
+				SchoolSetupGroupSubjects: {{"group", "grade", "subject", "count"}, {"5A", "", "Math", "four"}},
+			},
+			wantErrs: SchoolSetupErrors{
+				{Section: SchoolSetupGroupSubjects, Line: 2, Field: "count", Message: "must be a number"},
+				{Section: SchoolSetupGroupSubjects, Line: 2, Field: "grade", Message: "value is required"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errs := ParseSchoolSetupSheets(tt.sheets)
+
+			if !reflect.DeepEqual(errs, tt.wantErrs) {
+				t.Errorf("ParseSchoolSetupSheets() errs = %v, want %v", errs, tt.wantErrs)
+			}
+
+			if len(tt.wantErrs) == 0 && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSchoolSetupSheets() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestSchoolSetupValidate(t *testing.T) {
+	setup := SchoolSetup{
+		Teachers: []SchoolSetupTeacher{
+			{
+				Line: 2, FirstName: "Ali", LastName: "Valiev", Gender: "Male", Email: " Ali@Example.com",
+				Phone: stringPtr("998 90 123-45-67"),
+			},
+			{Line: 3, FirstName: "Vali", LastName: "", Gender: "boy", Email: "ali@example.com"},
+		},
+		Subjects: []SchoolSetupSubject{
+			{Line: 2, Name: " Math "},
+			{Line: 3, Name: "math"},
+		},
+		Groups: []SchoolSetupGroup{
+			{
+				Line: 2, Name: "5A", Grade: "5", ClassTeacher: stringPtr("ali@"),
+				Subjects: []SchoolSetupGroupSubject{
+					{Line: 3, Subject: "Math", Count: newSchoolSetupCount(0)},
+					{Line: 4, Subject: "MATH"},
+				},
+			},
+			{Line: 5, Name: "5a", Grade: "5"},
+		},
+	}
+
+	wantErrs := SchoolSetupErrors{
+		{Section: SchoolSetupTeachers, Line: 3, Field: "last_name", Message: "value is required"},
+		{Section: SchoolSetupTeachers, Line: 3, Field: "gender", Message: "must be one of male, female"},
+		{Section: SchoolSetupTeachers, Line: 3, Field: "email", Message: "value is already used in line 2"},
+		{Section: SchoolSetupSubjects, Line: 3, Field: "name", Message: "value is already used in line 2"},
+		{Section: SchoolSetupGroups, Line: 2, Field: "class_teacher", Message: "must be a valid email"},
+		{Section: SchoolSetupGroupSubjects, Line: 3, Field: "count", Message: "must be at least 1"},
+		{
+			Section: SchoolSetupGroupSubjects, Line: 4, Field: "subject",
+			Message: "subject of the group is already used in line 3",
+		},
+		{Section: SchoolSetupGroups, Line: 5, Field: "name", Message: "group is already used in line 2"},
+	}
+
+	if errs := setup.Validate(); !reflect.DeepEqual(errs, wantErrs) {
+		t.Errorf("Validate() errs = %v, want %v", errs, wantErrs)
+	}
+
+	teacher := setup.Teachers[0]
+	if teacher.Email != "ali@example.com" || teacher.Gender != UserGenderTypeMale || *teacher.Phone != "+998901234567" {
+		t.Errorf("Validate() teacher = %+v, want normalized email, gender and phone", teacher)
+	}
+
+	if subject := setup.Subjects[0]; subject.Name != "Math" || subject.Subject != "Math" {
+		t.Errorf("Validate() subject = %+v, want trimmed name used as subject", subject)
+	}
+}
+
+// stringPtr returns the pointer to the string.
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// SearchQueryMinLength is the minimum length of the search query in characters.
+	SearchQueryMinLength = 2
+	// SearchDefaultLimit is the default limit of the search hits.
+	SearchDefaultLimit = 20
+)
+
+// NewSearchQuery normalises the search query of users by their names, email and phone,
+// empty string means the list is not searched.
+func NewSearchQuery(query string) (string, error) {
+	query = strings.ToLower(strings.Join(strings.Fields(query), " "))
+
+	if query != "" && utf8.RuneCountInString(query) < SearchQueryMinLength {
+		return "", ErrSearchQueryTooShort
+	}
+
+	return query, nil
+}
+
+// SearchHitType is the kind of the entity found by the search.
+type SearchHitType string
+
+const (
+	// SearchHitStudent is the student found by the search.
+	SearchHitStudent SearchHitType = "student"
+	// SearchHitTeacher is the teacher found by the search.
+	SearchHitTeacher SearchHitType = "teacher"
+	// SearchHitGuardian is the student guardian found by the search.
+	SearchHitGuardian SearchHitType = "guardian"
+	// SearchHitDirector is the director found by the search.
+	SearchHitDirector SearchHitType = "director"
+	// SearchHitHeadmaster is the headmaster found by the search.
+	SearchHitHeadmaster SearchHitType = "headmaster"
+)
+
+// SearchHitTypes is the list of SearchHitType.
+type SearchHitTypes []SearchHitType
+
+// AllSearchHitTypes returns all kinds of the entities found by the search.
+func AllSearchHitTypes() SearchHitTypes {
+	return SearchHitTypes{
+		SearchHitStudent,
+		SearchHitTeacher,
+		SearchHitGuardian,
+		SearchHitDirector,
+		SearchHitHeadmaster,
+	}
+}
+
+// Validate checks whether all kinds are known.
+func (t SearchHitTypes) Validate() bool {
+	all := AllSearchHitTypes()
+
+	for _, hitType := range t {
+		if !all.Has(hitType) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Has checks whether there is a given kind in the list.
+func (t SearchHitTypes) Has(hitType SearchHitType) bool {
+	for _, v := range t {
+		if v == hitType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SearchHit is the entity of the school found by the search of its user.
+type SearchHit struct {
+	Type     SearchHitType
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	SchoolID uuid.UUID
+
+	FirstName  string
+	LastName   string
+	MiddleName *string
+
+	// Score is the relevance of the hit, the greater the more relevant.
+	Score float64
+}
+
+// SearchHits is list of SearchHit.
+type SearchHits []SearchHit
+
+// SearchFilter is the filter of the global search.
+type SearchFilter struct {
+	Query string
+	Types SearchHitTypes
+	Limit int
+
+	// Unscoped is true if the hits of all schools are found, otherwise only the schools of SchoolIDs
+	// and the schools of the organizations of OrganizationIDs are searched.
+	Unscoped        bool
+	SchoolIDs       []uuid.UUID
+	OrganizationIDs []uuid.UUID
+}
+
+// NewSearchFilter creates a new SearchFilter domain scoped to the schools the actor manages or teaches in.
+func NewSearchFilter(actor Actor, query string, types SearchHitTypes, limit int) (SearchFilter, error) {
+	query, err := NewSearchQuery(query)
+	if err != nil {
+		return SearchFilter{}, err
+	}
+
+	if query == "" {
+		return SearchFilter{}, ErrSearchQueryTooShort
+	}
+
+	if !types.Validate() {
+		return SearchFilter{}, ErrSearchTypeBadRequest
+	}
+
+	if len(types) == 0 {
+		types = AllSearchHitTypes()
+	}
+
+	if limit <= 0 {
+		limit = SearchDefaultLimit
+	}
+
+	scope := actor.SchoolScope(RoleOwner, RoleDirector, RoleHeadmaster, RoleTeacher)
+
+	return SearchFilter{
+		Query:           query,
+		Types:           types,
+		Limit:           limit,
+		Unscoped:        scope.Unscoped,
+		SchoolIDs:       scope.SchoolIDs,
+		OrganizationIDs: scope.OrganizationIDs,
+	}, nil
+}
+
+// IsEmpty checks whether there are no schools to search in.
+func (f SearchFilter) IsEmpty() bool {
+	return !f.Unscoped && len(f.SchoolIDs) == 0 && len(f.OrganizationIDs) == 0
+}
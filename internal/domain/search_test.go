@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestNewSearchQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr error
+	}{
+		{name: "empty query", query: "  ", want: ""},
+		{name: "query with spaces and capitals", query: "  Alisher   Gulomzoda ", want: "alisher gulomzoda"},
+		{name: "cyrillic query", query: "Гуломзода", want: "гуломзода"},
+		{name: "too short query", query: " a ", wantErr: ErrSearchQueryTooShort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSearchQuery(tt.query)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewSearchQuery() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("NewSearchQuery() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestNewSearchFilter(t *testing.T) {
+	var (
+		schoolID       = uuid.New()
+		otherSchoolID  = uuid.New()
+		organizationID = uuid.New()
+		teacherRole    = UserRole{ID: uuid.New(), Role: RoleTeacher, SchoolID: &schoolID}
+		guardianRole   = UserRole{ID: uuid.New(), Role: RoleGuardian, SchoolID: &otherSchoolID}
+		ownerRole      = UserRole{ID: uuid.New(), Role: RoleOwner, OrganizationID: &organizationID}
+	)
+
+	withActiveRole := func(actor Actor, role UserRole) Actor {
+		actor.SetActiveRole(NewActiveRole(role))
+		return actor
+	}
+
+	tests := []struct {
+		name    string
+		actor   Actor
+		query   string
+		types   SearchHitTypes
+		want    SearchFilter
+		wantErr error
+	}{
+		{
+			name:  "admin searches all schools",
+			actor: NewActor(uuid.New(), UserRoles{{Role: RoleAdmin}}),
+			query: "Gulomzoda",
+			want:  SearchFilter{Query: "gulomzoda", Types: AllSearchHitTypes(), Limit: SearchDefaultLimit, Unscoped: true},
+		},
+		{
+			name:  "teacher and guardian searches the school of teacher",
+			actor: NewActor(uuid.New(), UserRoles{teacherRole, guardianRole}),
+			query: "Gulomzoda",
+			types: SearchHitTypes{SearchHitStudent},
+			want: SearchFilter{
+				Query: "gulomzoda", Types: SearchHitTypes{SearchHitStudent}, Limit: SearchDefaultLimit,
+				SchoolIDs: []uuid.UUID{schoolID},
+			},
+		},
+		{
+			name:  "owner and teacher acting as owner searches the organization",
+			actor: withActiveRole(NewActor(uuid.New(), UserRoles{teacherRole, ownerRole}), ownerRole),
+			query: "Gulomzoda",
+			want: SearchFilter{
+				Query: "gulomzoda", Types: AllSearchHitTypes(), Limit: SearchDefaultLimit,
+				OrganizationIDs: []uuid.UUID{organizationID},
+			},
+		},
+		{
+			name:    "empty query",
+			actor:   NewActor(uuid.New(), UserRoles{teacherRole}),
+			query:   " ",
+			wantErr: ErrSearchQueryTooShort,
+		},
+		{
+			name:    "unknown type",
+			actor:   NewActor(uuid.New(), UserRoles{teacherRole}),
+			query:   "Gulomzoda",
+			types:   SearchHitTypes{"owner"},
+			wantErr: ErrSearchTypeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSearchFilter(tt.actor, tt.query, tt.types, 0)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewSearchFilter() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewSearchFilter() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package domain
+
+// SMS is a text message sent to a phone.
+type SMS struct {
+	To   string
+	Text string
+}
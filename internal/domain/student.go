@@ -47,6 +47,23 @@ func (s *Student) SetUser(user User) {
 	s.User = user
 }
 
+// UpdatePersonalInfo updates name and gender of the student user.
+func (s *Student) UpdatePersonalInfo(
+	firstName string,
+	lastName string,
+	middleName *string,
+	gender string,
+	nowFunc func() time.Time,
+) error {
+	if err := s.User.UpdatePersonalInfo(firstName, lastName, middleName, gender, nowFunc); err != nil {
+		return err
+	}
+
+	s.UpdatedAt = s.User.UpdatedAt
+
+	return nil
+}
+
 // SetGroup sets Group info in Student model.
 func (s *Student) SetGroup(group Group) {
 	s.Group = group
@@ -57,6 +74,11 @@ func (s *Student) SetShortSchool(schoolShort SchoolShortInfo) {
 	s.SchoolShortInfo = &schoolShort
 }
 
+// Cursor returns the position of the student in the list sorted by the creation time.
+func (s Student) Cursor() Cursor {
+	return Cursor{Key: s.CreatedAt, ID: s.ID}
+}
+
 // Students are list of Student.
 type Students []Student
 
@@ -136,6 +158,8 @@ type StudentListFilter struct {
 	GroupIDs        []uuid.UUID
 	SchoolIDs       []uuid.UUID
 	OrganizationIDs []uuid.UUID
+	// Search is the normalised search query of the student users, empty if the list is not searched.
+	Search string
 }
 
 // NewStudentListFilter creates a new StudentListFilter domain.
@@ -146,6 +170,7 @@ func NewStudentListFilter(
 	groupIDs []uuid.UUID,
 	schoolIDs []uuid.UUID,
 	organizationIDs []uuid.UUID,
+	search string,
 ) StudentListFilter {
 	return StudentListFilter{
 		CreatedDate: createdDate,
@@ -154,5 +179,18 @@ func NewStudentListFilter(
 		GroupIDs:        groupIDs,
 		SchoolIDs:       schoolIDs,
 		OrganizationIDs: organizationIDs,
+		Search:          search,
+	}
+}
+
+// StudentListFields returns the fields the list of students is sorted and filtered by.
+func StudentListFields() ListFields {
+	return ListFields{
+		"created_at": ListFieldTime,
+		"updated_at": ListFieldTime,
+		"group_id":   ListFieldUUID,
+		"user_id":    ListFieldUUID,
+		"first_name": ListFieldString,
+		"last_name":  ListFieldString,
 	}
 }
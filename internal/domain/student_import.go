@@ -0,0 +1,526 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// StudentRosterMaxSize is the maximum size of the student roster file in bytes.
+	StudentRosterMaxSize = 5 << 20
+	// StudentRosterMaxRows is the maximum number of the students in the roster.
+	StudentRosterMaxRows = 1000
+	// StudentRosterMaxGuardians is the maximum number of the guardians of the student in the roster.
+	StudentRosterMaxGuardians = 3
+
+	// importNameMaxLength is the maximum length of the names of the users.
+	importNameMaxLength = 25
+	// importEmailMaxLength is the maximum length of the emails of the users.
+	importEmailMaxLength = 100
+
+	// importedUserPasswordSize is the size of the random password of the imported user in bytes.
+	importedUserPasswordSize = 24
+)
+
+// Columns of the student roster. The columns of the guardian are prefixed with "guardian_",
+// e.g. guardian_phone, and the columns of the second and next guardians with their numbers, e.g. guardian2_phone.
+const (
+	RosterColumnFirstName  = "first_name"
+	RosterColumnLastName   = "last_name"
+	RosterColumnMiddleName = "middle_name"
+	RosterColumnGender     = "gender"
+	RosterColumnEmail      = "email"
+	RosterColumnPhone      = "phone"
+	RosterColumnGroup      = "group"
+	RosterColumnRelation   = "relation"
+)
+
+//nolint:gochecknoglobals // it's compiled regular expressions
+var (
+	// rosterGuardianColumn matches the column of the guardian, e.g. guardian2_phone.
+	rosterGuardianColumn = regexp.MustCompile(`^guardian([1-9]?)_([a-z_]+)$`)
+	// importPhone matches the phone in E.164 format.
+	importPhone = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+)
+
+// StudentImportUser is the user of the student or the guardian in the roster.
+type StudentImportUser struct {
+	FirstName  string
+	LastName   string
+	MiddleName *string
+	Gender     Gender
+	Email      string
+	Phone      *string
+}
+
+// StudentImportGuardian is the guardian of the student in the roster.
+type StudentImportGuardian struct {
+	StudentImportUser
+	Relation StudentGuardianRelation
+
+	// Column is the prefix of the columns of the guardian, e.g. guardian2_.
+	Column string
+	// UserID is the existing user who becomes the guardian, nil if the user is created by the import.
+	UserID *uuid.UUID
+}
+
+// StudentImportRow is the student of the roster with the guardians.
+type StudentImportRow struct {
+	// Line is the line of the row in the roster, the first line is the header.
+	Line int
+
+	Student   StudentImportUser
+	GroupName string
+	// GroupID is the group of the school found by the group name.
+	GroupID uuid.UUID
+
+	Guardians []StudentImportGuardian
+}
+
+// StudentImportRows is list of StudentImportRow.
+type StudentImportRows []StudentImportRow
+
+// StudentImportError is the error of the row or the value of the roster.
+type StudentImportError struct {
+	Line    int    `json:"line"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// StudentImportErrors is list of StudentImportError.
+type StudentImportErrors []StudentImportError
+
+// Add adds the error of the value of the column in the line, the column is empty for the errors of the whole row.
+func (e *StudentImportErrors) Add(line int, column, message string) {
+	*e = append(*e, StudentImportError{Line: line, Column: column, Message: message})
+}
+
+// Err returns the error listing all errors of the roster, nil if there are no errors.
+func (e StudentImportErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return NewInvalidStudentRosterErr(e)
+}
+
+// StudentImport is the result of the import of the student roster.
+type StudentImport struct {
+	DryRun bool
+	Rows   StudentImportRows
+
+	// Students are the created students in the order of the rows, empty in the dry run.
+	Students Students
+}
+
+// NewUsers returns the number of the users of the students and the guardians created by the import.
+func (i StudentImport) NewUsers() int {
+	var (
+		count     = len(i.Rows)
+		guardians = make(map[string]struct{})
+	)
+
+	for _, row := range i.Rows {
+		for _, guardian := range row.Guardians {
+			if guardian.UserID == nil {
+				guardians[guardian.Email] = struct{}{}
+			}
+		}
+	}
+
+	return count + len(guardians)
+}
+
+// ExistingGuardians returns the number of the existing users who become guardians by the import.
+func (i StudentImport) ExistingGuardians() int {
+	guardians := make(map[uuid.UUID]struct{})
+
+	for _, row := range i.Rows {
+		for _, guardian := range row.Guardians {
+			if guardian.UserID != nil {
+				guardians[*guardian.UserID] = struct{}{}
+			}
+		}
+	}
+
+	return len(guardians)
+}
+
+// NewImportedUserPassword returns the random password of the user created by the import.
+// Nobody knows it, so the user signs in by the code sent to the phone or resets the password by the email.
+func NewImportedUserPassword() (string, error) {
+	buf := make([]byte, importedUserPasswordSize)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate password: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// rosterColumn is the column of the roster, guardian is 0 for the columns of the student.
+type rosterColumn struct {
+	guardian int
+	field    string
+}
+
+// name returns the name of the column in the roster header.
+func (c rosterColumn) name() string {
+	return rosterGuardianPrefix(c.guardian) + c.field
+}
+
+// rosterGuardianPrefix returns the prefix of the columns of the guardian, empty for the student.
+func rosterGuardianPrefix(guardian int) string {
+	switch guardian {
+	case 0:
+		return ""
+	case 1:
+		return "guardian_"
+	default:
+		return "guardian" + strconv.Itoa(guardian) + "_"
+	}
+}
+
+// ParseStudentRoster parses the rows of the roster, the first one is the header with the names of the columns.
+// The students have first_name, last_name, gender, email and group, the guardians have first_name, last_name,
+// email, phone and relation, the gender of the mother and the father may be omitted.
+// The errors of all rows are returned, so the roster is fixed at once.
+func ParseStudentRoster(records [][]string) (StudentImportRows, StudentImportErrors) {
+	var errs StudentImportErrors
+
+	if len(records) == 0 {
+		errs.Add(1, "", "roster has no header")
+
+		return nil, errs
+	}
+
+	columns := parseRosterHeader(records[0], &errs)
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	var (
+		rows  = make(StudentImportRows, 0, len(records)-1)
+		users = make(rosterUsers)
+	)
+
+	for index, record := range records[1:] {
+		line := index + 2
+
+		values := make(map[rosterColumn]string, len(columns))
+
+		for i, column := range columns {
+			if i < len(record) && column.field != "" {
+				values[column] = strings.TrimSpace(record[i])
+			}
+		}
+
+		if !hasRosterValues(values, -1) {
+			continue
+		}
+
+		if len(rows) == StudentRosterMaxRows {
+			errs.Add(line, "", fmt.Sprintf("roster has more than %d students", StudentRosterMaxRows))
+
+			break
+		}
+
+		row := parseRosterRow(line, values, &errs)
+		users.check(row, &errs)
+
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 && len(errs) == 0 {
+		errs.Add(1, "", "roster has no students")
+	}
+
+	return rows, errs
+}
+
+// parseRosterHeader returns the columns of the header, the columns with the empty names are skipped.
+func parseRosterHeader(header []string, errs *StudentImportErrors) []rosterColumn {
+	var (
+		columns = make([]rosterColumn, 0, len(header))
+		seen    = make(map[rosterColumn]bool, len(header))
+	)
+
+	for _, name := range header {
+		name = strings.ToLower(strings.Join(strings.Fields(name), "_"))
+		if name == "" {
+			columns = append(columns, rosterColumn{})
+			continue
+		}
+
+		column, ok := parseRosterColumn(name)
+		if !ok {
+			errs.Add(1, name, "unknown column")
+
+			columns = append(columns, rosterColumn{})
+
+			continue
+		}
+
+		if seen[column] {
+			errs.Add(1, name, "column is repeated")
+		}
+
+		seen[column] = true
+		columns = append(columns, column)
+	}
+
+	for _, field := range []string{
+		RosterColumnFirstName, RosterColumnLastName, RosterColumnGender, RosterColumnEmail, RosterColumnGroup,
+	} {
+		if !seen[rosterColumn{field: field}] {
+			errs.Add(1, field, "column is missing")
+		}
+	}
+
+	return columns
+}
+
+// parseRosterColumn parses the name of the column of the student or the guardian.
+func parseRosterColumn(name string) (rosterColumn, bool) {
+	userFields := []string{
+		RosterColumnFirstName,
+		RosterColumnLastName,
+		RosterColumnMiddleName,
+		RosterColumnGender,
+		RosterColumnEmail,
+		RosterColumnPhone,
+	}
+
+	match := rosterGuardianColumn.FindStringSubmatch(name)
+	if match == nil {
+		if name == RosterColumnGroup || slices.Contains(userFields, name) {
+			return rosterColumn{field: name}, true
+		}
+
+		return rosterColumn{}, false
+	}
+
+	guardian := 1
+	if match[1] != "" {
+		guardian, _ = strconv.Atoi(match[1])
+	}
+
+	column := rosterColumn{guardian: guardian, field: match[2]}
+
+	if guardian > StudentRosterMaxGuardians {
+		return rosterColumn{}, false
+	}
+
+	if column.field != RosterColumnRelation && !slices.Contains(userFields, column.field) {
+		return rosterColumn{}, false
+	}
+
+	return column, true
+}
+
+// hasRosterValues checks whether there are values of the guardian, -1 checks the values of all columns.
+func hasRosterValues(values map[rosterColumn]string, guardian int) bool {
+	for column, value := range values {
+		if value != "" && (guardian < 0 || column.guardian == guardian) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseRosterRow parses the values of the row.
+func parseRosterRow(line int, values map[rosterColumn]string, errs *StudentImportErrors) StudentImportRow {
+	row := StudentImportRow{
+		Line:      line,
+		Student:   parseRosterUser(line, 0, values, errs),
+		GroupName: values[rosterColumn{field: RosterColumnGroup}],
+	}
+
+	if row.GroupName == "" {
+		errs.Add(line, RosterColumnGroup, "value is required")
+	}
+
+	for guardian := 1; guardian <= StudentRosterMaxGuardians; guardian++ {
+		if !hasRosterValues(values, guardian) {
+			continue
+		}
+
+		var (
+			prefix   = rosterGuardianPrefix(guardian)
+			relation = StudentGuardianRelation(strings.ToLower(values[rosterColumn{guardian, RosterColumnRelation}]))
+		)
+
+		switch {
+		case relation == "":
+			errs.Add(line, prefix+RosterColumnRelation, "value is required")
+		case !relation.Validate():
+			errs.Add(line, prefix+RosterColumnRelation, "must be one of mother, father, guardian, relative")
+		}
+
+		// the gender of the mother and the father is known from the relation.
+		genderColumn := rosterColumn{guardian, RosterColumnGender}
+		if values[genderColumn] == "" {
+			switch relation {
+			case StudentGuardianRelationTypeMother:
+				values[genderColumn] = string(UserGenderTypeFemale)
+			case StudentGuardianRelationTypeFather:
+				values[genderColumn] = string(UserGenderTypeMale)
+			}
+		}
+
+		user := parseRosterUser(line, guardian, values, errs)
+		if user.Phone == nil && values[rosterColumn{guardian, RosterColumnPhone}] == "" {
+			errs.Add(line, prefix+RosterColumnPhone, "value is required")
+		}
+
+		row.Guardians = append(row.Guardians, StudentImportGuardian{
+			StudentImportUser: user,
+			Relation:          relation,
+			Column:            prefix,
+		})
+	}
+
+	return row
+}
+
+// parseRosterUser parses the values of the user of the student or the guardian.
+func parseRosterUser(line, guardian int, values map[rosterColumn]string, errs *StudentImportErrors) StudentImportUser {
+	var (
+		prefix = rosterGuardianPrefix(guardian)
+		value  = func(field string) string { return values[rosterColumn{guardian, field}] }
+		user   = StudentImportUser{
+			FirstName: value(RosterColumnFirstName),
+			LastName:  value(RosterColumnLastName),
+			Gender:    Gender(strings.ToLower(value(RosterColumnGender))),
+			Email:     strings.ToLower(value(RosterColumnEmail)),
+		}
+	)
+
+	for _, field := range []string{RosterColumnFirstName, RosterColumnLastName, RosterColumnMiddleName} {
+		name := value(field)
+
+		switch {
+		case name == "" && field != RosterColumnMiddleName:
+			errs.Add(line, prefix+field, "value is required")
+		case utf8.RuneCountInString(name) > importNameMaxLength:
+			errs.Add(line, prefix+field, fmt.Sprintf("must be at most %d characters", importNameMaxLength))
+		}
+	}
+
+	if middleName := value(RosterColumnMiddleName); middleName != "" {
+		user.MiddleName = &middleName
+	}
+
+	switch {
+	case user.Gender == "":
+		errs.Add(line, prefix+RosterColumnGender, "value is required")
+	case !user.Gender.Validate():
+		errs.Add(line, prefix+RosterColumnGender, "must be one of male, female")
+	}
+
+	switch {
+	case user.Email == "":
+		errs.Add(line, prefix+RosterColumnEmail, "value is required")
+	case !isImportEmail(user.Email):
+		errs.Add(line, prefix+RosterColumnEmail, "must be a valid email")
+	}
+
+	if phone := value(RosterColumnPhone); phone != "" {
+		if phone = normalizeImportPhone(phone); importPhone.MatchString(phone) {
+			user.Phone = &phone
+		} else {
+			errs.Add(line, prefix+RosterColumnPhone, "must be a valid phone in international format")
+		}
+	}
+
+	return user
+}
+
+// isImportEmail checks whether the email of the imported user is valid.
+func isImportEmail(email string) bool {
+	address, err := mail.ParseAddress(email)
+
+	return err == nil && address.Address == email && len(email) <= importEmailMaxLength
+}
+
+// normalizeImportPhone removes the separators of the phone, the phone of digits only,
+// e.g. stored by the spreadsheet as the number, is prefixed with "+".
+func normalizeImportPhone(phone string) string {
+	phone = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "").Replace(phone)
+
+	if phone != "" && strings.Trim(phone, "0123456789") == "" {
+		phone = "+" + phone
+	}
+
+	return phone
+}
+
+// rosterUser is the user the email or the phone of the roster belongs to.
+type rosterUser struct {
+	line     int
+	guardian bool
+	email    string
+	phone    string
+}
+
+// rosterUsers are the users of the roster by their emails and phones.
+type rosterUsers map[string]rosterUser
+
+// check checks that the students are different users and the guardians of several students
+// are the same users in all rows.
+func (u rosterUsers) check(row StudentImportRow, errs *StudentImportErrors) {
+	u.add(row.Line, "", false, row.Student, errs)
+
+	for _, guardian := range row.Guardians {
+		u.add(row.Line, guardian.Column, true, guardian.StudentImportUser, errs)
+	}
+}
+
+// add adds the user, the emails and the phones of the students must be unique,
+// the guardians are repeated with the same email and phone.
+func (u rosterUsers) add(line int, prefix string, guardian bool, user StudentImportUser, errs *StudentImportErrors) {
+	if user.Email == "" {
+		return
+	}
+
+	added := rosterUser{line: line, guardian: guardian, email: user.Email}
+	if user.Phone != nil {
+		added.phone = *user.Phone
+	}
+
+	for _, column := range []string{RosterColumnEmail, RosterColumnPhone} {
+		key := added.email
+		if column == RosterColumnPhone {
+			key = added.phone
+		}
+
+		if key == "" {
+			continue
+		}
+
+		existing, ok := u[column+":"+key]
+		if !ok {
+			u[column+":"+key] = added
+			continue
+		}
+
+		switch {
+		case existing.line == line && guardian && existing.guardian:
+			errs.Add(line, prefix+column, "guardian is repeated")
+		case !guardian || !existing.guardian:
+			errs.Add(line, prefix+column, fmt.Sprintf("value is already used in line %d", existing.line))
+		case existing.email != added.email || existing.phone != added.phone:
+			errs.Add(line, prefix+column, fmt.Sprintf("guardian differs from the one in line %d", existing.line))
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestParseStudentRoster(t *testing.T) {
+	header := []string{
+		"First Name", "last_name", "gender", "email", "phone", "group",
+		"guardian_first_name", "guardian_last_name", "guardian_email", "guardian_phone", "guardian_relation",
+	}
+
+	tests := []struct {
+		name     string
+		records  [][]string
+		wantRows int
+		wantErrs StudentImportErrors
+	}{
+		{
+			name: "valid rows with shared guardian",
+			records: [][]string{
+				header,
+				{"Ali", "Valiev", "male", "ali@example.com", "", "5A", "Zarina", "Valieva", "zarina@example.com", "998 90 123-45-67", "mother"},
+				nil,
+				{"Vali", "Valiev", "Male", "vali@example.com", "+998901111111", "5 a", "Zarina", "Valieva", "Zarina@example.com", "+998901234567", "mother"},
+			},
+			wantRows: 2,
+		},
+		{
+			name:    "unknown and missing columns",
+			records: [][]string{{"first_name", "last_name", "email", "guardian4_phone", "guardian_age"}},
+			wantErrs: StudentImportErrors{
+				{Line: 1, Column: "guardian4_phone", Message: "unknown column"},
+				{Line: 1, Column: "guardian_age", Message: "unknown column"},
+				{Line: 1, Column: "gender", Message: "column is missing"},
+				{Line: 1, Column: "group", Message: "column is missing"},
+			},
+		},
+		{
+			name:     "no students",
+			records:  [][]string{header, {"", " "}},
+			wantErrs: StudentImportErrors{{Line: 1, Message: "roster has no students"}},
+		},
+		{
+			name: "invalid values",
+			records: [][]string{
+				header,
+				{"Ali", "", "boy", "ali@", "12ab", "5A", "Zarina", "Valieva", "zarina@example.com", "", "aunt"},
+			},
+			wantRows: 1,
+			wantErrs: StudentImportErrors{
+				{Line: 2, Column: "last_name", Message: "value is required"},
+				{Line: 2, Column: "gender", Message: "must be one of male, female"},
+				{Line: 2, Column: "email", Message: "must be a valid email"},
+				{Line: 2, Column: "phone", Message: "must be a valid phone in international format"},
+				{Line: 2, Column: "guardian_relation", Message: "must be one of mother, father, guardian, relative"},
+				{Line: 2, Column: "guardian_gender", Message: "value is required"},
+				{Line: 2, Column: "guardian_phone", Message: "value is required"},
+			},
+		},
+		{
+			name: "repeated users",
+			records: [][]string{
+				header,
+				{"Ali", "Valiev", "male", "ali@example.com", "", "5A", "Zarina", "Valieva", "zarina@example.com", "+998901234567", "mother"},
+				{"Vali", "Valiev", "male", "ali@example.com", "", "5A", "Zarina", "Valieva", "zarina@example.com", "+998907654321", "mother"},
+			},
+			wantRows: 2,
+			wantErrs: StudentImportErrors{
+				{Line: 3, Column: "email", Message: "value is already used in line 2"},
+				{Line: 3, Column: "guardian_email", Message: "guardian differs from the one in line 2"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, errs := ParseStudentRoster(tt.records)
+
+			if !reflect.DeepEqual(errs, tt.wantErrs) {
+				t.Errorf("ParseStudentRoster() errs = %v, want %v", errs, tt.wantErrs)
+			}
+
+			if len(rows) != tt.wantRows {
+				t.Errorf("ParseStudentRoster() rows = %d, want %d", len(rows), tt.wantRows)
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestParseStudentRosterGuardian(t *testing.T) {
+	rows, errs := ParseStudentRoster([][]string{
+		{"first_name", "last_name", "gender", "email", "group", "guardian2_first_name", "guardian2_last_name",
+			"guardian2_email", "guardian2_phone", "guardian2_relation"},
+		{"Ali", "Valiev", "male", "ali@example.com", "5A", "Karim", "Valiev", "karim@example.com", "998901234567", "Father"},
+	})
+	if len(errs) > 0 {
+		t.Fatalf("ParseStudentRoster() errs = %v", errs)
+	}
+
+	phone := "+998901234567"
+	want := StudentImportGuardian{
+		StudentImportUser: StudentImportUser{
+			FirstName: "Karim",
+			LastName:  "Valiev",
+			Gender:    UserGenderTypeMale,
+			Email:     "karim@example.com",
+			Phone:     &phone,
+		},
+		Relation: StudentGuardianRelationTypeFather,
+		Column:   "guardian2_",
+	}
+
+	if len(rows) != 1 || rows[0].Line != 2 || !reflect.DeepEqual(rows[0].Guardians, []StudentImportGuardian{want}) {
+		t.Errorf("ParseStudentRoster() rows = %+v, want guardian %+v", rows, want)
+	}
+}
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StudentMembership is a period the student studied in the group.
+// DateTill is the first day the student is no longer in the group, nil while the student is in it.
+type StudentMembership struct {
+	ID        uuid.UUID
+	StudentID uuid.UUID
+	GroupID   uuid.UUID
+	SchoolID  uuid.UUID
+	DateFrom  time.Time
+	DateTill  *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewStudentMembership creates a new StudentMembership domain starting from the date.
+func NewStudentMembership(
+	studentID uuid.UUID,
+	groupID uuid.UUID,
+	schoolID uuid.UUID,
+	dateFrom time.Time,
+
+	nowFunc func() time.Time,
+) StudentMembership {
+	now := nowFunc()
+
+	return StudentMembership{
+		ID:        uuid.New(),
+		StudentID: studentID,
+		GroupID:   groupID,
+		SchoolID:  schoolID,
+		DateFrom:  truncateDay(dateFrom),
+
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Covers checks whether the student was in the group on the date.
+func (m StudentMembership) Covers(date time.Time) bool {
+	day := truncateDay(date)
+
+	return !day.Before(m.DateFrom) && (m.DateTill == nil || day.Before(*m.DateTill))
+}
+
+// IsCurrent checks whether the student is still in the group.
+func (m StudentMembership) IsCurrent() bool {
+	return m.DateTill == nil
+}
+
+// Close ends the membership on the date, the student is not in the group since the date.
+func (m *StudentMembership) Close(date time.Time, nowFunc func() time.Time) error {
+	day := truncateDay(date)
+
+	if !m.IsCurrent() || day.Before(m.DateFrom) {
+		return ErrInvalidTransferDate
+	}
+
+	m.DateTill = &day
+	m.UpdatedAt = nowFunc()
+
+	return nil
+}
+
+// StudentMemberships is list of StudentMembership ordered by date.
+type StudentMemberships []StudentMembership
+
+// Current returns membership of the group the student is in now.
+func (s StudentMemberships) Current() (StudentMembership, bool) {
+	for _, membership := range s {
+		if membership.IsCurrent() {
+			return membership, true
+		}
+	}
+
+	return StudentMembership{}, false
+}
+
+// On returns membership of the group the student was in on the date.
+func (s StudentMemberships) On(date time.Time) (StudentMembership, bool) {
+	for _, membership := range s {
+		if membership.Covers(date) {
+			return membership, true
+		}
+	}
+
+	return StudentMembership{}, false
+}
+
+// Transfer closes the current membership and starts a new one in the group from the date.
+// The transfer can not be dated before the student joined the current group or in the future.
+func (s StudentMemberships) Transfer(
+	groupID uuid.UUID,
+	schoolID uuid.UUID,
+	date time.Time,
+
+	nowFunc func() time.Time,
+) (closed, opened StudentMembership, err error) {
+	closed, ok := s.Current()
+	if !ok {
+		return StudentMembership{}, StudentMembership{}, ErrStudentMembershipNotFound
+	}
+
+	if closed.GroupID == groupID {
+		return StudentMembership{}, StudentMembership{}, ErrStudentAlreadyInGroup
+	}
+
+	if truncateDay(date).After(truncateDay(nowFunc())) {
+		return StudentMembership{}, StudentMembership{}, ErrInvalidTransferDate
+	}
+
+	if err = closed.Close(date, nowFunc); err != nil {
+		return StudentMembership{}, StudentMembership{}, err
+	}
+
+	return closed, NewStudentMembership(closed.StudentID, groupID, schoolID, date, nowFunc), nil
+}
+
+// truncateDay returns the date without time.
+func truncateDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
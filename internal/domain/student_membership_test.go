@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestStudentMembershipsTransfer(t *testing.T) {
+	var (
+		now     = time.Date(2025, 3, 27, 10, 0, 0, 0, time.UTC)
+		nowFunc = func() time.Time { return now }
+
+		studentID = uuid.New()
+		schoolID  = uuid.New()
+		fromGroup = uuid.New()
+		toGroup   = uuid.New()
+
+		joined = time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	memberships := StudentMemberships{NewStudentMembership(studentID, fromGroup, schoolID, joined, nowFunc)}
+
+	tests := []struct {
+		name    string
+		groupID uuid.UUID
+		date    time.Time
+		wantErr error
+	}{
+		{name: "same group", groupID: fromGroup, date: now, wantErr: ErrStudentAlreadyInGroup},
+		{name: "future date", groupID: toGroup, date: now.AddDate(0, 0, 1), wantErr: ErrInvalidTransferDate},
+		{name: "before joining", groupID: toGroup, date: joined.AddDate(0, 0, -1), wantErr: ErrInvalidTransferDate},
+		{name: "valid", groupID: toGroup, date: time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closed, opened, err := memberships.Transfer(tt.groupID, schoolID, tt.date, nowFunc)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Transfer() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			transferDay := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+
+			if closed.DateTill == nil || !closed.DateTill.Equal(transferDay) {
+				t.Errorf("expected the previous membership to end on %v, got %v", transferDay, closed.DateTill)
+			}
+
+			if opened.GroupID != toGroup || !opened.DateFrom.Equal(transferDay) || !opened.IsCurrent() {
+				t.Errorf("unexpected new membership: %+v", opened)
+			}
+
+			history := StudentMemberships{closed, opened}
+
+			if got, ok := history.On(transferDay.AddDate(0, 0, -1)); !ok || got.GroupID != fromGroup {
+				t.Errorf("expected the student in the previous group the day before the transfer")
+			}
+
+			if got, ok := history.On(transferDay); !ok || got.GroupID != toGroup {
+				t.Errorf("expected the student in the new group on the transfer day")
+			}
+
+			if _, ok := history.On(joined.AddDate(0, 0, -1)); ok {
+				t.Errorf("expected no group before the student joined")
+			}
+		})
+	}
+
+	if memberships[0].DateTill != nil {
+		t.Errorf("expected Transfer() not to change the memberships")
+	}
+}
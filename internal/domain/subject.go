@@ -34,6 +34,14 @@ func NewSubject(
 	}
 }
 
+// Update updates subject.
+func (s *Subject) Update(name string, description *string, nowFunc func() time.Time) {
+	s.Name = name
+	s.Description = description
+
+	s.UpdatedAt = nowFunc()
+}
+
 // Subjects are collections of Subject.
 type Subjects []Subject
 
@@ -46,3 +54,12 @@ type SubjectListFilter struct {
 func NewSubjectListFilter(list ListFilter) SubjectListFilter {
 	return SubjectListFilter{ListFilter: list}
 }
+
+// SubjectListFields returns the fields the list of subjects is sorted and filtered by.
+func SubjectListFields() ListFields {
+	return ListFields{
+		"created_at": ListFieldTime,
+		"updated_at": ListFieldTime,
+		"name":       ListFieldString,
+	}
+}
@@ -47,6 +47,14 @@ func NewTeacher(
 	}
 }
 
+// Update updates contacts of the teacher.
+func (t *Teacher) Update(phone, email *string, nowFunc func() time.Time) {
+	t.Phone = phone
+	t.Email = email
+
+	t.UpdatedAt = nowFunc()
+}
+
 // SetUser sets User info in Teacher model.
 func (t *Teacher) SetUser(user User) {
 	t.User = user
@@ -114,6 +122,8 @@ type TeacherListFilter struct {
 	GroupIDs        []uuid.UUID
 	SchoolIDs       []uuid.UUID
 	OrganizationIDs []uuid.UUID
+	// Search is the normalised search query of the teacher users, empty if the list is not searched.
+	Search string
 }
 
 // NewTeacherListFilter creates a new TeacherListFilter domain.
@@ -123,6 +133,7 @@ func NewTeacherListFilter(
 	groupIDs []uuid.UUID,
 	schoolIDs []uuid.UUID,
 	organizationIDs []uuid.UUID,
+	search string,
 ) TeacherListFilter {
 	return TeacherListFilter{
 		ListFilter:      listFilter,
@@ -130,5 +141,18 @@ func NewTeacherListFilter(
 		GroupIDs:        groupIDs,
 		SchoolIDs:       schoolIDs,
 		OrganizationIDs: organizationIDs,
+		Search:          search,
+	}
+}
+
+// TeacherListFields returns the fields the list of teachers is sorted and filtered by.
+func TeacherListFields() ListFields {
+	return ListFields{
+		"created_at": ListFieldTime,
+		"updated_at": ListFieldTime,
+		"school_id":  ListFieldUUID,
+		"user_id":    ListFieldUUID,
+		"email":      ListFieldString,
+		"phone":      ListFieldString,
 	}
 }
@@ -0,0 +1,381 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/pkg/utils"
+)
+
+// defaultTimetableWeekdays returns week days on which lessons are scheduled by default.
+func defaultTimetableWeekdays() []time.Weekday {
+	return []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+}
+
+// BellSlot is a lesson slot of the school day. Start and End are offsets from the beginning of the day.
+type BellSlot struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// BellSlots is list of BellSlot ordered by start.
+type BellSlots []BellSlot
+
+// Validate checks that every slot ends after it starts and slots do not overlap.
+func (b BellSlots) Validate() bool {
+	for i := range b {
+		if b[i].End <= b[i].Start {
+			return false
+		}
+
+		if i > 0 && b[i].Start < b[i-1].End {
+			return false
+		}
+	}
+
+	return len(b) != 0
+}
+
+// TimetableConstraints are constraints of timetable generation.
+type TimetableConstraints struct {
+	Weekdays         []time.Weekday
+	MaxLessonsPerDay int
+	TeacherDaysOff   map[uuid.UUID][]time.Weekday
+}
+
+// NewTimetableConstraints creates a new TimetableConstraints domain.
+// Monday-Friday are used when weekdays are empty, number of bell slots is used when max lessons per day is not set.
+func NewTimetableConstraints(
+	weekdays []time.Weekday,
+	maxLessonsPerDay int,
+	teacherDaysOff map[uuid.UUID][]time.Weekday,
+	bells BellSlots,
+) TimetableConstraints {
+	if len(weekdays) == 0 {
+		weekdays = defaultTimetableWeekdays()
+	}
+
+	if maxLessonsPerDay <= 0 || maxLessonsPerDay > len(bells) {
+		maxLessonsPerDay = len(bells)
+	}
+
+	return TimetableConstraints{
+		Weekdays:         weekdays,
+		MaxLessonsPerDay: maxLessonsPerDay,
+		TeacherDaysOff:   teacherDaysOff,
+	}
+}
+
+// isTeacherDayOff checks whether teacher is not available on the week day.
+func (c TimetableConstraints) isTeacherDayOff(teacherID *uuid.UUID, weekday time.Weekday) bool {
+	if teacherID == nil {
+		return false
+	}
+
+	for _, dayOff := range c.TeacherDaysOff[*teacherID] {
+		if dayOff == weekday {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TimetableDemand is a group subject which must be scheduled Count times per week.
+type TimetableDemand struct {
+	GroupID         uuid.UUID
+	GroupSubjectID  uuid.UUID
+	SchoolSubjectID uuid.UUID
+	TeacherID       *uuid.UUID
+	Count           int
+}
+
+// NewTimetableDemands creates timetable demands from group subjects.
+func NewTimetableDemands(groupSubjects GroupSubjects) []TimetableDemand {
+	demands := make([]TimetableDemand, 0, len(groupSubjects))
+
+	for _, gs := range groupSubjects {
+		if gs.Count == nil || *gs.Count <= 0 {
+			continue
+		}
+
+		demands = append(demands, TimetableDemand{
+			GroupID:         gs.GroupID,
+			GroupSubjectID:  gs.ID,
+			SchoolSubjectID: gs.SchoolSubjectID,
+			TeacherID:       gs.TeacherID,
+			Count:           int(*gs.Count),
+		})
+	}
+
+	return demands
+}
+
+// GroupTimetable is week lessons of a group.
+type GroupTimetable struct {
+	GroupID uuid.UUID
+	Lessons Lessons
+}
+
+// UnscheduledLesson is a group subject which could not be fully scheduled.
+type UnscheduledLesson struct {
+	GroupID        uuid.UUID
+	GroupSubjectID uuid.UUID
+	Count          int
+}
+
+// Timetable is a week timetable of a school.
+type Timetable struct {
+	SchoolID    uuid.UUID
+	WeekStart   time.Time
+	Groups      []GroupTimetable
+	Unscheduled []UnscheduledLesson
+}
+
+// timetableSlot is a place of the lesson in the week.
+type timetableSlot struct {
+	day  int
+	bell int
+}
+
+// timetableBuilder keeps occupation state during timetable generation.
+type timetableBuilder struct {
+	schoolID    uuid.UUID
+	days        []time.Time
+	bells       BellSlots
+	constraints TimetableConstraints
+	auditoriums Auditoriums
+	nowFunc     func() time.Time
+
+	groupSlots      map[uuid.UUID]map[timetableSlot]struct{}
+	groupDayCount   map[uuid.UUID][]int
+	subjectDayCount map[uuid.UUID][]int
+	teacherLessons  map[uuid.UUID]Lessons
+	auditoriumBusy  map[uuid.UUID]Lessons
+	groupLessons    map[uuid.UUID]Lessons
+}
+
+// GenerateTimetable generates a conflict-free draft timetable of the week.
+// Lessons are distributed evenly over the week days, subject-bound auditoriums are used for their subjects,
+// teachers are not scheduled on their days off and at the time of booked lessons,
+// no lessons are scheduled on holidays of the academic years.
+// Demands which could not be placed are returned as unscheduled.
+func GenerateTimetable(
+	schoolID uuid.UUID,
+	weekStart time.Time,
+	bells BellSlots,
+	constraints TimetableConstraints,
+	demands []TimetableDemand,
+	auditoriums Auditoriums,
+	booked Lessons,
+	academicYears AcademicYears,
+	nowFunc func() time.Time,
+) Timetable {
+	b := timetableBuilder{
+		schoolID:    schoolID,
+		bells:       bells,
+		constraints: constraints,
+		auditoriums: auditoriums,
+		nowFunc:     nowFunc,
+
+		groupSlots:      make(map[uuid.UUID]map[timetableSlot]struct{}),
+		groupDayCount:   make(map[uuid.UUID][]int),
+		subjectDayCount: make(map[uuid.UUID][]int),
+		teacherLessons:  make(map[uuid.UUID]Lessons),
+		auditoriumBusy:  make(map[uuid.UUID]Lessons),
+		groupLessons:    make(map[uuid.UUID]Lessons),
+	}
+
+	for _, weekday := range constraints.Weekdays {
+		// week starts on Monday.
+		offset := int(weekday) - int(time.Monday)
+		if offset < 0 {
+			offset += utils.WeekDaysCount
+		}
+
+		day := weekStart.AddDate(0, 0, offset)
+		if academicYears.IsHoliday(day) {
+			continue
+		}
+
+		b.days = append(b.days, day)
+	}
+
+	for _, lesson := range booked {
+		if lesson.TeacherID != nil {
+			b.teacherLessons[*lesson.TeacherID] = append(b.teacherLessons[*lesson.TeacherID], lesson)
+		}
+
+		b.auditoriumBusy[lesson.AuditoriumID] = append(b.auditoriumBusy[lesson.AuditoriumID], lesson)
+	}
+
+	timetable := Timetable{
+		SchoolID:  schoolID,
+		WeekStart: weekStart,
+	}
+
+	groupIDs := make([]uuid.UUID, 0)
+
+	for _, demand := range demands {
+		if _, ok := b.groupSlots[demand.GroupID]; !ok {
+			b.groupSlots[demand.GroupID] = make(map[timetableSlot]struct{})
+			b.groupDayCount[demand.GroupID] = make([]int, len(b.days))
+			groupIDs = append(groupIDs, demand.GroupID)
+		}
+
+		b.subjectDayCount[demand.GroupSubjectID] = make([]int, len(b.days))
+	}
+
+	// the most frequent subjects are the hardest to place, so they go first.
+	sorted := append([]TimetableDemand(nil), demands...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Count > sorted[j].Count
+	})
+
+	for _, demand := range sorted {
+		for placed := 0; placed < demand.Count; placed++ {
+			if !b.place(demand) {
+				timetable.Unscheduled = append(timetable.Unscheduled, UnscheduledLesson{
+					GroupID:        demand.GroupID,
+					GroupSubjectID: demand.GroupSubjectID,
+					Count:          demand.Count - placed,
+				})
+
+				break
+			}
+		}
+	}
+
+	for _, groupID := range groupIDs {
+		lessons := b.groupLessons[groupID]
+		sort.Slice(lessons, func(i, j int) bool {
+			return lessons[i].StartTime.Before(lessons[j].StartTime)
+		})
+
+		timetable.Groups = append(timetable.Groups, GroupTimetable{
+			GroupID: groupID,
+			Lessons: lessons,
+		})
+	}
+
+	return timetable
+}
+
+// place puts one lesson of the demand into the first suitable slot.
+func (b *timetableBuilder) place(demand TimetableDemand) bool {
+	for _, day := range b.daysOrder(demand) {
+		if b.groupDayCount[demand.GroupID][day] >= b.constraints.MaxLessonsPerDay ||
+			b.constraints.isTeacherDayOff(demand.TeacherID, b.days[day].Weekday()) {
+			continue
+		}
+
+		for bell := range b.bells {
+			slot := timetableSlot{day: day, bell: bell}
+			if _, busy := b.groupSlots[demand.GroupID][slot]; busy {
+				continue
+			}
+
+			lesson := NewLesson(
+				b.schoolID,
+				demand.GroupSubjectID,
+				nil,
+				demand.TeacherID,
+				uuid.Nil,
+				b.days[day].Add(b.bells[bell].Start),
+				b.days[day].Add(b.bells[bell].End),
+				nil,
+
+				b.nowFunc,
+			)
+
+			if demand.TeacherID != nil && overlapsAny(lesson, b.teacherLessons[*demand.TeacherID]) {
+				continue
+			}
+
+			auditoriumID, ok := b.freeAuditorium(demand.SchoolSubjectID, lesson)
+			if !ok {
+				continue
+			}
+
+			lesson.AuditoriumID = auditoriumID
+
+			b.groupSlots[demand.GroupID][slot] = struct{}{}
+			b.groupDayCount[demand.GroupID][day]++
+			b.subjectDayCount[demand.GroupSubjectID][day]++
+			b.auditoriumBusy[auditoriumID] = append(b.auditoriumBusy[auditoriumID], lesson)
+			b.groupLessons[demand.GroupID] = append(b.groupLessons[demand.GroupID], lesson)
+
+			if demand.TeacherID != nil {
+				b.teacherLessons[*demand.TeacherID] = append(b.teacherLessons[*demand.TeacherID], lesson)
+			}
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// daysOrder returns week days ordered so that the subject and the group load are spread evenly.
+func (b *timetableBuilder) daysOrder(demand TimetableDemand) []int {
+	days := make([]int, len(b.days))
+	for i := range days {
+		days[i] = i
+	}
+
+	var (
+		subjectCount = b.subjectDayCount[demand.GroupSubjectID]
+		groupCount   = b.groupDayCount[demand.GroupID]
+	)
+
+	sort.SliceStable(days, func(i, j int) bool {
+		if subjectCount[days[i]] != subjectCount[days[j]] {
+			return subjectCount[days[i]] < subjectCount[days[j]]
+		}
+
+		return groupCount[days[i]] < groupCount[days[j]]
+	})
+
+	return days
+}
+
+// freeAuditorium returns an auditorium which is free at the time of the lesson.
+// Auditoriums bound to the subject are preferred, otherwise only not bound auditoriums are used.
+func (b *timetableBuilder) freeAuditorium(schoolSubjectID uuid.UUID, lesson Lesson) (uuid.UUID, bool) {
+	var bound, common Auditoriums
+
+	for _, auditorium := range b.auditoriums {
+		switch {
+		case auditorium.SchoolSubjectID == nil:
+			common = append(common, auditorium)
+		case *auditorium.SchoolSubjectID == schoolSubjectID:
+			bound = append(bound, auditorium)
+		}
+	}
+
+	candidates := common
+	if len(bound) != 0 {
+		candidates = bound
+	}
+
+	for _, auditorium := range candidates {
+		if !overlapsAny(lesson, b.auditoriumBusy[auditorium.ID]) {
+			return auditorium.ID, true
+		}
+	}
+
+	return uuid.Nil, false
+}
+
+// overlapsAny checks whether lesson overlaps any of lessons.
+func overlapsAny(lesson Lesson, lessons Lessons) bool {
+	for _, l := range lessons {
+		if lesson.Overlaps(l) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,322 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/pkg/utils"
+)
+
+// TimetableTemplate is a recurring weekly timetable of a group for a term.
+type TimetableTemplate struct {
+	ID       uuid.UUID
+	SchoolID uuid.UUID
+	GroupID  uuid.UUID
+	Name     string
+	DateFrom time.Time
+	DateTill time.Time
+
+	Slots      TimetableTemplateSlots
+	Exclusions TimetableTemplateExclusions
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// NewTimetableTemplate creates a new TimetableTemplate domain.
+func NewTimetableTemplate(
+	schoolID uuid.UUID,
+	groupID uuid.UUID,
+	name string,
+	dateFrom time.Time,
+	dateTill time.Time,
+
+	nowFunc func() time.Time,
+) TimetableTemplate {
+	now := nowFunc()
+
+	return TimetableTemplate{
+		ID:       uuid.New(),
+		SchoolID: schoolID,
+		GroupID:  groupID,
+		Name:     name,
+		DateFrom: dateFrom,
+		DateTill: dateTill,
+
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Validate checks template period and that slots of the same week day do not overlap.
+func (t TimetableTemplate) Validate() error {
+	if t.DateTill.Before(t.DateFrom) {
+		return ErrInvalidTimetableTemplatePeriod
+	}
+
+	for _, exclusion := range t.Exclusions {
+		if exclusion.DateTill.Before(exclusion.DateFrom) {
+			return ErrInvalidTimetableTemplatePeriod
+		}
+	}
+
+	// slots are placed on a reference week, so lesson index in conflicts is slot index.
+	var (
+		referenceMonday = time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+		lessons         = make(Lessons, 0, len(t.Slots))
+	)
+
+	for _, slot := range t.Slots {
+		day := referenceMonday.AddDate(0, 0, (int(slot.Weekday)+utils.WeekDaysCount-int(time.Monday))%utils.WeekDaysCount)
+
+		lessons = append(lessons, Lesson{
+			StartTime: day.Add(slot.Bell.Start),
+			EndTime:   day.Add(slot.Bell.End),
+		})
+	}
+
+	if conflicts := lessons.Conflicts(); len(conflicts) != 0 {
+		return NewLessonScheduleConflictErr(conflicts)
+	}
+
+	return nil
+}
+
+// TimetableTemplates is list of TimetableTemplate.
+type TimetableTemplates []TimetableTemplate
+
+// IDs returns ids of timetable templates.
+func (t TimetableTemplates) IDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(t))
+
+	for _, template := range t {
+		ids = append(ids, template.ID)
+	}
+
+	return ids
+}
+
+// TimetableTemplateSlot is a weekly lesson of the timetable template.
+type TimetableTemplateSlot struct {
+	ID             uuid.UUID
+	TemplateID     uuid.UUID
+	GroupSubjectID uuid.UUID
+	TeacherID      *uuid.UUID
+	AuditoriumID   uuid.UUID
+	Weekday        time.Weekday
+	Bell           BellSlot
+	Description    *string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// NewTimetableTemplateSlot creates a new TimetableTemplateSlot domain.
+func NewTimetableTemplateSlot(
+	templateID uuid.UUID,
+	groupSubjectID uuid.UUID,
+	teacherID *uuid.UUID,
+	auditoriumID uuid.UUID,
+	weekday time.Weekday,
+	bell BellSlot,
+	description *string,
+
+	nowFunc func() time.Time,
+) TimetableTemplateSlot {
+	now := nowFunc()
+
+	return TimetableTemplateSlot{
+		ID:             uuid.New(),
+		TemplateID:     templateID,
+		GroupSubjectID: groupSubjectID,
+		TeacherID:      teacherID,
+		AuditoriumID:   auditoriumID,
+		Weekday:        weekday,
+		Bell:           bell,
+		Description:    description,
+
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// TimetableTemplateSlots is list of TimetableTemplateSlot.
+type TimetableTemplateSlots []TimetableTemplateSlot
+
+// IDs returns ids of timetable template slots.
+func (t TimetableTemplateSlots) IDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(t))
+
+	for _, slot := range t {
+		ids = append(ids, slot.ID)
+	}
+
+	return ids
+}
+
+// TimetableTemplateExclusion is a period without lessons, e.g. holidays.
+type TimetableTemplateExclusion struct {
+	ID         uuid.UUID
+	TemplateID uuid.UUID
+	DateFrom   time.Time
+	DateTill   time.Time
+	Reason     *string
+
+	CreatedAt time.Time
+}
+
+// NewTimetableTemplateExclusion creates a new TimetableTemplateExclusion domain.
+func NewTimetableTemplateExclusion(
+	templateID uuid.UUID,
+	dateFrom time.Time,
+	dateTill time.Time,
+	reason *string,
+
+	nowFunc func() time.Time,
+) TimetableTemplateExclusion {
+	return TimetableTemplateExclusion{
+		ID:         uuid.New(),
+		TemplateID: templateID,
+		DateFrom:   dateFrom,
+		DateTill:   dateTill,
+		Reason:     reason,
+
+		CreatedAt: nowFunc(),
+	}
+}
+
+// TimetableTemplateExclusions is list of TimetableTemplateExclusion.
+type TimetableTemplateExclusions []TimetableTemplateExclusion
+
+// SetSlots sets slots and exclusions to timetable templates.
+func (t TimetableTemplates) SetSlots(slots TimetableTemplateSlots, exclusions TimetableTemplateExclusions) {
+	for i := range t {
+		t[i].Slots = TimetableTemplateSlots{}
+		t[i].Exclusions = TimetableTemplateExclusions{}
+
+		for _, slot := range slots {
+			if slot.TemplateID == t[i].ID {
+				t[i].Slots = append(t[i].Slots, slot)
+			}
+		}
+
+		for _, exclusion := range exclusions {
+			if exclusion.TemplateID == t[i].ID {
+				t[i].Exclusions = append(t[i].Exclusions, exclusion)
+			}
+		}
+	}
+}
+
+// IsExcluded checks whether there are no lessons on the date.
+func (t TimetableTemplate) IsExcluded(date time.Time) bool {
+	for _, exclusion := range t.Exclusions {
+		if !date.Before(exclusion.DateFrom) && !date.After(exclusion.DateTill) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TemplateOccurrence is a lesson of the template slot on a date.
+// Date is the UTC midnight of the lesson start, so occurrences compare equally whatever location
+// the start time is read in.
+type TemplateOccurrence struct {
+	SlotID uuid.UUID
+	Date   time.Time
+}
+
+// Occurrence returns template occurrence of the lesson, false if lesson is not materialised from a template.
+func (l Lesson) Occurrence() (TemplateOccurrence, bool) {
+	if l.TemplateSlotID == nil {
+		return TemplateOccurrence{}, false
+	}
+
+	return NewTemplateOccurrence(*l.TemplateSlotID, l.StartTime), true
+}
+
+// NewTemplateOccurrence creates template occurrence of the slot lesson starting at the time.
+func NewTemplateOccurrence(slotID uuid.UUID, startTime time.Time) TemplateOccurrence {
+	startTime = startTime.UTC()
+
+	return TemplateOccurrence{
+		SlotID: slotID,
+		Date:   time.Date(startTime.Year(), startTime.Month(), startTime.Day(), 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// Materialise creates lessons of the template for the dates in the period within the template term.
+// Excluded dates, holidays of the academic years and occurrences already having a lesson are skipped.
+// Group subject teacher is used when slot has no teacher.
+func (t TimetableTemplate) Materialise(
+	from, till time.Time,
+	groupSubjects map[uuid.UUID]GroupSubject,
+	existing map[TemplateOccurrence]struct{},
+	academicYears AcademicYears,
+
+	nowFunc func() time.Time,
+) Lessons {
+	if from.Before(t.DateFrom) {
+		from = t.DateFrom
+	}
+
+	if till.After(t.DateTill) {
+		till = t.DateTill
+	}
+
+	lessons := make(Lessons, 0)
+
+	for date := from; !date.After(till); date = date.AddDate(0, 0, 1) {
+		if t.IsExcluded(date) || academicYears.IsHoliday(date) {
+			continue
+		}
+
+		for _, slot := range t.Slots {
+			if slot.Weekday != date.Weekday() {
+				continue
+			}
+
+			if _, ok := existing[NewTemplateOccurrence(slot.ID, date.Add(slot.Bell.Start))]; ok {
+				continue
+			}
+
+			lesson := NewLesson(
+				t.SchoolID,
+				slot.GroupSubjectID,
+				slot.TeacherID,
+				groupSubjects[slot.GroupSubjectID].TeacherID,
+				slot.AuditoriumID,
+				date.Add(slot.Bell.Start),
+				date.Add(slot.Bell.End),
+				slot.Description,
+
+				nowFunc,
+			)
+
+			slotID := slot.ID
+			lesson.TemplateSlotID = &slotID
+
+			lessons = append(lessons, lesson)
+		}
+	}
+
+	return lessons
+}
+
+// TimetableTemplateFilters is filters of timetable templates list.
+type TimetableTemplateFilters struct {
+	SchoolID uuid.UUID
+	GroupID  *uuid.UUID
+}
+
+// NewTimetableTemplateFilters creates a new TimetableTemplateFilters domain.
+func NewTimetableTemplateFilters(schoolID uuid.UUID, groupID *uuid.UUID) TimetableTemplateFilters {
+	return TimetableTemplateFilters{
+		SchoolID: schoolID,
+		GroupID:  groupID,
+	}
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestTimetableTemplate_Materialise(t *testing.T) {
+	var (
+		monday  = time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC)
+		bell    = BellSlot{Start: 8 * time.Hour, End: 8*time.Hour + 45*time.Minute}
+		nowFunc = func() time.Time { return monday }
+	)
+
+	template := NewTimetableTemplate(uuid.New(), uuid.New(), "autumn", monday, monday.AddDate(0, 0, 27), nowFunc)
+	mondaySlot := NewTimetableTemplateSlot(template.ID, uuid.New(), nil, uuid.New(), time.Monday, bell, nil, nowFunc)
+	fridaySlot := NewTimetableTemplateSlot(template.ID, uuid.New(), nil, uuid.New(), time.Friday, bell, nil, nowFunc)
+	template.Slots = TimetableTemplateSlots{mondaySlot, fridaySlot}
+
+	year := NewAcademicYear(template.SchoolID, "2024/2025", monday, monday.AddDate(0, 9, 0), nowFunc)
+	year.AddHoliday("holidays", monday, monday.AddDate(0, 0, 6), nowFunc)
+
+	tests := []struct {
+		name          string
+		from, till    time.Time
+		exclusions    TimetableTemplateExclusions
+		overridden    map[TemplateOccurrence]struct{}
+		academicYears AcademicYears
+		want          int
+	}{
+		{
+			name: "whole term",
+			from: monday.AddDate(0, 0, -7),
+			till: monday.AddDate(0, 0, 60),
+			want: 8,
+		},
+		{
+			name: "single week",
+			from: monday,
+			till: monday.AddDate(0, 0, 6),
+			want: 2,
+		},
+		{
+			name: "excluded week",
+			from: monday,
+			till: monday.AddDate(0, 0, 13),
+			exclusions: TimetableTemplateExclusions{
+				NewTimetableTemplateExclusion(template.ID, monday, monday.AddDate(0, 0, 6), nil, nowFunc),
+			},
+			want: 2,
+		},
+		{
+			name:       "overridden occurrence",
+			from:       monday,
+			till:       monday.AddDate(0, 0, 6),
+			overridden: map[TemplateOccurrence]struct{}{NewTemplateOccurrence(mondaySlot.ID, monday.Add(bell.Start)): {}},
+			want:       1,
+		},
+		{
+			name:          "holidays",
+			from:          monday,
+			till:          monday.AddDate(0, 0, 13),
+			academicYears: AcademicYears{year},
+			want:          2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template.Exclusions = tt.exclusions
+
+			lessons := template.Materialise(tt.from, tt.till, nil, tt.overridden, tt.academicYears, nowFunc)
+			if len(lessons) != tt.want {
+				t.Fatalf("expected %d lessons, got %d", tt.want, len(lessons))
+			}
+
+			for _, lesson := range lessons {
+				if lesson.TemplateSlotID == nil {
+					t.Fatalf("expected lesson to reference template slot")
+				}
+
+				if occurrence, _ := lesson.Occurrence(); occurrence.Date.Weekday() != lesson.StartTime.Weekday() {
+					t.Fatalf("unexpected occurrence date %v", occurrence.Date)
+				}
+			}
+		})
+	}
+}
+
+//nolint:nolintlint,all // it's ok
+func TestLesson_Occurrence(t *testing.T) {
+	var (
+		slotID   = uuid.New()
+		tashkent = time.FixedZone("Asia/Tashkent", 5*60*60)
+		nowFunc  = func() time.Time { return time.Now() }
+	)
+
+	tests := []struct {
+		name      string
+		startTime time.Time
+		want      time.Time
+	}{
+		{
+			name:      "utc start time",
+			startTime: time.Date(2024, 9, 2, 8, 0, 0, 0, time.UTC),
+			want:      time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "non-utc start time",
+			startTime: time.Date(2024, 9, 2, 8, 0, 0, 0, tashkent),
+			want:      time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "non-utc start time on the previous utc day",
+			startTime: time.Date(2024, 9, 2, 1, 0, 0, 0, tashkent),
+			want:      time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lesson := NewLesson(
+				uuid.New(), uuid.New(), nil, nil, uuid.New(),
+				tt.startTime, tt.startTime.Add(45*time.Minute), nil, nowFunc,
+			)
+			lesson.TemplateSlotID = &slotID
+
+			occurrence, ok := lesson.Occurrence()
+			if !ok {
+				t.Fatalf("expected lesson to have occurrence")
+			}
+
+			if !occurrence.Date.Equal(tt.want) || occurrence.Date.Location() != time.UTC {
+				t.Fatalf("expected occurrence date %v, got %v", tt.want, occurrence.Date)
+			}
+
+			// Lesson read back from the database in UTC must have the same occurrence.
+			lesson.StartTime = lesson.StartTime.UTC()
+
+			if got, _ := lesson.Occurrence(); got != occurrence {
+				t.Fatalf("expected occurrence %v, got %v", occurrence, got)
+			}
+		})
+	}
+}
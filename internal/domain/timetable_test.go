@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestGenerateTimetable(t *testing.T) {
+	var (
+		weekStart    = time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC)
+		teacherID    = uuid.New()
+		mathID       = uuid.New()
+		physicsID    = uuid.New()
+		physicsRoom  = Auditorium{ID: uuid.New(), SchoolSubjectID: &physicsID}
+		commonRoom   = Auditorium{ID: uuid.New()}
+		firstGroupID = uuid.New()
+		otherGroupID = uuid.New()
+		bells        = BellSlots{
+			{Start: 8 * time.Hour, End: 8*time.Hour + 45*time.Minute},
+			{Start: 9 * time.Hour, End: 9*time.Hour + 45*time.Minute},
+		}
+		nowFunc = func() time.Time { return weekStart }
+	)
+
+	demands := []TimetableDemand{
+		{GroupID: firstGroupID, GroupSubjectID: uuid.New(), SchoolSubjectID: mathID, TeacherID: &teacherID, Count: 5},
+		{GroupID: otherGroupID, GroupSubjectID: uuid.New(), SchoolSubjectID: mathID, TeacherID: &teacherID, Count: 5},
+		{GroupID: firstGroupID, GroupSubjectID: uuid.New(), SchoolSubjectID: physicsID, Count: 3},
+	}
+
+	timetable := GenerateTimetable(
+		uuid.New(),
+		weekStart,
+		bells,
+		NewTimetableConstraints(nil, 0, map[uuid.UUID][]time.Weekday{teacherID: {time.Friday}}, bells),
+		demands,
+		Auditoriums{physicsRoom, commonRoom},
+		nil,
+		nil,
+		nowFunc,
+	)
+
+	var all Lessons
+	for _, group := range timetable.Groups {
+		if conflicts := group.Lessons.Conflicts(); len(conflicts) != 0 {
+			t.Fatalf("group lessons conflict: %v", conflicts)
+		}
+
+		for _, lesson := range group.Lessons {
+			if lesson.TeacherID != nil && lesson.StartTime.Weekday() == time.Friday {
+				t.Errorf("lesson is scheduled on teacher day off: %v", lesson.StartTime)
+			}
+
+			if lesson.GroupSubjectID == demands[2].GroupSubjectID && lesson.AuditoriumID != physicsRoom.ID {
+				t.Errorf("physics lesson is not in physics auditorium")
+			}
+		}
+
+		if conflicts := group.Lessons.ConflictsWith(all); len(conflicts) != 0 {
+			t.Fatalf("lessons of groups conflict: %v", conflicts)
+		}
+
+		all = append(all, group.Lessons...)
+	}
+
+	// teacher has 8 free slots from Monday to Thursday, so 2 math lessons can't be scheduled.
+	scheduled := len(all)
+	if scheduled != 11 {
+		t.Errorf("scheduled lessons = %d, want 11", scheduled)
+	}
+
+	unscheduled := 0
+	for _, lesson := range timetable.Unscheduled {
+		unscheduled += lesson.Count
+	}
+
+	if unscheduled != 2 {
+		t.Errorf("unscheduled lessons = %d, want 2", unscheduled)
+	}
+}
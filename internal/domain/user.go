@@ -19,6 +19,9 @@ type User struct {
 	Email      string
 	Password   string
 
+	// EmailVerifiedAt is the time the user confirmed the email, nil if the email is not verified.
+	EmailVerifiedAt *time.Time
+
 	UserRoles UserRoles
 
 	CreatedAt time.Time
@@ -58,11 +61,39 @@ func NewUser(
 	}, nil
 }
 
+// UpdatePersonalInfo updates name and gender of the user.
+func (u *User) UpdatePersonalInfo(
+	firstName string,
+	lastName string,
+	middleName *string,
+	gender string,
+	nowFunc func() time.Time,
+) error {
+	userGender := Gender(gender)
+	if ok := userGender.Validate(); !ok {
+		return ErrUserGenderBadRequest
+	}
+
+	u.FirstName = firstName
+	u.LastName = lastName
+	u.MiddleName = utils.GetStrValueFromArgument(middleName)
+	u.Gender = userGender
+
+	u.UpdatedAt = nowFunc()
+
+	return nil
+}
+
 // SetRoles sets user roles.
 func (u *User) SetRoles(roles UserRoles) {
 	u.UserRoles = roles
 }
 
+// Cursor returns the position of the user in the list sorted by the creation time.
+func (u User) Cursor() Cursor {
+	return Cursor{Key: u.CreatedAt, ID: u.ID}
+}
+
 // Users is a list of User.
 type Users []User
 
@@ -334,6 +365,8 @@ type UserListFilter struct {
 
 	Emails []string
 	Phones []string
+	// Search is the normalised search query of the users, empty if the list is not searched.
+	Search string
 }
 
 // NewUserListFilter creates a new UserListFilter domain.
@@ -345,6 +378,7 @@ func NewUserListFilter(
 
 	emails []string,
 	phones []string,
+	search string,
 
 	list ListFilter,
 ) (UserListFilter, error) {
@@ -360,6 +394,7 @@ func NewUserListFilter(
 
 		Emails: emails,
 		Phones: phones,
+		Search: search,
 
 		ListFilter: list,
 	}, nil
@@ -369,3 +404,17 @@ func NewUserListFilter(
 func (u *UserRole) IsStudent() bool {
 	return u.Role == RoleStudent
 }
+
+// UserListFields returns the fields the list of users is sorted and filtered by.
+func UserListFields() ListFields {
+	return ListFields{
+		"created_at":  ListFieldTime,
+		"updated_at":  ListFieldTime,
+		"first_name":  ListFieldString,
+		"last_name":   ListFieldString,
+		"middle_name": ListFieldString,
+		"gender":      ListFieldString,
+		"email":       ListFieldString,
+		"phone":       ListFieldString,
+	}
+}
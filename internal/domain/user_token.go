@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// userTokenSize is the size of the random part of user token secrets in bytes.
+const userTokenSize = 32
+
+// UserTokenPurpose is an action a user token confirms.
+type UserTokenPurpose string
+
+const (
+	// PasswordReset is a token for setting a new password of the user who forgot it.
+	PasswordReset UserTokenPurpose = "password_reset"
+	// EmailVerification is a token for confirming that the user owns the email.
+	EmailVerification UserTokenPurpose = "email_verification"
+)
+
+// UserToken is a single-use expiring token sent to the user by email.
+// Only the hash of the secret is stored, the secret itself is known only to the email recipient.
+type UserToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Purpose   UserTokenPurpose
+	TokenHash string
+	// Email is the email the token was sent to.
+	Email     string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+
+	CreatedAt time.Time
+}
+
+// NewUserToken creates a new UserToken domain and returns the secret to be sent to the user.
+func NewUserToken(
+	user User,
+	purpose UserTokenPurpose,
+	ttl time.Duration,
+
+	nowFunc func() time.Time,
+) (token UserToken, secret string, err error) {
+	buf := make([]byte, userTokenSize)
+
+	if _, err = rand.Read(buf); err != nil {
+		return UserToken{}, "", fmt.Errorf("failed to generate user token: %w", err)
+	}
+
+	now := nowFunc()
+	secret = base64.RawURLEncoding.EncodeToString(buf)
+
+	return UserToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		Purpose:   purpose,
+		TokenHash: HashUserToken(secret),
+		Email:     user.Email,
+		ExpiresAt: now.Add(ttl),
+
+		CreatedAt: now,
+	}, secret, nil
+}
+
+// HashUserToken returns the hash the user token secret is stored and searched by.
+func HashUserToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Use marks the token as used, a token can be used only once and before it expires.
+func (u *UserToken) Use(nowFunc func() time.Time) error {
+	now := nowFunc()
+
+	if u.UsedAt != nil || !now.Before(u.ExpiresAt) {
+		return ErrInvalidUserToken
+	}
+
+	u.UsedAt = &now
+
+	return nil
+}
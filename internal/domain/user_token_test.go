@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestUserTokenUse(t *testing.T) {
+	var (
+		now     = time.Date(2025, 3, 29, 10, 0, 0, 0, time.UTC)
+		nowFunc = func() time.Time { return now }
+		used    = now.Add(-time.Minute)
+		user    = User{ID: uuid.New(), Email: "user@example.com"}
+	)
+
+	newToken := func(ttl time.Duration) UserToken {
+		token, secret, err := NewUserToken(user, PasswordReset, ttl, nowFunc)
+		if err != nil {
+			t.Fatalf("NewUserToken() error = %v", err)
+		}
+
+		if token.TokenHash != HashUserToken(secret) {
+			t.Fatalf("expected the token to store the hash of the secret")
+		}
+
+		return token
+	}
+
+	tests := []struct {
+		name    string
+		token   func() UserToken
+		wantErr error
+	}{
+		{
+			name:  "valid token",
+			token: func() UserToken { return newToken(time.Hour) },
+		},
+		{
+			name: "used token",
+			token: func() UserToken {
+				token := newToken(time.Hour)
+				token.UsedAt = &used
+
+				return token
+			},
+			wantErr: ErrInvalidUserToken,
+		},
+		{
+			name:    "expired token",
+			token:   func() UserToken { return newToken(0) },
+			wantErr: ErrInvalidUserToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := tt.token()
+
+			err := token.Use(nowFunc)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Use() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if token.UsedAt == nil || !token.UsedAt.Equal(now) {
+				t.Errorf("expected the token to be used at %v, got %v", now, token.UsedAt)
+			}
+
+			if err = token.Use(nowFunc); !errors.Is(err, ErrInvalidUserToken) {
+				t.Errorf("expected the token to be single-use, got %v", err)
+			}
+		})
+	}
+}
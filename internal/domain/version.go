@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// CheckVersion returns an error if the entity was updated after its version was read.
+// The time of the last update of the entity is its version.
+func CheckVersion(updatedAt, version time.Time) error {
+	if !updatedAt.Equal(version) {
+		return ErrOutdatedVersion
+	}
+
+	return nil
+}
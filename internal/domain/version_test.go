@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+//nolint:nolintlint,all // it's ok
+func TestCheckVersion(t *testing.T) {
+	updatedAt := time.Date(2025, 4, 2, 9, 0, 0, 123456000, time.UTC)
+
+	tests := []struct {
+		name    string
+		version time.Time
+		wantErr error
+	}{
+		{name: "same version", version: updatedAt},
+		{name: "same version in another time zone", version: updatedAt.In(time.FixedZone("UTC+5", 5*60*60))},
+		{name: "version read before the last update", version: updatedAt.Add(-time.Second), wantErr: ErrOutdatedVersion},
+		{name: "version without fractional seconds", version: updatedAt.Truncate(time.Second), wantErr: ErrOutdatedVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckVersion(updatedAt, tt.version); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckVersion() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/liblog"
+)
+
+// Log is a mailer for local development and tests, it does not send emails
+// but writes them to the log and, if dir is set, into .eml files of the dir.
+type Log struct {
+	from string
+	dir  string
+}
+
+// NewLog creates a new log mailer.
+func NewLog(from, dir string) *Log {
+	return &Log{
+		from: from,
+		dir:  dir,
+	}
+}
+
+// Send writes the email.
+func (l *Log) Send(ctx context.Context, email domain.Email) error {
+	liblog.Must(ctx).Infof("email to %s: %s\n%s", email.To, email.Subject, email.Body)
+
+	if l.dir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(l.dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create emails dir: %w", err)
+	}
+
+	name := fmt.Sprintf("%s_%s_%s.eml",
+		time.Now().UTC().Format("20060102T150405"), fileName(email.To), uuid.NewString(),
+	)
+
+	if err := os.WriteFile(filepath.Join(l.dir, name), message(l.from, email), 0o600); err != nil {
+		return fmt.Errorf("failed to write email file: %w", err)
+	}
+
+	return nil
+}
+
+// fileName makes the email address safe to be a part of a file name.
+func fileName(address string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == os.PathSeparator {
+			return '_'
+		}
+
+		return r
+	}, address)
+}
@@ -0,0 +1,59 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+
+	"bum-service/internal/domain"
+)
+
+// SMTP is a mailer sending emails through an SMTP server.
+type SMTP struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTP creates a new SMTP mailer, emails are sent without authentication if username is empty.
+func NewSMTP(host string, port int, username, password, from string) *SMTP {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTP{
+		addr: net.JoinHostPort(host, strconv.Itoa(port)),
+		from: from,
+		auth: auth,
+	}
+}
+
+// Send sends the email.
+func (s *SMTP) Send(_ context.Context, email domain.Email) error {
+	err := smtp.SendMail(s.addr, s.auth, s.from, []string{email.To}, message(s.from, email))
+	if err != nil {
+		return fmt.Errorf("failed to send email via smtp: %w", err)
+	}
+
+	return nil
+}
+
+// message builds a plain text UTF-8 message of the email.
+func message(from string, email domain.Email) []byte {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", email.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", email.Subject))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(email.Body)
+
+	return buf.Bytes()
+}
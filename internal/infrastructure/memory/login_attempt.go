@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"bum-service/internal/domain"
+)
+
+const (
+	// maxFailedLoginAttempts is the number of the latest failed login audit records kept in memory.
+	maxFailedLoginAttempts = 10000
+	// pruneLoginFailuresAfter is the number of keys after which outdated failures are pruned.
+	pruneLoginFailuresAfter = 10000
+)
+
+// LoginAttempt is an in-memory store of failed logins for a single instance of the service
+// and for local development, the failures are lost on restart.
+type LoginAttempt struct {
+	// ttl is the time after the last failure the failures are not needed anymore if logins are not locked.
+	ttl time.Duration
+
+	mu       sync.Mutex
+	failures map[string]domain.LoginFailures
+	attempts []domain.FailedLoginAttempt
+}
+
+// NewLoginAttempt creates a new in-memory login attempt store.
+func NewLoginAttempt(ttl time.Duration) *LoginAttempt {
+	return &LoginAttempt{
+		ttl:      ttl,
+		failures: make(map[string]domain.LoginFailures),
+	}
+}
+
+// LoginFailures returns failed logins by the key.
+func (l *LoginAttempt) LoginFailures(_ context.Context, key string) (domain.LoginFailures, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	failures, ok := l.failures[key]
+	if !ok {
+		return domain.LoginFailures{}, domain.ErrNotFound
+	}
+
+	return failures, nil
+}
+
+// AddLoginFailure counts a failed login by the key under the lock, so concurrent failures are not lost,
+// and returns the failed logins after it.
+func (l *LoginAttempt) AddLoginFailure(
+	_ context.Context, key string, policy domain.LoginThrottlePolicy, now time.Time,
+) (domain.LoginFailures, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.failures) >= pruneLoginFailuresAfter {
+		l.prune(now)
+	}
+
+	failures, ok := l.failures[key]
+	if !ok {
+		failures = domain.NewLoginFailures(key)
+	}
+
+	failures.Fail(policy, now)
+	l.failures[key] = failures
+
+	return failures, nil
+}
+
+// prune drops the failures which are not locked and are older than ttl.
+func (l *LoginAttempt) prune(now time.Time) {
+	for key, failures := range l.failures {
+		if (failures.LockedUntil == nil || !now.Before(*failures.LockedUntil)) &&
+			now.Sub(failures.LastFailedAt) > l.ttl {
+			delete(l.failures, key)
+		}
+	}
+}
+
+// DeleteLoginFailures forgets failed logins by the key.
+func (l *LoginAttempt) DeleteLoginFailures(_ context.Context, key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.failures, key)
+
+	return nil
+}
+
+// AddFailedLoginAttempt stores an audit record of a failed login, the oldest records are dropped.
+func (l *LoginAttempt) AddFailedLoginAttempt(_ context.Context, attempt domain.FailedLoginAttempt) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.attempts) >= maxFailedLoginAttempts {
+		l.attempts = append(l.attempts[:0], l.attempts[1:]...)
+	}
+
+	l.attempts = append(l.attempts, attempt)
+
+	return nil
+}
+
+// FailedLoginAttempts returns the kept audit records of failed logins.
+func (l *LoginAttempt) FailedLoginAttempts() []domain.FailedLoginAttempt {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	attempts := make([]domain.FailedLoginAttempt, len(l.attempts))
+	copy(attempts, l.attempts)
+
+	return attempts
+}
@@ -0,0 +1,391 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"bum-service/internal/domain"
+)
+
+const (
+	// AcademicYearsNameKey is academic year name unique key.
+	AcademicYearsNameKey = "academic_years_name_key"
+	// AcademicYearsSchoolIDFKey is academic year school id foreign key.
+	AcademicYearsSchoolIDFKey = "academic_years_school_id_fkey"
+	// AcademicYearsPeriodCheck is academic year period check.
+	AcademicYearsPeriodCheck = "academic_years_period_check"
+	// TermsPeriodCheck is term period check.
+	TermsPeriodCheck = "terms_period_check"
+	// HolidaysPeriodCheck is holiday period check.
+	HolidaysPeriodCheck = "holidays_period_check"
+)
+
+// AcademicYearRow is row of academic year.
+type AcademicYearRow struct {
+	ID       uuid.UUID `db:"id"`
+	SchoolID uuid.UUID `db:"school_id"`
+	Name     string    `db:"name"`
+	DateFrom time.Time `db:"date_from"`
+	DateTill time.Time `db:"date_till"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+func (a AcademicYearRow) toDomain() domain.AcademicYear {
+	return domain.AcademicYear{
+		ID:        a.ID,
+		SchoolID:  a.SchoolID,
+		Name:      a.Name,
+		DateFrom:  a.DateFrom,
+		DateTill:  a.DateTill,
+		CreatedAt: a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+		DeletedAt: a.DeletedAt,
+	}
+}
+
+// AcademicYearRows is list of AcademicYearRow.
+type AcademicYearRows []AcademicYearRow
+
+func (a AcademicYearRows) toDomain() domain.AcademicYears {
+	list := make(domain.AcademicYears, 0, len(a))
+
+	for _, row := range a {
+		list = append(list, row.toDomain())
+	}
+
+	return list
+}
+
+// TermRow is row of term.
+type TermRow struct {
+	ID             uuid.UUID `db:"id"`
+	AcademicYearID uuid.UUID `db:"academic_year_id"`
+	Name           string    `db:"name"`
+	Position       int       `db:"position"`
+	DateFrom       time.Time `db:"date_from"`
+	DateTill       time.Time `db:"date_till"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+func (t TermRow) toDomain() domain.Term {
+	return domain.Term{
+		ID:             t.ID,
+		AcademicYearID: t.AcademicYearID,
+		Name:           t.Name,
+		Position:       t.Position,
+		DateFrom:       t.DateFrom,
+		DateTill:       t.DateTill,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+		DeletedAt:      t.DeletedAt,
+	}
+}
+
+// TermRows is list of TermRow.
+type TermRows []TermRow
+
+func (t TermRows) toDomain() domain.Terms {
+	list := make(domain.Terms, 0, len(t))
+
+	for _, row := range t {
+		list = append(list, row.toDomain())
+	}
+
+	return list
+}
+
+// HolidayRow is row of holiday.
+type HolidayRow struct {
+	ID             uuid.UUID `db:"id"`
+	AcademicYearID uuid.UUID `db:"academic_year_id"`
+	Name           string    `db:"name"`
+	DateFrom       time.Time `db:"date_from"`
+	DateTill       time.Time `db:"date_till"`
+
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (h HolidayRow) toDomain() domain.Holiday {
+	return domain.Holiday{
+		ID:             h.ID,
+		AcademicYearID: h.AcademicYearID,
+		Name:           h.Name,
+		DateFrom:       h.DateFrom,
+		DateTill:       h.DateTill,
+		CreatedAt:      h.CreatedAt,
+	}
+}
+
+// HolidayRows is list of HolidayRow.
+type HolidayRows []HolidayRow
+
+func (h HolidayRows) toDomain() domain.Holidays {
+	list := make(domain.Holidays, 0, len(h))
+
+	for _, row := range h {
+		list = append(list, row.toDomain())
+	}
+
+	return list
+}
+
+// CreateAcademicYearTx creates a new academic year with its terms and holidays.
+// Lessons and marks of the school already placed within the terms are assigned to them.
+func (s School) CreateAcademicYearTx(ctx context.Context, year domain.AcademicYear) error {
+	var (
+		yearQuery = `
+			INSERT INTO academic_years
+				(id, school_id, name, date_from, date_till, created_at, updated_at)
+			VALUES
+				(:id, :school_id, :name, :date_from, :date_till, :created_at, :updated_at)`
+
+		termQuery = `
+			INSERT INTO terms
+				(id, academic_year_id, name, position, date_from, date_till, created_at, updated_at)
+			VALUES
+				(:id, :academic_year_id, :name, :position, :date_from, :date_till, :created_at, :updated_at)`
+
+		holidayQuery = `
+			INSERT INTO holidays
+				(id, academic_year_id, name, date_from, date_till, created_at)
+			VALUES
+				(:id, :academic_year_id, :name, :date_from, :date_till, :created_at)`
+
+		lessonsTermQuery = `
+			UPDATE
+				lessons
+			SET
+				term_id = t.id
+			FROM
+				terms AS t
+			WHERE
+				t.academic_year_id = :academic_year_id AND
+				lessons.school_id = :school_id AND
+				lessons.term_id IS NULL AND
+				CAST(lessons.start_time AS DATE) BETWEEN t.date_from AND t.date_till`
+
+		marksTermQuery = `
+			UPDATE
+				marks
+			SET
+				term_id = lessons.term_id
+			FROM
+				lessons
+			WHERE
+				lessons.id = marks.lesson_id AND
+				lessons.school_id = :school_id AND
+				lessons.term_id IS NOT NULL AND
+				marks.term_id IS NULL`
+	)
+
+	_, err := s.session(ctx).NamedExecContext(ctx, yearQuery, map[string]any{
+		"id":         year.ID,
+		"school_id":  year.SchoolID,
+		"name":       year.Name,
+		"date_from":  year.DateFrom,
+		"date_till":  year.DateTill,
+		"created_at": year.CreatedAt,
+		"updated_at": year.UpdatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to insert academic year: %w", err))
+	}
+
+	for _, term := range year.Terms {
+		_, err = s.session(ctx).NamedExecContext(ctx, termQuery, map[string]any{
+			"id":               term.ID,
+			"academic_year_id": term.AcademicYearID,
+			"name":             term.Name,
+			"position":         term.Position,
+			"date_from":        term.DateFrom,
+			"date_till":        term.DateTill,
+			"created_at":       term.CreatedAt,
+			"updated_at":       term.UpdatedAt,
+		})
+		if err != nil {
+			return handleError(fmt.Errorf("failed to insert term: %w", err))
+		}
+	}
+
+	for _, holiday := range year.Holidays {
+		_, err = s.session(ctx).NamedExecContext(ctx, holidayQuery, map[string]any{
+			"id":               holiday.ID,
+			"academic_year_id": holiday.AcademicYearID,
+			"name":             holiday.Name,
+			"date_from":        holiday.DateFrom,
+			"date_till":        holiday.DateTill,
+			"created_at":       holiday.CreatedAt,
+		})
+		if err != nil {
+			return handleError(fmt.Errorf("failed to insert holiday: %w", err))
+		}
+	}
+
+	args := map[string]any{
+		"academic_year_id": year.ID,
+		"school_id":        year.SchoolID,
+	}
+
+	if _, err = s.session(ctx).NamedExecContext(ctx, lessonsTermQuery, args); err != nil {
+		return handleError(fmt.Errorf("failed to assign terms to lessons: %w", err))
+	}
+
+	if _, err = s.session(ctx).NamedExecContext(ctx, marksTermQuery, args); err != nil {
+		return handleError(fmt.Errorf("failed to assign terms to marks: %w", err))
+	}
+
+	return nil
+}
+
+// AcademicYearByIDTx returns academic year of the school by id with its terms and holidays.
+func (s School) AcademicYearByIDTx(ctx context.Context, id, schoolID uuid.UUID) (domain.AcademicYear, error) {
+	var (
+		sqlQuery = `
+			SELECT
+				id, school_id, name, date_from, date_till, created_at, updated_at, deleted_at
+			FROM
+				academic_years
+			WHERE
+				id = ? AND
+				school_id = ? AND
+				deleted_at IS NULL`
+
+		row AcademicYearRow
+	)
+
+	err := s.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), id, schoolID)
+	if err != nil {
+		return domain.AcademicYear{}, handleError(fmt.Errorf("failed to select academic year: %w", err))
+	}
+
+	years := domain.AcademicYears{row.toDomain()}
+
+	if err = s.setAcademicYearTerms(ctx, years); err != nil {
+		return domain.AcademicYear{}, err
+	}
+
+	return years[0], nil
+}
+
+// AcademicYearListTx returns academic years of the school with their terms and holidays.
+func (s School) AcademicYearListTx(ctx context.Context, schoolID uuid.UUID) (domain.AcademicYears, error) {
+	var (
+		sqlQuery = `
+			SELECT
+				id, school_id, name, date_from, date_till, created_at, updated_at, deleted_at
+			FROM
+				academic_years
+			WHERE
+				school_id = ? AND
+				deleted_at IS NULL
+			ORDER BY date_from DESC`
+
+		rows AcademicYearRows
+	)
+
+	err := s.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), schoolID)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select academic year list: %w", err))
+	}
+
+	years := rows.toDomain()
+
+	if err = s.setAcademicYearTerms(ctx, years); err != nil {
+		return nil, err
+	}
+
+	return years, nil
+}
+
+// TermByIDTx returns term of the school academic year by id.
+func (s School) TermByIDTx(ctx context.Context, id, schoolID uuid.UUID) (domain.Term, error) {
+	var (
+		sqlQuery = `
+			SELECT
+				t.id, t.academic_year_id, t.name, t.position, t.date_from, t.date_till,
+				t.created_at, t.updated_at, t.deleted_at
+			FROM
+				terms AS t
+			INNER JOIN
+				academic_years AS ay ON ay.id = t.academic_year_id
+			WHERE
+				t.id = ? AND
+				ay.school_id = ? AND
+				t.deleted_at IS NULL AND
+				ay.deleted_at IS NULL`
+
+		row TermRow
+	)
+
+	err := s.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), id, schoolID)
+	if err != nil {
+		return domain.Term{}, handleError(fmt.Errorf("failed to select term: %w", err))
+	}
+
+	return row.toDomain(), nil
+}
+
+// setAcademicYearTerms selects and sets terms and holidays to academic years.
+func (s School) setAcademicYearTerms(ctx context.Context, years domain.AcademicYears) error {
+	if len(years) == 0 {
+		return nil
+	}
+
+	var (
+		termsQuery = `
+			SELECT
+				id, academic_year_id, name, position, date_from, date_till, created_at, updated_at, deleted_at
+			FROM
+				terms
+			WHERE
+				academic_year_id IN (?) AND
+				deleted_at IS NULL
+			ORDER BY position`
+
+		holidaysQuery = `
+			SELECT
+				id, academic_year_id, name, date_from, date_till, created_at
+			FROM
+				holidays
+			WHERE
+				academic_year_id IN (?)
+			ORDER BY date_from`
+
+		terms    TermRows
+		holidays HolidayRows
+	)
+
+	query, params, err := sqlx.In(termsQuery, years.IDs())
+	if err != nil {
+		return handleError(fmt.Errorf("failed to prepare terms query: %w", err))
+	}
+
+	err = s.session(ctx).SelectContext(ctx, &terms, sqlx.Rebind(sqlx.DOLLAR, query), params...)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to select terms: %w", err))
+	}
+
+	query, params, err = sqlx.In(holidaysQuery, years.IDs())
+	if err != nil {
+		return handleError(fmt.Errorf("failed to prepare holidays query: %w", err))
+	}
+
+	err = s.session(ctx).SelectContext(ctx, &holidays, sqlx.Rebind(sqlx.DOLLAR, query), params...)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to select holidays: %w", err))
+	}
+
+	years.SetTerms(terms.toDomain(), holidays.toDomain())
+
+	return nil
+}
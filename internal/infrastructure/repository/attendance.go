@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"bum-service/internal/domain"
+)
+
+const (
+	// AttendancesLessonIDFKey is attendance lesson id foreign key.
+	AttendancesLessonIDFKey = "attendances_lesson_id_fkey"
+	// AttendancesStudentIDFKey is attendance student id foreign key.
+	AttendancesStudentIDFKey = "attendances_student_id_fkey"
+)
+
+// AttendanceRow is attendance row.
+type AttendanceRow struct {
+	ID          uuid.UUID `db:"id"`
+	LessonID    uuid.UUID `db:"lesson_id"`
+	StudentID   uuid.UUID `db:"student_id"`
+	Status      string    `db:"status"`
+	Description *string   `db:"description"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+// AttendanceRows is slice of AttendanceRow.
+type AttendanceRows []AttendanceRow
+
+func (a AttendanceRows) toDomain() domain.Attendances {
+	res := make(domain.Attendances, 0, len(a))
+
+	for _, row := range a {
+		res = append(res, row.toDomain())
+	}
+
+	return res
+}
+
+func (a AttendanceRow) toDomain() domain.Attendance {
+	return domain.Attendance{
+		ID:          a.ID,
+		LessonID:    a.LessonID,
+		StudentID:   a.StudentID,
+		Status:      domain.AttendanceStatus(a.Status),
+		Description: a.Description,
+
+		CreatedAt: a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+		DeletedAt: a.DeletedAt,
+	}
+}
+
+// GroupStudentIDsTx returns ids of the students who were in the group on any day of the period.
+func (l *Lesson) GroupStudentIDsTx(ctx context.Context, groupID uuid.UUID, from, till time.Time) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+
+	sqlQuery := `
+	SELECT DISTINCT
+		students.id 
+	FROM 
+		students 
+	INNER JOIN
+		student_group_memberships ON student_group_memberships.student_id = students.id
+	WHERE 
+		students.deleted_at IS NULL AND 
+		student_group_memberships.group_id = ? AND
+		student_group_memberships.date_from <= CAST(? AS DATE) AND
+		(student_group_memberships.date_till IS NULL OR student_group_memberships.date_till > CAST(? AS DATE));
+	`
+
+	err := l.session(ctx).SelectContext(ctx, &ids, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), groupID, till, from)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select group students: %w", err))
+	}
+
+	return ids, nil
+}
+
+// SetAttendancesTx inserts attendances or updates already recorded ones.
+func (l *Lesson) SetAttendancesTx(ctx context.Context, attendances domain.Attendances) error {
+	if len(attendances) == 0 {
+		return nil
+	}
+
+	sqlQuery := `
+	INSERT INTO attendances
+		( id, lesson_id, student_id, status, description, created_at, updated_at)
+	VALUES
+		(:id,:lesson_id,:student_id,:status,:description,:created_at,:updated_at)
+	ON CONFLICT (lesson_id, student_id) DO UPDATE SET
+		status      = EXCLUDED.status,
+		description = EXCLUDED.description,
+		updated_at  = EXCLUDED.updated_at,
+		deleted_at  = NULL
+	`
+
+	rows := make([]map[string]any, 0, len(attendances))
+
+	for _, attendance := range attendances {
+		rows = append(rows, map[string]any{
+			"id":          attendance.ID,
+			"lesson_id":   attendance.LessonID,
+			"student_id":  attendance.StudentID,
+			"status":      attendance.Status,
+			"description": attendance.Description,
+
+			"created_at": attendance.CreatedAt,
+			"updated_at": attendance.UpdatedAt,
+		})
+	}
+
+	_, err := l.session(ctx).NamedExecContext(ctx, sqlQuery, rows)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to upsert attendances: %w", err))
+	}
+
+	return nil
+}
+
+// LessonAttendancesTx returns attendances of the lesson.
+func (l *Lesson) LessonAttendancesTx(ctx context.Context, lessonID uuid.UUID) (domain.Attendances, error) {
+	rows := make(AttendanceRows, 0)
+
+	sqlQuery := `
+	SELECT 
+		id, lesson_id, student_id, status, description, created_at, updated_at, deleted_at 
+	FROM 
+		attendances 
+	WHERE 
+		deleted_at IS NULL AND 
+		lesson_id = ?
+	ORDER BY created_at;
+	`
+
+	err := l.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), lessonID)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select lesson attendances: %w", err))
+	}
+
+	return rows.toDomain(), nil
+}
+
+// AttendanceListTx returns attendances by filters ordered by lesson start time.
+func (l *Lesson) AttendanceListTx(ctx context.Context, filters domain.AttendanceFilters) (domain.Attendances, error) {
+	params, filtersQuery := attendanceListFilter(filters)
+
+	sqlQuery := `
+	SELECT 
+		attendances.id,
+		attendances.lesson_id,
+		attendances.student_id,
+		attendances.status,
+		attendances.description,
+
+		attendances.created_at,
+		attendances.updated_at,
+		attendances.deleted_at
+	FROM 
+		attendances
+	INNER JOIN
+		lessons ON lessons.id = attendances.lesson_id
+	INNER JOIN
+		group_subjects ON group_subjects.id = lessons.group_subject_id
+	` + where(filtersQuery) + `
+	ORDER BY lessons.start_time, attendances.created_at
+	`
+
+	rows := make(AttendanceRows, 0)
+
+	err := l.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), params...)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select attendance list: %w", err))
+	}
+
+	return rows.toDomain(), nil
+}
+
+// attendanceListFilter returns query by attendance list filter.
+func attendanceListFilter(filters domain.AttendanceFilters) (params []any, filtersQuery []string) {
+	filtersQuery = append(filtersQuery,
+		"attendances.deleted_at IS NULL",
+		"lessons.deleted_at IS NULL",
+		"lessons.school_id = ?",
+	)
+	params = append(params, filters.SchoolID)
+
+	if filters.GroupID != nil {
+		filtersQuery = append(filtersQuery, "group_subjects.group_id = ?")
+		params = append(params, filters.GroupID)
+	}
+
+	if filters.StudentID != nil {
+		filtersQuery = append(filtersQuery, "attendances.student_id = ?")
+		params = append(params, filters.StudentID)
+	}
+
+	if filters.Period.DateFrom != nil {
+		filtersQuery = append(filtersQuery, "lessons.start_time >= ?")
+		params = append(params, filters.Period.DateFrom)
+	}
+
+	if filters.Period.DateTill != nil {
+		filtersQuery = append(filtersQuery, "lessons.start_time < ?")
+		// add 1 day to include lessons of the last day.
+		params = append(params, filters.Period.DateTill.AddDate(0, 0, 1))
+	}
+
+	if filters.TermID != nil {
+		filtersQuery = append(filtersQuery, "lessons.term_id = ?")
+		params = append(params, filters.TermID)
+	}
+
+	return params, filtersQuery
+}
@@ -111,6 +111,31 @@ func (s School) AuditoriumByIDAndSchoolIDTx(ctx context.Context, id, schoolID uu
 	return auditorium.toDomain(), nil
 }
 
+// SchoolAuditoriumsTx gets all auditoriums of the school.
+func (s School) SchoolAuditoriumsTx(ctx context.Context, schoolID uuid.UUID) (domain.Auditoriums, error) {
+	var (
+		sqlQuery = `
+			SELECT 
+				id, school_id, name, school_subject_id, description, created_at, updated_at
+			FROM 
+				auditoriums
+			WHERE 
+				school_id = ? AND
+				deleted_at IS NULL
+			ORDER BY 
+				name`
+
+		auditoriums AuditoriumRows
+	)
+
+	err := s.session(ctx).SelectContext(ctx, &auditoriums, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), schoolID)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select school auditoriums: %w", err))
+	}
+
+	return auditoriums.toDomain(), nil
+}
+
 // AuditoriumListTx get school auditorium list.
 func (s School) AuditoriumListTx(
 	ctx context.Context, filters domain.AuditoriumListFilters,
@@ -124,11 +149,7 @@ func (s School) AuditoriumListTx(
 				auditoriums
 	` + where(filtersQuery)
 
-	sqlQuery += fmt.Sprintf(
-		` ORDER BY created_at %s 
-			LIMIT ? OFFSET ? `,
-		filters.SortOrder,
-	)
+	sqlQuery += auditoriumListColumns.orderBy(filters.ListFilter, "created_at") + ` LIMIT ? OFFSET ? `
 
 	params = append(params, filters.Limit, filters.Offset)
 
@@ -152,6 +173,11 @@ func (s School) AuditoriumListTx(
 	return schoolSubjectList.toDomain(), nil
 }
 
+// auditoriumListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var auditoriumListColumns = newListColumns("auditoriums", domain.AuditoriumListFields(), nil)
+
 func auditoriumListFilter(
 	filters domain.AuditoriumListFilters,
 ) (params []any, filtersQuery []string, anySlices bool) {
@@ -161,6 +187,10 @@ func auditoriumListFilter(
 	filtersQuery = append(filtersQuery, "school_id = ?")
 	params = append(params, filters.SchoolID)
 
+	params, filtersQuery, anySlices = auditoriumListColumns.appendConditions(
+		filters.ListFilter, params, filtersQuery, anySlices,
+	)
+
 	return params, filtersQuery, anySlices
 }
 
@@ -194,3 +224,88 @@ func (s School) AuditoriumListCountTx(ctx context.Context, filters domain.Audito
 
 	return count, nil
 }
+
+// AuditoriumDependentsTx returns the number of not deleted lessons in the auditorium which start after now.
+func (s School) AuditoriumDependentsTx(ctx context.Context, id uuid.UUID, now time.Time) (domain.Dependents, error) {
+	sqlQuery := `
+		SELECT
+			(
+				SELECT 
+					count(*) 
+				FROM 
+					lessons 
+				WHERE 
+					auditorium_id = $1 AND 
+					start_time > $2 AND 
+					deleted_at IS NULL
+			) AS lessons`
+
+	list, err := dependents(ctx, s.session(ctx), sqlQuery, id, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count auditorium dependents: %w", err)
+	}
+
+	return list, nil
+}
+
+// DeleteAuditoriumTx marks auditorium as deleted.
+func (s School) DeleteAuditoriumTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	deleted, err := softDelete(ctx, s.session(ctx), "auditoriums", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrAuditoriumNotFound
+	}
+
+	return nil
+}
+
+// RestoreAuditoriumTx restores deleted auditorium.
+func (s School) RestoreAuditoriumTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	restored, err := restore(ctx, s.session(ctx), "auditoriums", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !restored {
+		return domain.ErrAuditoriumNotFound
+	}
+
+	return nil
+}
+
+// UpdateAuditoriumTx updates auditorium.
+func (s School) UpdateAuditoriumTx(ctx context.Context, o domain.Auditorium, version time.Time) error {
+	var (
+		sqlQuery = `
+			UPDATE
+				auditoriums
+			SET
+				name              = :name,
+				school_subject_id = :school_subject_id,
+				description       = :description,
+				updated_at        = :updated_at
+			WHERE
+				id = :id AND
+				updated_at = :version AND
+				deleted_at IS NULL`
+
+		args = map[string]any{
+			"id":                o.ID,
+			"name":              o.Name,
+			"school_subject_id": o.SchoolSubjectID,
+			"description":       o.Description,
+			"updated_at":        o.UpdatedAt,
+			"version":           version,
+		}
+	)
+
+	result, err := s.session(ctx).NamedExecContext(ctx, sqlQuery, args)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update auditorium: %w", err))
+	}
+
+	return updatedWithVersion(result)
+}
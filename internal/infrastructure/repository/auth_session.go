@@ -0,0 +1,273 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"bum-service/internal/domain"
+)
+
+const (
+	// AuthSessionsUserIDFKey is auth session user id foreign key.
+	AuthSessionsUserIDFKey = "auth_sessions_user_id_fkey"
+	// AuthSessionsActiveRoleIDFKey is auth session active role id foreign key.
+	AuthSessionsActiveRoleIDFKey = "auth_sessions_active_role_id_fkey"
+)
+
+// AuthSessionRow is a row containing user auth session.
+type AuthSessionRow struct {
+	ID        uuid.UUID `db:"id"`
+	UserID    uuid.UUID `db:"user_id"`
+	TokenID   uuid.UUID `db:"token_id"`
+	UserAgent string    `db:"user_agent"`
+	IP        string    `db:"ip"`
+	ExpiresAt time.Time `db:"expires_at"`
+
+	ActiveRoleID *uuid.UUID `db:"active_role_id"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+}
+
+// toDomain converts to entity.
+func (a AuthSessionRow) toDomain() domain.AuthSession {
+	return domain.AuthSession{
+		ID:        a.ID,
+		UserID:    a.UserID,
+		TokenID:   a.TokenID,
+		UserAgent: a.UserAgent,
+		IP:        a.IP,
+		ExpiresAt: a.ExpiresAt,
+
+		ActiveRoleID: a.ActiveRoleID,
+
+		CreatedAt: a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+		RevokedAt: a.RevokedAt,
+	}
+}
+
+// AuthSessionRows is list of AuthSessionRow.
+type AuthSessionRows []AuthSessionRow
+
+// toDomain converts to entity.
+func (a AuthSessionRows) toDomain() domain.AuthSessions {
+	list := make(domain.AuthSessions, 0, len(a))
+
+	for _, row := range a {
+		list = append(list, row.toDomain())
+	}
+
+	return list
+}
+
+// AddAuthSessionTx creates a new user auth session.
+func (u *User) AddAuthSessionTx(ctx context.Context, session domain.AuthSession) error {
+	sqlQuery := `
+		INSERT INTO auth_sessions
+			( id, user_id, token_id, user_agent, ip, expires_at, created_at, updated_at )
+		VALUES
+			(:id,:user_id,:token_id,:user_agent,:ip,:expires_at,:created_at,:updated_at )`
+
+	_, err := u.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":         session.ID,
+		"user_id":    session.UserID,
+		"token_id":   session.TokenID,
+		"user_agent": session.UserAgent,
+		"ip":         session.IP,
+		"expires_at": session.ExpiresAt,
+
+		"created_at": session.CreatedAt,
+		"updated_at": session.UpdatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to insert auth session: %w", err))
+	}
+
+	return nil
+}
+
+// AuthSessionByIDTx returns user auth session by id.
+func (u *User) AuthSessionByIDTx(ctx context.Context, id uuid.UUID) (domain.AuthSession, error) {
+	var (
+		sqlQuery = `
+			SELECT
+				id, user_id, token_id, user_agent, ip, expires_at, active_role_id, created_at, updated_at, revoked_at
+			FROM
+				auth_sessions
+			WHERE
+				id = ?`
+
+		row AuthSessionRow
+	)
+
+	err := u.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), id)
+	if err != nil {
+		return domain.AuthSession{}, handleError(fmt.Errorf("failed to select auth session by id: %w", err))
+	}
+
+	return row.toDomain(), nil
+}
+
+// ActiveAuthSessionsTx returns not revoked and not expired auth sessions of the user.
+func (u *User) ActiveAuthSessionsTx(ctx context.Context, userID uuid.UUID, now time.Time) (domain.AuthSessions, error) {
+	var (
+		sqlQuery = `
+			SELECT
+				id, user_id, token_id, user_agent, ip, expires_at, active_role_id, created_at, updated_at, revoked_at
+			FROM
+				auth_sessions
+			WHERE
+				user_id = ? AND
+				revoked_at IS NULL AND
+				expires_at > ?
+			ORDER BY
+				updated_at DESC`
+
+		rows AuthSessionRows
+	)
+
+	err := u.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), userID, now)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select auth sessions: %w", err))
+	}
+
+	return rows.toDomain(), nil
+}
+
+// RotateAuthSessionTx stores the new refresh token of the session if the token with previousTokenID
+// was not exchanged concurrently, otherwise ErrRefreshTokenReused is returned.
+func (u *User) RotateAuthSessionTx(
+	ctx context.Context, session domain.AuthSession, previousTokenID uuid.UUID,
+) error {
+	sqlQuery := `
+		UPDATE
+			auth_sessions
+		SET
+			token_id = :token_id,
+			expires_at = :expires_at,
+			updated_at = :updated_at
+		WHERE
+			id = :id AND
+			token_id = :previous_token_id AND
+			revoked_at IS NULL`
+
+	result, err := u.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":                session.ID,
+		"token_id":          session.TokenID,
+		"previous_token_id": previousTokenID,
+		"expires_at":        session.ExpiresAt,
+		"updated_at":        session.UpdatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to rotate auth session: %w", err))
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return handleError(fmt.Errorf("failed to rotate auth session: %w", err))
+	}
+
+	if affected == 0 {
+		return domain.ErrRefreshTokenReused
+	}
+
+	return nil
+}
+
+// UpdateAuthSessionActiveRoleTx stores the role selected for the active auth session.
+func (u *User) UpdateAuthSessionActiveRoleTx(ctx context.Context, session domain.AuthSession) error {
+	sqlQuery := `
+		UPDATE
+			auth_sessions
+		SET
+			active_role_id = :active_role_id,
+			updated_at = :updated_at
+		WHERE
+			id = :id AND
+			revoked_at IS NULL`
+
+	result, err := u.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":             session.ID,
+		"active_role_id": session.ActiveRoleID,
+		"updated_at":     session.UpdatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update auth session active role: %w", err))
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update auth session active role: %w", err))
+	}
+
+	if affected == 0 {
+		return domain.ErrInvalidToken
+	}
+
+	return nil
+}
+
+// RevokeAuthSessionTx revokes the auth session of the user.
+func (u *User) RevokeAuthSessionTx(ctx context.Context, userID, sessionID uuid.UUID, now time.Time) error {
+	sqlQuery := `
+		UPDATE
+			auth_sessions
+		SET
+			revoked_at = :revoked_at
+		WHERE
+			id = :id AND
+			user_id = :user_id AND
+			revoked_at IS NULL`
+
+	result, err := u.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":         sessionID,
+		"user_id":    userID,
+		"revoked_at": now,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to revoke auth session: %w", err))
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return handleError(fmt.Errorf("failed to revoke auth session: %w", err))
+	}
+
+	if affected == 0 {
+		return domain.ErrAuthSessionNotFound
+	}
+
+	return nil
+}
+
+// RevokeUserAuthSessionsTx revokes all auth sessions of the user except the kept one if it is set.
+func (u *User) RevokeUserAuthSessionsTx(
+	ctx context.Context, userID uuid.UUID, keptSessionID *uuid.UUID, now time.Time,
+) error {
+	sqlQuery := `
+		UPDATE
+			auth_sessions
+		SET
+			revoked_at = :revoked_at
+		WHERE
+			user_id = :user_id AND
+			(CAST(:kept_session_id AS UUID) IS NULL OR id <> :kept_session_id) AND
+			revoked_at IS NULL`
+
+	_, err := u.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"user_id":         userID,
+		"kept_session_id": keptSessionID,
+		"revoked_at":      now,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to revoke user auth sessions: %w", err))
+	}
+
+	return nil
+}
@@ -130,11 +130,7 @@ func (d *Director) DirectorListTx(ctx context.Context, filters domain.DirectorLi
 				directors
 		` + where(filtersQuery)
 
-	sqlQuery += fmt.Sprintf(
-		` ORDER BY created_at %s 
-			LIMIT ? OFFSET ? `,
-		filters.SortOrder,
-	)
+	sqlQuery += directorListColumns.orderBy(filters.ListFilter, "created_at") + ` LIMIT ? OFFSET ? `
 
 	params = append(params, filters.Limit, filters.Offset)
 
@@ -158,6 +154,11 @@ func (d *Director) DirectorListTx(ctx context.Context, filters domain.DirectorLi
 	return directorList.toDomain(), nil
 }
 
+// directorListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var directorListColumns = newListColumns("directors", domain.DirectorListFields(), nil)
+
 // directorListFilter returns query by director list filter.
 func directorListFilter(filters domain.DirectorListFilter) (params []any, filtersQuery []string, anySlices bool) {
 	filtersQuery = append(filtersQuery, "deleted_at IS NULL")
@@ -181,6 +182,10 @@ func directorListFilter(filters domain.DirectorListFilter) (params []any, filter
 		params = append(params, filters.SchoolIDs)
 	}
 
+	params, filtersQuery, anySlices = directorListColumns.appendConditions(
+		filters.ListFilter, params, filtersQuery, anySlices,
+	)
+
 	return params, filtersQuery, anySlices
 }
 
@@ -245,3 +250,35 @@ func (d *Director) DirectorByIDTx(
 
 	return row.toDomain(), nil
 }
+
+// UpdateDirectorTx updates director.
+func (d *Director) UpdateDirectorTx(ctx context.Context, o domain.Director, version time.Time) error {
+	var (
+		sqlQuery = `
+			UPDATE
+				directors
+			SET
+				phone      = :phone,
+				email      = :email,
+				updated_at = :updated_at
+			WHERE
+				id = :id AND
+				updated_at = :version AND
+				deleted_at IS NULL`
+
+		args = map[string]any{
+			"id":         o.ID,
+			"phone":      o.Phone,
+			"email":      o.Email,
+			"updated_at": o.UpdatedAt,
+			"version":    version,
+		}
+	)
+
+	result, err := d.session(ctx).NamedExecContext(ctx, sqlQuery, args)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update director: %w", err))
+	}
+
+	return updatedWithVersion(result)
+}
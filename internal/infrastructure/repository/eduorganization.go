@@ -120,7 +120,11 @@ func (s *EduOrganization) CreateEduOrganizationTx(ctx context.Context, o domain.
 }
 
 // UpdateEduOrganizationTx update educational organization by id.
-func (s *EduOrganization) UpdateEduOrganizationTx(ctx context.Context, o domain.EduOrganization) error {
+func (s *EduOrganization) UpdateEduOrganizationTx(
+	ctx context.Context,
+	o domain.EduOrganization,
+	version time.Time,
+) error {
 	var (
 		sqlQuery = `
 		UPDATE educational_organizations 
@@ -128,10 +132,11 @@ func (s *EduOrganization) UpdateEduOrganizationTx(ctx context.Context, o domain.
 			    name=:name, 
 			    logo=:logo, 
 			    description=:description,
-			    grade_standard_id=:grade_standard_id, 
 			    updated_at=:updated_at
 		WHERE
-		    id = :id`
+		    id = :id AND
+		    updated_at = :version AND
+		    deleted_at IS NULL`
 
 		args = map[string]any{
 			"id":          o.ID,
@@ -140,39 +145,47 @@ func (s *EduOrganization) UpdateEduOrganizationTx(ctx context.Context, o domain.
 			"description": o.Description,
 
 			"updated_at": o.UpdatedAt,
+			"version":    version,
 		}
 	)
 
-	_, err := s.session(ctx).NamedExecContext(ctx, sqlQuery, args)
+	result, err := s.session(ctx).NamedExecContext(ctx, sqlQuery, args)
 	if err != nil {
-		return handleError(fmt.Errorf("failed to insert educational organization: %w", err))
+		return handleError(fmt.Errorf("failed to update educational organization: %w", err))
 	}
 
-	return nil
+	return updatedWithVersion(result)
 }
 
 // EduOrganizationListTx returns a list of educational organizations.
 func (s *EduOrganization) EduOrganizationListTx(
 	ctx context.Context, filters domain.EduOrganizationFilters,
 ) (domain.EduOrganizations, error) {
+	params, filtersQuery, anySlices := eduOrganizationListFilter(filters)
+
 	var (
-		sqlQuery = fmt.Sprintf(`
+		sqlQuery = `
 			SELECT
 				id, name, logo, description, created_at, updated_at, deleted_at 
 			FROM
 				educational_organizations
-			WHERE
-				deleted_at IS NULL
-			ORDER BY 
-				created_at %s
-			LIMIT ? OFFSET ?`,
-			filters.SortOrder,
-		)
+			` + where(filtersQuery) +
+			eduOrganizationListColumns.orderBy(filters.ListFilter, "created_at") + ` LIMIT ? OFFSET ?`
 
 		rows EduOrganizationRows
+		err  error
 	)
 
-	err := s.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), filters.Limit, filters.Offset)
+	params = append(params, filters.Limit, filters.Offset)
+
+	if anySlices {
+		sqlQuery, params, err = sqlx.In(sqlQuery, params...)
+		if err != nil {
+			return nil, handleError(fmt.Errorf("failed to select a list of educational organizations: %w", err))
+		}
+	}
+
+	err = s.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), params...)
 	if err != nil {
 		return nil, handleError(fmt.Errorf("failed to select a list of educational organizations: %w", err))
 	}
@@ -180,6 +193,22 @@ func (s *EduOrganization) EduOrganizationListTx(
 	return rows.toDomain(), nil
 }
 
+// eduOrganizationListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var eduOrganizationListColumns = newListColumns(
+	"educational_organizations", domain.EduOrganizationListFields(), nil,
+)
+
+// eduOrganizationListFilter returns query by educational organization list filter.
+func eduOrganizationListFilter(
+	filters domain.EduOrganizationFilters,
+) (params []any, filtersQuery []string, anySlices bool) {
+	filtersQuery = append(filtersQuery, "deleted_at IS NULL")
+
+	return eduOrganizationListColumns.appendConditions(filters.ListFilter, params, filtersQuery, anySlices)
+}
+
 // EduOrganizationByIDTx get educational organization by id.
 func (s *EduOrganization) EduOrganizationByIDTx(ctx context.Context, id uuid.UUID) (domain.EduOrganization, error) {
 	var (
@@ -328,24 +357,76 @@ func (s *EduOrganization) EduOrganizationsShortInfoByIDsTx(
 }
 
 // EduOrganizationCountTx return educational organization count.
-func (s *EduOrganization) EduOrganizationCountTx(ctx context.Context) (int, error) {
+func (s *EduOrganization) EduOrganizationCountTx(
+	ctx context.Context, filters domain.EduOrganizationFilters,
+) (int, error) {
+	params, filtersQuery, anySlices := eduOrganizationListFilter(filters)
+
 	var (
 		sqlQuery = `
 			SELECT
 				count(*)
 			FROM
 				educational_organizations
-			WHERE
-				deleted_at IS NULL
-	`
+	` + where(filtersQuery)
 
 		count int
+		err   error
 	)
 
-	err := s.session(ctx).QueryRowxContext(ctx, sqlx.Rebind(sqlx.DOLLAR, sqlQuery)).Scan(&count)
+	if anySlices {
+		sqlQuery, params, err = sqlx.In(sqlQuery, params...)
+		if err != nil {
+			return 0, handleError(fmt.Errorf("failed to get educational organization count: %w", err))
+		}
+	}
+
+	err = s.session(ctx).QueryRowxContext(ctx, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), params...).Scan(&count)
 	if err != nil {
 		return 0, handleError(fmt.Errorf("failed to get educational organization count: %w", err))
 	}
 
 	return count, nil
 }
+
+// EduOrganizationDependentsTx returns the number of not deleted entities of the educational organization.
+func (s *EduOrganization) EduOrganizationDependentsTx(ctx context.Context, id uuid.UUID) (domain.Dependents, error) {
+	sqlQuery := `
+		SELECT
+			(SELECT count(*) FROM schools WHERE organization_id = $1 AND deleted_at IS NULL) AS schools`
+
+	list, err := dependents(ctx, s.session(ctx), sqlQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count educational organization dependents: %w", err)
+	}
+
+	return list, nil
+}
+
+// DeleteEduOrganizationTx marks educational organization as deleted.
+func (s *EduOrganization) DeleteEduOrganizationTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	deleted, err := softDelete(ctx, s.session(ctx), "educational_organizations", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrEduOrganizationNotFound
+	}
+
+	return nil
+}
+
+// RestoreEduOrganizationTx restores deleted educational organization.
+func (s *EduOrganization) RestoreEduOrganizationTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	restored, err := restore(ctx, s.session(ctx), "educational_organizations", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !restored {
+		return domain.ErrEduOrganizationNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"bum-service/internal/domain"
+)
+
+const (
+	// FinalGradesGroupSubjectIDFKey is final grade group subject id foreign key.
+	FinalGradesGroupSubjectIDFKey = "final_grades_group_subject_id_fkey"
+	// FinalGradesStudentIDFKey is final grade student id foreign key.
+	FinalGradesStudentIDFKey = "final_grades_student_id_fkey"
+	// FinalGradesPeriodCheck is final grade period check.
+	FinalGradesPeriodCheck = "final_grades_period_check"
+)
+
+// FinalGradeRow is final grade row.
+type FinalGradeRow struct {
+	ID             uuid.UUID  `db:"id"`
+	GroupSubjectID uuid.UUID  `db:"group_subject_id"`
+	StudentID      uuid.UUID  `db:"student_id"`
+	DateFrom       time.Time  `db:"date_from"`
+	DateTill       time.Time  `db:"date_till"`
+	Mark           string     `db:"mark"`
+	Weight         float64    `db:"weight"`
+	Average        float64    `db:"average"`
+	LockedAt       *time.Time `db:"locked_at"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+// FinalGradeRows is slice of FinalGradeRow.
+type FinalGradeRows []FinalGradeRow
+
+func (f FinalGradeRows) toDomain() domain.FinalGrades {
+	res := make(domain.FinalGrades, 0, len(f))
+
+	for _, row := range f {
+		res = append(res, domain.FinalGrade{
+			ID:             row.ID,
+			GroupSubjectID: row.GroupSubjectID,
+			StudentID:      row.StudentID,
+			DateFrom:       row.DateFrom,
+			DateTill:       row.DateTill,
+			Mark:           row.Mark,
+			Weight:         row.Weight,
+			Average:        row.Average,
+			LockedAt:       row.LockedAt,
+
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+			DeletedAt: row.DeletedAt,
+		})
+	}
+
+	return res
+}
+
+// GroupSubjectLessonsTx returns lessons of the group subject within the period ordered by start time.
+func (l *Lesson) GroupSubjectLessonsTx(
+	ctx context.Context,
+	groupSubjectID uuid.UUID,
+	from, till time.Time,
+) (domain.Lessons, error) {
+	rows := make(LessonRows, 0)
+
+	sqlQuery := `
+	SELECT 
+		id, school_id, group_subject_id, teacher_id, auditorium_id, start_time, end_time, 
+		description, template_slot_id, is_overridden, created_at, updated_at, deleted_at
+	FROM 
+		lessons
+	WHERE 
+		deleted_at IS NULL AND 
+		group_subject_id = ? AND 
+		start_time >= ? AND 
+		start_time < ?
+	ORDER BY start_time;
+	`
+
+	err := l.session(ctx).SelectContext(
+		ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), groupSubjectID, from, till.AddDate(0, 0, 1),
+	)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select group subject lessons: %w", err))
+	}
+
+	return rows.toDomain(), nil
+}
+
+// MarksByLessonIDsTx returns marks of the lessons.
+func (l *Lesson) MarksByLessonIDsTx(ctx context.Context, lessonIDs []uuid.UUID) (domain.Marks, error) {
+	if len(lessonIDs) == 0 {
+		return domain.Marks{}, nil
+	}
+
+	rows := make(MarkRows, 0)
+
+	sqlQuery, params, err := sqlx.In(`
+	SELECT 
+		id, lesson_id, student_id, mark, weight, description, created_at, updated_at, deleted_at 
+	FROM 
+		marks 
+	WHERE 
+		deleted_at IS NULL AND 
+		lesson_id IN (?)
+	ORDER BY created_at;
+	`, lessonIDs)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select marks by lesson ids: %w", err))
+	}
+
+	err = l.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), params...)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select marks by lesson ids: %w", err))
+	}
+
+	return rows.toDomain(), nil
+}
+
+// FinalGradesTx returns final grades of the group subject for exactly the period.
+func (l *Lesson) FinalGradesTx(
+	ctx context.Context,
+	groupSubjectID uuid.UUID,
+	from, till time.Time,
+) (domain.FinalGrades, error) {
+	rows := make(FinalGradeRows, 0)
+
+	sqlQuery := `
+	SELECT 
+		id, group_subject_id, student_id, date_from, date_till, mark, weight, average, locked_at,
+		created_at, updated_at, deleted_at
+	FROM 
+		final_grades
+	WHERE 
+		deleted_at IS NULL AND 
+		group_subject_id = ? AND 
+		date_from = ? AND 
+		date_till = ?;
+	`
+
+	err := l.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), groupSubjectID, from, till)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select final grades: %w", err))
+	}
+
+	return rows.toDomain(), nil
+}
+
+// IsFinalGradeLockedTx checks whether the student has a locked final grade of the group subject covering the date.
+func (l *Lesson) IsFinalGradeLockedTx(
+	ctx context.Context,
+	groupSubjectID, studentID uuid.UUID,
+	date time.Time,
+) (bool, error) {
+	var locked bool
+
+	sqlQuery := `
+	SELECT EXISTS (
+		SELECT 
+			1
+		FROM 
+			final_grades
+		WHERE 
+			deleted_at IS NULL AND 
+			locked_at IS NOT NULL AND 
+			group_subject_id = ? AND 
+			student_id = ? AND 
+			?::date BETWEEN date_from AND date_till
+	);
+	`
+
+	err := l.session(ctx).GetContext(
+		ctx, &locked, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), groupSubjectID, studentID, date,
+	)
+	if err != nil {
+		return false, handleError(fmt.Errorf("failed to check locked final grade: %w", err))
+	}
+
+	return locked, nil
+}
+
+// SetFinalGradesTx inserts final grades or updates already existing ones of the same period.
+func (l *Lesson) SetFinalGradesTx(ctx context.Context, grades domain.FinalGrades) error {
+	if len(grades) == 0 {
+		return nil
+	}
+
+	sqlQuery := `
+	INSERT INTO final_grades
+		( id, group_subject_id, student_id, date_from, date_till, mark, weight, average, locked_at,
+		  created_at, updated_at)
+	VALUES
+		(:id,:group_subject_id,:student_id,:date_from,:date_till,:mark,:weight,:average,:locked_at,
+		 :created_at,:updated_at)
+	ON CONFLICT (group_subject_id, student_id, date_from, date_till) DO UPDATE SET
+		mark       = EXCLUDED.mark,
+		weight     = EXCLUDED.weight,
+		average    = EXCLUDED.average,
+		locked_at  = EXCLUDED.locked_at,
+		updated_at = EXCLUDED.updated_at
+	`
+
+	rows := make([]map[string]any, 0, len(grades))
+
+	for _, grade := range grades {
+		rows = append(rows, map[string]any{
+			"id":               grade.ID,
+			"group_subject_id": grade.GroupSubjectID,
+			"student_id":       grade.StudentID,
+			"date_from":        grade.DateFrom,
+			"date_till":        grade.DateTill,
+			"mark":             grade.Mark,
+			"weight":           grade.Weight,
+			"average":          grade.Average,
+			"locked_at":        grade.LockedAt,
+
+			"created_at": grade.CreatedAt,
+			"updated_at": grade.UpdatedAt,
+		})
+	}
+
+	_, err := l.session(ctx).NamedExecContext(ctx, sqlQuery, rows)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to upsert final grades: %w", err))
+	}
+
+	return nil
+}
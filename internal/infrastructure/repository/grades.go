@@ -36,6 +36,8 @@ const (
 	GradeStandardsOrganizationIDFKey = "grade_standards_organization_id_fkey"
 	// GradesGradeStandardIDFKey is grades grade_standard_id foreign key.
 	GradesGradeStandardIDFKey = "grades_grade_standard_id_fkey"
+	// GradeStandardsGradingScaleIDFKey is grade standards grading_scale_id foreign key.
+	GradeStandardsGradingScaleIDFKey = "grade_standards_grading_scale_id_fkey"
 )
 
 // GradeStandardRows is list of GradeStandardRow.
@@ -59,6 +61,7 @@ type GradeStandardRow struct {
 	Name           string     `db:"name"`
 	EducationYears int8       `db:"education_years"`
 	Description    *string    `db:"description"`
+	GradingScaleID *uuid.UUID `db:"grading_scale_id"`
 
 	CreatedAt time.Time  `db:"created_at"`
 	UpdatedAt time.Time  `db:"updated_at"`
@@ -73,6 +76,7 @@ func (g GradeStandardRow) toDomain() domain.GradeStandard {
 		Name:           g.Name,
 		EducationYears: g.EducationYears,
 		Description:    g.Description,
+		GradingScaleID: g.GradingScaleID,
 		CreatedAt:      g.CreatedAt,
 		UpdatedAt:      g.UpdatedAt,
 		DeletedAt:      g.DeletedAt,
@@ -163,6 +167,7 @@ func (g *Grades) CreateGradeStandardTx(ctx context.Context, gradeStandard domain
 		Name:           gradeStandard.Name,
 		EducationYears: gradeStandard.EducationYears,
 		Description:    gradeStandard.Description,
+		GradingScaleID: gradeStandard.GradingScaleID,
 		CreatedAt:      gradeStandard.CreatedAt,
 		UpdatedAt:      gradeStandard.UpdatedAt,
 		DeletedAt:      gradeStandard.DeletedAt,
@@ -170,9 +175,9 @@ func (g *Grades) CreateGradeStandardTx(ctx context.Context, gradeStandard domain
 
 	sqlQuery := `
 		INSERT INTO grade_standards 
-			( id, organization_id, name, education_years, description, created_at, updated_at)
+			( id, organization_id, name, education_years, description, grading_scale_id, created_at, updated_at)
 		VALUES 
-			(:id,:organization_id,:name,:education_years,:description,:created_at,:updated_at)
+			(:id,:organization_id,:name,:education_years,:description,:grading_scale_id,:created_at,:updated_at)
 	`
 
 	_, err := g.session(ctx).NamedExecContext(ctx, sqlQuery, row)
@@ -210,7 +215,7 @@ func (g *Grades) GradeStandardByIDTx(ctx context.Context, id uuid.UUID) (domain.
 
 	sqlQuery := `
 	SELECT 
-	    id, organization_id, name, education_years, description, created_at, updated_at, deleted_at
+	    id, organization_id, name, education_years, description, grading_scale_id, created_at, updated_at, deleted_at
 	FROM 
 	    grade_standards 
 	WHERE 
@@ -226,16 +231,23 @@ func (g *Grades) GradeStandardByIDTx(ctx context.Context, id uuid.UUID) (domain.
 	return gradeStandard.toDomain(), nil
 }
 
+// gradeStandardListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var gradeStandardListColumns = newListColumns("grade_standards", domain.GradeStandardListFields(), nil)
+
 // gradeStandardListFilter returns query by grade standard list filter.
 func gradeStandardListFilter(
-	_ domain.GradeStandardListFilter,
-) (_ []any, filtersQuery []string, anySlices bool) {
+	filters domain.GradeStandardListFilter,
+) (params []any, filtersQuery []string, anySlices bool) {
 	filtersQuery = append(filtersQuery, "deleted_at IS NULL")
 	anySlices = false
 
-	// TODO: add filters here.
+	params, filtersQuery, anySlices = gradeStandardListColumns.appendConditions(
+		filters.ListFilter, params, filtersQuery, anySlices,
+	)
 
-	return []any{}, filtersQuery, anySlices
+	return params, filtersQuery, anySlices
 }
 
 // GradeStandardListTx returns list of grade standard by filter from database.
@@ -252,17 +264,13 @@ func (g *Grades) GradeStandardListTx(
 
 	sqlQuery := `
 		SELECT
-			id, organization_id, name, education_years, description, created_at, updated_at, deleted_at
+			id, organization_id, name, education_years, description, grading_scale_id, created_at, updated_at, deleted_at
 		FROM 
 			grade_standards 
 		` +
 		where(filtersQuery)
 
-	sqlQuery += fmt.Sprintf(
-		` ORDER BY created_at %s 
-			LIMIT ? OFFSET ? `,
-		filters.SortOrder,
-	)
+	sqlQuery += gradeStandardListColumns.orderBy(filters.ListFilter, "created_at") + ` LIMIT ? OFFSET ? `
 
 	params = append(params, filters.Limit, filters.Offset)
 
@@ -360,3 +368,39 @@ func (g *Grades) GradeByIDTx(ctx context.Context, id uuid.UUID) (domain.Grade, e
 
 	return grade.toDomain(), nil
 }
+
+// UpdateGradeStandardTx updates grade standard.
+func (g *Grades) UpdateGradeStandardTx(ctx context.Context, gradeStandard domain.GradeStandard, version time.Time) error {
+	var (
+		sqlQuery = `
+			UPDATE
+				grade_standards
+			SET
+				name             = :name,
+				education_years  = :education_years,
+				description      = :description,
+				grading_scale_id = :grading_scale_id,
+				updated_at       = :updated_at
+			WHERE
+				id = :id AND
+				updated_at = :version AND
+				deleted_at IS NULL`
+
+		args = map[string]any{
+			"id":               gradeStandard.ID,
+			"name":             gradeStandard.Name,
+			"education_years":  gradeStandard.EducationYears,
+			"description":      gradeStandard.Description,
+			"grading_scale_id": gradeStandard.GradingScaleID,
+			"updated_at":       gradeStandard.UpdatedAt,
+			"version":          version,
+		}
+	)
+
+	result, err := g.session(ctx).NamedExecContext(ctx, sqlQuery, args)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update grade standard: %w", err))
+	}
+
+	return updatedWithVersion(result)
+}
@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"bum-service/internal/domain"
+)
+
+const (
+	// GradingScalesNameUniqueKey is grading scales name unique key.
+	GradingScalesNameUniqueKey = "grading_scales_name_key"
+	// GradingScalesOrganizationIDFKey is grading scales organization_id foreign key.
+	GradingScalesOrganizationIDFKey = "grading_scales_organization_id_fkey"
+	// GradingScaleValuesValueKey is grading scale values unique key.
+	GradingScaleValuesValueKey = "grading_scale_values_value_key"
+)
+
+// GradingScaleRow is a row containing grading scale.
+type GradingScaleRow struct {
+	ID             uuid.UUID  `db:"id"`
+	OrganizationID *uuid.UUID `db:"organization_id"`
+	Name           string     `db:"name"`
+	Kind           string     `db:"kind"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+// toDomain converts an object into domain model.
+func (g GradingScaleRow) toDomain() domain.GradingScale {
+	return domain.GradingScale{
+		ID:             g.ID,
+		OrganizationID: g.OrganizationID,
+		Name:           g.Name,
+		Kind:           domain.GradingScaleKind(g.Kind),
+
+		CreatedAt: g.CreatedAt,
+		UpdatedAt: g.UpdatedAt,
+		DeletedAt: g.DeletedAt,
+	}
+}
+
+// GradingScaleRows is list of GradingScaleRow.
+type GradingScaleRows []GradingScaleRow
+
+// toDomain converts rows into domain model.
+func (g GradingScaleRows) toDomain() domain.GradingScales {
+	list := make(domain.GradingScales, 0, len(g))
+
+	for _, row := range g {
+		list = append(list, row.toDomain())
+	}
+
+	return list
+}
+
+// GradingScaleValueRow is a row containing grading scale value.
+type GradingScaleValueRow struct {
+	ID             uuid.UUID `db:"id"`
+	GradingScaleID uuid.UUID `db:"grading_scale_id"`
+	Value          string    `db:"value"`
+	Weight         float64   `db:"weight"`
+	Position       int       `db:"position"`
+}
+
+// GradingScaleValueRows is list of GradingScaleValueRow.
+type GradingScaleValueRows []GradingScaleValueRow
+
+// toDomain converts rows into domain model.
+func (g GradingScaleValueRows) toDomain() domain.GradingScaleValues {
+	list := make(domain.GradingScaleValues, 0, len(g))
+
+	for _, row := range g {
+		list = append(list, domain.GradingScaleValue{
+			ID:             row.ID,
+			GradingScaleID: row.GradingScaleID,
+			Value:          row.Value,
+			Weight:         row.Weight,
+			Position:       row.Position,
+		})
+	}
+
+	return list
+}
+
+// CreateGradingScaleTx creates a new grading scale with its values within a transaction session.
+func (g *Grades) CreateGradingScaleTx(ctx context.Context, scale domain.GradingScale) error {
+	sqlQuery := `
+		INSERT INTO grading_scales
+			( id, organization_id, name, kind, created_at, updated_at)
+		VALUES 
+			(:id,:organization_id,:name,:kind,:created_at,:updated_at)
+	`
+
+	_, err := g.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":              scale.ID,
+		"organization_id": scale.OrganizationID,
+		"name":            scale.Name,
+		"kind":            scale.Kind,
+
+		"created_at": scale.CreatedAt,
+		"updated_at": scale.UpdatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to insert grading scale: %w", err))
+	}
+
+	if len(scale.Values) == 0 {
+		return nil
+	}
+
+	values := make([]map[string]any, 0, len(scale.Values))
+
+	for _, value := range scale.Values {
+		values = append(values, map[string]any{
+			"id":               value.ID,
+			"grading_scale_id": value.GradingScaleID,
+			"value":            value.Value,
+			"weight":           value.Weight,
+			"position":         value.Position,
+		})
+	}
+
+	sqlQuery = `
+		INSERT INTO grading_scale_values
+			( id, grading_scale_id, value, weight, position)
+		VALUES 
+			(:id,:grading_scale_id,:value,:weight,:position)
+	`
+
+	_, err = g.session(ctx).NamedExecContext(ctx, sqlQuery, values)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to insert grading scale values: %w", err))
+	}
+
+	return nil
+}
+
+// GradingScaleByIDTx returns grading scale with its values by id.
+func (g *Grades) GradingScaleByIDTx(ctx context.Context, id uuid.UUID) (domain.GradingScale, error) {
+	var row GradingScaleRow
+
+	sqlQuery := `
+	SELECT 
+		id, organization_id, name, kind, created_at, updated_at, deleted_at
+	FROM 
+		grading_scales 
+	WHERE 
+		deleted_at IS NULL AND 
+		id = ?;
+	`
+
+	err := g.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), id)
+	if err != nil {
+		return domain.GradingScale{}, handleError(fmt.Errorf("failed to select grading scale by id: %w", err))
+	}
+
+	scales := domain.GradingScales{row.toDomain()}
+
+	if err = g.setGradingScaleValues(ctx, scales); err != nil {
+		return domain.GradingScale{}, err
+	}
+
+	return scales[0], nil
+}
+
+// GradingScaleBySchoolIDTx returns grading scale of the school grade standard,
+// the default one if grade standard has no grading scale.
+func (g *Grades) GradingScaleBySchoolIDTx(ctx context.Context, schoolID uuid.UUID) (domain.GradingScale, error) {
+	var scaleID uuid.UUID
+
+	sqlQuery := `
+	SELECT 
+		COALESCE(grade_standards.grading_scale_id, ?)
+	FROM 
+		schools
+	LEFT JOIN
+		grade_standards ON grade_standards.id = schools.grade_standard_id
+	WHERE 
+		schools.id = ?;
+	`
+
+	err := g.session(ctx).GetContext(
+		ctx, &scaleID, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), domain.DefaultGradingScaleID(), schoolID,
+	)
+	if err != nil {
+		return domain.GradingScale{}, handleError(fmt.Errorf("failed to select grading scale of school: %w", err))
+	}
+
+	return g.GradingScaleByIDTx(ctx, scaleID)
+}
+
+// GradingScaleListTx returns preset grading scales and the ones of the organization.
+func (g *Grades) GradingScaleListTx(ctx context.Context, organizationID *uuid.UUID) (domain.GradingScales, error) {
+	rows := make(GradingScaleRows, 0)
+
+	sqlQuery := `
+	SELECT 
+		id, organization_id, name, kind, created_at, updated_at, deleted_at
+	FROM 
+		grading_scales 
+	WHERE 
+		deleted_at IS NULL AND 
+		(organization_id IS NULL OR organization_id = ?)
+	ORDER BY organization_id NULLS FIRST, created_at;
+	`
+
+	err := g.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), organizationID)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select grading scale list: %w", err))
+	}
+
+	scales := rows.toDomain()
+
+	if err = g.setGradingScaleValues(ctx, scales); err != nil {
+		return nil, err
+	}
+
+	return scales, nil
+}
+
+// setGradingScaleValues selects values of the grading scales and sets them.
+func (g *Grades) setGradingScaleValues(ctx context.Context, scales domain.GradingScales) error {
+	if len(scales) == 0 {
+		return nil
+	}
+
+	rows := make(GradingScaleValueRows, 0)
+
+	sqlQuery, params, err := sqlx.In(`
+	SELECT 
+		id, grading_scale_id, value, weight, position
+	FROM 
+		grading_scale_values 
+	WHERE 
+		grading_scale_id IN (?)
+	ORDER BY position;
+	`, scales.IDs())
+	if err != nil {
+		return handleError(fmt.Errorf("failed to select grading scale values: %w", err))
+	}
+
+	err = g.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), params...)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to select grading scale values: %w", err))
+	}
+
+	scales.SetValues(rows.toDomain())
+
+	return nil
+}
@@ -36,6 +36,10 @@ const (
 	GroupsClassTeacherIDFKey = "groups_class_teacher_id_fkey"
 	// GroupsClassPresidentIDFKey is foreign key for groups to student.
 	GroupsClassPresidentIDFKey = "groups_class_president_id_fkey"
+	// GroupsAcademicYearIDFKey is foreign key for groups to academic year.
+	GroupsAcademicYearIDFKey = "groups_academic_year_id_fkey"
+	// GroupsPreviousGroupIDKey is unique key of the group promoted from the previous academic year group.
+	GroupsPreviousGroupIDKey = "groups_previous_group_id_key"
 )
 
 // GroupRow represents a row of Group.
@@ -45,6 +49,9 @@ type GroupRow struct {
 	Name     string    `db:"name"`
 	GradeID  uuid.UUID `db:"grade_id"`
 
+	AcademicYearID  *uuid.UUID `db:"academic_year_id"`
+	PreviousGroupID *uuid.UUID `db:"previous_group_id"`
+
 	ClassTeacherID         *uuid.UUID `db:"class_teacher_id"`
 	ClassPresidentID       *uuid.UUID `db:"class_president_id"`
 	DeputyClassPresidentID *uuid.UUID `db:"deputy_class_president_id"`
@@ -62,6 +69,9 @@ func (e GroupRow) toDomain() domain.Group {
 		Name:     e.Name,
 		GradeID:  e.GradeID,
 
+		AcademicYearID:  e.AcademicYearID,
+		PreviousGroupID: e.PreviousGroupID,
+
 		ClassTeacherID:         e.ClassTeacherID,
 		ClassPresidentID:       e.ClassPresidentID,
 		DeputyClassPresidentID: e.DeputyClassPresidentID,
@@ -91,9 +101,9 @@ func (g Group) CreateGroupTx(ctx context.Context, o domain.Group) error {
 	var (
 		sqlQuery = `
 			INSERT INTO groups
-	    		( id, school_id, name, grade_id, created_at, updated_at) 
+	    		( id, school_id, name, grade_id, academic_year_id, created_at, updated_at) 
 			VALUES 
-	    		(:id,:school_id,:name,:grade_id,:created_at,:updated_at)`
+	    		(:id,:school_id,:name,:grade_id,:academic_year_id,:created_at,:updated_at)`
 
 		args = map[string]any{
 			"id":        o.ID,
@@ -101,6 +111,8 @@ func (g Group) CreateGroupTx(ctx context.Context, o domain.Group) error {
 			"name":      o.Name,
 			"grade_id":  o.GradeID,
 
+			"academic_year_id": o.AcademicYearID,
+
 			"created_at": o.CreatedAt,
 			"updated_at": o.UpdatedAt,
 		}
@@ -119,7 +131,8 @@ func (g Group) GroupByIDTx(ctx context.Context, id uuid.UUID) (domain.Group, err
 	var (
 		getHeadmasterQuery = `
 			SELECT 
-	    		id, school_id, name, grade_id, class_teacher_id, class_president_id, created_at, updated_at, deleted_at 			
+	    		id, school_id, name, grade_id, academic_year_id, previous_group_id, 
+	    		class_teacher_id, class_president_id, created_at, updated_at, deleted_at
 			FROM 
 				groups
 			WHERE 
@@ -138,7 +151,7 @@ func (g Group) GroupByIDTx(ctx context.Context, id uuid.UUID) (domain.Group, err
 }
 
 // UpdateGroupTx updates group.
-func (g Group) UpdateGroupTx(ctx context.Context, group domain.Group) error {
+func (g Group) UpdateGroupTx(ctx context.Context, group domain.Group, version time.Time) error {
 	var (
 		sqlQuery = `
 			UPDATE 
@@ -155,6 +168,7 @@ func (g Group) UpdateGroupTx(ctx context.Context, group domain.Group) error {
 	    		updated_at 					=:updated_at 
 			WHERE
 			    id = :id AND
+			    updated_at = :version AND
 			    deleted_at IS NULL`
 
 		args = map[string]any{
@@ -168,15 +182,16 @@ func (g Group) UpdateGroupTx(ctx context.Context, group domain.Group) error {
 			"deputy_class_president_id": group.DeputyClassPresidentID,
 
 			"updated_at": group.UpdatedAt,
+			"version":    version,
 		}
 	)
 
-	_, err := g.session(ctx).NamedExecContext(ctx, sqlQuery, args)
+	result, err := g.session(ctx).NamedExecContext(ctx, sqlQuery, args)
 	if err != nil {
 		return handleError(fmt.Errorf("failed to update group by id: %w", err))
 	}
 
-	return nil
+	return updatedWithVersion(result)
 }
 
 // GroupsByIDsTx get groups by ids.
@@ -188,6 +203,8 @@ func (g Group) GroupsByIDsTx(ctx context.Context, ids []uuid.UUID) (domain.Group
 	    		school_id, 
 	    		name, 
 	    		grade_id, 
+	    		academic_year_id, 
+	    		previous_group_id, 
 	    		class_teacher_id, 
 	    		class_president_id, 
 	    		deputy_class_president_id, 
@@ -223,8 +240,10 @@ func (g Group) GroupsByIDsTx(ctx context.Context, ids []uuid.UUID) (domain.Group
 
 // GroupListTx get group list.
 func (g Group) GroupListTx(
-	ctx context.Context, schoolID uuid.UUID, _ domain.GroupFilters,
+	ctx context.Context, schoolID uuid.UUID, filters domain.GroupFilters,
 ) (domain.Groups, error) {
+	params, filtersQuery, anySlices := groupListFilter(schoolID, filters)
+
 	var (
 		getHeadmasterQuery = `
 			SELECT 
@@ -232,6 +251,8 @@ func (g Group) GroupListTx(
 	    		school_id, 
 	    		name, 
 	    		grade_id, 
+	    		academic_year_id, 
+	    		previous_group_id, 
 	    		class_teacher_id, 
 	    		class_president_id, 
 	    		deputy_class_president_id, 
@@ -241,16 +262,20 @@ func (g Group) GroupListTx(
 	    		deleted_at 			
 			FROM 
 				groups
-			WHERE 
-				school_id = ? AND
-				deleted_at IS NULL
-			ORDER BY 
-			    name`
+			` + where(filtersQuery) + groupListOrderBy(filters.ListFilter)
 
 		row GroupRows
+		err error
 	)
 
-	err := g.session(ctx).SelectContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, getHeadmasterQuery), schoolID)
+	if anySlices {
+		getHeadmasterQuery, params, err = sqlx.In(getHeadmasterQuery, params...)
+		if err != nil {
+			return domain.Groups{}, handleError(fmt.Errorf("failed to get group list by school_id: %w", err))
+		}
+	}
+
+	err = g.session(ctx).SelectContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, getHeadmasterQuery), params...)
 	if err != nil {
 		return domain.Groups{}, handleError(fmt.Errorf("failed to get group list by school_id: %w", err))
 	}
@@ -260,26 +285,117 @@ func (g Group) GroupListTx(
 
 // GroupListCountTx get group list count.
 func (g Group) GroupListCountTx(
-	ctx context.Context, schoolID uuid.UUID, _ domain.GroupFilters,
+	ctx context.Context, schoolID uuid.UUID, filters domain.GroupFilters,
 ) (int, error) {
+	params, filtersQuery, anySlices := groupListFilter(schoolID, filters)
+
 	var (
 		sqlQuery = `
 			SELECT
 				count(*)
 			FROM 
 				groups
-			WHERE 
-				school_id = ? AND
-				deleted_at IS NULL
-	`
+			` + where(filtersQuery)
 
 		count int
+		err   error
 	)
 
-	err := g.session(ctx).QueryRowxContext(ctx, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), schoolID).Scan(&count)
+	if anySlices {
+		sqlQuery, params, err = sqlx.In(sqlQuery, params...)
+		if err != nil {
+			return 0, handleError(fmt.Errorf("failed to get groups count by school_id: %w", err))
+		}
+	}
+
+	err = g.session(ctx).QueryRowxContext(ctx, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), params...).Scan(&count)
 	if err != nil {
 		return 0, handleError(fmt.Errorf("failed to get groups count by school_id: %w", err))
 	}
 
 	return count, nil
 }
+
+// groupListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var groupListColumns = newListColumns("groups", domain.GroupListFields(), nil)
+
+// groupListOrderBy returns ORDER BY clause of the group list, the groups are sorted by name by default and on ties.
+func groupListOrderBy(list domain.ListFilter) string {
+	if sort := groupListColumns.sort(list); sort != "" {
+		return " ORDER BY " + sort + ", name"
+	}
+
+	return " ORDER BY name"
+}
+
+// groupListFilter returns query by group list filter.
+func groupListFilter(
+	schoolID uuid.UUID, filters domain.GroupFilters,
+) (params []any, filtersQuery []string, anySlices bool) {
+	filtersQuery = append(filtersQuery, "school_id = ?", "deleted_at IS NULL")
+	params = append(params, schoolID)
+
+	if filters.AcademicYearID != nil {
+		filtersQuery = append(filtersQuery, "academic_year_id = ?")
+		params = append(params, filters.AcademicYearID)
+	}
+
+	return groupListColumns.appendConditions(filters.ListFilter, params, filtersQuery, anySlices)
+}
+
+// GroupDependentsTx returns the number of not deleted students of the group and of its lessons
+// which start after now.
+func (g Group) GroupDependentsTx(ctx context.Context, id uuid.UUID, now time.Time) (domain.Dependents, error) {
+	sqlQuery := `
+		SELECT
+			(SELECT count(*) FROM students WHERE group_id = $1 AND deleted_at IS NULL) AS students,
+			(
+				SELECT 
+					count(*) 
+				FROM 
+					lessons AS l
+				INNER JOIN 
+					group_subjects AS gs ON l.group_subject_id = gs.id
+				WHERE 
+					gs.group_id = $1 AND
+					l.start_time > $2 AND
+					l.deleted_at IS NULL
+			) AS lessons`
+
+	list, err := dependents(ctx, g.session(ctx), sqlQuery, id, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count group dependents: %w", err)
+	}
+
+	return list, nil
+}
+
+// DeleteGroupTx marks group as deleted.
+func (g Group) DeleteGroupTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	deleted, err := softDelete(ctx, g.session(ctx), "groups", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrGroupNotFound
+	}
+
+	return nil
+}
+
+// RestoreGroupTx restores deleted group.
+func (g Group) RestoreGroupTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	restored, err := restore(ctx, g.session(ctx), "groups", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !restored {
+		return domain.ErrGroupNotFound
+	}
+
+	return nil
+}
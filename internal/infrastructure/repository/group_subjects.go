@@ -156,3 +156,39 @@ func (g *GroupSubjects) GroupSubjectListTx(ctx context.Context, groupID uuid.UUI
 
 	return groupSubjectList.toDomain(), nil
 }
+
+// UpdateGroupSubjectTx updates the teacher and the number of the lessons of the group subject of the version.
+func (g *GroupSubjects) UpdateGroupSubjectTx(
+	ctx context.Context,
+	groupSubject domain.GroupSubject,
+	version time.Time,
+) error {
+	var (
+		sqlQuery = `
+			UPDATE
+				group_subjects
+			SET
+				teacher_id = :teacher_id,
+				count      = :count,
+				updated_at = :updated_at
+			WHERE
+				id = :id AND
+				updated_at = :version AND
+				deleted_at IS NULL`
+
+		args = map[string]any{
+			"id":         groupSubject.ID,
+			"teacher_id": groupSubject.TeacherID,
+			"count":      groupSubject.Count,
+			"updated_at": groupSubject.UpdatedAt,
+			"version":    version,
+		}
+	)
+
+	result, err := g.session(ctx).NamedExecContext(ctx, sqlQuery, args)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update group subject: %w", err))
+	}
+
+	return updatedWithVersion(result)
+}
@@ -145,11 +145,7 @@ func (h *Headmaster) HeadmasterListTx(
 				headmasters
 		` + where(filtersQuery)
 
-	sqlQuery += fmt.Sprintf(
-		` ORDER BY created_at %s 
-			LIMIT ? OFFSET ? `,
-		filters.SortOrder,
-	)
+	sqlQuery += headmasterListColumns.orderBy(filters.ListFilter, "created_at") + ` LIMIT ? OFFSET ? `
 
 	params = append(params, filters.Limit, filters.Offset)
 
@@ -173,6 +169,11 @@ func (h *Headmaster) HeadmasterListTx(
 	return headmasterList.toDomain(), nil
 }
 
+// headmasterListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var headmasterListColumns = newListColumns("headmasters", domain.HeadmasterListFields(), nil)
+
 // headmasterListFilter returns query by headmaster list filter.
 func headmasterListFilter(filters domain.HeadmasterListFilter) (params []any, filtersQuery []string, anySlices bool) {
 	filtersQuery = append(filtersQuery, "deleted_at IS NULL")
@@ -197,6 +198,10 @@ func headmasterListFilter(filters domain.HeadmasterListFilter) (params []any, fi
 		params = append(params, filters.SchoolIDs)
 	}
 
+	params, filtersQuery, anySlices = headmasterListColumns.appendConditions(
+		filters.ListFilter, params, filtersQuery, anySlices,
+	)
+
 	return params, filtersQuery, anySlices
 }
 
@@ -230,3 +235,35 @@ func (h *Headmaster) HeadmasterCountTx(ctx context.Context, filters domain.Headm
 
 	return count, nil
 }
+
+// UpdateHeadmasterTx updates headmaster.
+func (h *Headmaster) UpdateHeadmasterTx(ctx context.Context, o domain.Headmaster, version time.Time) error {
+	var (
+		sqlQuery = `
+			UPDATE
+				headmasters
+			SET
+				phone      = :phone,
+				email      = :email,
+				updated_at = :updated_at
+			WHERE
+				id = :id AND
+				updated_at = :version AND
+				deleted_at IS NULL`
+
+		args = map[string]any{
+			"id":         o.ID,
+			"phone":      o.Phone,
+			"email":      o.Email,
+			"updated_at": o.UpdatedAt,
+			"version":    version,
+		}
+	)
+
+	result, err := h.session(ctx).NamedExecContext(ctx, sqlQuery, args)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update headmaster: %w", err))
+	}
+
+	return updatedWithVersion(result)
+}
@@ -36,6 +36,8 @@ const (
 	LessonsTeacherIDFKey = "lessons_teacher_id_fkey"
 	// LessonsAuditoriumIDFKey is auditorium id foreign key.
 	LessonsAuditoriumIDFKey = "lessons_auditorium_id_fkey"
+	// LessonsTermIDFKey is term id foreign key.
+	LessonsTermIDFKey = "lessons_term_id_fkey"
 )
 
 // LessonRow is row containing lesson.
@@ -48,6 +50,9 @@ type LessonRow struct {
 	StartTime      time.Time  `db:"start_time"`
 	EndTime        time.Time  `db:"end_time"`
 	Description    *string    `db:"description"`
+	TermID         *uuid.UUID `db:"term_id"`
+	TemplateSlotID *uuid.UUID `db:"template_slot_id"`
+	Overridden     bool       `db:"is_overridden"`
 
 	CreatedAt time.Time  `db:"created_at"`
 	UpdatedAt time.Time  `db:"updated_at"`
@@ -67,6 +72,9 @@ func (l LessonRow) toDomain() domain.Lesson {
 		StartTime:      l.StartTime,
 		EndTime:        l.EndTime,
 		Description:    l.Description,
+		TermID:         l.TermID,
+		TemplateSlotID: l.TemplateSlotID,
+		Overridden:     l.Overridden,
 		CreatedAt:      l.CreatedAt,
 		UpdatedAt:      l.UpdatedAt,
 		DeletedAt:      l.DeletedAt,
@@ -87,8 +95,7 @@ func (l LessonRows) toDomain() domain.Lessons {
 func (l *Lesson) AssignsLessons(
 	ctx context.Context, groupID uuid.UUID, firstDayOfWeek, firstDayOfNextWeek time.Time, lessons domain.Lessons,
 ) error {
-	var (
-		deleteQuery = `
+	deleteQuery := `
 			DELETE FROM 
 			           lessons AS l
 			       USING 
@@ -100,20 +107,6 @@ func (l *Lesson) AssignsLessons(
 			    l.end_time < :first_day_of_next_week
 		`
 
-		insertQuery = `
-	INSERT INTO 
-			lessons
-		( 
-			id, school_id, group_subject_id, teacher_id, auditorium_id, 
-			start_time, end_time, description, created_at, updated_at
-		) 
-	VALUES 
-		(
-			:id,:school_id,:group_subject_id,:teacher_id,:auditorium_id,
-			:start_time,:end_time,:description,:created_at,:updated_at
-		)`
-	)
-
 	_, err := l.session(ctx).NamedExecContext(
 		ctx, deleteQuery,
 		map[string]any{
@@ -125,6 +118,39 @@ func (l *Lesson) AssignsLessons(
 		return handleError(fmt.Errorf("failed to remove group subjects : %w", err))
 	}
 
+	return l.insertLessons(ctx, lessons)
+}
+
+// insertLessons inserts lessons into database.
+// Term of the lesson is resolved by the lesson date among terms of the school academic years.
+func (l *Lesson) insertLessons(ctx context.Context, lessons domain.Lessons) error {
+	insertQuery := `
+	INSERT INTO 
+			lessons
+		( 
+			id, school_id, group_subject_id, teacher_id, auditorium_id, 
+			start_time, end_time, description, template_slot_id, is_overridden, created_at, updated_at, term_id
+		) 
+	VALUES 
+		(
+			:id,:school_id,:group_subject_id,:teacher_id,:auditorium_id,
+			:start_time,:end_time,:description,:template_slot_id,:is_overridden,:created_at,:updated_at,
+			(
+				SELECT 
+					t.id 
+				FROM 
+					terms AS t
+				INNER JOIN 
+					academic_years AS ay ON ay.id = t.academic_year_id
+				WHERE 
+					ay.school_id = :school_id AND 
+					CAST(:start_time AS DATE) BETWEEN t.date_from AND t.date_till AND 
+					t.deleted_at IS NULL AND 
+					ay.deleted_at IS NULL
+				LIMIT 1
+			)
+		)`
+
 	listOfLessonsInsertRows := make([]map[string]any, 0, len(lessons))
 
 	for _, lesson := range lessons {
@@ -137,13 +163,19 @@ func (l *Lesson) AssignsLessons(
 		lessonsInsertRow["start_time"] = lesson.StartTime
 		lessonsInsertRow["end_time"] = lesson.EndTime
 		lessonsInsertRow["description"] = lesson.Description
+		lessonsInsertRow["template_slot_id"] = lesson.TemplateSlotID
+		lessonsInsertRow["is_overridden"] = lesson.Overridden
 		lessonsInsertRow["created_at"] = lesson.CreatedAt
 		lessonsInsertRow["updated_at"] = lesson.UpdatedAt
 
 		listOfLessonsInsertRows = append(listOfLessonsInsertRows, lessonsInsertRow)
 	}
 
-	_, err = l.session(ctx).NamedExecContext(ctx, insertQuery, listOfLessonsInsertRows)
+	if len(listOfLessonsInsertRows) == 0 {
+		return nil
+	}
+
+	_, err := l.session(ctx).NamedExecContext(ctx, insertQuery, listOfLessonsInsertRows)
 	if err != nil {
 		return handleError(fmt.Errorf("failed to insert lessons : %w", err))
 	}
@@ -158,6 +190,12 @@ func (l *Lesson) LessonsListTx(
 ) (domain.Lessons, error) {
 	params, filtersQuery, anySlices := lessonsListFilter(filters)
 
+	page := newKeyset(lessonListColumns, "l.start_time", "l.id", filters.ListFilter)
+	if condition, pageParams, ok := page.filter(); ok {
+		filtersQuery = append(filtersQuery, condition)
+		params = append(params, pageParams...)
+	}
+
 	sqlQuery := `  
 		SELECT 
 			l.id, 
@@ -168,6 +206,9 @@ func (l *Lesson) LessonsListTx(
 			l.start_time, 
 			l.end_time, 
 			l.description, 
+			l.term_id, 
+			l.template_slot_id, 
+			l.is_overridden, 
 			l.created_at, 
 			l.updated_at
 		FROM 
@@ -176,13 +217,9 @@ func (l *Lesson) LessonsListTx(
 			group_subjects AS gs ON l.group_subject_id = gs.id
 		` + where(filtersQuery)
 
-	sqlQuery += fmt.Sprintf(
-		` ORDER BY l.start_time %s 
-			LIMIT ? OFFSET ? `,
-		filters.SortOrder,
-	)
-
-	params = append(params, filters.Limit, filters.Offset)
+	orderBy, pageParams := page.orderBy()
+	sqlQuery += orderBy
+	params = append(params, pageParams...)
 
 	var (
 		lessonsList LessonRows
@@ -201,9 +238,14 @@ func (l *Lesson) LessonsListTx(
 		return nil, handleError(fmt.Errorf("failed to select lessons list: %w", err))
 	}
 
-	return lessonsList.toDomain(), nil
+	return keysetRows(page, lessonsList).toDomain(), nil
 }
 
+// lessonListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var lessonListColumns = newListColumns("l", domain.LessonListFields(), nil)
+
 // lessonsListFilter returns query by headmaster list filter.
 func lessonsListFilter(filters domain.LessonsListFilter) (params []any, filtersQuery []string, anySlices bool) {
 	filtersQuery = append(filtersQuery, "l.deleted_at IS NULL")
@@ -232,8 +274,204 @@ func lessonsListFilter(filters domain.LessonsListFilter) (params []any, filtersQ
 
 	if filters.TeacherID != nil {
 		filtersQuery = append(filtersQuery, "l.teacher_id = ?")
-		params = append(params, filters.TeacherID, filters.TeacherID)
+		params = append(params, filters.TeacherID)
+	}
+
+	if filters.TermID != nil {
+		filtersQuery = append(filtersQuery, "l.term_id = ?")
+		params = append(params, filters.TermID)
 	}
 
+	params, filtersQuery, anySlices = lessonListColumns.appendConditions(
+		filters.ListFilter, params, filtersQuery, anySlices,
+	)
+
 	return params, filtersQuery, anySlices
 }
+
+// LessonByIDTx returns lesson by id from database.
+func (l *Lesson) LessonByIDTx(ctx context.Context, id uuid.UUID) (domain.Lesson, error) {
+	var (
+		sqlQuery = `
+			SELECT 
+				id, 
+				school_id, 
+				group_subject_id, 
+				teacher_id, 
+				auditorium_id, 
+				start_time, 
+				end_time, 
+				description, 
+				term_id, 
+				template_slot_id, 
+				is_overridden, 
+				created_at, 
+				updated_at,
+				deleted_at
+			FROM 
+				lessons
+			WHERE 
+				id = ? AND
+				deleted_at IS NULL`
+
+		row LessonRow
+	)
+
+	err := l.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), id)
+	if err != nil {
+		return domain.Lesson{}, handleError(fmt.Errorf("failed to select lesson by id: %w", err))
+	}
+
+	return row.toDomain(), nil
+}
+
+// BookedLessonsTx returns lessons of other groups which take place in the period
+// and are held by one of the teachers or in one of the auditoriums.
+func (l *Lesson) BookedLessonsTx(
+	ctx context.Context,
+	groupID uuid.UUID,
+	from, till time.Time,
+	teacherIDs, auditoriumIDs []uuid.UUID,
+) (domain.Lessons, error) {
+	if len(teacherIDs) == 0 {
+		// sqlx.In does not accept empty slices, there is no teacher with nil id.
+		teacherIDs = []uuid.UUID{uuid.Nil}
+	}
+
+	if len(auditoriumIDs) == 0 {
+		auditoriumIDs = []uuid.UUID{uuid.Nil}
+	}
+
+	sqlQuery := `
+		SELECT 
+			l.id, 
+			l.school_id, 
+			l.group_subject_id, 
+			l.teacher_id, 
+			l.auditorium_id, 
+			l.start_time, 
+			l.end_time, 
+			l.description, 
+			l.template_slot_id, 
+			l.is_overridden, 
+			l.created_at, 
+			l.updated_at
+		FROM 
+			lessons AS l
+		INNER JOIN 
+			group_subjects AS gs ON l.group_subject_id = gs.id
+		WHERE 
+			l.deleted_at IS NULL AND
+			gs.group_id <> ? AND
+			l.start_time < ? AND 
+			l.end_time > ? AND
+			(l.teacher_id IN (?) OR l.auditorium_id IN (?))
+		ORDER BY l.start_time`
+
+	sqlQuery, params, err := sqlx.In(sqlQuery, groupID, till, from, teacherIDs, auditoriumIDs)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to prepare booked lessons query: %w", err))
+	}
+
+	var lessonsList LessonRows
+
+	err = l.session(ctx).SelectContext(ctx, &lessonsList, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), params...)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select booked lessons: %w", err))
+	}
+
+	return lessonsList.toDomain(), nil
+}
+
+// TeacherLessonsOutsideSchoolTx returns lessons of the teachers in other schools which take place in the period.
+func (l *Lesson) TeacherLessonsOutsideSchoolTx(
+	ctx context.Context,
+	schoolID uuid.UUID,
+	from, till time.Time,
+	teacherIDs []uuid.UUID,
+) (domain.Lessons, error) {
+	if len(teacherIDs) == 0 {
+		return domain.Lessons{}, nil
+	}
+
+	sqlQuery := `
+		SELECT 
+			id, 
+			school_id, 
+			group_subject_id, 
+			teacher_id, 
+			auditorium_id, 
+			start_time, 
+			end_time, 
+			description, 
+			template_slot_id, 
+			is_overridden, 
+			created_at, 
+			updated_at
+		FROM 
+			lessons
+		WHERE 
+			deleted_at IS NULL AND
+			school_id <> ? AND
+			start_time < ? AND 
+			end_time > ? AND
+			teacher_id IN (?)
+		ORDER BY start_time`
+
+	sqlQuery, params, err := sqlx.In(sqlQuery, schoolID, till, from, teacherIDs)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to prepare teacher lessons query: %w", err))
+	}
+
+	var lessonsList LessonRows
+
+	err = l.session(ctx).SelectContext(ctx, &lessonsList, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), params...)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select teacher lessons outside school: %w", err))
+	}
+
+	return lessonsList.toDomain(), nil
+}
+
+// LessonDependentsTx returns the number of not deleted marks and attendances of the lesson.
+func (l *Lesson) LessonDependentsTx(ctx context.Context, id uuid.UUID) (domain.Dependents, error) {
+	sqlQuery := `
+		SELECT
+			(SELECT count(*) FROM marks WHERE lesson_id = $1 AND deleted_at IS NULL) AS marks,
+			(SELECT count(*) FROM attendances WHERE lesson_id = $1 AND deleted_at IS NULL) AS attendances`
+
+	list, err := dependents(ctx, l.session(ctx), sqlQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count lesson dependents: %w", err)
+	}
+
+	return list, nil
+}
+
+// DeleteLessonTx marks lesson as deleted.
+func (l *Lesson) DeleteLessonTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	deleted, err := softDelete(ctx, l.session(ctx), "lessons", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrLessonNotFound
+	}
+
+	return nil
+}
+
+// RestoreLessonTx restores deleted lesson.
+func (l *Lesson) RestoreLessonTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	restored, err := restore(ctx, l.session(ctx), "lessons", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !restored {
+		return domain.ErrLessonNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/postgres"
+	"bum-service/pkg/transaction"
+)
+
+// FailedLoginAttemptsUserIDFKey is failed login attempt user id foreign key.
+const FailedLoginAttemptsUserIDFKey = "failed_login_attempts_user_id_fkey"
+
+// LoginAttempt is a repository of failed logins.
+type LoginAttempt struct {
+	db      postgres.DB
+	session func(context.Context) postgres.DB
+}
+
+// NewLoginAttempt creates a new login attempt repository instance.
+func NewLoginAttempt(db postgres.DB, session transaction.SessionDB) *LoginAttempt {
+	return &LoginAttempt{
+		db:      db,
+		session: session.DB,
+	}
+}
+
+// LoginFailuresRow is a row containing failed logins of an account or an IP address.
+type LoginFailuresRow struct {
+	Key          string     `db:"key"`
+	Failures     int        `db:"failures"`
+	LastFailedAt time.Time  `db:"last_failed_at"`
+	LockedUntil  *time.Time `db:"locked_until"`
+}
+
+// toDomain converts to entity.
+func (l LoginFailuresRow) toDomain() domain.LoginFailures {
+	return domain.LoginFailures{
+		Key:          l.Key,
+		Failures:     l.Failures,
+		LastFailedAt: l.LastFailedAt,
+		LockedUntil:  l.LockedUntil,
+	}
+}
+
+// LoginFailures returns failed logins by the key.
+func (l *LoginAttempt) LoginFailures(ctx context.Context, key string) (domain.LoginFailures, error) {
+	var (
+		sqlQuery = `
+			SELECT
+				key, failures, last_failed_at, locked_until
+			FROM
+				login_failures
+			WHERE
+				key = ?`
+
+		row LoginFailuresRow
+	)
+
+	err := l.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), key)
+	if err != nil {
+		return domain.LoginFailures{}, handleError(fmt.Errorf("failed to select login failures: %w", err))
+	}
+
+	return row.toDomain(), nil
+}
+
+// loginFailuresRestarted is the condition the stored failures are counted from the start by,
+// it's the same as in domain.LoginFailures.Fail.
+const loginFailuresRestarted = `(
+	f.failures = 0 OR f.last_failed_at < :forget_before OR f.locked_until <= :last_failed_at
+)`
+
+// AddLoginFailure counts a failed login by the key in one statement, so concurrent failures are not lost,
+// and returns the failed logins after it.
+func (l *LoginAttempt) AddLoginFailure(
+	ctx context.Context, key string, policy domain.LoginThrottlePolicy, now time.Time,
+) (domain.LoginFailures, error) {
+	sqlQuery := `
+		INSERT INTO login_failures AS f
+			( key, failures, last_failed_at, locked_until )
+		VALUES
+			(:key,:failures,:last_failed_at,:locked_until )
+		ON CONFLICT (key) DO UPDATE SET
+			failures = CASE WHEN ` + loginFailuresRestarted + ` THEN 1 ELSE f.failures + 1 END,
+			last_failed_at = EXCLUDED.last_failed_at,
+			locked_until = CASE
+				WHEN (
+					CASE WHEN ` + loginFailuresRestarted + ` THEN 1 ELSE f.failures + 1 END
+				) >= :lockout_after THEN :lockout_until
+				WHEN ` + loginFailuresRestarted + ` THEN NULL
+				ELSE f.locked_until
+			END
+		RETURNING
+			key, failures, last_failed_at, locked_until`
+
+	// the first failure of the key is inserted as is.
+	first := domain.NewLoginFailures(key)
+	first.Fail(policy, now)
+
+	var (
+		forgetBefore *time.Time
+		lockoutAfter *int
+		lockoutUntil = now.Add(policy.LockoutDuration)
+		row          LoginFailuresRow
+	)
+
+	if policy.Window > 0 {
+		windowStart := now.Add(-policy.Window)
+		forgetBefore = &windowStart
+	}
+
+	if policy.LockoutAfter > 0 {
+		lockoutAfter = &policy.LockoutAfter
+	}
+
+	query, params, err := sqlx.Named(sqlQuery, map[string]any{
+		"key":            first.Key,
+		"failures":       first.Failures,
+		"last_failed_at": first.LastFailedAt,
+		"locked_until":   first.LockedUntil,
+		"forget_before":  forgetBefore,
+		"lockout_after":  lockoutAfter,
+		"lockout_until":  lockoutUntil,
+	})
+	if err != nil {
+		return domain.LoginFailures{}, handleError(fmt.Errorf("failed to add login failure: %w", err))
+	}
+
+	err = l.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, query), params...)
+	if err != nil {
+		return domain.LoginFailures{}, handleError(fmt.Errorf("failed to add login failure: %w", err))
+	}
+
+	return row.toDomain(), nil
+}
+
+// DeleteLoginFailures forgets failed logins by the key.
+func (l *LoginAttempt) DeleteLoginFailures(ctx context.Context, key string) error {
+	sqlQuery := `DELETE FROM login_failures WHERE key = ?`
+
+	_, err := l.session(ctx).ExecContext(ctx, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), key)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to delete login failures: %w", err))
+	}
+
+	return nil
+}
+
+// AddFailedLoginAttempt stores an audit record of a failed login.
+func (l *LoginAttempt) AddFailedLoginAttempt(ctx context.Context, attempt domain.FailedLoginAttempt) error {
+	sqlQuery := `
+		INSERT INTO failed_login_attempts
+			( id, email, user_id, ip, user_agent, reason, created_at )
+		VALUES
+			(:id,:email,:user_id,:ip,:user_agent,:reason,:created_at )`
+
+	_, err := l.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":         attempt.ID,
+		"email":      attempt.Email,
+		"user_id":    attempt.UserID,
+		"ip":         attempt.IP,
+		"user_agent": attempt.UserAgent,
+		"reason":     attempt.Reason,
+		"created_at": attempt.CreatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to insert failed login attempt: %w", err))
+	}
+
+	return nil
+}
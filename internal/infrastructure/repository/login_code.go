@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"bum-service/internal/domain"
+)
+
+// LoginCodesUserIDFKey is login code user id foreign key.
+const LoginCodesUserIDFKey = "login_codes_user_id_fkey"
+
+// LoginCodeRow is a row containing one-time login code.
+type LoginCodeRow struct {
+	ID        uuid.UUID  `db:"id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	Phone     string     `db:"phone"`
+	CodeHash  string     `db:"code_hash"`
+	Attempts  int        `db:"attempts"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// toDomain converts to entity.
+func (l LoginCodeRow) toDomain() domain.LoginCode {
+	return domain.LoginCode{
+		ID:        l.ID,
+		UserID:    l.UserID,
+		Phone:     l.Phone,
+		CodeHash:  l.CodeHash,
+		Attempts:  l.Attempts,
+		ExpiresAt: l.ExpiresAt,
+		UsedAt:    l.UsedAt,
+
+		CreatedAt: l.CreatedAt,
+	}
+}
+
+// AddLoginCodeTx creates a new one-time login code.
+func (u *User) AddLoginCodeTx(ctx context.Context, code domain.LoginCode) error {
+	sqlQuery := `
+		INSERT INTO login_codes
+			( id, user_id, phone, code_hash, attempts, expires_at, created_at )
+		VALUES
+			(:id,:user_id,:phone,:code_hash,:attempts,:expires_at,:created_at )`
+
+	_, err := u.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":         code.ID,
+		"user_id":    code.UserID,
+		"phone":      code.Phone,
+		"code_hash":  code.CodeHash,
+		"attempts":   code.Attempts,
+		"expires_at": code.ExpiresAt,
+		"created_at": code.CreatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to insert login code: %w", err))
+	}
+
+	return nil
+}
+
+// LastLoginCodeByPhoneTx returns the latest login code sent to the phone.
+func (u *User) LastLoginCodeByPhoneTx(ctx context.Context, phone string) (domain.LoginCode, error) {
+	var (
+		sqlQuery = `
+			SELECT
+				id, user_id, phone, code_hash, attempts, expires_at, used_at, created_at
+			FROM
+				login_codes
+			WHERE
+				phone = ?
+			ORDER BY
+				created_at DESC
+			LIMIT 1`
+
+		row LoginCodeRow
+	)
+
+	err := u.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), phone)
+	if err != nil {
+		return domain.LoginCode{}, handleError(fmt.Errorf("failed to select login code by phone: %w", err))
+	}
+
+	return row.toDomain(), nil
+}
+
+// UpdateLoginCodeTx stores attempts and usage of the login code if it was not tried concurrently
+// since previousAttempts, otherwise ErrInvalidLoginCode is returned.
+func (u *User) UpdateLoginCodeTx(ctx context.Context, code domain.LoginCode, previousAttempts int) error {
+	sqlQuery := `
+		UPDATE
+			login_codes
+		SET
+			attempts = :attempts,
+			used_at = :used_at
+		WHERE
+			id = :id AND
+			attempts = :previous_attempts AND
+			used_at IS NULL`
+
+	result, err := u.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":                code.ID,
+		"attempts":          code.Attempts,
+		"previous_attempts": previousAttempts,
+		"used_at":           code.UsedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update login code: %w", err))
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update login code: %w", err))
+	}
+
+	if affected == 0 {
+		return domain.ErrInvalidLoginCode
+	}
+
+	return nil
+}
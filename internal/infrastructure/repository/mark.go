@@ -18,15 +18,19 @@ const (
 	MarksLessonIDFKey = "marks_lesson_id_fkey"
 	// MarksLessonKey is mark lesson key.
 	MarksLessonKey = "marks_lesson_key"
+	// MarksTermIDFKey is mark term id foreign key.
+	MarksTermIDFKey = "marks_term_id_fkey"
 )
 
 // MarkRow is mark row.
 type MarkRow struct {
-	ID          uuid.UUID `db:"id"`
-	LessonID    uuid.UUID `db:"lesson_id"`
-	StudentID   uuid.UUID `db:"student_id"`
-	Mark        string    `db:"mark"`
-	Description *string   `db:"description"`
+	ID          uuid.UUID  `db:"id"`
+	LessonID    uuid.UUID  `db:"lesson_id"`
+	StudentID   uuid.UUID  `db:"student_id"`
+	Mark        string     `db:"mark"`
+	Weight      float64    `db:"weight"`
+	Description *string    `db:"description"`
+	TermID      *uuid.UUID `db:"term_id"`
 
 	CreatedAt time.Time  `db:"created_at"`
 	UpdatedAt time.Time  `db:"updated_at"`
@@ -52,7 +56,9 @@ func (m MarkRow) toDomain() domain.Mark {
 		LessonID:    m.LessonID,
 		StudentID:   m.StudentID,
 		Mark:        m.Mark,
+		Weight:      m.Weight,
 		Description: m.Description,
+		TermID:      m.TermID,
 
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
@@ -65,9 +71,9 @@ func (l *Lesson) AddMark(ctx context.Context, m domain.Mark) error {
 	var (
 		sqlQuery = `
 			INSERT INTO marks
-				( id, lesson_id, student_id, mark, description, created_at, updated_at) 
+				( id, lesson_id, student_id, mark, weight, description, term_id, created_at, updated_at) 
 			VALUES
-				(:id,:lesson_id,:student_id,:mark,:description,:created_at,:updated_at) 
+				(:id,:lesson_id,:student_id,:mark,:weight,:description,:term_id,:created_at,:updated_at) 
 `
 
 		args = map[string]any{
@@ -75,7 +81,9 @@ func (l *Lesson) AddMark(ctx context.Context, m domain.Mark) error {
 			"lesson_id":   m.LessonID,
 			"student_id":  m.StudentID,
 			"mark":        m.Mark,
+			"weight":      m.Weight,
 			"description": m.Description,
+			"term_id":     m.TermID,
 
 			"created_at": m.CreatedAt,
 			"updated_at": m.UpdatedAt,
@@ -96,7 +104,7 @@ func (l *Lesson) MarkByIDTx(ctx context.Context, id uuid.UUID) (domain.Mark, err
 
 	sqlQuery := `
 	SELECT 
-		id, lesson_id, student_id, mark, description, created_at, updated_at, deleted_at 
+		id, lesson_id, student_id, mark, weight, description, term_id, created_at, updated_at, deleted_at 
 	FROM 
 	    marks 
 	WHERE 
@@ -111,3 +119,65 @@ func (l *Lesson) MarkByIDTx(ctx context.Context, id uuid.UUID) (domain.Mark, err
 
 	return mark.toDomain(), nil
 }
+
+// DeleteMarkTx marks mark as deleted.
+func (l *Lesson) DeleteMarkTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	deleted, err := softDelete(ctx, l.session(ctx), "marks", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrMarkNotFound
+	}
+
+	return nil
+}
+
+// RestoreMarkTx restores deleted mark.
+func (l *Lesson) RestoreMarkTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	restored, err := restore(ctx, l.session(ctx), "marks", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !restored {
+		return domain.ErrMarkNotFound
+	}
+
+	return nil
+}
+
+// UpdateMarkTx updates mark.
+func (l *Lesson) UpdateMarkTx(ctx context.Context, m domain.Mark, version time.Time) error {
+	var (
+		sqlQuery = `
+			UPDATE
+				marks
+			SET
+				mark        = :mark,
+				weight      = :weight,
+				description = :description,
+				updated_at  = :updated_at
+			WHERE
+				id = :id AND
+				updated_at = :version AND
+				deleted_at IS NULL`
+
+		args = map[string]any{
+			"id":          m.ID,
+			"mark":        m.Mark,
+			"weight":      m.Weight,
+			"description": m.Description,
+			"updated_at":  m.UpdatedAt,
+			"version":     version,
+		}
+	)
+
+	result, err := l.session(ctx).NamedExecContext(ctx, sqlQuery, args)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update mark: %w", err))
+	}
+
+	return updatedWithVersion(result)
+}
@@ -166,11 +166,7 @@ func (o *Owner) OwnerListTx(ctx context.Context, filters domain.OwnerListFilter)
 				owners
 		` + where(filtersQuery)
 
-	sqlQuery += fmt.Sprintf(
-		` ORDER BY created_at %s 
-			LIMIT ? OFFSET ? `,
-		filters.SortOrder,
-	)
+	sqlQuery += ownerListColumns.orderBy(filters.ListFilter, "created_at") + ` LIMIT ? OFFSET ? `
 
 	params = append(params, filters.Limit, filters.Offset)
 
@@ -194,6 +190,11 @@ func (o *Owner) OwnerListTx(ctx context.Context, filters domain.OwnerListFilter)
 	return ownersList.toDomain(), nil
 }
 
+// ownerListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var ownerListColumns = newListColumns("owners", domain.OwnerListFields(), nil)
+
 // ownerListFilter returns query by owner list filter.
 func ownerListFilter(filters domain.OwnerListFilter) (params []any, filtersQuery []string, anySlices bool) {
 	filtersQuery = append(filtersQuery, "deleted_at IS NULL")
@@ -217,6 +218,10 @@ func ownerListFilter(filters domain.OwnerListFilter) (params []any, filtersQuery
 		params = append(params, filters.OrganizationIDs)
 	}
 
+	params, filtersQuery, anySlices = ownerListColumns.appendConditions(
+		filters.ListFilter, params, filtersQuery, anySlices,
+	)
+
 	return params, filtersQuery, anySlices
 }
 
@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"bum-service/internal/domain"
+)
+
+const (
+	// StudentPromotionsKey is unique key of the student promotion to the academic year.
+	StudentPromotionsKey = "student_promotions_key"
+	// StudentPromotionsAcademicYearIDFKey is student promotion academic year id foreign key.
+	StudentPromotionsAcademicYearIDFKey = "student_promotions_academic_year_id_fkey"
+)
+
+// PromotableGroupsTx returns groups of the school academic year locked for promotion,
+// groups without academic year are returned when academic year is nil.
+func (g Group) PromotableGroupsTx(
+	ctx context.Context, schoolID uuid.UUID, academicYearID *uuid.UUID,
+) (domain.Groups, error) {
+	var (
+		sqlQuery = `
+			SELECT
+	    		id, school_id, name, grade_id, academic_year_id, previous_group_id,
+	    		class_teacher_id, class_president_id, deputy_class_president_id,
+	    		created_at, updated_at, deleted_at
+			FROM
+				groups`
+
+		filtersQuery = []string{"school_id = ?", "deleted_at IS NULL"}
+		params       = []any{schoolID}
+		rows         GroupRows
+	)
+
+	if academicYearID != nil {
+		filtersQuery = append(filtersQuery, "academic_year_id = ?")
+		params = append(params, academicYearID)
+	} else {
+		filtersQuery = append(filtersQuery, "academic_year_id IS NULL")
+	}
+
+	sqlQuery += where(filtersQuery) + ` ORDER BY name FOR UPDATE`
+
+	err := g.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), params...)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select groups for promotion: %w", err))
+	}
+
+	return rows.toDomain(), nil
+}
+
+// GroupStudentsTx returns students of the groups.
+func (g Group) GroupStudentsTx(ctx context.Context, groupIDs []uuid.UUID) (domain.Students, error) {
+	var (
+		sqlQuery = `
+			SELECT
+				students.id,
+				students.role_id,
+				students.user_id,
+				students.group_id,
+				groups.school_id,
+
+				students.created_at,
+				students.updated_at,
+				students.deleted_at
+			FROM
+				students
+			INNER JOIN
+				groups ON students.group_id = groups.id
+			WHERE
+				students.group_id IN (?) AND
+				students.deleted_at IS NULL`
+
+		rows StudentRows
+	)
+
+	if len(groupIDs) == 0 {
+		return domain.Students{}, nil
+	}
+
+	sqlQuery, params, err := sqlx.In(sqlQuery, groupIDs)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to prepare group students query: %w", err))
+	}
+
+	err = g.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), params...)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select group students: %w", err))
+	}
+
+	return rows.toDomain(), nil
+}
+
+// PromoteGroupsTx creates next year groups, moves promoted and held back students into them,
+// deletes graduated students with their roles and stores promotion of every student.
+func (g Group) PromoteGroupsTx(ctx context.Context, promotions domain.GroupPromotions) error {
+	var (
+		groupQuery = `
+			INSERT INTO groups
+				(
+					id, school_id, name, grade_id, academic_year_id, previous_group_id,
+					class_teacher_id, class_president_id, deputy_class_president_id, created_at, updated_at
+				)
+			VALUES
+				(
+					:id, :school_id, :name, :grade_id, :academic_year_id, :previous_group_id,
+					:class_teacher_id, :class_president_id, :deputy_class_president_id, :created_at, :updated_at
+				)`
+
+		moveStudentQuery = `
+			UPDATE
+				students
+			SET
+				group_id = :group_id,
+				updated_at = :updated_at
+			WHERE
+				id = :id AND
+				deleted_at IS NULL`
+
+		// memberships are switched on the first day of the academic year.
+		closeMembershipQuery = `
+			UPDATE
+				student_group_memberships
+			SET
+				date_till = GREATEST(date_from, (SELECT date_from FROM academic_years WHERE id = :academic_year_id)),
+				updated_at = :updated_at
+			WHERE
+				student_id = :student_id AND
+				date_till IS NULL`
+
+		openMembershipQuery = `
+			INSERT INTO student_group_memberships
+				(id, student_id, group_id, date_from, created_at, updated_at)
+			VALUES
+				(
+					gen_random_uuid(), :student_id, :group_id,
+					GREATEST(
+						(SELECT date_from FROM academic_years WHERE id = :academic_year_id),
+						(SELECT MAX(date_till) FROM student_group_memberships WHERE student_id = :student_id)
+					),
+					:created_at, :created_at
+				)`
+
+		promotionQuery = `
+			INSERT INTO student_promotions
+				(id, student_id, from_group_id, to_group_id, academic_year_id, status, created_at)
+			VALUES
+				(:id, :student_id, :from_group_id, :to_group_id, :academic_year_id, :status, :created_at)`
+	)
+
+	for _, group := range promotions.NewGroups() {
+		_, err := g.session(ctx).NamedExecContext(ctx, groupQuery, map[string]any{
+			"id":                        group.ID,
+			"school_id":                 group.SchoolID,
+			"name":                      group.Name,
+			"grade_id":                  group.GradeID,
+			"academic_year_id":          group.AcademicYearID,
+			"previous_group_id":         group.PreviousGroupID,
+			"class_teacher_id":          group.ClassTeacherID,
+			"class_president_id":        group.ClassPresidentID,
+			"deputy_class_president_id": group.DeputyClassPresidentID,
+			"created_at":                group.CreatedAt,
+			"updated_at":                group.UpdatedAt,
+		})
+		if err != nil {
+			return handleError(fmt.Errorf("failed to insert promoted group: %w", err))
+		}
+	}
+
+	studentPromotions := promotions.StudentPromotions()
+	rows := make([]map[string]any, 0, len(studentPromotions))
+
+	for _, promotion := range studentPromotions {
+		if err := g.switchMembership(ctx, promotion, closeMembershipQuery, openMembershipQuery); err != nil {
+			return err
+		}
+
+		if promotion.ToGroupID != nil {
+			_, err := g.session(ctx).NamedExecContext(ctx, moveStudentQuery, map[string]any{
+				"id":         promotion.StudentID,
+				"group_id":   promotion.ToGroupID,
+				"updated_at": promotion.CreatedAt,
+			})
+			if err != nil {
+				return handleError(fmt.Errorf("failed to move promoted student: %w", err))
+			}
+		}
+
+		if promotion.Status == domain.Graduated {
+			if _, err := softDelete(ctx, g.session(ctx), "students", promotion.StudentID, promotion.CreatedAt); err != nil {
+				return err
+			}
+
+			if err := deleteEntityRole(ctx, g.session(ctx), "students", promotion.StudentID, promotion.CreatedAt); err != nil {
+				return err
+			}
+		}
+
+		rows = append(rows, map[string]any{
+			"id":               promotion.ID,
+			"student_id":       promotion.StudentID,
+			"from_group_id":    promotion.FromGroupID,
+			"to_group_id":      promotion.ToGroupID,
+			"academic_year_id": promotion.AcademicYearID,
+			"status":           promotion.Status,
+			"created_at":       promotion.CreatedAt,
+		})
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	if _, err := g.session(ctx).NamedExecContext(ctx, promotionQuery, rows); err != nil {
+		return handleError(fmt.Errorf("failed to insert student promotions: %w", err))
+	}
+
+	return nil
+}
+
+// switchMembership closes group membership of the student,
+// promoted and held back students join the next year group.
+func (g Group) switchMembership(
+	ctx context.Context, promotion domain.StudentPromotion, closeQuery, openQuery string,
+) error {
+	args := map[string]any{
+		"student_id":       promotion.StudentID,
+		"group_id":         promotion.ToGroupID,
+		"academic_year_id": promotion.AcademicYearID,
+		"created_at":       promotion.CreatedAt,
+		"updated_at":       promotion.CreatedAt,
+	}
+
+	if _, err := g.session(ctx).NamedExecContext(ctx, closeQuery, args); err != nil {
+		return handleError(fmt.Errorf("failed to close student group membership: %w", err))
+	}
+
+	if promotion.ToGroupID == nil {
+		return nil
+	}
+
+	if _, err := g.session(ctx).NamedExecContext(ctx, openQuery, args); err != nil {
+		return handleError(fmt.Errorf("failed to open student group membership: %w", err))
+	}
+
+	return nil
+}
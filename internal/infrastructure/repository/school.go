@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -134,8 +135,8 @@ func (s School) CreateSchoolTx(ctx context.Context, o domain.School) error {
 	return nil
 }
 
-// UpdateSchoolTx creates a new school.
-func (s School) UpdateSchoolTx(ctx context.Context, o domain.School) error {
+// UpdateSchoolTx updates school.
+func (s School) UpdateSchoolTx(ctx context.Context, o domain.School, version time.Time) error {
 	var (
 		sqlQuery = `
 			UPDATE 
@@ -151,6 +152,7 @@ func (s School) UpdateSchoolTx(ctx context.Context, o domain.School) error {
 			    updated_at =:updated_at 
 			WHERE 
 			    id =:id AND 
+			    updated_at =:version AND
 			    deleted_at IS NULL
 	`
 		args = map[string]any{
@@ -163,15 +165,16 @@ func (s School) UpdateSchoolTx(ctx context.Context, o domain.School) error {
 
 			"created_at": o.CreatedAt,
 			"updated_at": o.UpdatedAt,
+			"version":    version,
 		}
 	)
 
-	_, err := s.session(ctx).NamedExecContext(ctx, sqlQuery, args)
+	result, err := s.session(ctx).NamedExecContext(ctx, sqlQuery, args)
 	if err != nil {
 		return handleError(fmt.Errorf("failed to update school: %w", err))
 	}
 
-	return nil
+	return updatedWithVersion(result)
 }
 
 // SchoolByIDTx get a school by id.
@@ -227,11 +230,7 @@ func (s School) SchoolListTx(ctx context.Context, filters domain.SchoolFilters)
 				schools
 	` + where(filtersQuery)
 
-	sqlQuery += fmt.Sprintf(
-		` ORDER BY created_at %s 
-			LIMIT ? OFFSET ? `,
-		filters.SortOrder,
-	)
+	sqlQuery += schoolListColumns.orderBy(filters.ListFilter, "created_at") + ` LIMIT ? OFFSET ? `
 
 	params = append(params, filters.Limit, filters.Offset)
 
@@ -255,6 +254,11 @@ func (s School) SchoolListTx(ctx context.Context, filters domain.SchoolFilters)
 	return schoolList.toDomain(), nil
 }
 
+// schoolListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var schoolListColumns = newListColumns("schools", domain.SchoolListFields(), nil)
+
 func schoolsListFilter(filters domain.SchoolFilters) (params []any, filtersQuery []string, anySlices bool) {
 	filtersQuery = append(filtersQuery, "deleted_at IS NULL")
 	anySlices = false
@@ -280,6 +284,34 @@ func schoolsListFilter(filters domain.SchoolFilters) (params []any, filtersQuery
 		params = append(params, filters.OrganizationIDs)
 	}
 
+	if !filters.Scope.Unscoped {
+		scope := make([]string, 0, 2)
+
+		if len(filters.Scope.SchoolIDs) > 0 {
+			anySlices = true
+
+			scope = append(scope, "id IN (?)")
+			params = append(params, filters.Scope.SchoolIDs)
+		}
+
+		if len(filters.Scope.OrganizationIDs) > 0 {
+			anySlices = true
+
+			scope = append(scope, "organization_id IN (?)")
+			params = append(params, filters.Scope.OrganizationIDs)
+		}
+
+		if len(scope) == 0 {
+			scope = append(scope, "FALSE")
+		}
+
+		filtersQuery = append(filtersQuery, "("+strings.Join(scope, " OR ")+")")
+	}
+
+	params, filtersQuery, anySlices = schoolListColumns.appendConditions(
+		filters.ListFilter, params, filtersQuery, anySlices,
+	)
+
 	return params, filtersQuery, anySlices
 }
 
@@ -373,6 +405,49 @@ func (s School) SchoolShortByIDsTx(ctx context.Context, ids []uuid.UUID) (domain
 	return schoolList.toShortDomain(), nil
 }
 
+// SchoolDependentsTx returns the number of not deleted entities of the school.
+func (s School) SchoolDependentsTx(ctx context.Context, id uuid.UUID) (domain.Dependents, error) {
+	sqlQuery := `
+		SELECT
+			(SELECT count(*) FROM groups WHERE school_id = $1 AND deleted_at IS NULL) AS groups,
+			(SELECT count(*) FROM teachers WHERE school_id = $1 AND deleted_at IS NULL) AS teachers`
+
+	list, err := dependents(ctx, s.session(ctx), sqlQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count school dependents: %w", err)
+	}
+
+	return list, nil
+}
+
+// DeleteSchoolTx marks school as deleted.
+func (s School) DeleteSchoolTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	deleted, err := softDelete(ctx, s.session(ctx), "schools", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrSchoolNotFound
+	}
+
+	return nil
+}
+
+// RestoreSchoolTx restores deleted school.
+func (s School) RestoreSchoolTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	restored, err := restore(ctx, s.session(ctx), "schools", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !restored {
+		return domain.ErrSchoolNotFound
+	}
+
+	return nil
+}
+
 // SchoolSubjectRow is a row of school subject.
 type SchoolSubjectRow struct {
 	ID          uuid.UUID `db:"id"`
@@ -504,6 +579,65 @@ func (s School) SchoolSubjectsByIDs(ctx context.Context, ids []uuid.UUID) (domai
 	return schoolSubjectList.toDomain(), nil
 }
 
+// SchoolSubjectsBySchoolIDTx get all school subjects of the school.
+func (s School) SchoolSubjectsBySchoolIDTx(ctx context.Context, schoolID uuid.UUID) (domain.SchoolSubjects, error) {
+	var (
+		sqlQuery = `
+			SELECT 
+				id, subject_id, school_id, name, description, created_at, updated_at
+			FROM	
+				school_subjects
+			WHERE 
+				school_id = ? AND 
+				deleted_at IS NULL
+			ORDER BY
+				created_at`
+
+		schoolSubjectList = make(SchoolSubjectRows, 0)
+	)
+
+	err := s.session(ctx).SelectContext(ctx, &schoolSubjectList, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), schoolID)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select school subjects by school id: %w", err))
+	}
+
+	return schoolSubjectList.toDomain(), nil
+}
+
+// UpdateSchoolSubjectTx updates the school subject of the version.
+func (s School) UpdateSchoolSubjectTx(ctx context.Context, o domain.SchoolSubject, version time.Time) error {
+	var (
+		sqlQuery = `
+			UPDATE
+				school_subjects
+			SET
+				subject_id  = :subject_id,
+				name        = :name,
+				description = :description,
+				updated_at  = :updated_at
+			WHERE
+				id = :id AND
+				updated_at = :version AND
+				deleted_at IS NULL`
+
+		args = map[string]any{
+			"id":          o.ID,
+			"subject_id":  o.SubjectID,
+			"name":        o.Name,
+			"description": o.Description,
+			"updated_at":  o.UpdatedAt,
+			"version":     version,
+		}
+	)
+
+	result, err := s.session(ctx).NamedExecContext(ctx, sqlQuery, args)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update school subject: %w", err))
+	}
+
+	return updatedWithVersion(result)
+}
+
 // SchoolSubjectListTx get school subject list.
 func (s School) SchoolSubjectListTx(
 	ctx context.Context, filters domain.SchoolSubjectFilters,
@@ -517,11 +651,7 @@ func (s School) SchoolSubjectListTx(
 				school_subjects
 	` + where(filtersQuery)
 
-	sqlQuery += fmt.Sprintf(
-		` ORDER BY created_at %s 
-			LIMIT ? OFFSET ? `,
-		filters.SortOrder,
-	)
+	sqlQuery += schoolSubjectListColumns.orderBy(filters.ListFilter, "created_at") + ` LIMIT ? OFFSET ? `
 
 	params = append(params, filters.Limit, filters.Offset)
 
@@ -545,6 +675,11 @@ func (s School) SchoolSubjectListTx(
 	return schoolSubjectList.toDomain(), nil
 }
 
+// schoolSubjectListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var schoolSubjectListColumns = newListColumns("school_subjects", domain.SchoolSubjectListFields(), nil)
+
 func schoolsSubjectListFilter(
 	filters domain.SchoolSubjectFilters,
 ) (params []any, filtersQuery []string, anySlices bool) {
@@ -554,6 +689,10 @@ func schoolsSubjectListFilter(
 	filtersQuery = append(filtersQuery, "school_id = ?")
 	params = append(params, filters.SchoolID)
 
+	params, filtersQuery, anySlices = schoolSubjectListColumns.appendConditions(
+		filters.ListFilter, params, filtersQuery, anySlices,
+	)
+
 	return params, filtersQuery, anySlices
 }
 
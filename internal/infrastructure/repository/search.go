@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"bum-service/internal/domain"
+)
+
+// searchSources are the tables of the school entities found by the search of their users,
+// the entity is aliased as hits and its school is joined by the school id column.
+//
+//nolint:gochecknoglobals // it's list of tables
+var searchSources = []struct {
+	hitType  domain.SearchHitType
+	from     string
+	schoolID string
+}{
+	{
+		hitType:  domain.SearchHitStudent,
+		from:     "students AS hits INNER JOIN groups ON groups.id = hits.group_id",
+		schoolID: "groups.school_id",
+	},
+	{hitType: domain.SearchHitTeacher, from: "teachers AS hits", schoolID: "hits.school_id"},
+	{hitType: domain.SearchHitGuardian, from: "student_guardians AS hits", schoolID: "hits.school_id"},
+	{hitType: domain.SearchHitDirector, from: "directors AS hits", schoolID: "hits.school_id"},
+	{hitType: domain.SearchHitHeadmaster, from: "headmasters AS hits", schoolID: "hits.school_id"},
+}
+
+// SearchHitRow is a search hit row from database.
+type SearchHitRow struct {
+	Type     string    `db:"type"`
+	ID       uuid.UUID `db:"id"`
+	UserID   uuid.UUID `db:"user_id"`
+	SchoolID uuid.UUID `db:"school_id"`
+
+	FirstName  string  `db:"first_name"`
+	LastName   string  `db:"last_name"`
+	MiddleName *string `db:"middle_name"`
+
+	Score float64 `db:"score"`
+}
+
+// toDomain converts an object into a domain model.
+func (e SearchHitRow) toDomain() domain.SearchHit {
+	return domain.SearchHit{
+		Type:     domain.SearchHitType(e.Type),
+		ID:       e.ID,
+		UserID:   e.UserID,
+		SchoolID: e.SchoolID,
+
+		FirstName:  e.FirstName,
+		LastName:   e.LastName,
+		MiddleName: e.MiddleName,
+
+		Score: e.Score,
+	}
+}
+
+// SearchHitRows is a list of SearchHitRow.
+type SearchHitRows []SearchHitRow
+
+func (s SearchHitRows) toDomain() domain.SearchHits {
+	list := make(domain.SearchHits, 0, len(s))
+
+	for index := range s {
+		list = append(list, s[index].toDomain())
+	}
+
+	return list
+}
+
+// SearchTx returns the school entities of the users found by the search, the most relevant first.
+func (u *User) SearchTx(ctx context.Context, filter domain.SearchFilter) (domain.SearchHits, error) {
+	var (
+		queries   = make([]string, 0, len(searchSources))
+		params    []any
+		anySlices bool
+	)
+
+	for _, source := range searchSources {
+		if !filter.Types.Has(source.hitType) {
+			continue
+		}
+
+		condition, searchParams := searchCondition(filter.Query)
+
+		filtersQuery := []string{
+			"hits.deleted_at IS NULL",
+			"users.deleted_at IS NULL",
+			"schools.deleted_at IS NULL",
+			condition,
+		}
+
+		scopeQuery, scopeParams := searchScope(filter)
+		if scopeQuery != "" {
+			filtersQuery = append(filtersQuery, scopeQuery)
+			anySlices = anySlices || len(scopeParams) > 0
+		}
+
+		queries = append(queries, `
+			SELECT
+				CAST(? AS TEXT) AS type,
+				hits.id,
+				hits.user_id,
+				schools.id AS school_id,
+				users.first_name,
+				users.last_name,
+				users.middle_name,
+				`+searchScore+` AS score
+			FROM
+				`+source.from+`
+			INNER JOIN
+				schools ON schools.id = `+source.schoolID+`
+			INNER JOIN
+				users ON users.id = hits.user_id
+		`+where(filtersQuery))
+
+		params = append(params, string(source.hitType), filter.Query, filter.Query)
+		params = append(params, searchParams...)
+		params = append(params, scopeParams...)
+	}
+
+	if len(queries) == 0 {
+		return domain.SearchHits{}, nil
+	}
+
+	query := `SELECT * FROM (` + strings.Join(queries, " UNION ALL ") + `) AS hits ORDER BY score DESC, id LIMIT ?`
+	params = append(params, filter.Limit)
+
+	var (
+		list = make(SearchHitRows, 0)
+		err  error
+	)
+
+	if anySlices {
+		query, params, err = sqlx.In(query, params...)
+		if err != nil {
+			return nil, handleError(fmt.Errorf("failed to search users: %w", err))
+		}
+	}
+
+	err = u.session(ctx).SelectContext(ctx, &list, sqlx.Rebind(sqlx.DOLLAR, query), params...)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to search users: %w", err))
+	}
+
+	return list.toDomain(), nil
+}
+
+// searchScore is the relevance of the user found by the search, it takes the search query twice.
+const searchScore = `GREATEST(
+	ts_rank(users.search_vector, plainto_tsquery('simple', ?)),
+	word_similarity(?, users.search_text)
+)`
+
+// searchCondition returns the condition of the search of users by the normalised search query.
+// The whole words are found by the full-text search, the parts of the words, e.g. the part of the phone,
+// by the substring and the misspelled words by the trigram word similarity.
+func searchCondition(query string) (string, []any) {
+	condition := `(
+		users.search_vector @@ plainto_tsquery('simple', ?) OR
+		users.search_text LIKE ? OR
+		? <% users.search_text
+	)`
+
+	return condition, []any{query, "%" + escapeLike(query) + "%", query}
+}
+
+// searchScope returns the condition of the schools of the search, empty if all schools are searched.
+func searchScope(filter domain.SearchFilter) (string, []any) {
+	var (
+		conditions []string
+		params     []any
+	)
+
+	if filter.Unscoped {
+		return "", nil
+	}
+
+	if len(filter.SchoolIDs) > 0 {
+		conditions = append(conditions, "schools.id IN (?)")
+		params = append(params, filter.SchoolIDs)
+	}
+
+	if len(filter.OrganizationIDs) > 0 {
+		conditions = append(conditions, "schools.organization_id IN (?)")
+		params = append(params, filter.OrganizationIDs)
+	}
+
+	if len(conditions) == 0 {
+		return "FALSE", nil
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ")", params
+}
+
+// escapeLike escapes the wildcards of LIKE pattern, so the value is matched as is.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -210,6 +210,12 @@ func (s *Student) StudentsByUserIDTx(ctx context.Context, userID uuid.UUID) (dom
 func (s *Student) StudentListTx(ctx context.Context, filters domain.StudentListFilter) (domain.Students, error) {
 	params, filtersQuery, anySlices := studentListFilter(filters)
 
+	page := newKeyset(studentListColumns, "students.created_at", "students.id", filters.ListFilter)
+	if condition, pageParams, ok := page.filter(); ok {
+		filtersQuery = append(filtersQuery, condition)
+		params = append(params, pageParams...)
+	}
+
 	sqlQuery := `	
 			SELECT 
 				students.id,
@@ -227,15 +233,13 @@ func (s *Student) StudentListTx(ctx context.Context, filters domain.StudentListF
 				groups ON groups.id = students.group_id
 			INNER JOIN
 				schools ON schools.id = groups.school_id
+			INNER JOIN
+				users ON users.id = students.user_id
 		` + where(filtersQuery)
 
-	sqlQuery += fmt.Sprintf(
-		` ORDER BY students.created_at %s 
-			LIMIT ? OFFSET ? `,
-		filters.SortOrder,
-	)
-
-	params = append(params, filters.Limit, filters.Offset)
+	orderBy, pageParams := page.orderBy()
+	sqlQuery += orderBy
+	params = append(params, pageParams...)
 
 	var (
 		studentList = make(StudentRows, 0)
@@ -254,9 +258,18 @@ func (s *Student) StudentListTx(ctx context.Context, filters domain.StudentListF
 		return nil, handleError(fmt.Errorf("failed to select student list: %w", err))
 	}
 
-	return studentList.toDomain(), nil
+	return keysetRows(page, studentList).toDomain(), nil
 }
 
+// studentListColumns are the columns of the fields the list is sorted and filtered by,
+// the names of the students are the names of their users.
+//
+//nolint:gochecknoglobals // it's map of columns
+var studentListColumns = newListColumns("students", domain.StudentListFields(), map[string]string{
+	"first_name": "users.first_name",
+	"last_name":  "users.last_name",
+})
+
 // studentListFilter returns query by student list filter.
 func studentListFilter(filters domain.StudentListFilter) (params []any, filtersQuery []string, anySlices bool) {
 	filtersQuery = append(filtersQuery, "students.deleted_at IS NULL")
@@ -296,6 +309,17 @@ func studentListFilter(filters domain.StudentListFilter) (params []any, filtersQ
 		params = append(params, filters.OrganizationIDs)
 	}
 
+	if filters.Search != "" {
+		condition, searchParams := searchCondition(filters.Search)
+
+		filtersQuery = append(filtersQuery, condition)
+		params = append(params, searchParams...)
+	}
+
+	params, filtersQuery, anySlices = studentListColumns.appendConditions(
+		filters.ListFilter, params, filtersQuery, anySlices,
+	)
+
 	return params, filtersQuery, anySlices
 }
 
@@ -312,6 +336,8 @@ func (s *Student) StudentCountTx(ctx context.Context, filters domain.StudentList
 				groups ON groups.id = students.group_id
 			INNER JOIN
 				schools ON schools.id = groups.school_id
+			INNER JOIN
+				users ON users.id = students.user_id
 	` + where(filtersQuery)
 
 	var (
@@ -333,3 +359,132 @@ func (s *Student) StudentCountTx(ctx context.Context, filters domain.StudentList
 
 	return count, nil
 }
+
+// DeleteStudentTx marks student, the student role of the user and the guardians of the student as deleted.
+func (s *Student) DeleteStudentTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	guardiansQuery := `
+		UPDATE
+			student_guardians
+		SET
+			deleted_at = :deleted_at,
+			updated_at = :updated_at
+		WHERE
+			student_id = :student_id AND
+			deleted_at IS NULL`
+
+	deleted, err := softDelete(ctx, s.session(ctx), "students", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrStudentNotFound
+	}
+
+	if err = deleteEntityRole(ctx, s.session(ctx), "students", id, now); err != nil {
+		return err
+	}
+
+	_, err = s.session(ctx).NamedExecContext(ctx, guardiansQuery, map[string]any{
+		"student_id": id,
+		"deleted_at": now,
+		"updated_at": now,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to delete student guardians: %w", err))
+	}
+
+	return nil
+}
+
+// RestoreStudentTx restores deleted student with the role and the guardians deleted together with the student.
+func (s *Student) RestoreStudentTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	guardiansQuery := `
+		UPDATE
+			student_guardians
+		SET
+			deleted_at = NULL,
+			updated_at = :updated_at
+		WHERE
+			student_id = :student_id AND
+			deleted_at = (SELECT deleted_at FROM students WHERE id = :student_id)`
+
+	_, err := s.session(ctx).NamedExecContext(ctx, guardiansQuery, map[string]any{
+		"student_id": id,
+		"updated_at": now,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to restore student guardians: %w", err))
+	}
+
+	if err = restoreEntityRole(ctx, s.session(ctx), "students", id, now); err != nil {
+		return err
+	}
+
+	restored, err := restore(ctx, s.session(ctx), "students", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !restored {
+		return domain.ErrStudentNotFound
+	}
+
+	return nil
+}
+
+// UpdateStudentTx updates personal info of the student user.
+// The student is updated only if it's not updated since its version was read.
+func (s *Student) UpdateStudentTx(ctx context.Context, o domain.Student, version time.Time) error {
+	var (
+		updateStudentQuery = `
+			UPDATE
+				students
+			SET
+				updated_at = :updated_at
+			WHERE
+				id = :id AND
+				updated_at = :version AND
+				deleted_at IS NULL`
+
+		updateUserQuery = `
+			UPDATE
+				users
+			SET
+				first_name  = :first_name,
+				last_name   = :last_name,
+				middle_name = :middle_name,
+				gender      = :gender,
+				updated_at  = :updated_at
+			WHERE
+				id = :id AND
+				deleted_at IS NULL`
+	)
+
+	result, err := s.session(ctx).NamedExecContext(ctx, updateStudentQuery, map[string]any{
+		"id":         o.ID,
+		"updated_at": o.UpdatedAt,
+		"version":    version,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update student: %w", err))
+	}
+
+	if err = updatedWithVersion(result); err != nil {
+		return err
+	}
+
+	_, err = s.session(ctx).NamedExecContext(ctx, updateUserQuery, map[string]any{
+		"id":          o.UserID,
+		"first_name":  o.FirstName,
+		"last_name":   o.LastName,
+		"middle_name": o.MiddleName,
+		"gender":      o.Gender,
+		"updated_at":  o.User.UpdatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update student user: %w", err))
+	}
+
+	return nil
+}
@@ -30,8 +30,9 @@ type StudentGuardianRow struct {
 	SchoolID  uuid.UUID `db:"school_id"`
 	Relation  string    `db:"relation"`
 
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
 }
 
 // toDomain converts an object into domain model.
@@ -45,6 +46,7 @@ func (s StudentGuardianRow) toDomain() domain.StudentGuardian {
 
 		CreatedAt: s.CreatedAt,
 		UpdatedAt: s.UpdatedAt,
+		DeletedAt: s.DeletedAt,
 	}
 }
 
@@ -74,11 +76,12 @@ func (s *Student) StudentGuardiansByStudentIDTx(
 
 	query := `
 		SELECT 
-		    id, user_id, student_id, school_id, relation, created_at, updated_at
+		    id, user_id, student_id, school_id, relation, created_at, updated_at, deleted_at
 		FROM 
 		    student_guardians
 		WHERE 
-		    student_id = ?`
+		    student_id = ? AND
+		    deleted_at IS NULL`
 
 	err = s.session(ctx).SelectContext(ctx, &list, sqlx.Rebind(sqlx.DOLLAR, query), studentID)
 	if err != nil {
@@ -122,11 +125,12 @@ func (s *Student) StudentGuardianByIDTx(ctx context.Context, id uuid.UUID) (doma
 
 	getQuery := `
 	SELECT 
-		    id, user_id, student_id, school_id, relation, created_at, updated_at
+		    id, user_id, student_id, school_id, relation, created_at, updated_at, deleted_at
 	FROM 
 		student_guardians
 	WHERE 
-		id = ?`
+		id = ? AND
+		deleted_at IS NULL`
 
 	err := s.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, getQuery), id)
 	if err != nil {
@@ -143,11 +147,12 @@ func (s *Student) StudentGuardianByUserIDTx(ctx context.Context, id uuid.UUID) (
 
 	getQuery := `
 	SELECT 
-		    id, user_id, student_id, school_id, relation, created_at, updated_at
+		    id, user_id, student_id, school_id, relation, created_at, updated_at, deleted_at
 	FROM 
 		student_guardians
 	WHERE 
-		user_id = ?`
+		user_id = ? AND
+		deleted_at IS NULL`
 
 	err := s.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, getQuery), id)
 	if err != nil {
@@ -173,20 +178,19 @@ func (s *Student) StudentGuardianListTx(
 				sg.school_id,
 				sg.relation,
 				sg.created_at, 
-				sg.updated_at
+				sg.updated_at,
+				sg.deleted_at
 			FROM 
 				student_guardians sg
 			INNER JOIN
 				schools ON schools.id = sg.school_id
 			INNER JOIN
 				students ON students.id = sg.student_id
+			INNER JOIN
+				users ON users.id = sg.user_id
 		` + where(filtersQuery)
 
-	sqlQuery += fmt.Sprintf(
-		` ORDER BY sg.created_at %s 
-			LIMIT ? OFFSET ? `,
-		filters.SortOrder,
-	)
+	sqlQuery += studentGuardianListColumns.orderBy(filters.ListFilter, "sg.created_at") + ` LIMIT ? OFFSET ? `
 
 	params = append(params, filters.Limit, filters.Offset)
 
@@ -210,10 +214,17 @@ func (s *Student) StudentGuardianListTx(
 	return list.toDomain(), nil
 }
 
+// studentGuardianListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var studentGuardianListColumns = newListColumns("sg", domain.StudentGuardianListFields(), nil)
+
 // studentListFilter returns query by student guardian list filter.
 func studentGuardianListFilter(
 	filters domain.StudentGuardianListFilter,
 ) (params []any, filtersQuery []string, anySlices bool) {
+	filtersQuery = append(filtersQuery, "sg.deleted_at IS NULL")
+
 	if filters.CreatedDate.DateFrom != nil {
 		filtersQuery = append(filtersQuery, "sg.created_at >= ?")
 		params = append(params, filters.CreatedDate.DateFrom)
@@ -249,6 +260,17 @@ func studentGuardianListFilter(
 		params = append(params, filters.OrganizationIDs)
 	}
 
+	if filters.Search != "" {
+		condition, searchParams := searchCondition(filters.Search)
+
+		filtersQuery = append(filtersQuery, condition)
+		params = append(params, searchParams...)
+	}
+
+	params, filtersQuery, anySlices = studentGuardianListColumns.appendConditions(
+		filters.ListFilter, params, filtersQuery, anySlices,
+	)
+
 	return params, filtersQuery, anySlices
 }
 
@@ -268,6 +290,8 @@ func (s *Student) StudentGuardianListCountTx(
 				schools ON schools.id = sg.school_id
 			INNER JOIN
 				students ON students.id = sg.student_id
+			INNER JOIN
+				users ON users.id = sg.user_id
 	` + where(filtersQuery)
 
 	var (
@@ -289,3 +313,31 @@ func (s *Student) StudentGuardianListCountTx(
 
 	return count, nil
 }
+
+// DeleteStudentGuardianTx marks student guardian as deleted.
+func (s *Student) DeleteStudentGuardianTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	deleted, err := softDelete(ctx, s.session(ctx), "student_guardians", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrStudentGuardianNotFound
+	}
+
+	return nil
+}
+
+// RestoreStudentGuardianTx restores deleted student guardian.
+func (s *Student) RestoreStudentGuardianTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	restored, err := restore(ctx, s.session(ctx), "student_guardians", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !restored {
+		return domain.ErrStudentGuardianNotFound
+	}
+
+	return nil
+}
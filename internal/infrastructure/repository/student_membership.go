@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"bum-service/internal/domain"
+)
+
+const (
+	// StudentGroupMembershipsCurrentKey is unique key of the current group membership of the student.
+	StudentGroupMembershipsCurrentKey = "student_group_memberships_current_key"
+	// StudentGroupMembershipsGroupIDFKey is student group membership group id foreign key.
+	StudentGroupMembershipsGroupIDFKey = "student_group_memberships_group_id_fkey"
+	// StudentGroupMembershipsStudentIDFKey is student group membership student id foreign key.
+	StudentGroupMembershipsStudentIDFKey = "student_group_memberships_student_id_fkey"
+	// StudentGroupMembershipsPeriodCheck is student group membership period check.
+	StudentGroupMembershipsPeriodCheck = "student_group_memberships_period_check"
+)
+
+// StudentMembershipRow is a row containing student group membership.
+type StudentMembershipRow struct {
+	ID        uuid.UUID  `db:"id"`
+	StudentID uuid.UUID  `db:"student_id"`
+	GroupID   uuid.UUID  `db:"group_id"`
+	SchoolID  uuid.UUID  `db:"school_id"`
+	DateFrom  time.Time  `db:"date_from"`
+	DateTill  *time.Time `db:"date_till"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// StudentMembershipRows is list of StudentMembershipRow.
+type StudentMembershipRows []StudentMembershipRow
+
+// toDomain converts to entity.
+func (s StudentMembershipRows) toDomain() domain.StudentMemberships {
+	list := make(domain.StudentMemberships, 0, len(s))
+
+	for _, row := range s {
+		list = append(list, domain.StudentMembership{
+			ID:        row.ID,
+			StudentID: row.StudentID,
+			GroupID:   row.GroupID,
+			SchoolID:  row.SchoolID,
+			DateFrom:  row.DateFrom,
+			DateTill:  row.DateTill,
+
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		})
+	}
+
+	return list
+}
+
+// AddStudentMembershipTx creates a new student group membership.
+func (s *Student) AddStudentMembershipTx(ctx context.Context, membership domain.StudentMembership) error {
+	sqlQuery := `
+		INSERT INTO student_group_memberships
+			( id, student_id, group_id, date_from, date_till, created_at, updated_at )
+		VALUES
+			(:id,:student_id,:group_id,:date_from,:date_till,:created_at,:updated_at )`
+
+	_, err := s.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":         membership.ID,
+		"student_id": membership.StudentID,
+		"group_id":   membership.GroupID,
+		"date_from":  membership.DateFrom,
+		"date_till":  membership.DateTill,
+
+		"created_at": membership.CreatedAt,
+		"updated_at": membership.UpdatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to insert student group membership: %w", err))
+	}
+
+	return nil
+}
+
+// StudentMembershipsTx returns group memberships of the student ordered by date.
+func (s *Student) StudentMembershipsTx(ctx context.Context, studentID uuid.UUID) (domain.StudentMemberships, error) {
+	var (
+		sqlQuery = `
+			SELECT
+				student_group_memberships.id,
+				student_group_memberships.student_id,
+				student_group_memberships.group_id,
+				groups.school_id,
+				student_group_memberships.date_from,
+				student_group_memberships.date_till,
+
+				student_group_memberships.created_at,
+				student_group_memberships.updated_at
+			FROM
+				student_group_memberships
+			INNER JOIN
+				groups ON groups.id = student_group_memberships.group_id
+			WHERE
+				student_group_memberships.student_id = ?
+			ORDER BY
+				student_group_memberships.date_from, student_group_memberships.created_at`
+
+		rows StudentMembershipRows
+	)
+
+	err := s.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), studentID)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select student group memberships: %w", err))
+	}
+
+	return rows.toDomain(), nil
+}
+
+// TransferStudentTx closes the current group membership of the student, opens the new one
+// and moves the student with the role into the group of the new membership.
+func (s *Student) TransferStudentTx(ctx context.Context, closed, opened domain.StudentMembership) error {
+	var (
+		closeQuery = `
+			UPDATE
+				student_group_memberships
+			SET
+				date_till = :date_till,
+				updated_at = :updated_at
+			WHERE
+				id = :id AND
+				date_till IS NULL`
+
+		studentQuery = `
+			UPDATE
+				students
+			SET
+				group_id = :group_id,
+				updated_at = :updated_at
+			WHERE
+				id = :id AND
+				deleted_at IS NULL`
+
+		roleQuery = `
+			UPDATE
+				user_roles
+			SET
+				school_id = :school_id,
+				updated_at = :updated_at
+			WHERE
+				id = (SELECT role_id FROM students WHERE id = :student_id) AND
+				school_id IS DISTINCT FROM :school_id`
+	)
+
+	result, err := s.session(ctx).NamedExecContext(ctx, closeQuery, map[string]any{
+		"id":         closed.ID,
+		"date_till":  closed.DateTill,
+		"updated_at": closed.UpdatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to close student group membership: %w", err))
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return handleError(fmt.Errorf("failed to close student group membership: %w", err))
+	}
+
+	// the membership is already closed by a concurrent transfer.
+	if affected == 0 {
+		return domain.ErrStudentMembershipConflict
+	}
+
+	if err = s.AddStudentMembershipTx(ctx, opened); err != nil {
+		return err
+	}
+
+	_, err = s.session(ctx).NamedExecContext(ctx, studentQuery, map[string]any{
+		"id":         opened.StudentID,
+		"group_id":   opened.GroupID,
+		"updated_at": opened.CreatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to move student to the group: %w", err))
+	}
+
+	_, err = s.session(ctx).NamedExecContext(ctx, roleQuery, map[string]any{
+		"student_id": opened.StudentID,
+		"school_id":  opened.SchoolID,
+		"updated_at": opened.CreatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to move student role to the school: %w", err))
+	}
+
+	return nil
+}
@@ -16,6 +16,8 @@ const (
 	StudyPlanGroupSubjectPlanOrderUniqueKey = "study_plan_group_subject_id_plan_order_key"
 	// StudyPlanGroupSubjectIDFKey is foreign key for group subjects.
 	StudyPlanGroupSubjectIDFKey = "study_plan_group_subject_id_fkey"
+	// StudyPlansTermIDFKey is foreign key for terms.
+	StudyPlansTermIDFKey = "study_plans_term_id_fkey"
 )
 
 // AssignStudyPlansTx adds study plans.
@@ -32,9 +34,9 @@ func (s School) AssignStudyPlansTx(ctx context.Context, groupSubjectID uuid.UUID
 
 		insertQuery = `
 			INSERT INTO study_plans
-	    		( id, group_subject_id, title, description, plan_order, status, created_at, updated_at) 
+	    		( id, group_subject_id, title, description, plan_order, status, term_id, created_at, updated_at) 
 			VALUES 
-	    		(:id,:group_subject_id,:title,:description,:plan_order,:status,:created_at,:updated_at)
+	    		(:id,:group_subject_id,:title,:description,:plan_order,:status,:term_id,:created_at,:updated_at)
 			ON CONFLICT 
 				(id) 
 			DO UPDATE SET 
@@ -43,7 +45,8 @@ func (s School) AssignStudyPlansTx(ctx context.Context, groupSubjectID uuid.UUID
 				title = :title,
 				description = :description, 
 				plan_order = :plan_order, 
-				status = :status;`
+				status = :status,
+				term_id = :term_id;`
 	)
 
 	_, err := s.session(ctx).NamedExecContext(ctx, softDelete, map[string]any{"group_subject_id": groupSubjectID})
@@ -59,6 +62,7 @@ func (s School) AssignStudyPlansTx(ctx context.Context, groupSubjectID uuid.UUID
 			"description":      studyPlan.Description,
 			"plan_order":       studyPlan.PlanOrder,
 			"status":           studyPlan.Status,
+			"term_id":          studyPlan.TermID,
 			"created_at":       studyPlan.CreatedAt,
 			"updated_at":       studyPlan.UpdatedAt,
 		})
@@ -72,12 +76,13 @@ func (s School) AssignStudyPlansTx(ctx context.Context, groupSubjectID uuid.UUID
 
 // StudyPlanRow is a row of study plan.
 type StudyPlanRow struct {
-	ID             uuid.UUID `db:"id"`
-	GroupSubjectID uuid.UUID `db:"group_subject_id"`
-	Title          string    `db:"title"`
-	Description    *string   `db:"description"`
-	PlanOrder      int16     `db:"plan_order"`
-	Status         string    `db:"status"`
+	ID             uuid.UUID  `db:"id"`
+	GroupSubjectID uuid.UUID  `db:"group_subject_id"`
+	Title          string     `db:"title"`
+	Description    *string    `db:"description"`
+	PlanOrder      int16      `db:"plan_order"`
+	Status         string     `db:"status"`
+	TermID         *uuid.UUID `db:"term_id"`
 
 	CreatedAt time.Time  `db:"created_at"`
 	UpdatedAt time.Time  `db:"updated_at"`
@@ -93,6 +98,7 @@ func (s StudyPlanRow) toDomain() domain.StudyPlan {
 		Description:    s.Description,
 		PlanOrder:      s.PlanOrder,
 		Status:         domain.StudyPlanStatus(s.Status),
+		TermID:         s.TermID,
 		CreatedAt:      s.CreatedAt,
 		UpdatedAt:      s.UpdatedAt,
 		DeletedAt:      s.DeletedAt,
@@ -117,7 +123,7 @@ func (s StudyPlanRows) toDomain() domain.StudyPlans {
 func (s School) StudyPlanListTx(ctx context.Context, groupSubjectID uuid.UUID) (domain.StudyPlans, error) {
 	query := `
 		SELECT 
-		    id, group_subject_id, title, description, plan_order, status, created_at, updated_at
+		    id, group_subject_id, title, description, plan_order, status, term_id, created_at, updated_at
 		FROM 
 		    study_plans
 		WHERE 
@@ -117,25 +117,60 @@ func (r Subject) GetSubjectByIDTx(ctx context.Context, id uuid.UUID) (domain.Sub
 	return subjectRow.toModel(), nil
 }
 
+// SubjectByNameTx gets a subject by name, the name is compared case-insensitively.
+func (r Subject) SubjectByNameTx(ctx context.Context, name string) (domain.Subject, error) {
+	var (
+		query = `
+				SELECT 
+					id, name, description, created_at, updated_at, deleted_at
+				FROM 
+					subjects
+				WHERE 
+					LOWER(name) = LOWER(?) AND
+					deleted_at IS NULL
+				ORDER BY
+					created_at
+				LIMIT 1`
+
+		subjectRow SubjectRow
+	)
+
+	err := r.session(ctx).GetContext(ctx, &subjectRow, sqlx.Rebind(sqlx.DOLLAR, query), name)
+	if err != nil {
+		return domain.Subject{}, handleError(fmt.Errorf("failed to get subject by name: %w", err))
+	}
+
+	return subjectRow.toModel(), nil
+}
+
 // GetSubjectListTx gets a subject list.
 func (r Subject) GetSubjectListTx(ctx context.Context, filters domain.SubjectListFilter) (domain.Subjects, error) {
+	params, filtersQuery, anySlices := subjectListFilter(filters)
+
 	var (
 		subjects = make(SubjectRows, 0)
 
-		query = fmt.Sprintf(`
+		query = `
 			SELECT 
 				id, name, description, created_at, updated_at, deleted_at
 			FROM 
 				subjects
-			WHERE 
-				deleted_at IS NULL
-			ORDER BY 
-				created_at %s
-			LIMIT ? OFFSET ?
-			`, filters.SortOrder)
+			` + where(filtersQuery) +
+			subjectListColumns.orderBy(filters.ListFilter, "created_at") + ` LIMIT ? OFFSET ?`
+
+		err error
 	)
 
-	err := r.session(ctx).SelectContext(ctx, &subjects, sqlx.Rebind(sqlx.DOLLAR, query), filters.Limit, filters.Offset)
+	params = append(params, filters.Limit, filters.Offset)
+
+	if anySlices {
+		query, params, err = sqlx.In(query, params...)
+		if err != nil {
+			return nil, handleError(fmt.Errorf("failed to get subject list: %w", err))
+		}
+	}
+
+	err = r.session(ctx).SelectContext(ctx, &subjects, sqlx.Rebind(sqlx.DOLLAR, query), params...)
 	if err != nil {
 		return nil, handleError(fmt.Errorf("failed to get subject list: %w", err))
 	}
@@ -143,23 +178,130 @@ func (r Subject) GetSubjectListTx(ctx context.Context, filters domain.SubjectLis
 	return subjects.toDomain(), nil
 }
 
+// subjectListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var subjectListColumns = newListColumns("subjects", domain.SubjectListFields(), nil)
+
+// subjectListFilter returns query by subject list filter.
+func subjectListFilter(filters domain.SubjectListFilter) (params []any, filtersQuery []string, anySlices bool) {
+	filtersQuery = append(filtersQuery, "deleted_at IS NULL")
+
+	return subjectListColumns.appendConditions(filters.ListFilter, params, filtersQuery, anySlices)
+}
+
 // SubjectCountTx gets a count of subject.
-func (r Subject) SubjectCountTx(ctx context.Context) (int, error) {
+func (r Subject) SubjectCountTx(ctx context.Context, filters domain.SubjectListFilter) (int, error) {
+	params, filtersQuery, anySlices := subjectListFilter(filters)
+
 	var (
 		query = `
 			SELECT 
 				count(*)
 			FROM 
 				subjects
-			WHERE 
-				deleted_at IS NULL`
+			` + where(filtersQuery)
+
 		count int
+		err   error
 	)
 
-	err := r.session(ctx).QueryRowxContext(ctx, sqlx.Rebind(sqlx.DOLLAR, query)).Scan(&count)
+	if anySlices {
+		query, params, err = sqlx.In(query, params...)
+		if err != nil {
+			return 0, handleError(fmt.Errorf("failed to get subject count: %w", err))
+		}
+	}
+
+	err = r.session(ctx).QueryRowxContext(ctx, sqlx.Rebind(sqlx.DOLLAR, query), params...).Scan(&count)
 	if err != nil {
 		return 0, handleError(fmt.Errorf("failed to get subject count: %w", err))
 	}
 
 	return count, nil
 }
+
+// SubjectDependentsTx returns the number of not deleted subjects of not deleted schools based on the subject.
+func (r Subject) SubjectDependentsTx(ctx context.Context, id uuid.UUID) (domain.Dependents, error) {
+	query := `
+		SELECT
+			(
+				SELECT 
+					count(*) 
+				FROM 
+					school_subjects AS ss
+				INNER JOIN 
+					schools AS s ON ss.school_id = s.id
+				WHERE 
+					ss.subject_id = $1 AND
+					ss.deleted_at IS NULL AND
+					s.deleted_at IS NULL
+			) AS school_subjects`
+
+	list, err := dependents(ctx, r.session(ctx), query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count subject dependents: %w", err)
+	}
+
+	return list, nil
+}
+
+// DeleteSubjectTx marks subject as deleted.
+func (r Subject) DeleteSubjectTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	deleted, err := softDelete(ctx, r.session(ctx), "subjects", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrSubjectNotFound
+	}
+
+	return nil
+}
+
+// RestoreSubjectTx restores deleted subject.
+func (r Subject) RestoreSubjectTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	restored, err := restore(ctx, r.session(ctx), "subjects", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !restored {
+		return domain.ErrSubjectNotFound
+	}
+
+	return nil
+}
+
+// UpdateSubjectTx updates subject.
+func (r Subject) UpdateSubjectTx(ctx context.Context, subject domain.Subject, version time.Time) error {
+	var (
+		sqlQuery = `
+			UPDATE
+				subjects
+			SET
+				name        = :name,
+				description = :description,
+				updated_at  = :updated_at
+			WHERE
+				id = :id AND
+				updated_at = :version AND
+				deleted_at IS NULL`
+
+		args = map[string]any{
+			"id":          subject.ID,
+			"name":        subject.Name,
+			"description": subject.Description,
+			"updated_at":  subject.UpdatedAt,
+			"version":     version,
+		}
+	)
+
+	result, err := r.session(ctx).NamedExecContext(ctx, sqlQuery, args)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update subject: %w", err))
+	}
+
+	return updatedWithVersion(result)
+}
@@ -138,6 +138,37 @@ func (t *Teacher) TeacherByIDTx(ctx context.Context, id uuid.UUID) (domain.Teach
 	return row.toDomain(), nil
 }
 
+// TeacherByUserIDTx get the teacher of the school by user id.
+func (t *Teacher) TeacherByUserIDTx(ctx context.Context, schoolID, userID uuid.UUID) (domain.Teacher, error) {
+	var row TeacherRow
+
+	getTeacherQuery := `
+	SELECT 
+		id,
+		role_id,
+		user_id,
+		school_id, 
+		phone, 
+		email, 
+		
+		created_at, 
+		updated_at, 
+		deleted_at
+	FROM 
+		teachers
+	WHERE 
+		school_id = ? AND
+		user_id = ? AND
+		deleted_at IS NULL`
+
+	err := t.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, getTeacherQuery), schoolID, userID)
+	if err != nil {
+		return domain.Teacher{}, handleError(fmt.Errorf("failed to get teacher by user id: %w", err))
+	}
+
+	return row.toDomain(), nil
+}
+
 // TeachersByIDsTx get teachers by ids.
 func (t *Teacher) TeachersByIDsTx(ctx context.Context, ids []uuid.UUID) (domain.Teachers, error) {
 	var rows TeacherRows
@@ -199,13 +230,11 @@ func (t *Teacher) TeacherListTx(ctx context.Context, filters domain.TeacherListF
 			    ON schools.id = teachers.school_id
 			LEFT JOIN group_subjects
 				ON group_subjects.teacher_id = teachers.id
+			INNER JOIN users
+				ON users.id = teachers.user_id
 		` + where(filtersQuery) + ` GROUP BY teachers.id `
 
-	sqlQuery += fmt.Sprintf(
-		` ORDER BY teachers.created_at %s 
-			LIMIT ? OFFSET ? `,
-		filters.SortOrder,
-	)
+	sqlQuery += teacherListColumns.orderBy(filters.ListFilter, "teachers.created_at") + ` LIMIT ? OFFSET ? `
 
 	params = append(params, filters.Limit, filters.Offset)
 
@@ -229,6 +258,11 @@ func (t *Teacher) TeacherListTx(ctx context.Context, filters domain.TeacherListF
 	return teacherList.toDomain(), nil
 }
 
+// teacherListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var teacherListColumns = newListColumns("teachers", domain.TeacherListFields(), nil)
+
 // teacherListFilter returns query by teacher list filter.
 func teacherListFilter(filters domain.TeacherListFilter) (params []any, filtersQuery []string, anySlices bool) {
 	filtersQuery = append(filtersQuery, "teachers.deleted_at IS NULL")
@@ -268,6 +302,17 @@ func teacherListFilter(filters domain.TeacherListFilter) (params []any, filtersQ
 		params = append(params, filters.OrganizationIDs)
 	}
 
+	if filters.Search != "" {
+		condition, searchParams := searchCondition(filters.Search)
+
+		filtersQuery = append(filtersQuery, condition)
+		params = append(params, searchParams...)
+	}
+
+	params, filtersQuery, anySlices = teacherListColumns.appendConditions(
+		filters.ListFilter, params, filtersQuery, anySlices,
+	)
+
 	return params, filtersQuery, anySlices
 }
 
@@ -284,6 +329,8 @@ func (t *Teacher) TeacherCountTx(ctx context.Context, filters domain.TeacherList
 			    ON schools.id = teachers.school_id
 			LEFT JOIN group_subjects
 				ON group_subjects.teacher_id = teachers.id
+			INNER JOIN users
+				ON users.id = teachers.user_id
 	` + where(filtersQuery) + ` GROUP BY teachers.id ) t`
 
 	var (
@@ -305,3 +352,104 @@ func (t *Teacher) TeacherCountTx(ctx context.Context, filters domain.TeacherList
 
 	return count, nil
 }
+
+// TeacherDependentsTx returns the number of not deleted groups the teacher is class teacher of,
+// group subjects the teacher teaches and lessons of the teacher which start after now.
+func (t *Teacher) TeacherDependentsTx(ctx context.Context, id uuid.UUID, now time.Time) (domain.Dependents, error) {
+	sqlQuery := `
+		SELECT
+			(SELECT count(*) FROM groups WHERE class_teacher_id = $1 AND deleted_at IS NULL) AS groups,
+			(
+				SELECT 
+					count(*) 
+				FROM 
+					group_subjects AS gs
+				INNER JOIN 
+					groups AS g ON gs.group_id = g.id
+				WHERE 
+					gs.teacher_id = $1 AND
+					gs.deleted_at IS NULL AND
+					g.deleted_at IS NULL
+			) AS group_subjects,
+			(
+				SELECT 
+					count(*) 
+				FROM 
+					lessons 
+				WHERE 
+					teacher_id = $1 AND 
+					start_time > $2 AND 
+					deleted_at IS NULL
+			) AS lessons`
+
+	list, err := dependents(ctx, t.session(ctx), sqlQuery, id, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count teacher dependents: %w", err)
+	}
+
+	return list, nil
+}
+
+// DeleteTeacherTx marks teacher and the teacher role of the user as deleted.
+func (t *Teacher) DeleteTeacherTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	deleted, err := softDelete(ctx, t.session(ctx), "teachers", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrTeacherNotFound
+	}
+
+	return deleteEntityRole(ctx, t.session(ctx), "teachers", id, now)
+}
+
+// RestoreTeacherTx restores deleted teacher and the teacher role deleted together with the teacher.
+func (t *Teacher) RestoreTeacherTx(ctx context.Context, id uuid.UUID, now time.Time) error {
+	if err := restoreEntityRole(ctx, t.session(ctx), "teachers", id, now); err != nil {
+		return err
+	}
+
+	restored, err := restore(ctx, t.session(ctx), "teachers", id, now)
+	if err != nil {
+		return err
+	}
+
+	if !restored {
+		return domain.ErrTeacherNotFound
+	}
+
+	return nil
+}
+
+// UpdateTeacherTx updates teacher.
+func (t *Teacher) UpdateTeacherTx(ctx context.Context, o domain.Teacher, version time.Time) error {
+	var (
+		sqlQuery = `
+			UPDATE
+				teachers
+			SET
+				phone      = :phone,
+				email      = :email,
+				updated_at = :updated_at
+			WHERE
+				id = :id AND
+				updated_at = :version AND
+				deleted_at IS NULL`
+
+		args = map[string]any{
+			"id":         o.ID,
+			"phone":      o.Phone,
+			"email":      o.Email,
+			"updated_at": o.UpdatedAt,
+			"version":    version,
+		}
+	)
+
+	result, err := t.session(ctx).NamedExecContext(ctx, sqlQuery, args)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update teacher: %w", err))
+	}
+
+	return updatedWithVersion(result)
+}
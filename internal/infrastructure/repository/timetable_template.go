@@ -0,0 +1,469 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"bum-service/internal/domain"
+)
+
+const (
+	// TimetableTemplatesGroupIDFKey is timetable template group id foreign key.
+	TimetableTemplatesGroupIDFKey = "timetable_templates_group_id_fkey"
+	// TimetableTemplatesPeriodCheck is timetable template period check.
+	TimetableTemplatesPeriodCheck = "timetable_templates_period_check"
+	// TimetableTemplateSlotsGroupSubjectIDFKey is timetable template slot group subject id foreign key.
+	TimetableTemplateSlotsGroupSubjectIDFKey = "timetable_template_slots_group_subject_id_fkey"
+	// TimetableTemplateSlotsTeacherIDFKey is timetable template slot teacher id foreign key.
+	TimetableTemplateSlotsTeacherIDFKey = "timetable_template_slots_teacher_id_fkey"
+	// TimetableTemplateSlotsAuditoriumIDFKey is timetable template slot auditorium id foreign key.
+	TimetableTemplateSlotsAuditoriumIDFKey = "timetable_template_slots_auditorium_id_fkey"
+	// TimetableTemplateExclusionsPeriodCheck is timetable template exclusion period check.
+	TimetableTemplateExclusionsPeriodCheck = "timetable_template_exclusions_period_check"
+)
+
+// TimetableTemplateRow is row of timetable template.
+type TimetableTemplateRow struct {
+	ID       uuid.UUID `db:"id"`
+	SchoolID uuid.UUID `db:"school_id"`
+	GroupID  uuid.UUID `db:"group_id"`
+	Name     string    `db:"name"`
+	DateFrom time.Time `db:"date_from"`
+	DateTill time.Time `db:"date_till"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+func (t TimetableTemplateRow) toDomain() domain.TimetableTemplate {
+	return domain.TimetableTemplate{
+		ID:        t.ID,
+		SchoolID:  t.SchoolID,
+		GroupID:   t.GroupID,
+		Name:      t.Name,
+		DateFrom:  t.DateFrom,
+		DateTill:  t.DateTill,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+		DeletedAt: t.DeletedAt,
+	}
+}
+
+// TimetableTemplateRows is list of TimetableTemplateRow.
+type TimetableTemplateRows []TimetableTemplateRow
+
+func (t TimetableTemplateRows) toDomain() domain.TimetableTemplates {
+	list := make(domain.TimetableTemplates, 0, len(t))
+
+	for _, row := range t {
+		list = append(list, row.toDomain())
+	}
+
+	return list
+}
+
+// TimetableTemplateSlotRow is row of timetable template slot.
+type TimetableTemplateSlotRow struct {
+	ID             uuid.UUID  `db:"id"`
+	TemplateID     uuid.UUID  `db:"template_id"`
+	GroupSubjectID uuid.UUID  `db:"group_subject_id"`
+	TeacherID      *uuid.UUID `db:"teacher_id"`
+	AuditoriumID   uuid.UUID  `db:"auditorium_id"`
+	Weekday        int16      `db:"weekday"`
+	StartMinute    int16      `db:"start_minute"`
+	EndMinute      int16      `db:"end_minute"`
+	Description    *string    `db:"description"`
+
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+func (t TimetableTemplateSlotRow) toDomain() domain.TimetableTemplateSlot {
+	return domain.TimetableTemplateSlot{
+		ID:             t.ID,
+		TemplateID:     t.TemplateID,
+		GroupSubjectID: t.GroupSubjectID,
+		TeacherID:      t.TeacherID,
+		AuditoriumID:   t.AuditoriumID,
+		Weekday:        time.Weekday(t.Weekday),
+		Bell: domain.BellSlot{
+			Start: time.Duration(t.StartMinute) * time.Minute,
+			End:   time.Duration(t.EndMinute) * time.Minute,
+		},
+		Description: t.Description,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+		DeletedAt:   t.DeletedAt,
+	}
+}
+
+// TimetableTemplateSlotRows is list of TimetableTemplateSlotRow.
+type TimetableTemplateSlotRows []TimetableTemplateSlotRow
+
+func (t TimetableTemplateSlotRows) toDomain() domain.TimetableTemplateSlots {
+	list := make(domain.TimetableTemplateSlots, 0, len(t))
+
+	for _, row := range t {
+		list = append(list, row.toDomain())
+	}
+
+	return list
+}
+
+// TimetableTemplateExclusionRow is row of timetable template exclusion.
+type TimetableTemplateExclusionRow struct {
+	ID         uuid.UUID `db:"id"`
+	TemplateID uuid.UUID `db:"template_id"`
+	DateFrom   time.Time `db:"date_from"`
+	DateTill   time.Time `db:"date_till"`
+	Reason     *string   `db:"reason"`
+
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (t TimetableTemplateExclusionRow) toDomain() domain.TimetableTemplateExclusion {
+	return domain.TimetableTemplateExclusion{
+		ID:         t.ID,
+		TemplateID: t.TemplateID,
+		DateFrom:   t.DateFrom,
+		DateTill:   t.DateTill,
+		Reason:     t.Reason,
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+// TimetableTemplateExclusionRows is list of TimetableTemplateExclusionRow.
+type TimetableTemplateExclusionRows []TimetableTemplateExclusionRow
+
+func (t TimetableTemplateExclusionRows) toDomain() domain.TimetableTemplateExclusions {
+	list := make(domain.TimetableTemplateExclusions, 0, len(t))
+
+	for _, row := range t {
+		list = append(list, row.toDomain())
+	}
+
+	return list
+}
+
+// CreateTimetableTemplateTx creates a new timetable template with its slots and exclusions.
+func (l *Lesson) CreateTimetableTemplateTx(ctx context.Context, t domain.TimetableTemplate) error {
+	var (
+		templateQuery = `
+			INSERT INTO timetable_templates 
+				(id, school_id, group_id, name, date_from, date_till, created_at, updated_at) 
+			VALUES 
+				(:id, :school_id, :group_id, :name, :date_from, :date_till, :created_at, :updated_at)`
+
+		slotQuery = `
+			INSERT INTO timetable_template_slots 
+				(
+					id, template_id, group_subject_id, teacher_id, auditorium_id, 
+					weekday, start_minute, end_minute, description, created_at, updated_at
+				) 
+			VALUES 
+				(
+					:id, :template_id, :group_subject_id, :teacher_id, :auditorium_id, 
+					:weekday, :start_minute, :end_minute, :description, :created_at, :updated_at
+				)`
+
+		exclusionQuery = `
+			INSERT INTO timetable_template_exclusions 
+				(id, template_id, date_from, date_till, reason, created_at) 
+			VALUES 
+				(:id, :template_id, :date_from, :date_till, :reason, :created_at)`
+	)
+
+	_, err := l.session(ctx).NamedExecContext(ctx, templateQuery, map[string]any{
+		"id":         t.ID,
+		"school_id":  t.SchoolID,
+		"group_id":   t.GroupID,
+		"name":       t.Name,
+		"date_from":  t.DateFrom,
+		"date_till":  t.DateTill,
+		"created_at": t.CreatedAt,
+		"updated_at": t.UpdatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to insert timetable template: %w", err))
+	}
+
+	for _, slot := range t.Slots {
+		_, err = l.session(ctx).NamedExecContext(ctx, slotQuery, map[string]any{
+			"id":               slot.ID,
+			"template_id":      slot.TemplateID,
+			"group_subject_id": slot.GroupSubjectID,
+			"teacher_id":       slot.TeacherID,
+			"auditorium_id":    slot.AuditoriumID,
+			"weekday":          int16(slot.Weekday),
+			"start_minute":     int16(slot.Bell.Start / time.Minute),
+			"end_minute":       int16(slot.Bell.End / time.Minute),
+			"description":      slot.Description,
+			"created_at":       slot.CreatedAt,
+			"updated_at":       slot.UpdatedAt,
+		})
+		if err != nil {
+			return handleError(fmt.Errorf("failed to insert timetable template slot: %w", err))
+		}
+	}
+
+	for _, exclusion := range t.Exclusions {
+		_, err = l.session(ctx).NamedExecContext(ctx, exclusionQuery, map[string]any{
+			"id":          exclusion.ID,
+			"template_id": exclusion.TemplateID,
+			"date_from":   exclusion.DateFrom,
+			"date_till":   exclusion.DateTill,
+			"reason":      exclusion.Reason,
+			"created_at":  exclusion.CreatedAt,
+		})
+		if err != nil {
+			return handleError(fmt.Errorf("failed to insert timetable template exclusion: %w", err))
+		}
+	}
+
+	return nil
+}
+
+// TimetableTemplateByIDTx returns timetable template of the school by id with its slots and exclusions.
+func (l *Lesson) TimetableTemplateByIDTx(
+	ctx context.Context, id, schoolID uuid.UUID,
+) (domain.TimetableTemplate, error) {
+	var (
+		sqlQuery = `
+			SELECT 
+				id, school_id, group_id, name, date_from, date_till, created_at, updated_at, deleted_at
+			FROM 
+				timetable_templates
+			WHERE 
+				id = ? AND
+				school_id = ? AND
+				deleted_at IS NULL`
+
+		row TimetableTemplateRow
+	)
+
+	err := l.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), id, schoolID)
+	if err != nil {
+		return domain.TimetableTemplate{}, handleError(fmt.Errorf("failed to select timetable template: %w", err))
+	}
+
+	templates := domain.TimetableTemplates{row.toDomain()}
+
+	if err = l.setTimetableTemplateSlots(ctx, templates); err != nil {
+		return domain.TimetableTemplate{}, err
+	}
+
+	return templates[0], nil
+}
+
+// TimetableTemplateListTx returns timetable templates by filters with their slots and exclusions.
+func (l *Lesson) TimetableTemplateListTx(
+	ctx context.Context, filters domain.TimetableTemplateFilters,
+) (domain.TimetableTemplates, error) {
+	var (
+		sqlQuery = `
+			SELECT 
+				id, school_id, group_id, name, date_from, date_till, created_at, updated_at, deleted_at
+			FROM 
+				timetable_templates`
+
+		filtersQuery = []string{"school_id = ?", "deleted_at IS NULL"}
+		params       = []any{filters.SchoolID}
+		rows         TimetableTemplateRows
+	)
+
+	if filters.GroupID != nil {
+		filtersQuery = append(filtersQuery, "group_id = ?")
+		params = append(params, filters.GroupID)
+	}
+
+	sqlQuery += where(filtersQuery) + ` ORDER BY date_from DESC`
+
+	err := l.session(ctx).SelectContext(ctx, &rows, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), params...)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select timetable template list: %w", err))
+	}
+
+	templates := rows.toDomain()
+
+	if err = l.setTimetableTemplateSlots(ctx, templates); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// setTimetableTemplateSlots selects and sets slots and exclusions to timetable templates.
+func (l *Lesson) setTimetableTemplateSlots(ctx context.Context, templates domain.TimetableTemplates) error {
+	if len(templates) == 0 {
+		return nil
+	}
+
+	var (
+		slotsQuery = `
+			SELECT 
+				id, template_id, group_subject_id, teacher_id, auditorium_id, 
+				weekday, start_minute, end_minute, description, created_at, updated_at, deleted_at
+			FROM 
+				timetable_template_slots
+			WHERE 
+				template_id IN (?) AND
+				deleted_at IS NULL
+			ORDER BY weekday, start_minute`
+
+		exclusionsQuery = `
+			SELECT 
+				id, template_id, date_from, date_till, reason, created_at
+			FROM 
+				timetable_template_exclusions
+			WHERE 
+				template_id IN (?)
+			ORDER BY date_from`
+
+		slots      TimetableTemplateSlotRows
+		exclusions TimetableTemplateExclusionRows
+	)
+
+	query, params, err := sqlx.In(slotsQuery, templates.IDs())
+	if err != nil {
+		return handleError(fmt.Errorf("failed to prepare timetable template slots query: %w", err))
+	}
+
+	err = l.session(ctx).SelectContext(ctx, &slots, sqlx.Rebind(sqlx.DOLLAR, query), params...)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to select timetable template slots: %w", err))
+	}
+
+	query, params, err = sqlx.In(exclusionsQuery, templates.IDs())
+	if err != nil {
+		return handleError(fmt.Errorf("failed to prepare timetable template exclusions query: %w", err))
+	}
+
+	err = l.session(ctx).SelectContext(ctx, &exclusions, sqlx.Rebind(sqlx.DOLLAR, query), params...)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to select timetable template exclusions: %w", err))
+	}
+
+	templates.SetSlots(slots.toDomain(), exclusions.toDomain())
+
+	return nil
+}
+
+// TemplateLessonsTx returns lessons materialised from the template slots in the period.
+// Soft deleted lessons are returned as well, so their occurrences are not materialised again.
+func (l *Lesson) TemplateLessonsTx(
+	ctx context.Context, slotIDs []uuid.UUID, from, till time.Time,
+) (domain.Lessons, error) {
+	if len(slotIDs) == 0 {
+		return domain.Lessons{}, nil
+	}
+
+	sqlQuery := `
+		SELECT 
+			id, 
+			school_id, 
+			group_subject_id, 
+			teacher_id, 
+			auditorium_id, 
+			start_time, 
+			end_time, 
+			description, 
+			template_slot_id, 
+			is_overridden, 
+			created_at, 
+			updated_at,
+			deleted_at
+		FROM 
+			lessons
+		WHERE 
+			template_slot_id IN (?) AND
+			start_time >= ? AND 
+			start_time < ?
+		ORDER BY start_time`
+
+	sqlQuery, params, err := sqlx.In(sqlQuery, slotIDs, from, till)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to prepare template lessons query: %w", err))
+	}
+
+	var lessonsList LessonRows
+
+	err = l.session(ctx).SelectContext(ctx, &lessonsList, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), params...)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to select template lessons: %w", err))
+	}
+
+	return lessonsList.toDomain(), nil
+}
+
+// DeleteTemplateLessonsTx removes lessons of the template slots in the period which may be materialised again:
+// not overridden, not soft deleted and having neither marks nor attendance.
+func (l *Lesson) DeleteTemplateLessonsTx(ctx context.Context, slotIDs []uuid.UUID, from, till time.Time) error {
+	if len(slotIDs) == 0 {
+		return nil
+	}
+
+	deleteQuery := `
+		DELETE FROM 
+			lessons AS l
+		WHERE 
+			l.template_slot_id IN (?) AND
+			l.is_overridden = FALSE AND
+			l.deleted_at IS NULL AND
+			l.start_time >= ? AND 
+			l.start_time < ? AND
+			NOT EXISTS (SELECT 1 FROM marks AS m WHERE m.lesson_id = l.id) AND
+			NOT EXISTS (SELECT 1 FROM attendances AS a WHERE a.lesson_id = l.id)`
+
+	query, params, err := sqlx.In(deleteQuery, slotIDs, from, till)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to prepare template lessons delete query: %w", err))
+	}
+
+	_, err = l.session(ctx).ExecContext(ctx, sqlx.Rebind(sqlx.DOLLAR, query), params...)
+	if err != nil {
+		return handleError(fmt.Errorf("failed to remove template lessons: %w", err))
+	}
+
+	return nil
+}
+
+// AddLessonsTx inserts the lessons.
+func (l *Lesson) AddLessonsTx(ctx context.Context, lessons domain.Lessons) error {
+	return l.insertLessons(ctx, lessons)
+}
+
+// UpdateLessonTx updates teacher, auditorium, description and override flag of the lesson.
+func (l *Lesson) UpdateLessonTx(ctx context.Context, lesson domain.Lesson) error {
+	sqlQuery := `
+		UPDATE 
+			lessons
+		SET 
+			teacher_id = :teacher_id,
+			auditorium_id = :auditorium_id,
+			description = :description,
+			is_overridden = :is_overridden,
+			updated_at = :updated_at
+		WHERE 
+			id = :id AND
+			deleted_at IS NULL`
+
+	_, err := l.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":            lesson.ID,
+		"teacher_id":    lesson.TeacherID,
+		"auditorium_id": lesson.AuditoriumID,
+		"description":   lesson.Description,
+		"is_overridden": lesson.Overridden,
+		"updated_at":    lesson.UpdatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update lesson: %w", err))
+	}
+
+	return nil
+}
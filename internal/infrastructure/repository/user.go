@@ -47,6 +47,8 @@ type UserRow struct {
 	CreatedAt  time.Time  `db:"created_at"`
 	UpdatedAt  time.Time  `db:"updated_at"`
 	DeletedAt  *time.Time `db:"deleted_at"`
+
+	EmailVerifiedAt *time.Time `db:"email_verified_at"`
 }
 
 // toDomain converts an object into a domain model.
@@ -60,6 +62,8 @@ func (e UserRow) toDomain() domain.User {
 		Phone:      e.Phone,
 		Email:      e.Email,
 
+		EmailVerifiedAt: e.EmailVerifiedAt,
+
 		CreatedAt: e.CreatedAt,
 		UpdatedAt: e.UpdatedAt,
 		DeletedAt: e.DeletedAt,
@@ -124,7 +128,8 @@ func (u *User) UserByIDTx(ctx context.Context, id uuid.UUID) (domain.User, error
 	var (
 		getUserQuery = `
 			SELECT 
-				id, first_name, last_name, middle_name, gender, phone, email, created_at, updated_at, deleted_at
+				id, first_name, last_name, middle_name, gender, phone, email, email_verified_at,
+				created_at, updated_at, deleted_at
 			FROM 
 				users
 			WHERE 
@@ -147,7 +152,8 @@ func (u *User) UserByEmailTx(ctx context.Context, email string) (domain.User, er
 	var (
 		getUserQuery = `
 			SELECT 
-				id, first_name, last_name, middle_name, password, gender, phone, email, created_at, updated_at, deleted_at
+				id, first_name, last_name, middle_name, password, gender, phone, email, email_verified_at,
+				created_at, updated_at, deleted_at
 			FROM 
 				users
 			WHERE 
@@ -165,12 +171,37 @@ func (u *User) UserByEmailTx(ctx context.Context, email string) (domain.User, er
 	return row.toDomainWithPassword(), nil
 }
 
+// UserByPhoneTx get user by phone.
+func (u *User) UserByPhoneTx(ctx context.Context, phone string) (domain.User, error) {
+	var (
+		getUserQuery = `
+			SELECT
+				id, first_name, last_name, middle_name, gender, phone, email, email_verified_at,
+				created_at, updated_at, deleted_at
+			FROM
+				users
+			WHERE
+				phone = ? AND
+				deleted_at IS NULL`
+
+		row UserRow
+	)
+
+	err := u.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, getUserQuery), phone)
+	if err != nil {
+		return domain.User{}, handleError(fmt.Errorf("failed to select user by phone: %w", err))
+	}
+
+	return row.toDomain(), nil
+}
+
 // UsersByIDsTx get users by ids.
 func (u *User) UsersByIDsTx(ctx context.Context, ids []uuid.UUID) (domain.Users, error) {
 	var (
 		getUserQuery = `
 			SELECT 
-				id, first_name, last_name, middle_name, gender, phone, email, created_at, updated_at, deleted_at
+				id, first_name, last_name, middle_name, gender, phone, email, email_verified_at,
+				created_at, updated_at, deleted_at
 			FROM 
 				users
 			WHERE 
@@ -203,42 +234,49 @@ func (u *User) GetUserListTx(ctx context.Context, filters domain.UserListFilter)
 
 	var (
 		users = make(UserRows, 0)
+		page  = newKeyset(userListColumns, "users.created_at", "users.id", filters.ListFilter)
 		err   error
 	)
 
+	// users are distinct by id, so they are sorted for the page after the filters are applied.
 	query := `
-		SELECT DISTINCT ON (users.id)
-			users.id, 
-			users.first_name, 
-			users.last_name, 
-			users.middle_name, 
-			users.gender, 
-			users.phone, 
-			users.email, 
-
-			users.created_at, 
-			users.updated_at,
-			users.deleted_at
-		FROM 
-			users
-		LEFT JOIN
-			user_roles ON user_roles.user_id = users.id
-		LEFT JOIN 
-			schools ON schools.id = user_roles.school_id
-		LEFT JOIN 	
-			educational_organizations ON 
-				educational_organizations.id = user_roles.organization_id OR 
-				schools.organization_id = educational_organizations.id
-	` + where(filtersQuery)
-
-	query += fmt.Sprintf(
-		` ORDER BY 
-					users.id, users.created_at %s
-				LIMIT ? OFFSET ?`,
-		filters.SortOrder,
-	)
+		SELECT
+			*
+		FROM (
+			SELECT DISTINCT ON (users.id)
+				users.id, 
+				users.first_name, 
+				users.last_name, 
+				users.middle_name, 
+				users.gender, 
+				users.phone, 
+				users.email, 
+				users.email_verified_at,
+
+				users.created_at, 
+				users.updated_at,
+				users.deleted_at
+			FROM 
+				users
+			LEFT JOIN
+				user_roles ON user_roles.user_id = users.id
+			LEFT JOIN 
+				schools ON schools.id = user_roles.school_id
+			LEFT JOIN 	
+				educational_organizations ON 
+					educational_organizations.id = user_roles.organization_id OR 
+					schools.organization_id = educational_organizations.id
+	` + where(filtersQuery) + `
+		) AS users`
+
+	if condition, pageParams, ok := page.filter(); ok {
+		query += where([]string{condition})
+		params = append(params, pageParams...)
+	}
 
-	params = append(params, filters.Limit, filters.Offset)
+	orderBy, pageParams := page.orderBy()
+	query += orderBy
+	params = append(params, pageParams...)
 
 	if anySlices {
 		query, params, err = sqlx.In(query, params...)
@@ -252,7 +290,7 @@ func (u *User) GetUserListTx(ctx context.Context, filters domain.UserListFilter)
 		return nil, handleError(fmt.Errorf("failed to select user list: %w", err))
 	}
 
-	return users.toDomain(), nil
+	return keysetRows(page, users).toDomain(), nil
 }
 
 // UserCountTx gets a count of user.
@@ -294,6 +332,11 @@ func (u *User) UserCountTx(ctx context.Context, filters domain.UserListFilter) (
 	return count, nil
 }
 
+// userListColumns are the columns of the fields the list is sorted and filtered by.
+//
+//nolint:gochecknoglobals // it's map of columns
+var userListColumns = newListColumns("users", domain.UserListFields(), nil)
+
 // usersListFilter returns query by user list filter.
 func usersListFilter(filters domain.UserListFilter) (params []any, filtersQuery []string, anySlices bool) {
 	anySlices = false
@@ -335,5 +378,16 @@ func usersListFilter(filters domain.UserListFilter) (params []any, filtersQuery
 
 	filtersQuery = append(filtersQuery, "users.deleted_at IS NULL")
 
+	if filters.Search != "" {
+		condition, searchParams := searchCondition(filters.Search)
+
+		filtersQuery = append(filtersQuery, condition)
+		params = append(params, searchParams...)
+	}
+
+	params, filtersQuery, anySlices = userListColumns.appendConditions(
+		filters.ListFilter, params, filtersQuery, anySlices,
+	)
+
 	return params, filtersQuery, anySlices
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"bum-service/internal/domain"
+	"bum-service/pkg/postgres"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -149,3 +150,55 @@ func (u *User) UserRolesByIDsTx(ctx context.Context, userIDs []uuid.UUID) (domai
 
 	return row.toDomain(), nil
 }
+
+// deleteEntityRole marks the role of the teacher, student or other entity of the table as deleted.
+func deleteEntityRole(ctx context.Context, db postgres.DB, table string, id uuid.UUID, now time.Time) error {
+	sqlQuery := fmt.Sprintf(`
+		UPDATE
+			user_roles
+		SET
+			deleted_at = :deleted_at,
+			updated_at = :updated_at
+		WHERE
+			id = (SELECT role_id FROM %s WHERE id = :id) AND
+			deleted_at IS NULL`,
+		table,
+	)
+
+	_, err := db.NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":         id,
+		"deleted_at": now,
+		"updated_at": now,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to delete %s role: %w", table, err))
+	}
+
+	return nil
+}
+
+// restoreEntityRole restores the role of the deleted entity of the table if the role was deleted together
+// with the entity, so it must be called before the entity is restored.
+func restoreEntityRole(ctx context.Context, db postgres.DB, table string, id uuid.UUID, now time.Time) error {
+	sqlQuery := fmt.Sprintf(`
+		UPDATE
+			user_roles
+		SET
+			deleted_at = NULL,
+			updated_at = :updated_at
+		WHERE
+			id = (SELECT role_id FROM %[1]s WHERE id = :id) AND
+			deleted_at = (SELECT deleted_at FROM %[1]s WHERE id = :id)`,
+		table,
+	)
+
+	_, err := db.NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":         id,
+		"updated_at": now,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to restore %s role: %w", table, err))
+	}
+
+	return nil
+}
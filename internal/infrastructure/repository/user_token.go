@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"bum-service/internal/domain"
+)
+
+// UserTokensUserIDFKey is user token user id foreign key.
+const UserTokensUserIDFKey = "user_tokens_user_id_fkey"
+
+// UserTokenRow is a row containing user token.
+type UserTokenRow struct {
+	ID        uuid.UUID  `db:"id"`
+	UserID    uuid.UUID  `db:"user_id"`
+	Purpose   string     `db:"purpose"`
+	TokenHash string     `db:"token_hash"`
+	Email     string     `db:"email"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// toDomain converts to entity.
+func (u UserTokenRow) toDomain() domain.UserToken {
+	return domain.UserToken{
+		ID:        u.ID,
+		UserID:    u.UserID,
+		Purpose:   domain.UserTokenPurpose(u.Purpose),
+		TokenHash: u.TokenHash,
+		Email:     u.Email,
+		ExpiresAt: u.ExpiresAt,
+		UsedAt:    u.UsedAt,
+
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+// AddUserTokenTx creates a new user token.
+func (u *User) AddUserTokenTx(ctx context.Context, token domain.UserToken) error {
+	sqlQuery := `
+		INSERT INTO user_tokens
+			( id, user_id, purpose, token_hash, email, expires_at, created_at )
+		VALUES
+			(:id,:user_id,:purpose,:token_hash,:email,:expires_at,:created_at )`
+
+	_, err := u.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":         token.ID,
+		"user_id":    token.UserID,
+		"purpose":    token.Purpose,
+		"token_hash": token.TokenHash,
+		"email":      token.Email,
+		"expires_at": token.ExpiresAt,
+		"created_at": token.CreatedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to insert user token: %w", err))
+	}
+
+	return nil
+}
+
+// UserTokenByHashTx returns the user token with the purpose by the hash of its secret.
+func (u *User) UserTokenByHashTx(
+	ctx context.Context, purpose domain.UserTokenPurpose, tokenHash string,
+) (domain.UserToken, error) {
+	var (
+		sqlQuery = `
+			SELECT
+				id, user_id, purpose, token_hash, email, expires_at, used_at, created_at
+			FROM
+				user_tokens
+			WHERE
+				purpose = ? AND
+				token_hash = ?`
+
+		row UserTokenRow
+	)
+
+	err := u.session(ctx).GetContext(ctx, &row, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), purpose, tokenHash)
+	if err != nil {
+		return domain.UserToken{}, handleError(fmt.Errorf("failed to select user token by hash: %w", err))
+	}
+
+	return row.toDomain(), nil
+}
+
+// UseUserTokenTx marks the token as used, ErrInvalidUserToken is returned if the token
+// was used concurrently.
+func (u *User) UseUserTokenTx(ctx context.Context, token domain.UserToken) error {
+	sqlQuery := `
+		UPDATE
+			user_tokens
+		SET
+			used_at = :used_at
+		WHERE
+			id = :id AND
+			used_at IS NULL`
+
+	result, err := u.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":      token.ID,
+		"used_at": token.UsedAt,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to use user token: %w", err))
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return handleError(fmt.Errorf("failed to use user token: %w", err))
+	}
+
+	if affected == 0 {
+		return domain.ErrInvalidUserToken
+	}
+
+	return nil
+}
+
+// UpdateUserPasswordTx sets a new password hash of the user.
+func (u *User) UpdateUserPasswordTx(ctx context.Context, userID uuid.UUID, passwordHash string, now time.Time) error {
+	sqlQuery := `
+		UPDATE
+			users
+		SET
+			password = :password,
+			updated_at = :updated_at
+		WHERE
+			id = :id AND
+			deleted_at IS NULL`
+
+	_, err := u.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":         userID,
+		"password":   passwordHash,
+		"updated_at": now,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to update user password: %w", err))
+	}
+
+	return nil
+}
+
+// VerifyUserEmailTx marks the email of the user as verified if the user still has the email.
+func (u *User) VerifyUserEmailTx(ctx context.Context, userID uuid.UUID, email string, now time.Time) error {
+	sqlQuery := `
+		UPDATE
+			users
+		SET
+			email_verified_at = :email_verified_at,
+			updated_at = :updated_at
+		WHERE
+			id = :id AND
+			email = :email AND
+			deleted_at IS NULL`
+
+	result, err := u.session(ctx).NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":                userID,
+		"email":             email,
+		"email_verified_at": now,
+		"updated_at":        now,
+	})
+	if err != nil {
+		return handleError(fmt.Errorf("failed to verify user email: %w", err))
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return handleError(fmt.Errorf("failed to verify user email: %w", err))
+	}
+
+	// the email was changed after the token was sent.
+	if affected == 0 {
+		return domain.ErrInvalidUserToken
+	}
+
+	return nil
+}
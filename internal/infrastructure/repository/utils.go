@@ -1,11 +1,19 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 
 	"bum-service/internal/domain"
+	"bum-service/pkg/postgres"
 
 	"github.com/jackc/pgx/v5/pgconn"
 )
@@ -19,6 +27,284 @@ func where(parameters []string) string {
 	return " WHERE " + strings.Join(parameters, " AND ")
 }
 
+// listColumns are the columns of the fields the list is sorted and filtered by.
+type listColumns map[string]string
+
+// newListColumns returns the columns of the list fields of the table,
+// the fields of the joined tables are mapped to their columns by joined.
+func newListColumns(table string, fields domain.ListFields, joined map[string]string) listColumns {
+	columns := make(listColumns, len(fields))
+
+	for field := range fields {
+		columns[field] = table + "." + field
+
+		if column, ok := joined[field]; ok {
+			columns[field] = column
+		}
+	}
+
+	return columns
+}
+
+// filterOperators are SQL operators of the field conditions.
+//
+//nolint:gochecknoglobals // it's map of operators
+var filterOperators = map[domain.FilterOperator]string{
+	domain.FilterEqual:          "=",
+	domain.FilterNotEqual:       "<>",
+	domain.FilterGreater:        ">",
+	domain.FilterGreaterOrEqual: ">=",
+	domain.FilterLess:           "<",
+	domain.FilterLessOrEqual:    "<=",
+	domain.FilterIn:             "IN",
+}
+
+// appendConditions appends the conditions of the fields of the list filter to the query filters.
+// The fields are validated by the list fields of the domain, the condition of an unknown field matches nothing.
+func (c listColumns) appendConditions(
+	list domain.ListFilter, params []any, filtersQuery []string, anySlices bool,
+) ([]any, []string, bool) {
+	for _, condition := range list.Conditions {
+		column, ok := c[condition.Field]
+		operator, known := filterOperators[condition.Operator]
+
+		switch {
+		case !ok || !known || len(condition.Values) == 0:
+			filtersQuery = append(filtersQuery, "FALSE")
+		case condition.Operator == domain.FilterIn:
+			anySlices = true
+
+			filtersQuery = append(filtersQuery, column+" IN (?)")
+			params = append(params, condition.Values)
+		default:
+			filtersQuery = append(filtersQuery, column+" "+operator+" ?")
+			params = append(params, condition.Values[0])
+		}
+	}
+
+	return params, filtersQuery, anySlices
+}
+
+// sort returns the ORDER BY list of the fields the list is sorted by, empty if it's sorted by default.
+func (c listColumns) sort(list domain.ListFilter) string {
+	orders := make([]string, 0, len(list.Sort))
+
+	for _, field := range list.Sort {
+		column, ok := c[field.Field]
+		if !ok {
+			continue
+		}
+
+		order := domain.SortOrderASC
+		if field.Desc {
+			order = domain.SortOrderDesc
+		}
+
+		orders = append(orders, column+" "+order)
+	}
+
+	return strings.Join(orders, ", ")
+}
+
+// orderBy returns ORDER BY clause of the list sorted by the fields,
+// the list is sorted by the default column with the sort order of the list otherwise and on ties.
+func (c listColumns) orderBy(list domain.ListFilter, defaultColumn string) string {
+	defaultOrder := defaultColumn + " " + list.SortOrder
+
+	if sort := c.sort(list); sort != "" {
+		return " ORDER BY " + sort + ", " + defaultOrder
+	}
+
+	return " ORDER BY " + defaultOrder
+}
+
+// keyset is the page of the list sorted by the key column and then by the id column.
+// The page is selected with one extra row, so it's known whether there are more rows,
+// and the page before the cursor is selected in the reverse order.
+// The list sorted by fields is sorted by them and then by the id column, its page is selected by the offset.
+type keyset struct {
+	keyColumn  string
+	idColumn   string
+	sortOrder  string
+	sort       string
+	pagination domain.Pagination
+}
+
+// newKeyset creates a new keyset of the list filter.
+func newKeyset(columns listColumns, keyColumn, idColumn string, list domain.ListFilter) keyset {
+	return keyset{
+		keyColumn:  keyColumn,
+		idColumn:   idColumn,
+		sortOrder:  list.SortOrder,
+		sort:       columns.sort(list),
+		pagination: list.Pagination,
+	}
+}
+
+// reversed reports whether the rows are selected in the reverse order of the list.
+func (k keyset) reversed() bool {
+	return k.pagination.Cursor != nil && k.pagination.Cursor.Before
+}
+
+// order returns the order the rows are selected in.
+func (k keyset) order() string {
+	desc := k.sortOrder == domain.SortOrderDesc
+	if k.reversed() {
+		desc = !desc
+	}
+
+	if desc {
+		return domain.SortOrderDesc
+	}
+
+	return domain.SortOrderASC
+}
+
+// filter returns the condition of the rows after the cursor, false if the page is not selected by the cursor.
+func (k keyset) filter() (string, []any, bool) {
+	if k.pagination.Cursor == nil {
+		return "", nil, false
+	}
+
+	operator := ">"
+	if k.order() == domain.SortOrderDesc {
+		operator = "<"
+	}
+
+	condition := fmt.Sprintf("(%s, %s) %s (?, ?)", k.keyColumn, k.idColumn, operator)
+
+	return condition, []any{k.pagination.Cursor.Key, k.pagination.Cursor.ID}, true
+}
+
+// orderBy returns ORDER BY and LIMIT clauses of the page.
+func (k keyset) orderBy() (string, []any) {
+	query := fmt.Sprintf(
+		` ORDER BY %[1]s %[3]s, %[2]s %[3]s LIMIT ? OFFSET ?`,
+		k.keyColumn, k.idColumn, k.order(),
+	)
+
+	if k.sort != "" {
+		query = fmt.Sprintf(` ORDER BY %s, %s %s LIMIT ? OFFSET ?`, k.sort, k.idColumn, k.sortOrder)
+	}
+
+	offset := k.pagination.Offset
+	if k.pagination.Cursor != nil {
+		offset = 0
+	}
+
+	return query, []any{k.pagination.Limit + 1, offset}
+}
+
+// keysetRows returns the rows of the page in the list order.
+func keysetRows[S ~[]E, E any](k keyset, rows S) S {
+	if k.reversed() {
+		slices.Reverse(rows)
+	}
+
+	return rows
+}
+
+// softDelete marks the not deleted row of the table as deleted, false is returned if there is no such row.
+func softDelete(ctx context.Context, db postgres.DB, table string, id uuid.UUID, now time.Time) (bool, error) {
+	sqlQuery := fmt.Sprintf(`
+		UPDATE
+			%s
+		SET
+			deleted_at = :deleted_at,
+			updated_at = :updated_at
+		WHERE
+			id = :id AND
+			deleted_at IS NULL`,
+		table,
+	)
+
+	result, err := db.NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":         id,
+		"deleted_at": now,
+		"updated_at": now,
+	})
+	if err != nil {
+		return false, handleError(fmt.Errorf("failed to delete %s: %w", table, err))
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, handleError(fmt.Errorf("failed to delete %s: %w", table, err))
+	}
+
+	return affected != 0, nil
+}
+
+// restore clears the deletion mark of the deleted row of the table, false is returned if there is no such row.
+func restore(ctx context.Context, db postgres.DB, table string, id uuid.UUID, now time.Time) (bool, error) {
+	sqlQuery := fmt.Sprintf(`
+		UPDATE
+			%s
+		SET
+			deleted_at = NULL,
+			updated_at = :updated_at
+		WHERE
+			id = :id AND
+			deleted_at IS NOT NULL`,
+		table,
+	)
+
+	result, err := db.NamedExecContext(ctx, sqlQuery, map[string]any{
+		"id":         id,
+		"updated_at": now,
+	})
+	if err != nil {
+		return false, handleError(fmt.Errorf("failed to restore %s: %w", table, err))
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, handleError(fmt.Errorf("failed to restore %s: %w", table, err))
+	}
+
+	return affected != 0, nil
+}
+
+// updatedWithVersion returns domain.ErrOutdatedVersion if the update of the row with its version changed nothing,
+// i.e. the row was updated or deleted after its version was read. The row is updated only if it's not updated
+// since its version was read, so concurrent changes are not overwritten.
+func updatedWithVersion(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return handleError(fmt.Errorf("failed to get number of updated rows: %w", err))
+	}
+
+	if affected == 0 {
+		return domain.ErrOutdatedVersion
+	}
+
+	return nil
+}
+
+// dependents returns the number of dependent entities selected by the query,
+// every column of the query is a count of the entities of the kind it is named after.
+func dependents(ctx context.Context, db postgres.DB, sqlQuery string, args ...any) (domain.Dependents, error) {
+	row := make(map[string]any)
+
+	err := db.QueryRowxContext(ctx, sqlx.Rebind(sqlx.DOLLAR, sqlQuery), args...).MapScan(row)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("failed to count dependents: %w", err))
+	}
+
+	counts := make(domain.Dependents, len(row))
+
+	for kind, value := range row {
+		count, ok := value.(int64)
+		if !ok {
+			return nil, fmt.Errorf("failed to count dependents: unexpected %s count %T", kind, value)
+		}
+
+		counts[kind] = int(count)
+	}
+
+	return counts, nil
+}
+
 //nolint:gochecknoglobals //it's map of errors
 var mapOfErrors = map[string]error{
 	// Director errors
@@ -58,6 +344,19 @@ var mapOfErrors = map[string]error{
 	UserRolesSchoolIDFKey:       domain.ErrSchoolNotFound,
 	UserRolesOrganizationIDFKey: domain.ErrEduOrganizationNotFound,
 
+	// Auth sessions errors
+	AuthSessionsUserIDFKey:       domain.ErrUserNotFound,
+	AuthSessionsActiveRoleIDFKey: domain.ErrUserRoleNotFound,
+
+	// User tokens errors
+	UserTokensUserIDFKey: domain.ErrUserNotFound,
+
+	// Login codes errors
+	LoginCodesUserIDFKey: domain.ErrUserNotFound,
+
+	// Failed login attempts errors
+	FailedLoginAttemptsUserIDFKey: domain.ErrUserNotFound,
+
 	// Teacher errors
 	TeachersUserIDFKey:   domain.ErrUserNotFound,
 	TeachersSchoolIDFKey: domain.ErrSchoolNotFound,
@@ -69,6 +368,12 @@ var mapOfErrors = map[string]error{
 	// Grade Standard errors
 	GradeStandardsNameUniqueKey:      domain.ErrGradeStandardNameAlreadyExists,
 	GradeStandardsOrganizationIDFKey: domain.ErrEduOrganizationNotFound,
+	GradeStandardsGradingScaleIDFKey: domain.ErrGradingScaleNotFound,
+
+	// Grading scales
+	GradingScalesNameUniqueKey:      domain.ErrGradingScaleAlreadyExists,
+	GradingScalesOrganizationIDFKey: domain.ErrEduOrganizationNotFound,
+	GradingScaleValuesValueKey:      domain.ErrInvalidGradingScale,
 
 	// Group errors
 	GroupsGradeIDFkey:          domain.ErrGradeNotFound,
@@ -76,6 +381,12 @@ var mapOfErrors = map[string]error{
 	GroupsSchoolIDFkey:         domain.ErrSchoolNotFound,
 	GroupsClassTeacherIDFKey:   domain.ErrTeacherNotFound,
 	GroupsClassPresidentIDFKey: domain.ErrStudentNotFound,
+	GroupsAcademicYearIDFKey:   domain.ErrAcademicYearNotFound,
+	GroupsPreviousGroupIDKey:   domain.ErrGroupAlreadyPromoted,
+
+	// Student promotions
+	StudentPromotionsKey:                domain.ErrGroupAlreadyPromoted,
+	StudentPromotionsAcademicYearIDFKey: domain.ErrAcademicYearNotFound,
 
 	// Group subjects errors
 	GroupSubjectsUniqueKey:           domain.ErrGroupSubjectAlreadyExists,
@@ -86,6 +397,7 @@ var mapOfErrors = map[string]error{
 	// Study Plan errors
 	StudyPlanGroupSubjectPlanOrderUniqueKey: domain.ErrStudyPlanAlreadyExists,
 	StudyPlanGroupSubjectIDFKey:             domain.ErrGroupSubjectNotFound,
+	StudyPlansTermIDFKey:                    domain.ErrTermNotFound,
 
 	// Auditorium errors
 	AuditoriumsSchoolSubjectIDFkey: domain.ErrSchoolSubjectNotFound,
@@ -96,16 +408,48 @@ var mapOfErrors = map[string]error{
 	StudentsGroupIDFKey: domain.ErrGroupNotFound,
 	StudentsUserIDFKey:  domain.ErrUserNotFound,
 
+	// Student group memberships
+	StudentGroupMembershipsCurrentKey:    domain.ErrStudentMembershipConflict,
+	StudentGroupMembershipsGroupIDFKey:   domain.ErrGroupNotFound,
+	StudentGroupMembershipsStudentIDFKey: domain.ErrStudentNotFound,
+	StudentGroupMembershipsPeriodCheck:   domain.ErrInvalidTransferDate,
+
 	// Lesson errors
 	LessonsSchoolIDFKey:       domain.ErrSchoolNotFound,
 	LessonsGroupSubjectIDFKey: domain.ErrGroupSubjectNotFound,
 	LessonsTeacherIDFKey:      domain.ErrTeacherNotFound,
 	LessonsAuditoriumIDFKey:   domain.ErrAuditoriumNotFound,
+	LessonsTermIDFKey:         domain.ErrTermNotFound,
+
+	// Timetable templates
+	TimetableTemplatesGroupIDFKey:            domain.ErrGroupNotFound,
+	TimetableTemplatesPeriodCheck:            domain.ErrInvalidTimetableTemplatePeriod,
+	TimetableTemplateSlotsGroupSubjectIDFKey: domain.ErrGroupSubjectNotFound,
+	TimetableTemplateSlotsTeacherIDFKey:      domain.ErrTeacherNotFound,
+	TimetableTemplateSlotsAuditoriumIDFKey:   domain.ErrAuditoriumNotFound,
+	TimetableTemplateExclusionsPeriodCheck:   domain.ErrInvalidTimetableTemplatePeriod,
 
 	// Marks
 	MarksLessonIDFKey:  domain.ErrLessonNotFound,
 	MarksStudentIDFKey: domain.ErrStudentNotFound,
 	MarksLessonKey:     domain.ErrMarkAlreadyExists,
+	MarksTermIDFKey:    domain.ErrTermNotFound,
+
+	// Academic years
+	AcademicYearsNameKey:      domain.ErrAcademicYearAlreadyExists,
+	AcademicYearsSchoolIDFKey: domain.ErrSchoolNotFound,
+	AcademicYearsPeriodCheck:  domain.ErrInvalidAcademicYearPeriod,
+	TermsPeriodCheck:          domain.ErrInvalidAcademicYearPeriod,
+	HolidaysPeriodCheck:       domain.ErrInvalidAcademicYearPeriod,
+
+	// Final grades
+	FinalGradesGroupSubjectIDFKey: domain.ErrGroupSubjectNotFound,
+	FinalGradesStudentIDFKey:      domain.ErrStudentNotFound,
+	FinalGradesPeriodCheck:        domain.ErrInvalidGradebookPeriod,
+
+	// Attendances
+	AttendancesLessonIDFKey:  domain.ErrLessonNotFound,
+	AttendancesStudentIDFKey: domain.ErrStudentNotFound,
 
 	// Student Guardians
 	StudentGuardiansKey:           domain.ErrStudentGuardianAlreadyExists,
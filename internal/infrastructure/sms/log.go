@@ -0,0 +1,23 @@
+package sms
+
+import (
+	"context"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/liblog"
+)
+
+// Log is an SMS sender for local development and tests, it does not send messages but writes them to the log.
+type Log struct{}
+
+// NewLog creates a new log SMS sender.
+func NewLog() *Log {
+	return &Log{}
+}
+
+// Send writes the message to the log.
+func (*Log) Send(ctx context.Context, sms domain.SMS) error {
+	liblog.Must(ctx).Infof("sms to %s: %s", sms.To, sms.Text)
+
+	return nil
+}
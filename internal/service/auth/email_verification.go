@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// SendEmailVerification sends an email verification link to the email of the user.
+func (s Service) SendEmailVerification(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.userService.UserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return domain.ErrEmailAlreadyVerified
+	}
+
+	token, secret, err := domain.NewUserToken(user, domain.EmailVerification, s.cfg.EmailVerificationTokenExp, s.now)
+	if err != nil {
+		return fmt.Errorf("failed to create email verification token: %w", err)
+	}
+
+	if err = s.authRepo.AddUserTokenTx(ctx, token); err != nil {
+		return fmt.Errorf("failed to add email verification token: %w", err)
+	}
+
+	link, err := tokenLink(s.cfg.EmailVerificationURL, secret)
+	if err != nil {
+		return fmt.Errorf("failed to create email verification link: %w", err)
+	}
+
+	err = s.mailer.Send(ctx, domain.Email{
+		To:      user.Email,
+		Subject: "Email verification",
+		Body: fmt.Sprintf(
+			"Hello, %s!\n\nTo confirm your email follow the link:\n%s\n\nThe link is valid until %s UTC.\n",
+			user.FirstName, link, token.ExpiresAt.UTC().Format("2006-01-02 15:04"),
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send email verification email: %w", err)
+	}
+
+	return nil
+}
+
+// VerifyEmail marks the email the verification token was sent to as verified,
+// the token can be used only once.
+func (s Service) VerifyEmail(ctx context.Context, secret string) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on verify email: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	token, err := s.useUserToken(txCtx, domain.EmailVerification, secret)
+	if err != nil {
+		return err
+	}
+
+	if err = s.authRepo.VerifyUserEmailTx(txCtx, token.UserID, token.Email, s.now()); err != nil {
+		return fmt.Errorf("failed to verify user email: %w", err)
+	}
+
+	return nil
+}
@@ -2,6 +2,9 @@ package auth
 
 import (
 	"context"
+	"time"
+
+	"github.com/google/uuid"
 
 	"bum-service/internal/domain"
 )
@@ -9,4 +12,48 @@ import (
 // IUserService represents a user service for adding roles.
 type IUserService interface {
 	UserByEmail(ctx context.Context, email string) (domain.User, error)
+	UserByPhone(ctx context.Context, phone string) (domain.User, error)
+	UserByID(ctx context.Context, userID uuid.UUID) (domain.User, error)
+	UserRoles(ctx context.Context, userID uuid.UUID) (domain.UserRoles, error)
+}
+
+// IAuthRepo represents a repository of user auth sessions.
+type IAuthRepo interface {
+	AddAuthSessionTx(ctx context.Context, session domain.AuthSession) error
+	AuthSessionByIDTx(ctx context.Context, id uuid.UUID) (domain.AuthSession, error)
+	ActiveAuthSessionsTx(ctx context.Context, userID uuid.UUID, now time.Time) (domain.AuthSessions, error)
+	RotateAuthSessionTx(ctx context.Context, session domain.AuthSession, previousTokenID uuid.UUID) error
+	UpdateAuthSessionActiveRoleTx(ctx context.Context, session domain.AuthSession) error
+	RevokeAuthSessionTx(ctx context.Context, userID, sessionID uuid.UUID, now time.Time) error
+	RevokeUserAuthSessionsTx(ctx context.Context, userID uuid.UUID, keptSessionID *uuid.UUID, now time.Time) error
+
+	AddUserTokenTx(ctx context.Context, token domain.UserToken) error
+	UserTokenByHashTx(ctx context.Context, purpose domain.UserTokenPurpose, tokenHash string) (domain.UserToken, error)
+	UseUserTokenTx(ctx context.Context, token domain.UserToken) error
+	UpdateUserPasswordTx(ctx context.Context, userID uuid.UUID, passwordHash string, now time.Time) error
+	VerifyUserEmailTx(ctx context.Context, userID uuid.UUID, email string, now time.Time) error
+
+	AddLoginCodeTx(ctx context.Context, code domain.LoginCode) error
+	LastLoginCodeByPhoneTx(ctx context.Context, phone string) (domain.LoginCode, error)
+	UpdateLoginCodeTx(ctx context.Context, code domain.LoginCode, previousAttempts int) error
+}
+
+// IMailer represents a sender of emails to users.
+type IMailer interface {
+	Send(ctx context.Context, email domain.Email) error
+}
+
+// ISMSSender represents a sender of text messages to phones of users.
+type ISMSSender interface {
+	Send(ctx context.Context, sms domain.SMS) error
+}
+
+// ILoginAttemptStore represents a store of failed logins.
+type ILoginAttemptStore interface {
+	LoginFailures(ctx context.Context, key string) (domain.LoginFailures, error)
+	AddLoginFailure(
+		ctx context.Context, key string, policy domain.LoginThrottlePolicy, now time.Time,
+	) (domain.LoginFailures, error)
+	DeleteLoginFailures(ctx context.Context, key string) error
+	AddFailedLoginAttempt(ctx context.Context, attempt domain.FailedLoginAttempt) error
 }
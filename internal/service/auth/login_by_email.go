@@ -2,26 +2,161 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 
 	"bum-service/internal/domain"
+	"bum-service/pkg/liblog"
 	"bum-service/pkg/utils"
 )
 
+// LoginArgs is arguments for logging in by email and password.
+type LoginArgs struct {
+	Email     string
+	Password  string
+	IP        string
+	UserAgent string
+}
+
 // GetUserIDByEmailAndPassword returns userID by email and password.
-func (s Service) GetUserIDByEmailAndPassword(ctx context.Context, email, password string) (uuid.UUID, error) {
+// Failed logins to the account and from the IP address are counted, and after too many of them
+// logins are delayed and then locked, ErrLoginLocked is returned in this case.
+func (s Service) GetUserIDByEmailAndPassword(ctx context.Context, args LoginArgs) (uuid.UUID, error) {
+	if err := s.checkLoginThrottle(ctx, args); err != nil {
+		return uuid.Nil, err
+	}
+
 	// Get user by email in order to get its id and password
-	user, err := s.userService.UserByEmail(ctx, email)
+	user, err := s.userService.UserByEmail(ctx, args.Email)
 	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			s.loginFailed(ctx, args, nil)
+		}
+
 		return uuid.Nil, fmt.Errorf("failed to check password: %w : %w", domain.ErrInvalidUser, err)
 	}
 
 	// Check the password
-	if err = utils.ComparePassword(user.Password, password); err != nil {
+	if err = utils.ComparePassword(user.Password, args.Password); err != nil {
+		s.loginFailed(ctx, args, &user.ID)
+
 		return uuid.Nil, fmt.Errorf("failed to check password: %w : %w", domain.ErrInvalidUser, err)
 	}
 
+	// failures from the IP address are kept, otherwise one known password would unlock guessing the others.
+	if err = s.loginAttempts.DeleteLoginFailures(ctx, domain.AccountLoginFailuresKey(args.Email)); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to delete login failures: %w", err)
+	}
+
 	return user.ID, nil
 }
+
+// UnlockUserLogin forgets failed logins to the account of the user and so unlocks it.
+func (s Service) UnlockUserLogin(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.userService.UserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	if err = s.loginAttempts.DeleteLoginFailures(ctx, domain.AccountLoginFailuresKey(user.Email)); err != nil {
+		return fmt.Errorf("failed to delete login failures: %w", err)
+	}
+
+	return nil
+}
+
+// checkLoginThrottle returns ErrLoginLocked if logins to the account or from the IP address are not allowed now.
+func (s Service) checkLoginThrottle(ctx context.Context, args LoginArgs) error {
+	err := s.checkLoginThrottles(ctx, s.loginThrottles(domain.AccountLoginFailuresKey(args.Email), args.IP))
+	if err != nil {
+		s.addFailedLoginAttempt(ctx, args, nil, domain.LoginFailureLocked)
+	}
+
+	return err
+}
+
+// checkLoginThrottles returns ErrLoginLocked if logins are not allowed now by any of the throttles.
+func (s Service) checkLoginThrottles(ctx context.Context, throttles []loginThrottle) error {
+	now := s.now()
+
+	for _, throttle := range throttles {
+		failures, err := s.loginFailures(ctx, throttle.key)
+		if err != nil {
+			return err
+		}
+
+		if retryAfter := failures.RetryAfter(throttle.policy, now); retryAfter > 0 {
+			return fmt.Errorf("failed to check login throttle of %s: %w", throttle.key, domain.NewLoginLockedErr(retryAfter))
+		}
+	}
+
+	return nil
+}
+
+// loginFailed counts the failed login to the account and from the IP address and stores its audit record.
+// Errors are only logged in order to respond with the invalid user error anyway.
+func (s Service) loginFailed(ctx context.Context, args LoginArgs, userID *uuid.UUID) {
+	s.countLoginFailure(ctx, s.loginThrottles(domain.AccountLoginFailuresKey(args.Email), args.IP))
+	s.addFailedLoginAttempt(ctx, args, userID, domain.LoginFailureInvalidCredentials)
+}
+
+// countLoginFailure counts the failed login by all the throttles, errors are only logged.
+func (s Service) countLoginFailure(ctx context.Context, throttles []loginThrottle) {
+	var (
+		logger = liblog.Must(ctx)
+		now    = s.now()
+	)
+
+	for _, throttle := range throttles {
+		if _, err := s.loginAttempts.AddLoginFailure(ctx, throttle.key, throttle.policy, now); err != nil {
+			logger.Errorf("failed to count failed login: %v", err)
+		}
+	}
+}
+
+// addFailedLoginAttempt stores the audit record of the failed login, errors are only logged.
+func (s Service) addFailedLoginAttempt(
+	ctx context.Context, args LoginArgs, userID *uuid.UUID, reason domain.LoginFailureReason,
+) {
+	attempt := domain.NewFailedLoginAttempt(args.Email, userID, args.IP, args.UserAgent, reason, s.now)
+
+	if err := s.loginAttempts.AddFailedLoginAttempt(ctx, attempt); err != nil {
+		liblog.Must(ctx).Errorf("failed to add failed login attempt: %v", err)
+	}
+}
+
+// loginFailures returns failed logins by the key, a new counter if there are no failures.
+func (s Service) loginFailures(ctx context.Context, key string) (domain.LoginFailures, error) {
+	failures, err := s.loginAttempts.LoginFailures(ctx, key)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.NewLoginFailures(key), nil
+		}
+
+		return domain.LoginFailures{}, fmt.Errorf("failed to get login failures: %w", err)
+	}
+
+	return failures, nil
+}
+
+// loginThrottle is a key failed logins are counted by and the policy they are throttled by.
+type loginThrottle struct {
+	key    string
+	policy domain.LoginThrottlePolicy
+}
+
+// loginThrottles returns the counters of failed logins to the account and from the IP address
+// the login is checked by.
+func (s Service) loginThrottles(accountKey, ip string) []loginThrottle {
+	throttles := []loginThrottle{
+		{key: accountKey, policy: s.cfg.AccountLoginPolicy},
+	}
+
+	if ip != "" {
+		throttles = append(throttles, loginThrottle{key: domain.IPLoginFailuresKey(ip), policy: s.cfg.IPLoginPolicy})
+	}
+
+	return throttles
+}
@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/liblog"
+)
+
+// SendPhoneLoginCode sends a one-time login code to the phone.
+// Nothing is sent if there is no user with the phone or the previous code was sent too recently,
+// but no error is returned in order not to disclose which phones are registered.
+func (s Service) SendPhoneLoginCode(ctx context.Context, phone string) error {
+	logger := liblog.Must(ctx)
+
+	user, err := s.userService.UserByPhone(ctx, phone)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get user by phone: %w", err)
+	}
+
+	lastCode, err := s.authRepo.LastLoginCodeByPhoneTx(ctx, phone)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return fmt.Errorf("failed to get last login code by phone: %w", err)
+	}
+
+	if err == nil {
+		if resendAfter := lastCode.ResendAfter(s.cfg.PhoneLoginCodeResendInterval, s.now()); resendAfter > 0 {
+			logger.Infof("login code is not sent, the previous one was sent less than %v ago",
+				s.cfg.PhoneLoginCodeResendInterval)
+
+			return nil
+		}
+	}
+
+	loginCode, code, err := domain.NewLoginCode(user.ID, phone, s.cfg.PhoneLoginCodeExp, s.now)
+	if err != nil {
+		return fmt.Errorf("failed to create login code: %w", err)
+	}
+
+	if err = s.authRepo.AddLoginCodeTx(ctx, loginCode); err != nil {
+		return fmt.Errorf("failed to add login code: %w", err)
+	}
+
+	err = s.smsSender.Send(ctx, domain.SMS{
+		To: phone,
+		Text: fmt.Sprintf("Your login code is %s. It is valid for %d minutes, do not share it with anyone.",
+			code, int(s.cfg.PhoneLoginCodeExp.Minutes())),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send login code: %w", err)
+	}
+
+	return nil
+}
+
+// PhoneLoginArgs is arguments for logging in by phone and one-time login code.
+type PhoneLoginArgs struct {
+	Phone string
+	Code  string
+	IP    string
+}
+
+// GetUserIDByPhoneAndCode returns userID by phone and the last one-time login code sent to it,
+// the code can be used only once. Failed logins by the phone and from the IP address are throttled
+// the same way as logins by email, ErrLoginLocked is returned when they are locked.
+func (s Service) GetUserIDByPhoneAndCode(ctx context.Context, args PhoneLoginArgs) (uuid.UUID, error) {
+	throttles := s.loginThrottles(domain.PhoneLoginFailuresKey(args.Phone), args.IP)
+
+	if err := s.checkLoginThrottles(ctx, throttles); err != nil {
+		return uuid.Nil, err
+	}
+
+	userID, err := s.userIDByPhoneAndCode(ctx, args.Phone, args.Code)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidLoginCode) {
+			s.countLoginFailure(ctx, throttles)
+		}
+
+		return uuid.Nil, err
+	}
+
+	// failures from the IP address are kept, as for logins by email.
+	if err = s.loginAttempts.DeleteLoginFailures(ctx, domain.PhoneLoginFailuresKey(args.Phone)); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to delete login failures: %w", err)
+	}
+
+	return userID, nil
+}
+
+// userIDByPhoneAndCode verifies the last login code sent to the phone and returns id of the user having the phone.
+func (s Service) userIDByPhoneAndCode(ctx context.Context, phone, code string) (uuid.UUID, error) {
+	loginCode, err := s.authRepo.LastLoginCodeByPhoneTx(ctx, phone)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return uuid.Nil, domain.ErrInvalidLoginCode
+		}
+
+		return uuid.Nil, fmt.Errorf("failed to get last login code by phone: %w", err)
+	}
+
+	previousAttempts := loginCode.Attempts
+	errVerify := loginCode.Verify(code, s.cfg.PhoneLoginCodeMaxAttempts, s.now)
+
+	// the attempt is stored even if the code is wrong, so the code cannot be guessed endlessly.
+	if loginCode.Attempts != previousAttempts {
+		if err = s.authRepo.UpdateLoginCodeTx(ctx, loginCode, previousAttempts); err != nil {
+			return uuid.Nil, fmt.Errorf("failed to update login code: %w", err)
+		}
+	}
+
+	if errVerify != nil {
+		return uuid.Nil, fmt.Errorf("failed to verify login code: %w", errVerify)
+	}
+
+	user, err := s.userService.UserByID(ctx, loginCode.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return uuid.Nil, domain.ErrInvalidLoginCode
+		}
+
+		return uuid.Nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	// the phone was changed after the code was sent.
+	if user.Phone == nil || *user.Phone != phone {
+		return uuid.Nil, domain.ErrInvalidLoginCode
+	}
+
+	return user.ID, nil
+}
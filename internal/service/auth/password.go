@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+	"bum-service/pkg/utils"
+)
+
+// ChangePasswordArgs is arguments for changing password of the user.
+type ChangePasswordArgs struct {
+	UserID uuid.UUID
+	// SessionID is the session the password is changed from, it stays active.
+	SessionID       *uuid.UUID
+	CurrentPassword string
+	NewPassword     string
+}
+
+// ChangePassword sets a new password of the user if the current password is correct
+// and revokes the other sessions of the user.
+func (s Service) ChangePassword(ctx context.Context, args ChangePasswordArgs) error {
+	user, err := s.userService.UserByID(ctx, args.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	// the user by id has no password hash.
+	user, err = s.userService.UserByEmail(ctx, user.Email)
+	if err != nil {
+		return fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	if err = utils.ComparePassword(user.Password, args.CurrentPassword); err != nil {
+		return fmt.Errorf("failed to check password: %w : %w", domain.ErrWrongCurrentPassword, err)
+	}
+
+	return s.setPassword(ctx, user.ID, args.NewPassword, args.SessionID)
+}
+
+// ForgotPassword sends a password reset link to the email.
+// Nothing is sent if there is no user with the email, but no error is returned
+// in order not to disclose which emails are registered.
+func (s Service) ForgotPassword(ctx context.Context, email string) error {
+	user, err := s.userService.UserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	token, secret, err := domain.NewUserToken(user, domain.PasswordReset, s.cfg.PasswordResetTokenExp, s.now)
+	if err != nil {
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+
+	if err = s.authRepo.AddUserTokenTx(ctx, token); err != nil {
+		return fmt.Errorf("failed to add password reset token: %w", err)
+	}
+
+	link, err := tokenLink(s.cfg.PasswordResetURL, secret)
+	if err != nil {
+		return fmt.Errorf("failed to create password reset link: %w", err)
+	}
+
+	err = s.mailer.Send(ctx, domain.Email{
+		To:      user.Email,
+		Subject: "Password reset",
+		Body: fmt.Sprintf(
+			"Hello, %s!\n\nTo set a new password follow the link:\n%s\n\n"+
+				"The link is valid until %s UTC. If you did not ask for a password reset, ignore this email.\n",
+			user.FirstName, link, token.ExpiresAt.UTC().Format("2006-01-02 15:04"),
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send password reset email: %w", err)
+	}
+
+	return nil
+}
+
+// ResetPassword sets a new password of the user the password reset token was sent to
+// and revokes all sessions of the user, the token can be used only once.
+func (s Service) ResetPassword(ctx context.Context, secret, newPassword string) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on reset password: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	token, err := s.useUserToken(txCtx, domain.PasswordReset, secret)
+	if err != nil {
+		return err
+	}
+
+	return s.setPassword(txCtx, token.UserID, newPassword, nil)
+}
+
+// setPassword stores hash of the new password and revokes all sessions of the user except the kept one.
+func (s Service) setPassword(ctx context.Context, userID uuid.UUID, password string, keptSessionID *uuid.UUID) error {
+	passwordHash, err := utils.HashPassword(password, s.cfg.PasswordCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	now := s.now()
+
+	if err = s.authRepo.UpdateUserPasswordTx(ctx, userID, passwordHash, now); err != nil {
+		return fmt.Errorf("failed to update user password: %w", err)
+	}
+
+	if err = s.authRepo.RevokeUserAuthSessionsTx(ctx, userID, keptSessionID, now); err != nil {
+		return fmt.Errorf("failed to revoke user auth sessions: %w", err)
+	}
+
+	return nil
+}
+
+// useUserToken finds the token by its secret and marks it as used.
+func (s Service) useUserToken(
+	ctx context.Context, purpose domain.UserTokenPurpose, secret string,
+) (domain.UserToken, error) {
+	token, err := s.authRepo.UserTokenByHashTx(ctx, purpose, domain.HashUserToken(secret))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.UserToken{}, domain.ErrInvalidUserToken
+		}
+
+		return domain.UserToken{}, fmt.Errorf("failed to get user token by hash: %w", err)
+	}
+
+	if err = token.Use(s.now); err != nil {
+		return domain.UserToken{}, fmt.Errorf("failed to use user token: %w", err)
+	}
+
+	if err = s.authRepo.UseUserTokenTx(ctx, token); err != nil {
+		return domain.UserToken{}, fmt.Errorf("failed to store used user token: %w", err)
+	}
+
+	return token, nil
+}
+
+// tokenLink adds the token to the query of the page url.
+func tokenLink(pageURL, secret string) (string, error) {
+	link, err := url.Parse(pageURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse url: %w", err)
+	}
+
+	query := link.Query()
+	query.Set("token", secret)
+	link.RawQuery = query.Encode()
+
+	return link.String(), nil
+}
@@ -3,28 +3,72 @@ package auth
 import (
 	"time"
 
+	"bum-service/internal/domain"
 	"bum-service/pkg/liblog"
+	"bum-service/pkg/transaction"
 )
 
+// Config is configuration of passwords, logins and of the tokens sent to users by email.
+type Config struct {
+	PasswordCost int
+
+	// AccountLoginPolicy and IPLoginPolicy throttle failed logins to an account and from an IP address.
+	AccountLoginPolicy domain.LoginThrottlePolicy
+	IPLoginPolicy      domain.LoginThrottlePolicy
+
+	// PasswordResetURL and EmailVerificationURL are pages the token is sent to in the token query parameter.
+	PasswordResetURL          string
+	PasswordResetTokenExp     time.Duration
+	EmailVerificationURL      string
+	EmailVerificationTokenExp time.Duration
+
+	// PhoneLoginCodeExp is lifetime of one-time login codes, a new code is not sent to the phone
+	// more often than PhoneLoginCodeResendInterval and a code can be tried PhoneLoginCodeMaxAttempts times.
+	PhoneLoginCodeExp            time.Duration
+	PhoneLoginCodeResendInterval time.Duration
+	PhoneLoginCodeMaxAttempts    int
+}
+
 // Service is an auth use case.
 type Service struct {
 	userService IUserService
 
-	logger liblog.Logger
-	now    func() time.Time
+	authRepo      IAuthRepo
+	loginAttempts ILoginAttemptStore
+	mailer        IMailer
+	smsSender     ISMSSender
+
+	cfg            Config
+	sessionAdapter transaction.Session
+	logger         liblog.Logger
+	now            func() time.Time
 }
 
 // NewService creates a new auth use case.
 func NewService(
 	userService IUserService,
 
+	authRepo IAuthRepo,
+	loginAttempts ILoginAttemptStore,
+	mailer IMailer,
+	smsSender ISMSSender,
+
+	cfg Config,
+	sessionAdapter transaction.Session,
 	logger liblog.Logger,
 	nowFunc func() time.Time,
 ) *Service {
 	return &Service{
 		userService: userService,
 
-		logger: logger,
-		now:    nowFunc,
+		authRepo:      authRepo,
+		loginAttempts: loginAttempts,
+		mailer:        mailer,
+		smsSender:     smsSender,
+
+		cfg:            cfg,
+		sessionAdapter: sessionAdapter,
+		logger:         logger,
+		now:            nowFunc,
 	}
 }
@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// StartSessionArgs is arguments for starting a new user auth session.
+type StartSessionArgs struct {
+	UserID    uuid.UUID
+	UserAgent string
+	IP        string
+	ExpiresAt time.Time
+}
+
+// StartSession starts a new auth session of the user on the device.
+func (s Service) StartSession(ctx context.Context, args StartSessionArgs) (domain.AuthSession, error) {
+	session := domain.NewAuthSession(args.UserID, args.UserAgent, args.IP, args.ExpiresAt, s.now)
+
+	if err := s.authRepo.AddAuthSessionTx(ctx, session); err != nil {
+		return domain.AuthSession{}, fmt.Errorf("failed to add auth session: %w", err)
+	}
+
+	return session, nil
+}
+
+// RefreshSessionArgs is arguments for exchanging a refresh token of the session.
+type RefreshSessionArgs struct {
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+	TokenID   uuid.UUID
+	ExpiresAt time.Time
+}
+
+// RefreshSession exchanges the refresh token of the session for a new one.
+// If the refresh token was already exchanged the whole session is revoked.
+func (s Service) RefreshSession(ctx context.Context, args RefreshSessionArgs) (domain.AuthSession, error) {
+	session, err := s.authRepo.AuthSessionByIDTx(ctx, args.SessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.AuthSession{}, domain.ErrInvalidToken
+		}
+
+		return domain.AuthSession{}, fmt.Errorf("failed to get auth session by id: %w", err)
+	}
+
+	if session.UserID != args.UserID {
+		return domain.AuthSession{}, domain.ErrInvalidToken
+	}
+
+	if _, err = s.userService.UserByID(ctx, session.UserID); err != nil {
+		return domain.AuthSession{}, fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	err = session.Rotate(args.TokenID, args.ExpiresAt, s.now)
+	if err == nil {
+		err = s.authRepo.RotateAuthSessionTx(ctx, session, args.TokenID)
+	}
+
+	if errors.Is(err, domain.ErrRefreshTokenReused) {
+		// the refresh token was stolen, so neither the thief nor the user can use the session anymore.
+		if errRevoke := s.authRepo.RevokeAuthSessionTx(ctx, session.UserID, session.ID, s.now()); errRevoke != nil &&
+			!errors.Is(errRevoke, domain.ErrAuthSessionNotFound) {
+			return domain.AuthSession{}, fmt.Errorf("failed to revoke auth session: %w: %w", err, errRevoke)
+		}
+	}
+
+	if err != nil {
+		return domain.AuthSession{}, fmt.Errorf("failed to rotate auth session: %w", err)
+	}
+
+	return session, nil
+}
+
+// SelectSessionRoleArgs is arguments for selecting the role the auth session acts as.
+type SelectSessionRoleArgs struct {
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+	// RoleID is one of the roles of the user, nil clears the selection.
+	RoleID *uuid.UUID
+}
+
+// SelectSessionRole sets the role of the user the auth session acts as,
+// the tokens of the session issued after that carry the role.
+func (s Service) SelectSessionRole(ctx context.Context, args SelectSessionRoleArgs) (domain.AuthSession, error) {
+	session, err := s.authRepo.AuthSessionByIDTx(ctx, args.SessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.AuthSession{}, domain.ErrAuthSessionNotFound
+		}
+
+		return domain.AuthSession{}, fmt.Errorf("failed to get auth session by id: %w", err)
+	}
+
+	if session.UserID != args.UserID {
+		return domain.AuthSession{}, domain.ErrAuthSessionNotFound
+	}
+
+	if args.RoleID != nil {
+		roles, errRoles := s.userService.UserRoles(ctx, args.UserID)
+		if errRoles != nil {
+			return domain.AuthSession{}, fmt.Errorf("failed to get user roles: %w", errRoles)
+		}
+
+		if _, ok := roles.ByID(*args.RoleID); !ok {
+			return domain.AuthSession{}, domain.ErrUserRoleNotFound
+		}
+	}
+
+	if err = session.SelectRole(args.RoleID, s.now); err != nil {
+		return domain.AuthSession{}, fmt.Errorf("failed to select auth session role: %w", err)
+	}
+
+	if err = s.authRepo.UpdateAuthSessionActiveRoleTx(ctx, session); err != nil {
+		return domain.AuthSession{}, fmt.Errorf("failed to update auth session active role: %w", err)
+	}
+
+	return session, nil
+}
+
+// UserSessions returns active auth sessions of the user.
+func (s Service) UserSessions(ctx context.Context, userID uuid.UUID) (domain.AuthSessions, error) {
+	sessions, err := s.authRepo.ActiveAuthSessionsTx(ctx, userID, s.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active auth sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// Logout revokes the auth session of the user.
+func (s Service) Logout(ctx context.Context, userID, sessionID uuid.UUID) error {
+	if err := s.authRepo.RevokeAuthSessionTx(ctx, userID, sessionID, s.now()); err != nil {
+		return fmt.Errorf("failed to revoke auth session: %w", err)
+	}
+
+	return nil
+}
+
+// LogoutAll revokes all auth sessions of the user.
+func (s Service) LogoutAll(ctx context.Context, userID uuid.UUID) error {
+	if _, err := s.userService.UserByID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	if err := s.authRepo.RevokeUserAuthSessionsTx(ctx, userID, nil, s.now()); err != nil {
+		return fmt.Errorf("failed to revoke user auth sessions: %w", err)
+	}
+
+	return nil
+}
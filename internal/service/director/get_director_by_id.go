@@ -25,3 +25,17 @@ func (s Service) DirectorByID(ctx context.Context, id uuid.UUID) (domain.Directo
 
 	return director, nil
 }
+
+// DirectorByIDAndSchoolID get director of the school by id.
+func (s Service) DirectorByIDAndSchoolID(ctx context.Context, id, schoolID uuid.UUID) (domain.Director, error) {
+	director, err := s.DirectorByID(ctx, id)
+	if err != nil {
+		return director, err
+	}
+
+	if director.SchoolID != schoolID {
+		return domain.Director{}, domain.ErrDirectorNotFound
+	}
+
+	return director, nil
+}
@@ -2,6 +2,7 @@ package director
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -12,6 +13,7 @@ import (
 type IDirectorRepo interface {
 	AddDirectorTx(ctx context.Context, director domain.Director) error
 	DirectorByIDTx(ctx context.Context, id uuid.UUID) (domain.Director, error)
+	UpdateDirectorTx(ctx context.Context, o domain.Director, version time.Time) error
 	DirectorListTx(ctx context.Context, filters domain.DirectorListFilter) (domain.Directors, error)
 	DirectorCountTx(ctx context.Context, filters domain.DirectorListFilter) (int, error)
 }
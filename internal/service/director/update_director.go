@@ -0,0 +1,66 @@
+package director
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+	"bum-service/pkg/utils"
+)
+
+// UpdateDirectorArgs is arguments for updating director contacts, absent fields are left unchanged.
+type UpdateDirectorArgs struct {
+	ID       uuid.UUID
+	SchoolID uuid.UUID
+	Phone    utils.Patch[string]
+	Email    utils.Patch[string]
+	// UpdatedAt is the version of the director the update is based on.
+	UpdatedAt time.Time
+}
+
+// UpdateDirector updates contacts of the director of the school.
+func (s Service) UpdateDirector(ctx context.Context, args UpdateDirectorArgs) (_ domain.Director, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.Director{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on update director: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	director, err := s.directorRepo.DirectorByIDTx(txCtx, args.ID)
+	if err != nil {
+		return domain.Director{}, fmt.Errorf("failed to get director by id: %w", err)
+	}
+
+	if director.SchoolID != args.SchoolID {
+		return domain.Director{}, domain.ErrDirectorNotFound
+	}
+
+	if err = domain.CheckVersion(director.UpdatedAt, args.UpdatedAt); err != nil {
+		return domain.Director{}, err
+	}
+
+	director.Update(args.Phone.Apply(director.Phone), args.Email.Apply(director.Email), s.now)
+
+	if err = s.directorRepo.UpdateDirectorTx(txCtx, director, args.UpdatedAt); err != nil {
+		return domain.Director{}, fmt.Errorf("failed to update director: %w", err)
+	}
+
+	director, err = s.DirectorByID(txCtx, director.ID)
+	if err != nil {
+		return domain.Director{}, fmt.Errorf("failed to get director by id: %w", err)
+	}
+
+	return director, nil
+}
@@ -0,0 +1,35 @@
+package eduorganization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// DeleteEduOrganization deletes educational organization, all its schools must be deleted before.
+func (s Service) DeleteEduOrganization(ctx context.Context, id uuid.UUID) error {
+	dependents, err := s.eduOrganizationRepo.EduOrganizationDependentsTx(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get educational organization dependents: %w", err)
+	}
+
+	if err = dependents.CheckDelete(); err != nil {
+		return err
+	}
+
+	if err = s.eduOrganizationRepo.DeleteEduOrganizationTx(ctx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to delete educational organization: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreEduOrganization restores deleted educational organization.
+func (s Service) RestoreEduOrganization(ctx context.Context, id uuid.UUID) error {
+	if err := s.eduOrganizationRepo.RestoreEduOrganizationTx(ctx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to restore educational organization: %w", err)
+	}
+
+	return nil
+}
@@ -18,7 +18,7 @@ func (s Service) EduOrganizationList(
 		return nil, 0, err
 	}
 
-	count, err := s.eduOrganizationRepo.EduOrganizationCountTx(ctx)
+	count, err := s.eduOrganizationRepo.EduOrganizationCountTx(ctx, filters)
 	if err != nil {
 		err = fmt.Errorf("failed to get educational organization count to database: %w", err)
 		return nil, 0, err
@@ -2,6 +2,7 @@ package eduorganization
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -11,7 +12,7 @@ import (
 // IEduOrganizationRepo represents a repository for educational organization use cases.
 type IEduOrganizationRepo interface {
 	CreateEduOrganizationTx(ctx context.Context, organization domain.EduOrganization) error
-	UpdateEduOrganizationTx(ctx context.Context, o domain.EduOrganization) error
+	UpdateEduOrganizationTx(ctx context.Context, o domain.EduOrganization, version time.Time) error
 	EduOrganizationByIDTx(ctx context.Context, id uuid.UUID) (domain.EduOrganization, error)
 	EduOrganizationsByIDsTx(ctx context.Context, ids []uuid.UUID) (domain.EduOrganizations, error)
 	EduOrganizationsShortInfoByIDsTx(
@@ -21,5 +22,9 @@ type IEduOrganizationRepo interface {
 		ctx context.Context, id uuid.UUID,
 	) (domain.EduOrganizationShortInfo, error)
 	EduOrganizationListTx(ctx context.Context, filters domain.EduOrganizationFilters) (domain.EduOrganizations, error)
-	EduOrganizationCountTx(ctx context.Context) (int, error)
+	EduOrganizationCountTx(ctx context.Context, filters domain.EduOrganizationFilters) (int, error)
+
+	EduOrganizationDependentsTx(ctx context.Context, id uuid.UUID) (domain.Dependents, error)
+	DeleteEduOrganizationTx(ctx context.Context, id uuid.UUID, now time.Time) error
+	RestoreEduOrganizationTx(ctx context.Context, id uuid.UUID, now time.Time) error
 }
@@ -3,6 +3,7 @@ package eduorganization
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -14,6 +15,8 @@ type UpdateEduOrganizationArgs struct {
 	ID   uuid.UUID
 	Name string
 	Logo *string
+	// Version is the version of the organization the update is based on, nil if the update is not conditional.
+	Version *time.Time
 }
 
 // UpdateEduOrganizationByID update educational organization by id.
@@ -26,13 +29,19 @@ func (s Service) UpdateEduOrganizationByID(
 		return domain.EduOrganization{}, fmt.Errorf("failed to get organization by id: %w", err)
 	}
 
+	if args.Version != nil {
+		if err = domain.CheckVersion(educationOrganizationEntity.UpdatedAt, *args.Version); err != nil {
+			return domain.EduOrganization{}, err
+		}
+	}
+
+	version := educationOrganizationEntity.UpdatedAt
+
 	educationOrganizationEntity.Update(args.Name, args.Logo, s.now)
 
-	err = s.eduOrganizationRepo.UpdateEduOrganizationTx(ctx, educationOrganizationEntity)
+	err = s.eduOrganizationRepo.UpdateEduOrganizationTx(ctx, educationOrganizationEntity, version)
 	if err != nil {
-		return domain.EduOrganization{}, fmt.Errorf(
-			"failed to create a new educational organization to database: %w", err,
-		)
+		return domain.EduOrganization{}, fmt.Errorf("failed to update educational organization: %w", err)
 	}
 
 	return educationOrganizationEntity, nil
@@ -17,6 +17,7 @@ type CreateGradeStandardArgs struct {
 	Name           string
 	EducationYears int8
 	Description    *string
+	GradingScaleID *uuid.UUID
 	Grades         []CreateGradeArgs
 }
 
@@ -52,6 +53,7 @@ func (s Service) CreateGradeStandard(
 		arg.Name,
 		arg.EducationYears,
 		arg.Description,
+		arg.GradingScaleID,
 		s.now,
 	)
 
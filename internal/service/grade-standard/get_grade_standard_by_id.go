@@ -26,5 +26,14 @@ func (s Service) GradeStandardByID(
 
 	gradeStandard.SetGrades(grades)
 
+	if gradeStandard.GradingScaleID != nil {
+		scale, err := s.gradesRepo.GradingScaleByIDTx(ctx, *gradeStandard.GradingScaleID)
+		if err != nil {
+			return domain.GradeStandard{}, fmt.Errorf("failed to get grading scale by id from database: %w", err)
+		}
+
+		gradeStandard.SetGradingScale(scale)
+	}
+
 	return gradeStandard, nil
 }
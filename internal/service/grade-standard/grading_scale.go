@@ -0,0 +1,101 @@
+package grades
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// CreateGradingScaleArgs is arguments for creating a new grading scale.
+type CreateGradingScaleArgs struct {
+	OrganizationID *uuid.UUID
+	Name           string
+	Kind           domain.GradingScaleKind
+	Values         []GradingScaleValueArgs
+}
+
+// GradingScaleValueArgs is arguments of the grading scale value.
+type GradingScaleValueArgs struct {
+	Value  string
+	Weight float64
+}
+
+// CreateGradingScale creates a new grading scale.
+func (s Service) CreateGradingScale(
+	ctx context.Context,
+	args CreateGradingScaleArgs,
+) (_ domain.GradingScale, err error) {
+	scale := domain.NewGradingScale(args.OrganizationID, args.Name, args.Kind, s.now)
+
+	values := make(domain.GradingScaleValues, 0, len(args.Values))
+	for i, value := range args.Values {
+		values = append(values, domain.NewGradingScaleValue(scale.ID, value.Value, value.Weight, i+1))
+	}
+
+	scale.SetValues(values)
+
+	if err = scale.Validate(); err != nil {
+		return domain.GradingScale{}, err
+	}
+
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.GradingScale{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on create grading scale: %w: %w",
+				domain.ErrInternalServerError,
+				errEnd,
+			)
+		}
+	}(tx)
+
+	if err = s.gradesRepo.CreateGradingScaleTx(txCtx, scale); err != nil {
+		return domain.GradingScale{}, fmt.Errorf("failed create grading scale to database: %w", err)
+	}
+
+	saved, err := s.gradesRepo.GradingScaleByIDTx(txCtx, scale.ID)
+	if err != nil {
+		return domain.GradingScale{}, fmt.Errorf("failed to get a grading scale by id: %w", err)
+	}
+
+	return saved, nil
+}
+
+// GradingScaleByID returns grading scale by id.
+func (s Service) GradingScaleByID(ctx context.Context, id uuid.UUID) (domain.GradingScale, error) {
+	scale, err := s.gradesRepo.GradingScaleByIDTx(ctx, id)
+	if err != nil {
+		return domain.GradingScale{}, fmt.Errorf("failed to get a grading scale by id from database: %w", err)
+	}
+
+	return scale, nil
+}
+
+// GradingScaleBySchoolID returns grading scale which is used by the school.
+func (s Service) GradingScaleBySchoolID(ctx context.Context, schoolID uuid.UUID) (domain.GradingScale, error) {
+	scale, err := s.gradesRepo.GradingScaleBySchoolIDTx(ctx, schoolID)
+	if err != nil {
+		return domain.GradingScale{}, fmt.Errorf("failed to get a grading scale by school id from database: %w", err)
+	}
+
+	return scale, nil
+}
+
+// GradingScaleList returns preset grading scales and the ones of the organization.
+func (s Service) GradingScaleList(ctx context.Context, organizationID *uuid.UUID) (domain.GradingScales, error) {
+	list, err := s.gradesRepo.GradingScaleListTx(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed get grading scale list from database: %w", err)
+	}
+
+	return list, nil
+}
@@ -2,6 +2,7 @@ package grades
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -12,6 +13,7 @@ import (
 type IGradesRepo interface {
 	CreateGradeStandardTx(ctx context.Context, gradeStandard domain.GradeStandard) error
 	CreateGradesTx(ctx context.Context, grades domain.Grades) error
+	UpdateGradeStandardTx(ctx context.Context, gradeStandard domain.GradeStandard, version time.Time) error
 
 	GradeStandardByIDTx(ctx context.Context, id uuid.UUID) (domain.GradeStandard, error)
 	GradesByGradeStandardIDTx(ctx context.Context, gradeStandardID uuid.UUID) (domain.Grades, error)
@@ -20,4 +22,9 @@ type IGradesRepo interface {
 
 	GradeStandardListTx(ctx context.Context, filters domain.GradeStandardListFilter) (domain.GradeStandards, error)
 	GradeStandardListCountTx(ctx context.Context, filters domain.GradeStandardListFilter) (int, error)
+
+	CreateGradingScaleTx(ctx context.Context, scale domain.GradingScale) error
+	GradingScaleByIDTx(ctx context.Context, id uuid.UUID) (domain.GradingScale, error)
+	GradingScaleBySchoolIDTx(ctx context.Context, schoolID uuid.UUID) (domain.GradingScale, error)
+	GradingScaleListTx(ctx context.Context, organizationID *uuid.UUID) (domain.GradingScales, error)
 }
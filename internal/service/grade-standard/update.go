@@ -0,0 +1,74 @@
+package grades
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+	"bum-service/pkg/utils"
+)
+
+// UpdateGradeStandardArgs is arguments for updating grade standard, absent fields are left unchanged.
+type UpdateGradeStandardArgs struct {
+	ID             uuid.UUID
+	Name           *string
+	EducationYears *int8
+	Description    utils.Patch[string]
+	GradingScaleID utils.Patch[uuid.UUID]
+	// UpdatedAt is the version of the grade standard the update is based on.
+	UpdatedAt time.Time
+}
+
+// UpdateGradeStandard updates grade standard, its grades are left unchanged.
+func (s Service) UpdateGradeStandard(
+	ctx context.Context,
+	args UpdateGradeStandardArgs,
+) (_ domain.GradeStandard, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.GradeStandard{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on update grade standard: %w: %w",
+				domain.ErrInternalServerError,
+				errEnd,
+			)
+		}
+	}(tx)
+
+	gradeStandard, err := s.gradesRepo.GradeStandardByIDTx(txCtx, args.ID)
+	if err != nil {
+		return domain.GradeStandard{}, fmt.Errorf("failed to get grade standard by id: %w", err)
+	}
+
+	if err = domain.CheckVersion(gradeStandard.UpdatedAt, args.UpdatedAt); err != nil {
+		return domain.GradeStandard{}, err
+	}
+
+	gradeStandard.Update(
+		utils.ValueOr(args.Name, gradeStandard.Name),
+		utils.ValueOr(args.EducationYears, gradeStandard.EducationYears),
+		args.Description.Apply(gradeStandard.Description),
+		args.GradingScaleID.Apply(gradeStandard.GradingScaleID),
+		s.now,
+	)
+
+	if err = s.gradesRepo.UpdateGradeStandardTx(txCtx, gradeStandard, args.UpdatedAt); err != nil {
+		return domain.GradeStandard{}, fmt.Errorf("failed to update grade standard: %w", err)
+	}
+
+	gradeStandard, err = s.GradeStandardByID(txCtx, gradeStandard.ID)
+	if err != nil {
+		return domain.GradeStandard{}, fmt.Errorf("failed to get grade standard by id: %w", err)
+	}
+
+	return gradeStandard, nil
+}
@@ -27,3 +27,17 @@ func (s Service) HeadmasterByID(ctx context.Context, id uuid.UUID) (domain.Headm
 
 	return headmaster, nil
 }
+
+// HeadmasterByIDAndSchoolID get headmaster of the school by id.
+func (s Service) HeadmasterByIDAndSchoolID(ctx context.Context, id, schoolID uuid.UUID) (domain.Headmaster, error) {
+	headmaster, err := s.HeadmasterByID(ctx, id)
+	if err != nil {
+		return headmaster, err
+	}
+
+	if headmaster.SchoolID != schoolID {
+		return domain.Headmaster{}, domain.ErrHeadmasterNotFound
+	}
+
+	return headmaster, nil
+}
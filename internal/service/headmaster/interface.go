@@ -2,6 +2,7 @@ package headmaster
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -12,6 +13,7 @@ import (
 type IHeadmasterRepo interface {
 	AddHeadmasterTx(ctx context.Context, headmaster domain.Headmaster) error
 	HeadmasterByIDTx(ctx context.Context, id uuid.UUID) (domain.Headmaster, error)
+	UpdateHeadmasterTx(ctx context.Context, o domain.Headmaster, version time.Time) error
 	HeadmasterListTx(ctx context.Context, filters domain.HeadmasterListFilter) (domain.Headmasters, error)
 	HeadmasterCountTx(ctx context.Context, filters domain.HeadmasterListFilter) (int, error)
 }
@@ -0,0 +1,66 @@
+package headmaster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+	"bum-service/pkg/utils"
+)
+
+// UpdateHeadmasterArgs is arguments for updating headmaster contacts, absent fields are left unchanged.
+type UpdateHeadmasterArgs struct {
+	ID       uuid.UUID
+	SchoolID uuid.UUID
+	Phone    utils.Patch[string]
+	Email    utils.Patch[string]
+	// UpdatedAt is the version of the headmaster the update is based on.
+	UpdatedAt time.Time
+}
+
+// UpdateHeadmaster updates contacts of the headmaster of the school.
+func (s Service) UpdateHeadmaster(ctx context.Context, args UpdateHeadmasterArgs) (_ domain.Headmaster, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.Headmaster{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on update headmaster: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	headmaster, err := s.headmasterRepo.HeadmasterByIDTx(txCtx, args.ID)
+	if err != nil {
+		return domain.Headmaster{}, fmt.Errorf("failed to get headmaster by id: %w", err)
+	}
+
+	if headmaster.SchoolID != args.SchoolID {
+		return domain.Headmaster{}, domain.ErrHeadmasterNotFound
+	}
+
+	if err = domain.CheckVersion(headmaster.UpdatedAt, args.UpdatedAt); err != nil {
+		return domain.Headmaster{}, err
+	}
+
+	headmaster.Update(args.Phone.Apply(headmaster.Phone), args.Email.Apply(headmaster.Email), s.now)
+
+	if err = s.headmasterRepo.UpdateHeadmasterTx(txCtx, headmaster, args.UpdatedAt); err != nil {
+		return domain.Headmaster{}, fmt.Errorf("failed to update headmaster: %w", err)
+	}
+
+	headmaster, err = s.HeadmasterByID(txCtx, headmaster.ID)
+	if err != nil {
+		return domain.Headmaster{}, fmt.Errorf("failed to get headmaster by id: %w", err)
+	}
+
+	return headmaster, nil
+}
@@ -3,10 +3,12 @@ package lesson
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 
 	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
 )
 
 // AddMarkArgs is mark arguments for adding.
@@ -18,15 +20,68 @@ type AddMarkArgs struct {
 }
 
 // AddMark adds a new mark to student.
-func (s *Service) AddMark(ctx context.Context, args AddMarkArgs) (domain.Mark, error) {
-	markDomain := domain.NewMark(args.LessonID, args.StudentID, args.Mark, args.Description, s.now)
+// Student must be a member of the lesson group on the lesson day, mark must be allowed by
+// the grading scale of the lesson school and the final grade of the lesson period must not be locked.
+func (s *Service) AddMark(ctx context.Context, args AddMarkArgs) (_ domain.Mark, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.Mark{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf("failed to end transaction on add mark: %w: %w", domain.ErrInternalServerError, errEnd)
+		}
+	}(tx)
+
+	lesson, err := s.lessonRepo.LessonByIDTx(txCtx, args.LessonID)
+	if err != nil {
+		return domain.Mark{}, fmt.Errorf("failed to get lesson by id: %w", err)
+	}
+
+	groupSubject, err := s.groupService.GroupSubjectByID(txCtx, lesson.GroupSubjectID)
+	if err != nil {
+		return domain.Mark{}, fmt.Errorf("failed to get group subject by id: %w", err)
+	}
+
+	studentIDs, err := s.lessonRepo.GroupStudentIDsTx(txCtx, groupSubject.GroupID, lesson.StartTime, lesson.StartTime)
+	if err != nil {
+		return domain.Mark{}, fmt.Errorf("failed to get group students: %w", err)
+	}
+
+	if !slices.Contains(studentIDs, args.StudentID) {
+		return domain.Mark{}, domain.ErrStudentNotFound
+	}
+
+	scale, err := s.gradesService.GradingScaleBySchoolID(txCtx, lesson.SchoolID)
+	if err != nil {
+		return domain.Mark{}, fmt.Errorf("failed to get grading scale of school: %w", err)
+	}
+
+	weight, err := scale.Weight(args.Mark)
+	if err != nil {
+		return domain.Mark{}, err
+	}
+
+	locked, err := s.lessonRepo.IsFinalGradeLockedTx(txCtx, lesson.GroupSubjectID, args.StudentID, lesson.StartTime)
+	if err != nil {
+		return domain.Mark{}, fmt.Errorf("failed to check final grade lock: %w", err)
+	}
+
+	if locked {
+		return domain.Mark{}, domain.ErrFinalGradeLocked
+	}
+
+	markDomain := domain.NewMark(args.LessonID, args.StudentID, args.Mark, weight, args.Description, s.now)
+	markDomain.TermID = lesson.TermID
 
-	err := s.lessonRepo.AddMark(ctx, markDomain)
+	err = s.lessonRepo.AddMark(txCtx, markDomain)
 	if err != nil {
 		return domain.Mark{}, fmt.Errorf("failed to add mark: %w", err)
 	}
 
-	markDomain, err = s.MarkByID(ctx, markDomain.ID)
+	markDomain, err = s.MarkByID(txCtx, markDomain.ID)
 	if err != nil {
 		return domain.Mark{}, fmt.Errorf("failed to get mark by id: %w", err)
 	}
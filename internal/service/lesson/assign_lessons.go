@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 
 	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
 	"bum-service/pkg/utils"
 )
 
@@ -30,7 +31,9 @@ type Lesson struct {
 }
 
 // AssignLessons assigns lessons to group for a weak.
-func (s *Service) AssignLessons(ctx context.Context, args AddWeekLessonsArgs) (domain.Lessons, error) {
+// The whole week is rejected with domain.ErrLessonScheduleConflict if lessons overlap each other
+// or teacher or auditorium is already booked by another group.
+func (s *Service) AssignLessons(ctx context.Context, args AddWeekLessonsArgs) (_ domain.Lessons, err error) {
 	// TODO: учитывать часовой пояс в будущем.
 	var (
 		firstDayOfWeek     = utils.FirstDayOfWeek(args.WeekDate)
@@ -38,12 +41,12 @@ func (s *Service) AssignLessons(ctx context.Context, args AddWeekLessonsArgs) (d
 		lessons            = make(domain.Lessons, 0, len(args.Lessons))
 	)
 
-	group, err := s.groupService.GroupByID(ctx, args.GroupID)
+	group, err := s.groupService.GroupByID(ctx, args.SchoolID, args.GroupID)
 	if err != nil {
 		return domain.Lessons{}, fmt.Errorf("failed to get group by id and school id: %w", err)
 	}
 
-	groupSubject, err := s.groupService.GroupSubjectList(ctx, args.GroupID)
+	groupSubject, err := s.groupService.GroupSubjectList(ctx, args.SchoolID, args.GroupID)
 	if err != nil {
 		return domain.Lessons{}, fmt.Errorf("failed to get group subject list: %w", err)
 	}
@@ -67,10 +70,56 @@ func (s *Service) AssignLessons(ctx context.Context, args AddWeekLessonsArgs) (d
 		lessons = append(lessons, lessonDomain)
 	}
 
-	err = s.lessonRepo.AssignsLessons(ctx, args.GroupID, firstDayOfWeek, firstDayOfNextWeek, lessons)
+	conflicts := lessons.Conflicts()
+	if len(conflicts) != 0 {
+		return nil, domain.NewLessonScheduleConflictErr(conflicts)
+	}
+
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on assign lessons: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	if err = s.checkBookedLessons(txCtx, args.GroupID, lessons); err != nil {
+		return nil, err
+	}
+
+	err = s.lessonRepo.AssignsLessons(txCtx, args.GroupID, firstDayOfWeek, firstDayOfNextWeek, lessons)
 	if err != nil {
 		return nil, fmt.Errorf("failed to assign lessons: %w", err)
 	}
 
 	return lessons, nil
 }
+
+// checkBookedLessons checks that teachers and auditoriums of lessons are not booked by other groups.
+func (s *Service) checkBookedLessons(ctx context.Context, groupID uuid.UUID, lessons domain.Lessons) error {
+	if len(lessons) == 0 {
+		return nil
+	}
+
+	from, till := lessons.Period()
+
+	booked, err := s.lessonRepo.BookedLessonsTx(
+		ctx, groupID, from, till, lessons.TeacherIDs(), lessons.AuditoriumIDs(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get booked lessons: %w", err)
+	}
+
+	conflicts := lessons.ConflictsWith(booked)
+	if len(conflicts) != 0 {
+		return domain.NewLessonScheduleConflictErr(conflicts)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package lesson
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// RecordAttendanceArgs is arguments for recording attendance of the lesson.
+type RecordAttendanceArgs struct {
+	LessonID uuid.UUID
+	Students []StudentAttendance
+}
+
+// StudentAttendance is attendance of a single student.
+type StudentAttendance struct {
+	StudentID   uuid.UUID
+	Status      domain.AttendanceStatus
+	Description *string
+}
+
+// RecordAttendance records attendance of the group students on the lesson.
+// Already recorded attendance of a student is overwritten.
+func (s *Service) RecordAttendance(ctx context.Context, args RecordAttendanceArgs) (_ domain.Attendances, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on record attendance: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	lesson, err := s.lessonRepo.LessonByIDTx(txCtx, args.LessonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lesson by id: %w", err)
+	}
+
+	groupSubject, err := s.groupService.GroupSubjectByID(txCtx, lesson.GroupSubjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group subject by id: %w", err)
+	}
+
+	studentIDs, err := s.lessonRepo.GroupStudentIDsTx(txCtx, groupSubject.GroupID, lesson.StartTime, lesson.StartTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group students: %w", err)
+	}
+
+	groupStudents := make(map[uuid.UUID]bool, len(studentIDs))
+	for _, id := range studentIDs {
+		groupStudents[id] = false
+	}
+
+	attendances := make(domain.Attendances, 0, len(args.Students))
+
+	for _, student := range args.Students {
+		recorded, ok := groupStudents[student.StudentID]
+		if !ok {
+			return nil, domain.ErrStudentNotInLessonGroup
+		}
+
+		if recorded {
+			return nil, domain.ErrDuplicateAttendance
+		}
+
+		groupStudents[student.StudentID] = true
+
+		attendances = append(attendances, domain.NewAttendance(
+			lesson.ID, student.StudentID, student.Status, student.Description, s.now,
+		))
+	}
+
+	if err = s.lessonRepo.SetAttendancesTx(txCtx, attendances); err != nil {
+		return nil, fmt.Errorf("failed to set attendances: %w", err)
+	}
+
+	attendances, err = s.lessonRepo.LessonAttendancesTx(txCtx, lesson.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lesson attendances: %w", err)
+	}
+
+	return attendances, nil
+}
+
+// LessonAttendances returns attendance of the lesson.
+func (s *Service) LessonAttendances(ctx context.Context, lessonID uuid.UUID) (domain.Attendances, error) {
+	attendances, err := s.lessonRepo.LessonAttendancesTx(ctx, lessonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lesson attendances: %w", err)
+	}
+
+	return attendances, nil
+}
+
+// AttendanceList returns attendances by filters with summaries of each student.
+func (s *Service) AttendanceList(
+	ctx context.Context, filters domain.AttendanceFilters,
+) (domain.Attendances, domain.AttendanceSummaries, error) {
+	attendances, err := s.lessonRepo.AttendanceListTx(ctx, filters)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get attendance list: %w", err)
+	}
+
+	return attendances, attendances.Summaries(), nil
+}
@@ -0,0 +1,181 @@
+package lesson
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// DeleteLesson deletes lesson of the school, its marks and attendances must be deleted before.
+func (s *Service) DeleteLesson(ctx context.Context, schoolID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on delete lesson: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	lesson, err := s.lessonRepo.LessonByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get lesson by id: %w", err)
+	}
+
+	if lesson.SchoolID != schoolID {
+		return domain.ErrLessonNotFound
+	}
+
+	dependents, err := s.lessonRepo.LessonDependentsTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get lesson dependents: %w", err)
+	}
+
+	if err = dependents.CheckDelete(); err != nil {
+		return err
+	}
+
+	if err = s.lessonRepo.DeleteLessonTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to delete lesson: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreLesson restores deleted lesson of the school, the lesson group must not be deleted
+// and the lesson teacher and auditorium must not be booked by other lessons in the meantime.
+func (s *Service) RestoreLesson(ctx context.Context, schoolID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on restore lesson: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	if err = s.lessonRepo.RestoreLessonTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to restore lesson: %w", err)
+	}
+
+	lesson, err := s.lessonRepo.LessonByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get lesson by id: %w", err)
+	}
+
+	if lesson.SchoolID != schoolID {
+		return domain.ErrLessonNotFound
+	}
+
+	groupSubject, err := s.groupService.GroupSubjectByID(txCtx, lesson.GroupSubjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get group subject by id: %w", err)
+	}
+
+	if _, err = s.groupService.GroupByID(txCtx, schoolID, groupSubject.GroupID); err != nil {
+		return fmt.Errorf("failed to get group by id: %w", err)
+	}
+
+	return s.checkBookedLessons(txCtx, groupSubject.GroupID, domain.Lessons{lesson})
+}
+
+// DeleteMark deletes mark of the lesson, the final grade of the lesson period must not be locked.
+func (s *Service) DeleteMark(ctx context.Context, lessonID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on delete mark: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	mark, err := s.lessonRepo.MarkByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get mark by id: %w", err)
+	}
+
+	if mark.LessonID != lessonID {
+		return domain.ErrMarkNotFound
+	}
+
+	if err = s.checkFinalGradeLock(txCtx, mark); err != nil {
+		return err
+	}
+
+	if err = s.lessonRepo.DeleteMarkTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to delete mark: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreMark restores deleted mark of the lesson, the final grade of the lesson period must not be locked.
+func (s *Service) RestoreMark(ctx context.Context, lessonID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on restore mark: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	if err = s.lessonRepo.RestoreMarkTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to restore mark: %w", err)
+	}
+
+	mark, err := s.lessonRepo.MarkByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get mark by id: %w", err)
+	}
+
+	if mark.LessonID != lessonID {
+		return domain.ErrMarkNotFound
+	}
+
+	return s.checkFinalGradeLock(txCtx, mark)
+}
+
+// checkFinalGradeLock returns an error if the final grade of the mark lesson period is locked.
+func (s *Service) checkFinalGradeLock(ctx context.Context, mark domain.Mark) error {
+	lesson, err := s.lessonRepo.LessonByIDTx(ctx, mark.LessonID)
+	if err != nil {
+		return fmt.Errorf("failed to get lesson by id: %w", err)
+	}
+
+	locked, err := s.lessonRepo.IsFinalGradeLockedTx(ctx, lesson.GroupSubjectID, mark.StudentID, lesson.StartTime)
+	if err != nil {
+		return fmt.Errorf("failed to check final grade lock: %w", err)
+	}
+
+	if locked {
+		return domain.ErrFinalGradeLocked
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package lesson
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// LessonByID returns lesson by id.
+func (s *Service) LessonByID(ctx context.Context, lessonID uuid.UUID) (domain.Lesson, error) {
+	lesson, err := s.lessonRepo.LessonByIDTx(ctx, lessonID)
+	if err != nil {
+		return domain.Lesson{}, fmt.Errorf("failed get lesson by id from database: %w", err)
+	}
+
+	return lesson, nil
+}
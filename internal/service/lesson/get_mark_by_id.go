@@ -18,3 +18,17 @@ func (s *Service) MarkByID(ctx context.Context, markID uuid.UUID) (domain.Mark,
 
 	return mark, nil
 }
+
+// MarkByIDAndLessonID returns mark of the lesson by id.
+func (s *Service) MarkByIDAndLessonID(ctx context.Context, markID, lessonID uuid.UUID) (domain.Mark, error) {
+	mark, err := s.MarkByID(ctx, markID)
+	if err != nil {
+		return domain.Mark{}, err
+	}
+
+	if mark.LessonID != lessonID {
+		return domain.Mark{}, domain.ErrMarkNotFound
+	}
+
+	return mark, nil
+}
@@ -0,0 +1,172 @@
+package lesson
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// GradebookArgs is arguments for getting gradebook of the group subject.
+type GradebookArgs struct {
+	SchoolID       uuid.UUID
+	GroupSubjectID uuid.UUID
+	DateFrom       time.Time
+	DateTill       time.Time
+	// TermID replaces the period with dates of the term when set.
+	TermID *uuid.UUID
+}
+
+// Gradebook returns marks of the group students on the group subject lessons within the period.
+func (s *Service) Gradebook(ctx context.Context, args GradebookArgs) (domain.Gradebook, error) {
+	if args.TermID != nil {
+		term, err := s.schoolService.TermByID(ctx, *args.TermID, args.SchoolID)
+		if err != nil {
+			return domain.Gradebook{}, fmt.Errorf("failed to get term by id: %w", err)
+		}
+
+		args.DateFrom, args.DateTill = term.DateFrom, term.DateTill
+	}
+
+	if args.DateTill.Before(args.DateFrom) {
+		return domain.Gradebook{}, domain.ErrInvalidGradebookPeriod
+	}
+
+	groupSubject, err := s.groupService.GroupSubjectByID(ctx, args.GroupSubjectID)
+	if err != nil {
+		return domain.Gradebook{}, fmt.Errorf("failed to get group subject by id: %w", err)
+	}
+
+	_, err = s.groupService.GroupByID(ctx, args.SchoolID, groupSubject.GroupID)
+	if errors.Is(err, domain.ErrGroupNotFound) {
+		return domain.Gradebook{}, domain.ErrGroupSubjectNotFound
+	}
+
+	if err != nil {
+		return domain.Gradebook{}, fmt.Errorf("failed to get group by id: %w", err)
+	}
+
+	studentIDs, err := s.lessonRepo.GroupStudentIDsTx(ctx, groupSubject.GroupID, args.DateFrom, args.DateTill)
+	if err != nil {
+		return domain.Gradebook{}, fmt.Errorf("failed to get group students: %w", err)
+	}
+
+	lessons, err := s.lessonRepo.GroupSubjectLessonsTx(ctx, groupSubject.ID, args.DateFrom, args.DateTill)
+	if err != nil {
+		return domain.Gradebook{}, fmt.Errorf("failed to get group subject lessons: %w", err)
+	}
+
+	marks, err := s.lessonRepo.MarksByLessonIDsTx(ctx, lessons.IDs())
+	if err != nil {
+		return domain.Gradebook{}, fmt.Errorf("failed to get marks of lessons: %w", err)
+	}
+
+	finalGrades, err := s.lessonRepo.FinalGradesTx(ctx, groupSubject.ID, args.DateFrom, args.DateTill)
+	if err != nil {
+		return domain.Gradebook{}, fmt.Errorf("failed to get final grades: %w", err)
+	}
+
+	return domain.NewGradebook(
+		groupSubject.ID, args.DateFrom, args.DateTill, studentIDs, lessons, marks, finalGrades,
+	), nil
+}
+
+// FinaliseGradesArgs is arguments for finalising grades of the group subject for the period.
+type FinaliseGradesArgs struct {
+	GradebookArgs
+
+	Lock   bool
+	Grades []StudentFinalGrade
+}
+
+// StudentFinalGrade is final grade of a single student,
+// the nearest to average mark is used when mark is not set.
+type StudentFinalGrade struct {
+	StudentID uuid.UUID
+	Mark      *string
+}
+
+// FinaliseGrades sets final grades of the students, locked grades can not be changed anymore.
+func (s *Service) FinaliseGrades(ctx context.Context, args FinaliseGradesArgs) (_ domain.Gradebook, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.Gradebook{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on finalise grades: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	gradebook, err := s.Gradebook(txCtx, args.GradebookArgs)
+	if err != nil {
+		return domain.Gradebook{}, err
+	}
+
+	scale, err := s.gradesService.GradingScaleBySchoolID(txCtx, args.SchoolID)
+	if err != nil {
+		return domain.Gradebook{}, fmt.Errorf("failed to get grading scale of school: %w", err)
+	}
+
+	finalGrades := make(domain.FinalGrades, 0, len(args.Grades))
+
+	for _, studentGrade := range args.Grades {
+		row, ok := gradebook.Row(studentGrade.StudentID)
+		if !ok {
+			return domain.Gradebook{}, domain.ErrStudentNotInLessonGroup
+		}
+
+		mark, weight, err := finalMark(scale, row, studentGrade.Mark)
+		if err != nil {
+			return domain.Gradebook{}, err
+		}
+
+		grade := domain.NewFinalGrade(
+			gradebook.GroupSubjectID, row.StudentID, gradebook.DateFrom, gradebook.DateTill, s.now,
+		)
+		if row.FinalGrade != nil {
+			grade = *row.FinalGrade
+		}
+
+		if err = grade.Finalise(mark, weight, row.Average(), args.Lock, s.now); err != nil {
+			return domain.Gradebook{}, err
+		}
+
+		finalGrades = append(finalGrades, grade)
+	}
+
+	if err = s.lessonRepo.SetFinalGradesTx(txCtx, finalGrades); err != nil {
+		return domain.Gradebook{}, fmt.Errorf("failed to set final grades: %w", err)
+	}
+
+	return s.Gradebook(txCtx, args.GradebookArgs)
+}
+
+// finalMark returns the given mark with its weight or the nearest to the student average one.
+func finalMark(scale domain.GradingScale, row domain.GradebookRow, mark *string) (string, float64, error) {
+	if mark != nil {
+		weight, err := scale.Weight(*mark)
+		if err != nil {
+			return "", 0, err
+		}
+
+		return *mark, weight, nil
+	}
+
+	if len(row.Marks) == 0 {
+		return "", 0, domain.ErrInvalidMark
+	}
+
+	nearest, weight := scale.Nearest(row.Average())
+
+	return nearest, weight, nil
+}
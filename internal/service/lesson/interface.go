@@ -18,6 +18,9 @@ type IUserInfoService interface {
 // ISchoolService represents a school service.
 type ISchoolService interface {
 	SchoolShortByIDs(ctx context.Context, ids []uuid.UUID) (domain.SchoolShortInfos, error)
+	SchoolAuditoriums(ctx context.Context, schoolID uuid.UUID) (domain.Auditoriums, error)
+	TermByID(ctx context.Context, id, schoolID uuid.UUID) (domain.Term, error)
+	AcademicYearList(ctx context.Context, schoolID uuid.UUID) (domain.AcademicYears, error)
 }
 
 // ILessonRepo is lesson repository.
@@ -26,13 +29,62 @@ type ILessonRepo interface {
 		ctx context.Context, groupID uuid.UUID, firstDayOfWeek, firstDayOfNextWeek time.Time, lessons domain.Lessons,
 	) error
 	LessonsListTx(ctx context.Context, filters domain.LessonsListFilter) (domain.Lessons, error)
+	LessonByIDTx(ctx context.Context, id uuid.UUID) (domain.Lesson, error)
+	BookedLessonsTx(
+		ctx context.Context,
+		groupID uuid.UUID,
+		from, till time.Time,
+		teacherIDs, auditoriumIDs []uuid.UUID,
+	) (domain.Lessons, error)
+	TeacherLessonsOutsideSchoolTx(
+		ctx context.Context,
+		schoolID uuid.UUID,
+		from, till time.Time,
+		teacherIDs []uuid.UUID,
+	) (domain.Lessons, error)
+
+	CreateTimetableTemplateTx(ctx context.Context, t domain.TimetableTemplate) error
+	TimetableTemplateByIDTx(ctx context.Context, id, schoolID uuid.UUID) (domain.TimetableTemplate, error)
+	TimetableTemplateListTx(
+		ctx context.Context, filters domain.TimetableTemplateFilters,
+	) (domain.TimetableTemplates, error)
+	TemplateLessonsTx(ctx context.Context, slotIDs []uuid.UUID, from, till time.Time) (domain.Lessons, error)
+	DeleteTemplateLessonsTx(ctx context.Context, slotIDs []uuid.UUID, from, till time.Time) error
+	AddLessonsTx(ctx context.Context, lessons domain.Lessons) error
+	UpdateLessonTx(ctx context.Context, lesson domain.Lesson) error
+	LessonDependentsTx(ctx context.Context, id uuid.UUID) (domain.Dependents, error)
+	DeleteLessonTx(ctx context.Context, id uuid.UUID, now time.Time) error
+	RestoreLessonTx(ctx context.Context, id uuid.UUID, now time.Time) error
 
 	AddMark(ctx context.Context, m domain.Mark) error
 	MarkByIDTx(ctx context.Context, id uuid.UUID) (domain.Mark, error)
+	UpdateMarkTx(ctx context.Context, m domain.Mark, version time.Time) error
+	DeleteMarkTx(ctx context.Context, id uuid.UUID, now time.Time) error
+	RestoreMarkTx(ctx context.Context, id uuid.UUID, now time.Time) error
+
+	GroupStudentIDsTx(ctx context.Context, groupID uuid.UUID, from, till time.Time) ([]uuid.UUID, error)
+	SetAttendancesTx(ctx context.Context, attendances domain.Attendances) error
+	LessonAttendancesTx(ctx context.Context, lessonID uuid.UUID) (domain.Attendances, error)
+	AttendanceListTx(ctx context.Context, filters domain.AttendanceFilters) (domain.Attendances, error)
+
+	GroupSubjectLessonsTx(
+		ctx context.Context, groupSubjectID uuid.UUID, from, till time.Time,
+	) (domain.Lessons, error)
+	MarksByLessonIDsTx(ctx context.Context, lessonIDs []uuid.UUID) (domain.Marks, error)
+	FinalGradesTx(ctx context.Context, groupSubjectID uuid.UUID, from, till time.Time) (domain.FinalGrades, error)
+	IsFinalGradeLockedTx(ctx context.Context, groupSubjectID, studentID uuid.UUID, date time.Time) (bool, error)
+	SetFinalGradesTx(ctx context.Context, grades domain.FinalGrades) error
+}
+
+// IGradesService is a grades service use case interface.
+type IGradesService interface {
+	GradingScaleBySchoolID(ctx context.Context, schoolID uuid.UUID) (domain.GradingScale, error)
 }
 
 // IGroupService is a group service use case interface.
 type IGroupService interface {
-	GroupByID(ctx context.Context, groupID uuid.UUID) (domain.Group, error)
-	GroupSubjectList(ctx context.Context, groupID uuid.UUID) (domain.GroupSubjects, error)
+	GroupByID(ctx context.Context, schoolID, groupID uuid.UUID) (domain.Group, error)
+	GroupSubjectList(ctx context.Context, schoolID, groupID uuid.UUID) (domain.GroupSubjects, error)
+	GroupSubjectByID(ctx context.Context, id uuid.UUID) (domain.GroupSubject, error)
+	GroupList(ctx context.Context, schoolID uuid.UUID, filters domain.GroupFilters) (domain.Groups, int, error)
 }
@@ -11,6 +11,7 @@ import (
 type Service struct {
 	schoolService ISchoolService
 	groupService  IGroupService
+	gradesService IGradesService
 
 	lessonRepo ILessonRepo
 
@@ -23,6 +24,7 @@ type Service struct {
 func NewService(
 	schoolService ISchoolService,
 	groupService IGroupService,
+	gradesService IGradesService,
 
 	lessonRepo ILessonRepo,
 
@@ -33,6 +35,7 @@ func NewService(
 	return &Service{
 		schoolService: schoolService,
 		groupService:  groupService,
+		gradesService: gradesService,
 
 		lessonRepo: lessonRepo,
 
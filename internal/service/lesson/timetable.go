@@ -0,0 +1,153 @@
+package lesson
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+	"bum-service/pkg/utils"
+)
+
+// GenerateTimetableArgs is args for generating a draft timetable of the school.
+type GenerateTimetableArgs struct {
+	SchoolID         uuid.UUID
+	WeekDate         time.Time
+	Bells            domain.BellSlots
+	Weekdays         []time.Weekday
+	MaxLessonsPerDay int
+	TeacherDaysOff   map[uuid.UUID][]time.Weekday
+}
+
+// GenerateTimetable generates a draft timetable of the week for all groups of the school from group subjects counts.
+// The draft is not saved, it can be committed with CommitTimetable.
+func (s *Service) GenerateTimetable(ctx context.Context, args GenerateTimetableArgs) (domain.Timetable, error) {
+	if !args.Bells.Validate() {
+		return domain.Timetable{}, domain.ErrInvalidBellSlots
+	}
+
+	var (
+		firstDayOfWeek     = utils.FirstDayOfWeek(args.WeekDate)
+		firstDayOfNextWeek = firstDayOfWeek.AddDate(0, 0, utils.WeekDaysCount)
+		demands            []domain.TimetableDemand
+		teacherIDs         []uuid.UUID
+	)
+
+	groups, _, err := s.groupService.GroupList(ctx, args.SchoolID, domain.NewGroupFilters(domain.ListFilter{}, nil))
+	if err != nil {
+		return domain.Timetable{}, fmt.Errorf("failed to get school group list: %w", err)
+	}
+
+	for _, group := range groups {
+		groupSubjects, err := s.groupService.GroupSubjectList(ctx, args.SchoolID, group.ID)
+		if err != nil {
+			return domain.Timetable{}, fmt.Errorf("failed to get group subject list: %w", err)
+		}
+
+		demands = append(demands, domain.NewTimetableDemands(groupSubjects)...)
+		teacherIDs = append(teacherIDs, groupSubjects.TeacherIDs()...)
+	}
+
+	auditoriums, err := s.schoolService.SchoolAuditoriums(ctx, args.SchoolID)
+	if err != nil {
+		return domain.Timetable{}, fmt.Errorf("failed to get school auditoriums: %w", err)
+	}
+
+	booked, err := s.lessonRepo.TeacherLessonsOutsideSchoolTx(
+		ctx, args.SchoolID, firstDayOfWeek, firstDayOfNextWeek, teacherIDs,
+	)
+	if err != nil {
+		return domain.Timetable{}, fmt.Errorf("failed to get teacher lessons in other schools: %w", err)
+	}
+
+	academicYears, err := s.schoolService.AcademicYearList(ctx, args.SchoolID)
+	if err != nil {
+		return domain.Timetable{}, fmt.Errorf("failed to get school academic years: %w", err)
+	}
+
+	return domain.GenerateTimetable(
+		args.SchoolID,
+		firstDayOfWeek,
+		args.Bells,
+		domain.NewTimetableConstraints(args.Weekdays, args.MaxLessonsPerDay, args.TeacherDaysOff, args.Bells),
+		demands,
+		auditoriums,
+		booked,
+		academicYears,
+
+		s.now,
+	), nil
+}
+
+// CommitTimetableArgs is args for committing a timetable of the school.
+type CommitTimetableArgs struct {
+	SchoolID uuid.UUID
+	WeekDate time.Time
+	Groups   []GroupLessons
+}
+
+// GroupLessons is week lessons of a group.
+type GroupLessons struct {
+	GroupID uuid.UUID
+	Lessons []Lesson
+}
+
+// CommitTimetable assigns week lessons of several groups at once.
+// Lessons of all the groups are replaced in one transaction, so conflicts are checked against the new timetable.
+func (s *Service) CommitTimetable(ctx context.Context, args CommitTimetableArgs) (_ domain.Timetable, err error) {
+	var (
+		firstDayOfWeek     = utils.FirstDayOfWeek(args.WeekDate)
+		firstDayOfNextWeek = firstDayOfWeek.AddDate(0, 0, utils.WeekDaysCount)
+		timetable          = domain.Timetable{SchoolID: args.SchoolID, WeekStart: firstDayOfWeek}
+	)
+
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.Timetable{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on commit timetable: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	// current lessons of the groups are removed first, otherwise they conflict with the new ones.
+	for _, group := range args.Groups {
+		if _, err = s.groupService.GroupByID(txCtx, args.SchoolID, group.GroupID); err != nil {
+			return domain.Timetable{}, fmt.Errorf("failed to get group by id: %w", err)
+		}
+
+		err = s.lessonRepo.AssignsLessons(txCtx, group.GroupID, firstDayOfWeek, firstDayOfNextWeek, nil)
+		if err != nil {
+			return domain.Timetable{}, fmt.Errorf("failed to remove group week lessons: %w", err)
+		}
+	}
+
+	for _, group := range args.Groups {
+		var lessons domain.Lessons
+
+		lessons, err = s.AssignLessons(txCtx, AddWeekLessonsArgs{
+			SchoolID: args.SchoolID,
+			GroupID:  group.GroupID,
+			WeekDate: args.WeekDate,
+			Lessons:  group.Lessons,
+		})
+		if err != nil {
+			return domain.Timetable{}, fmt.Errorf("failed to assign group lessons: %w", err)
+		}
+
+		timetable.Groups = append(timetable.Groups, domain.GroupTimetable{
+			GroupID: group.GroupID,
+			Lessons: lessons,
+		})
+	}
+
+	return timetable, nil
+}
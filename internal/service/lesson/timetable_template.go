@@ -0,0 +1,274 @@
+package lesson
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// CreateTimetableTemplateArgs is args for creating a timetable template.
+type CreateTimetableTemplateArgs struct {
+	SchoolID   uuid.UUID
+	GroupID    uuid.UUID
+	Name       string
+	DateFrom   time.Time
+	DateTill   time.Time
+	Slots      []TemplateSlot
+	Exclusions []TemplateExclusion
+}
+
+// TemplateSlot is a weekly lesson of the timetable template.
+type TemplateSlot struct {
+	GroupSubjectID uuid.UUID
+	TeacherID      *uuid.UUID
+	AuditoriumID   uuid.UUID
+	Weekday        time.Weekday
+	Bell           domain.BellSlot
+	Description    *string
+}
+
+// TemplateExclusion is a period without lessons.
+type TemplateExclusion struct {
+	DateFrom time.Time
+	DateTill time.Time
+	Reason   *string
+}
+
+// CreateTimetableTemplate creates a new recurring weekly timetable of the group.
+func (s *Service) CreateTimetableTemplate(
+	ctx context.Context, args CreateTimetableTemplateArgs,
+) (domain.TimetableTemplate, error) {
+	if _, err := s.groupService.GroupByID(ctx, args.SchoolID, args.GroupID); err != nil {
+		return domain.TimetableTemplate{}, fmt.Errorf("failed to get group by id: %w", err)
+	}
+
+	groupSubjects, err := s.groupService.GroupSubjectList(ctx, args.SchoolID, args.GroupID)
+	if err != nil {
+		return domain.TimetableTemplate{}, fmt.Errorf("failed to get group subject list: %w", err)
+	}
+
+	groupSubjectMap := groupSubjects.MapByID()
+
+	template := domain.NewTimetableTemplate(
+		args.SchoolID, args.GroupID, args.Name, args.DateFrom, args.DateTill, s.now,
+	)
+
+	for _, slot := range args.Slots {
+		if _, ok := groupSubjectMap[slot.GroupSubjectID]; !ok {
+			return domain.TimetableTemplate{}, domain.ErrGroupSubjectNotFound
+		}
+
+		template.Slots = append(template.Slots, domain.NewTimetableTemplateSlot(
+			template.ID,
+			slot.GroupSubjectID,
+			slot.TeacherID,
+			slot.AuditoriumID,
+			slot.Weekday,
+			slot.Bell,
+			slot.Description,
+
+			s.now,
+		))
+	}
+
+	for _, exclusion := range args.Exclusions {
+		template.Exclusions = append(template.Exclusions, domain.NewTimetableTemplateExclusion(
+			template.ID, exclusion.DateFrom, exclusion.DateTill, exclusion.Reason, s.now,
+		))
+	}
+
+	if err = template.Validate(); err != nil {
+		return domain.TimetableTemplate{}, fmt.Errorf("invalid timetable template: %w", err)
+	}
+
+	if err = s.lessonRepo.CreateTimetableTemplateTx(ctx, template); err != nil {
+		return domain.TimetableTemplate{}, fmt.Errorf("failed to create timetable template: %w", err)
+	}
+
+	return template, nil
+}
+
+// TimetableTemplateByID returns timetable template of the school by id.
+func (s *Service) TimetableTemplateByID(
+	ctx context.Context, id, schoolID uuid.UUID,
+) (domain.TimetableTemplate, error) {
+	template, err := s.lessonRepo.TimetableTemplateByIDTx(ctx, id, schoolID)
+	if err != nil {
+		return domain.TimetableTemplate{}, fmt.Errorf("failed to get timetable template by id: %w", err)
+	}
+
+	return template, nil
+}
+
+// TimetableTemplateList returns timetable templates by filters.
+func (s *Service) TimetableTemplateList(
+	ctx context.Context, filters domain.TimetableTemplateFilters,
+) (domain.TimetableTemplates, error) {
+	list, err := s.lessonRepo.TimetableTemplateListTx(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timetable template list: %w", err)
+	}
+
+	return list, nil
+}
+
+// MaterialiseTimetableTemplateArgs is args for materialising timetable template lessons.
+type MaterialiseTimetableTemplateArgs struct {
+	SchoolID   uuid.UUID
+	TemplateID uuid.UUID
+	DateFrom   time.Time
+	DateTill   time.Time
+}
+
+// MaterialiseTimetableTemplate creates lessons of the template for the period.
+// Only upcoming lessons previously materialised in the period are replaced. Overridden, deleted lessons
+// and lessons having marks or attendance are kept, and their occurrences are not materialised again.
+func (s *Service) MaterialiseTimetableTemplate(
+	ctx context.Context, args MaterialiseTimetableTemplateArgs,
+) (_ domain.Lessons, err error) {
+	if args.DateTill.Before(args.DateFrom) {
+		return nil, domain.ErrInvalidTimetableTemplatePeriod
+	}
+
+	// lessons of the last day are included.
+	till := args.DateTill.AddDate(0, 0, 1)
+
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on materialise timetable template: %w: %w",
+				domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	template, err := s.lessonRepo.TimetableTemplateByIDTx(txCtx, args.TemplateID, args.SchoolID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timetable template by id: %w", err)
+	}
+
+	groupSubjects, err := s.groupService.GroupSubjectList(txCtx, args.SchoolID, template.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group subject list: %w", err)
+	}
+
+	academicYears, err := s.schoolService.AcademicYearList(txCtx, args.SchoolID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get school academic years: %w", err)
+	}
+
+	now := s.now()
+
+	replaceFrom := args.DateFrom
+	if now.After(replaceFrom) {
+		replaceFrom = now
+	}
+
+	err = s.lessonRepo.DeleteTemplateLessonsTx(txCtx, template.Slots.IDs(), replaceFrom, till)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete template lessons: %w", err)
+	}
+
+	kept, err := s.lessonRepo.TemplateLessonsTx(txCtx, template.Slots.IDs(), args.DateFrom, till)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template lessons: %w", err)
+	}
+
+	existing := make(map[domain.TemplateOccurrence]struct{}, len(kept))
+
+	for _, lesson := range kept {
+		if occurrence, ok := lesson.Occurrence(); ok {
+			existing[occurrence] = struct{}{}
+		}
+	}
+
+	lessons := make(domain.Lessons, 0)
+
+	materialised := template.Materialise(
+		args.DateFrom, args.DateTill, groupSubjects.MapByID(), existing, academicYears, s.now,
+	)
+
+	for _, lesson := range materialised {
+		if !lesson.StartTime.Before(replaceFrom) {
+			lessons = append(lessons, lesson)
+		}
+	}
+
+	if conflicts := lessons.Conflicts(); len(conflicts) != 0 {
+		return nil, domain.NewLessonScheduleConflictErr(conflicts)
+	}
+
+	if err = s.checkBookedLessons(txCtx, template.GroupID, lessons); err != nil {
+		return nil, err
+	}
+
+	if err = s.lessonRepo.AddLessonsTx(txCtx, lessons); err != nil {
+		return nil, fmt.Errorf("failed to add template lessons: %w", err)
+	}
+
+	return lessons, nil
+}
+
+// OverrideLessonArgs is args for overriding a single lesson occurrence.
+type OverrideLessonArgs struct {
+	SchoolID     uuid.UUID
+	LessonID     uuid.UUID
+	TeacherID    *uuid.UUID
+	AuditoriumID *uuid.UUID
+	Description  *string
+}
+
+// OverrideLesson changes a single lesson, e.g. sets substitute teacher or another auditorium.
+// Overridden lesson is kept when the timetable template is materialised again.
+func (s *Service) OverrideLesson(ctx context.Context, args OverrideLessonArgs) (_ domain.Lesson, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.Lesson{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on override lesson: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	lesson, err := s.lessonRepo.LessonByIDTx(txCtx, args.LessonID)
+	if err != nil {
+		return domain.Lesson{}, fmt.Errorf("failed to get lesson by id: %w", err)
+	}
+
+	if lesson.SchoolID != args.SchoolID {
+		return domain.Lesson{}, domain.ErrLessonNotFound
+	}
+
+	groupSubject, err := s.groupService.GroupSubjectByID(txCtx, lesson.GroupSubjectID)
+	if err != nil {
+		return domain.Lesson{}, fmt.Errorf("failed to get group subject by id: %w", err)
+	}
+
+	lesson.Override(args.TeacherID, args.AuditoriumID, args.Description, s.now)
+
+	if err = s.checkBookedLessons(txCtx, groupSubject.GroupID, domain.Lessons{lesson}); err != nil {
+		return domain.Lesson{}, err
+	}
+
+	if err = s.lessonRepo.UpdateLessonTx(txCtx, lesson); err != nil {
+		return domain.Lesson{}, fmt.Errorf("failed to update lesson: %w", err)
+	}
+
+	return lesson, nil
+}
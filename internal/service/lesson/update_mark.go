@@ -0,0 +1,95 @@
+package lesson
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+	"bum-service/pkg/utils"
+)
+
+// UpdateMarkArgs is arguments for updating mark, absent fields are left unchanged.
+type UpdateMarkArgs struct {
+	ID          uuid.UUID
+	LessonID    uuid.UUID
+	Mark        *string
+	Description utils.Patch[string]
+	// UpdatedAt is the version of the mark the update is based on.
+	UpdatedAt time.Time
+}
+
+// UpdateMark updates mark of the lesson.
+// Mark must be allowed by the grading scale of the lesson school
+// and the final grade of the lesson period must not be locked.
+func (s *Service) UpdateMark(ctx context.Context, args UpdateMarkArgs) (_ domain.Mark, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.Mark{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on update mark: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	mark, err := s.lessonRepo.MarkByIDTx(txCtx, args.ID)
+	if err != nil {
+		return domain.Mark{}, fmt.Errorf("failed to get mark by id: %w", err)
+	}
+
+	if mark.LessonID != args.LessonID {
+		return domain.Mark{}, domain.ErrMarkNotFound
+	}
+
+	if err = domain.CheckVersion(mark.UpdatedAt, args.UpdatedAt); err != nil {
+		return domain.Mark{}, err
+	}
+
+	if err = s.checkFinalGradeLock(txCtx, mark); err != nil {
+		return domain.Mark{}, err
+	}
+
+	weight := mark.Weight
+
+	if args.Mark != nil {
+		if weight, err = s.markWeight(txCtx, mark.LessonID, *args.Mark); err != nil {
+			return domain.Mark{}, err
+		}
+	}
+
+	mark.Update(utils.ValueOr(args.Mark, mark.Mark), weight, args.Description.Apply(mark.Description), s.now)
+
+	if err = s.lessonRepo.UpdateMarkTx(txCtx, mark, args.UpdatedAt); err != nil {
+		return domain.Mark{}, fmt.Errorf("failed to update mark: %w", err)
+	}
+
+	mark, err = s.MarkByID(txCtx, mark.ID)
+	if err != nil {
+		return domain.Mark{}, fmt.Errorf("failed to get mark by id: %w", err)
+	}
+
+	return mark, nil
+}
+
+// markWeight returns weight of the mark by the grading scale of the lesson school.
+func (s *Service) markWeight(ctx context.Context, lessonID uuid.UUID, mark string) (float64, error) {
+	lesson, err := s.lessonRepo.LessonByIDTx(ctx, lessonID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get lesson by id: %w", err)
+	}
+
+	scale, err := s.gradesService.GradingScaleBySchoolID(ctx, lesson.SchoolID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get grading scale of school: %w", err)
+	}
+
+	return scale.Weight(mark)
+}
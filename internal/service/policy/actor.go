@@ -0,0 +1,27 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// Actor returns the user with its roles to make access decisions, only the active role of the request
+// is used if it is selected.
+func (s Service) Actor(ctx context.Context, userID uuid.UUID) (domain.Actor, error) {
+	roles, err := s.userService.UserRoles(ctx, userID)
+	if err != nil {
+		return domain.Actor{}, fmt.Errorf("failed to get user roles: %w", err)
+	}
+
+	actor := domain.NewActor(userID, roles)
+
+	if activeRole, ok := domain.ActiveRoleFromContext(ctx); ok {
+		actor.SetActiveRole(activeRole)
+	}
+
+	return actor, nil
+}
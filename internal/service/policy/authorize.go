@@ -0,0 +1,117 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// AuthorizeRoles checks whether actor has one of the given roles.
+func (Service) AuthorizeRoles(_ context.Context, actor domain.Actor, roles ...domain.Role) error {
+	if !actor.HasAnyRole(roles...) {
+		return domain.ErrForbidden
+	}
+
+	return nil
+}
+
+// AuthorizeOrganization checks whether actor has one of the given roles within the organization.
+func (Service) AuthorizeOrganization(
+	_ context.Context, actor domain.Actor, organizationID uuid.UUID, roles ...domain.Role,
+) error {
+	if !actor.CanAccessOrganization(organizationID, roles...) {
+		return domain.ErrForbidden
+	}
+
+	return nil
+}
+
+// AuthorizeSchool checks whether actor has one of the given roles within the school.
+func (s Service) AuthorizeSchool(
+	ctx context.Context, actor domain.Actor, schoolID uuid.UUID, roles ...domain.Role,
+) error {
+	if actor.IsAdmin() {
+		return nil
+	}
+
+	school, err := s.schoolService.SchoolShortByID(ctx, schoolID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrForbidden
+		}
+
+		return fmt.Errorf("failed to get school short info: %w", err)
+	}
+
+	if !actor.CanAccessSchool(school.ID, school.OrganizationID, roles...) {
+		return domain.ErrForbidden
+	}
+
+	return nil
+}
+
+// AuthorizeLesson checks whether actor may manage the lesson: either actor teaches the lesson
+// or actor manages the lesson school.
+func (s Service) AuthorizeLesson(ctx context.Context, actor domain.Actor, lessonID uuid.UUID) error {
+	if actor.IsAdmin() {
+		return nil
+	}
+
+	lesson, err := s.lessonService.LessonByID(ctx, lessonID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrForbidden
+		}
+
+		return fmt.Errorf("failed to get lesson by id: %w", err)
+	}
+
+	if lesson.TeacherID != nil && actor.Roles.HasRole(domain.RoleTeacher) {
+		teacher, err := s.teacherService.TeacherByID(ctx, *lesson.TeacherID)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("failed to get lesson teacher by id: %w", err)
+		}
+
+		if err == nil && teacher.UserID == actor.UserID {
+			return nil
+		}
+	}
+
+	return s.AuthorizeSchool(ctx, actor, lesson.SchoolID, domain.RoleOwner, domain.RoleDirector, domain.RoleHeadmaster)
+}
+
+// AuthorizeGroupSubject checks whether actor may manage the grades of the group subject: either actor teaches
+// the group subject in the school or actor manages the school.
+func (s Service) AuthorizeGroupSubject(
+	ctx context.Context, actor domain.Actor, schoolID, groupSubjectID uuid.UUID,
+) error {
+	if actor.IsAdmin() {
+		return nil
+	}
+
+	groupSubject, err := s.schoolService.GroupSubjectByID(ctx, groupSubjectID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrForbidden
+		}
+
+		return fmt.Errorf("failed to get group subject by id: %w", err)
+	}
+
+	if groupSubject.TeacherID != nil && actor.Roles.HasRole(domain.RoleTeacher) {
+		teacher, err := s.teacherService.TeacherByID(ctx, *groupSubject.TeacherID)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("failed to get group subject teacher by id: %w", err)
+		}
+
+		if err == nil && teacher.UserID == actor.UserID && teacher.SchoolID == schoolID {
+			return nil
+		}
+	}
+
+	return s.AuthorizeSchool(ctx, actor, schoolID, domain.RoleOwner, domain.RoleDirector, domain.RoleHeadmaster)
+}
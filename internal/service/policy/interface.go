@@ -0,0 +1,30 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// IUserService represents a user service for loading user roles.
+type IUserService interface {
+	UserRoles(ctx context.Context, id uuid.UUID) (domain.UserRoles, error)
+}
+
+// ISchoolService represents a school service.
+type ISchoolService interface {
+	SchoolShortByID(ctx context.Context, id uuid.UUID) (domain.SchoolShortInfo, error)
+	GroupSubjectByID(ctx context.Context, id uuid.UUID) (domain.GroupSubject, error)
+}
+
+// ITeacherService represents a teacher service.
+type ITeacherService interface {
+	TeacherByID(ctx context.Context, id uuid.UUID) (domain.Teacher, error)
+}
+
+// ILessonService represents a lesson service.
+type ILessonService interface {
+	LessonByID(ctx context.Context, lessonID uuid.UUID) (domain.Lesson, error)
+}
@@ -0,0 +1,39 @@
+package policy
+
+import (
+	"time"
+
+	"bum-service/pkg/liblog"
+)
+
+// Service is an access policy use case.
+type Service struct {
+	userService    IUserService
+	schoolService  ISchoolService
+	teacherService ITeacherService
+	lessonService  ILessonService
+
+	logger liblog.Logger
+	now    func() time.Time
+}
+
+// NewService creates a new access policy use case.
+func NewService(
+	userService IUserService,
+	schoolService ISchoolService,
+	teacherService ITeacherService,
+	lessonService ILessonService,
+
+	logger liblog.Logger,
+	nowFunc func() time.Time,
+) *Service {
+	return &Service{
+		userService:    userService,
+		schoolService:  schoolService,
+		teacherService: teacherService,
+		lessonService:  lessonService,
+
+		logger: logger,
+		now:    nowFunc,
+	}
+}
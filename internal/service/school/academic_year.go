@@ -0,0 +1,123 @@
+package school
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// CreateAcademicYearArgs is args for creating an academic year.
+type CreateAcademicYearArgs struct {
+	SchoolID uuid.UUID
+	Name     string
+	DateFrom time.Time
+	DateTill time.Time
+	Terms    []AcademicYearPeriodArgs
+	Holidays []AcademicYearPeriodArgs
+}
+
+// AcademicYearPeriodArgs is args of a term or holidays of the academic year.
+type AcademicYearPeriodArgs struct {
+	Name     string
+	DateFrom time.Time
+	DateTill time.Time
+}
+
+// CreateAcademicYear creates a new academic year of the school with its terms and holidays.
+func (s Service) CreateAcademicYear(ctx context.Context, args CreateAcademicYearArgs) (domain.AcademicYear, error) {
+	year := domain.NewAcademicYear(args.SchoolID, args.Name, args.DateFrom, args.DateTill, s.now)
+
+	for _, term := range args.Terms {
+		year.AddTerm(term.Name, term.DateFrom, term.DateTill, s.now)
+	}
+
+	for _, holiday := range args.Holidays {
+		year.AddHoliday(holiday.Name, holiday.DateFrom, holiday.DateTill, s.now)
+	}
+
+	return s.createAcademicYear(ctx, year)
+}
+
+// AcademicYearByID returns academic year of the school by id.
+func (s Service) AcademicYearByID(ctx context.Context, id, schoolID uuid.UUID) (domain.AcademicYear, error) {
+	year, err := s.schoolRepo.AcademicYearByIDTx(ctx, id, schoolID)
+	if err != nil {
+		return domain.AcademicYear{}, fmt.Errorf("failed to get academic year by id: %w", err)
+	}
+
+	return year, nil
+}
+
+// AcademicYearList returns academic years of the school.
+func (s Service) AcademicYearList(ctx context.Context, schoolID uuid.UUID) (domain.AcademicYears, error) {
+	list, err := s.schoolRepo.AcademicYearListTx(ctx, schoolID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get academic year list: %w", err)
+	}
+
+	return list, nil
+}
+
+// RollOverAcademicYear creates the next academic year of the school
+// with terms and holidays of the given year moved one year forward.
+func (s Service) RollOverAcademicYear(
+	ctx context.Context, id, schoolID uuid.UUID, name string,
+) (domain.AcademicYear, error) {
+	year, err := s.schoolRepo.AcademicYearByIDTx(ctx, id, schoolID)
+	if err != nil {
+		return domain.AcademicYear{}, fmt.Errorf("failed to get academic year by id: %w", err)
+	}
+
+	return s.createAcademicYear(ctx, year.RollOver(name, 1, s.now))
+}
+
+// TermByID returns term of the school academic year by id.
+func (s Service) TermByID(ctx context.Context, id, schoolID uuid.UUID) (domain.Term, error) {
+	term, err := s.schoolRepo.TermByIDTx(ctx, id, schoolID)
+	if err != nil {
+		return domain.Term{}, fmt.Errorf("failed to get term by id: %w", err)
+	}
+
+	return term, nil
+}
+
+// createAcademicYear validates and stores the academic year.
+func (s Service) createAcademicYear(ctx context.Context, year domain.AcademicYear) (_ domain.AcademicYear, err error) {
+	if err = year.Validate(); err != nil {
+		return domain.AcademicYear{}, err
+	}
+
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.AcademicYear{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on create academic year: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	years, err := s.schoolRepo.AcademicYearListTx(txCtx, year.SchoolID)
+	if err != nil {
+		return domain.AcademicYear{}, fmt.Errorf("failed to get academic year list: %w", err)
+	}
+
+	if years.Overlaps(year) {
+		return domain.AcademicYear{}, domain.ErrAcademicYearOverlaps
+	}
+
+	if err = s.schoolRepo.CreateAcademicYearTx(txCtx, year); err != nil {
+		return domain.AcademicYear{}, fmt.Errorf("failed to create academic year: %w", err)
+	}
+
+	return year, nil
+}
@@ -14,18 +14,27 @@ type CreateGroupArgs struct {
 	SchoolID uuid.UUID
 	Name     string
 	GradeID  uuid.UUID
+
+	AcademicYearID *uuid.UUID
 }
 
 // CreateGroup creates a new group.
 func (s Service) CreateGroup(ctx context.Context, arg CreateGroupArgs) (domain.Group, error) {
-	newGroupDomain := domain.NewGroup(arg.SchoolID, arg.Name, arg.GradeID, s.now)
+	if arg.AcademicYearID != nil {
+		// academic year must belong to the group school.
+		if _, err := s.schoolRepo.AcademicYearByIDTx(ctx, *arg.AcademicYearID, arg.SchoolID); err != nil {
+			return domain.Group{}, fmt.Errorf("failed to get academic year by id: %w", err)
+		}
+	}
+
+	newGroupDomain := domain.NewGroup(arg.SchoolID, arg.Name, arg.GradeID, arg.AcademicYearID, s.now)
 
 	err := s.groupRepo.CreateGroupTx(ctx, newGroupDomain)
 	if err != nil {
 		return domain.Group{}, fmt.Errorf("failed to create a new group to database: %w", err)
 	}
 
-	responseGroupDomain, err := s.GroupByID(ctx, newGroupDomain.ID)
+	responseGroupDomain, err := s.GroupByID(ctx, arg.SchoolID, newGroupDomain.ID)
 	if err != nil {
 		return domain.Group{}, fmt.Errorf(
 			"failed to get group by id group_id=%s: %w", newGroupDomain.ID.String(), err,
@@ -56,7 +56,7 @@ func (s Service) checkSchoolPhoneAndEmail(ctx context.Context, organizationID uu
 					domain.SortOrderASC,
 					domain.NewPagination(domain.PaginationDefaultPage, domain.PaginationDefaultLimit),
 				),
-				[]string{*email}, nil, nil,
+				[]string{*email}, nil, nil, domain.SchoolScope{Unscoped: true},
 			),
 		)
 		if err != nil {
@@ -76,7 +76,7 @@ func (s Service) checkSchoolPhoneAndEmail(ctx context.Context, organizationID uu
 					domain.SortOrderASC,
 					domain.NewPagination(domain.PaginationDefaultPage, domain.PaginationDefaultLimit),
 				),
-				nil, []string{*phone}, nil,
+				nil, []string{*phone}, nil, domain.SchoolScope{Unscoped: true},
 			),
 		)
 		if err != nil {
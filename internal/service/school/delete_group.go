@@ -0,0 +1,91 @@
+package school
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// DeleteGroup deletes group of the school, its students must be moved to other groups
+// and its upcoming lessons must be deleted before.
+func (s Service) DeleteGroup(ctx context.Context, schoolID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on delete group: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	group, err := s.groupRepo.GroupByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get group by id: %w", err)
+	}
+
+	if group.SchoolID != schoolID {
+		return domain.ErrGroupNotFound
+	}
+
+	now := s.now()
+
+	dependents, err := s.groupRepo.GroupDependentsTx(txCtx, id, now)
+	if err != nil {
+		return fmt.Errorf("failed to get group dependents: %w", err)
+	}
+
+	if err = dependents.CheckDelete(); err != nil {
+		return err
+	}
+
+	if err = s.groupRepo.DeleteGroupTx(txCtx, id, now); err != nil {
+		return fmt.Errorf("failed to delete group: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreGroup restores deleted group of the school.
+func (s Service) RestoreGroup(ctx context.Context, schoolID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on restore group: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	if _, err = s.schoolRepo.SchoolShortByIDTx(txCtx, schoolID); err != nil {
+		return fmt.Errorf("failed to get school by id: %w", err)
+	}
+
+	if err = s.groupRepo.RestoreGroupTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to restore group: %w", err)
+	}
+
+	group, err := s.groupRepo.GroupByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get group by id: %w", err)
+	}
+
+	if group.SchoolID != schoolID {
+		return domain.ErrGroupNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package school
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// DeleteSchool deletes school, all its groups and teachers must be deleted before.
+func (s Service) DeleteSchool(ctx context.Context, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on delete school: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	dependents, err := s.schoolRepo.SchoolDependentsTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get school dependents: %w", err)
+	}
+
+	if err = dependents.CheckDelete(); err != nil {
+		return err
+	}
+
+	if err = s.schoolRepo.DeleteSchoolTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to delete school: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreSchool restores deleted school of the educational organization,
+// the organization must not be deleted.
+func (s Service) RestoreSchool(ctx context.Context, organizationID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on restore school: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	if _, err = s.eduOrganizationService.EduOrganizationShortByID(txCtx, organizationID); err != nil {
+		return fmt.Errorf("failed to get organization by id: %w", err)
+	}
+
+	if err = s.schoolRepo.RestoreSchoolTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to restore school: %w", err)
+	}
+
+	school, err := s.schoolRepo.SchoolByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get school by id: %w", err)
+	}
+
+	if school.OrganizationID != organizationID {
+		return domain.ErrSchoolNotFound
+	}
+
+	return nil
+}
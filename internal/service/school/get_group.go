@@ -9,13 +9,17 @@ import (
 	"bum-service/internal/domain"
 )
 
-// GroupByID gets group by id.
-func (s Service) GroupByID(ctx context.Context, groupID uuid.UUID) (domain.Group, error) {
+// GroupByID gets group of the school by id.
+func (s Service) GroupByID(ctx context.Context, schoolID, groupID uuid.UUID) (domain.Group, error) {
 	groupDomain, err := s.groupRepo.GroupByIDTx(ctx, groupID)
 	if err != nil {
 		return domain.Group{}, fmt.Errorf("failed to create a new group to database: %w", err)
 	}
 
+	if groupDomain.SchoolID != schoolID {
+		return domain.Group{}, domain.ErrGroupNotFound
+	}
+
 	grade, err := s.gradeService.GradeByID(ctx, groupDomain.GradeID)
 	if err != nil {
 		return domain.Group{}, fmt.Errorf("failed to get grade by id: %w", err)
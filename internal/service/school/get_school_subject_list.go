@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+
 	"bum-service/internal/domain"
 )
 
@@ -25,3 +27,13 @@ func (s Service) SchoolSubjectList(
 
 	return schoolSubjectList, count, nil
 }
+
+// SchoolSubjectsBySchoolID get all subjects of the school.
+func (s Service) SchoolSubjectsBySchoolID(ctx context.Context, schoolID uuid.UUID) (domain.SchoolSubjects, error) {
+	schoolSubjects, err := s.schoolRepo.SchoolSubjectsBySchoolIDTx(ctx, schoolID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get school subjects by school id from database: %w", err)
+	}
+
+	return schoolSubjects, nil
+}
@@ -29,8 +29,17 @@ func (s Service) GroupSubjectByID(ctx context.Context, id uuid.UUID) (domain.Gro
 	return groupSubject, nil
 }
 
-// GroupSubjectList returns a list of subjects assigned to a group.
-func (s Service) GroupSubjectList(ctx context.Context, groupID uuid.UUID) (domain.GroupSubjects, error) {
+// GroupSubjectList returns a list of subjects assigned to a group of the school.
+func (s Service) GroupSubjectList(ctx context.Context, schoolID, groupID uuid.UUID) (domain.GroupSubjects, error) {
+	group, err := s.groupRepo.GroupByIDTx(ctx, groupID)
+	if err != nil {
+		return domain.GroupSubjects{}, fmt.Errorf("failed to get group from database: %w", err)
+	}
+
+	if group.SchoolID != schoolID {
+		return domain.GroupSubjects{}, domain.ErrGroupNotFound
+	}
+
 	list, err := s.groupSubjectsRepo.GroupSubjectListTx(ctx, groupID)
 	if err != nil {
 		return domain.GroupSubjects{}, fmt.Errorf("failed to get school subject list from database: %w", err)
@@ -64,13 +73,17 @@ type AddGroupSubjectArgs struct {
 
 // AddGroupSubject adds subjects to a group.
 func (s Service) AddGroupSubject(
-	ctx context.Context, _, groupID uuid.UUID, args AddGroupSubjectArgs,
+	ctx context.Context, schoolID, groupID uuid.UUID, args AddGroupSubjectArgs,
 ) (domain.GroupSubject, error) {
 	group, err := s.groupRepo.GroupByIDTx(ctx, groupID)
 	if err != nil {
 		return domain.GroupSubject{}, fmt.Errorf("failed to get group from database: %w", err)
 	}
 
+	if group.SchoolID != schoolID {
+		return domain.GroupSubject{}, domain.ErrGroupNotFound
+	}
+
 	newGroupSubject := domain.NewGroupSubject(
 		args.SchoolSubjectID,
 		group.ID,
@@ -109,3 +122,29 @@ func (s Service) AddGroupSubject(
 
 	return newGroupSubject, nil
 }
+
+// UpdateGroupSubjectArgs is a struct for updating a subject of a group.
+type UpdateGroupSubjectArgs struct {
+	ID        uuid.UUID
+	TeacherID *uuid.UUID
+	Count     *int16
+}
+
+// UpdateGroupSubject updates the teacher and the number of the lessons of the group subject.
+func (s Service) UpdateGroupSubject(ctx context.Context, args UpdateGroupSubjectArgs) (domain.GroupSubject, error) {
+	groupSubject, err := s.groupSubjectsRepo.GroupSubjectByIDTx(ctx, args.ID)
+	if err != nil {
+		return domain.GroupSubject{}, fmt.Errorf("failed to get group subject by id from database: %w", err)
+	}
+
+	version := groupSubject.UpdatedAt
+
+	groupSubject.Update(args.TeacherID, args.Count, s.now)
+
+	err = s.groupSubjectsRepo.UpdateGroupSubjectTx(ctx, groupSubject, version)
+	if err != nil {
+		return domain.GroupSubject{}, fmt.Errorf("failed to update group subject to database: %w", err)
+	}
+
+	return groupSubject, nil
+}
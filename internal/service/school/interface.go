@@ -2,6 +2,7 @@ package school
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -14,11 +15,14 @@ import (
 type ISchoolRepo interface {
 	CreateSchoolTx(ctx context.Context, organization domain.School) error
 	SchoolByIDTx(ctx context.Context, schoolID uuid.UUID) (domain.School, error)
-	UpdateSchoolTx(ctx context.Context, o domain.School) error
+	UpdateSchoolTx(ctx context.Context, o domain.School, version time.Time) error
 	SchoolListTx(ctx context.Context, filters domain.SchoolFilters) (domain.Schools, error)
 	SchoolListCountTx(ctx context.Context, filters domain.SchoolFilters) (int, error)
 	SchoolShortByIDsTx(ctx context.Context, ids []uuid.UUID) (domain.SchoolShortInfos, error)
 	SchoolShortByIDTx(ctx context.Context, id uuid.UUID) (domain.SchoolShortInfo, error)
+	SchoolDependentsTx(ctx context.Context, id uuid.UUID) (domain.Dependents, error)
+	DeleteSchoolTx(ctx context.Context, id uuid.UUID, now time.Time) error
+	RestoreSchoolTx(ctx context.Context, id uuid.UUID, now time.Time) error
 
 	CreateSchoolSubjectTx(ctx context.Context, o domain.SchoolSubject) error
 	SchoolSubjectByIDAndSchoolIDTx(
@@ -29,15 +33,27 @@ type ISchoolRepo interface {
 	SchoolSubjectsByIDs(ctx context.Context, ids []uuid.UUID) (domain.SchoolSubjects, error)
 	SchoolSubjectListTx(ctx context.Context, filters domain.SchoolSubjectFilters) (domain.SchoolSubjects, error)
 	SchoolSubjectsListCountTx(ctx context.Context, filters domain.SchoolSubjectFilters) (int, error)
+	SchoolSubjectsBySchoolIDTx(ctx context.Context, schoolID uuid.UUID) (domain.SchoolSubjects, error)
+	UpdateSchoolSubjectTx(ctx context.Context, o domain.SchoolSubject, version time.Time) error
 
 	CreateAuditoriumTx(ctx context.Context, o domain.Auditorium) error
 	AuditoriumByIDAndSchoolIDTx(ctx context.Context, id, schoolID uuid.UUID) (domain.Auditorium, error)
 	AuditoriumListTx(ctx context.Context, filters domain.AuditoriumListFilters) (domain.Auditoriums, error)
+	SchoolAuditoriumsTx(ctx context.Context, schoolID uuid.UUID) (domain.Auditoriums, error)
 	AuditoriumListCountTx(ctx context.Context, filters domain.AuditoriumListFilters) (int, error)
+	UpdateAuditoriumTx(ctx context.Context, o domain.Auditorium, version time.Time) error
+	AuditoriumDependentsTx(ctx context.Context, id uuid.UUID, now time.Time) (domain.Dependents, error)
+	DeleteAuditoriumTx(ctx context.Context, id uuid.UUID, now time.Time) error
+	RestoreAuditoriumTx(ctx context.Context, id uuid.UUID, now time.Time) error
 
 	AssignStudyPlansTx(ctx context.Context, groupSubjectID uuid.UUID, studyPlans domain.StudyPlans) error
 	StudyPlanListTx(ctx context.Context, groupSubjectID uuid.UUID) (domain.StudyPlans, error)
 	StudyPlanChangeStatusTx(ctx context.Context, groupSubjectID, studyPlanID uuid.UUID, status string) error
+
+	CreateAcademicYearTx(ctx context.Context, year domain.AcademicYear) error
+	AcademicYearByIDTx(ctx context.Context, id, schoolID uuid.UUID) (domain.AcademicYear, error)
+	AcademicYearListTx(ctx context.Context, schoolID uuid.UUID) (domain.AcademicYears, error)
+	TermByIDTx(ctx context.Context, id, schoolID uuid.UUID) (domain.Term, error)
 }
 
 // IGroupSubjectsRepo represents a repository for group subjects.
@@ -45,13 +61,14 @@ type IGroupSubjectsRepo interface {
 	AddGroupSubjectsTx(ctx context.Context, groupSubject domain.GroupSubject) error
 	GroupSubjectByIDTx(ctx context.Context, id uuid.UUID) (domain.GroupSubject, error)
 	GroupSubjectListTx(ctx context.Context, groupID uuid.UUID) (domain.GroupSubjects, error)
+	UpdateGroupSubjectTx(ctx context.Context, groupSubject domain.GroupSubject, version time.Time) error
 }
 
 // IGroupRepo is a repository for groups.
 type IGroupRepo interface {
 	CreateGroupTx(ctx context.Context, g domain.Group) error
 	GroupByIDTx(ctx context.Context, id uuid.UUID) (domain.Group, error)
-	UpdateGroupTx(ctx context.Context, group domain.Group) error
+	UpdateGroupTx(ctx context.Context, group domain.Group, version time.Time) error
 	GroupsByIDsTx(ctx context.Context, ids []uuid.UUID) (domain.Groups, error)
 	GroupListTx(
 		ctx context.Context, schoolID uuid.UUID, filters domain.GroupFilters,
@@ -59,6 +76,14 @@ type IGroupRepo interface {
 	GroupListCountTx(
 		ctx context.Context, schoolID uuid.UUID, filters domain.GroupFilters,
 	) (int, error)
+
+	PromotableGroupsTx(ctx context.Context, schoolID uuid.UUID, academicYearID *uuid.UUID) (domain.Groups, error)
+	GroupStudentsTx(ctx context.Context, groupIDs []uuid.UUID) (domain.Students, error)
+	PromoteGroupsTx(ctx context.Context, promotions domain.GroupPromotions) error
+
+	GroupDependentsTx(ctx context.Context, id uuid.UUID, now time.Time) (domain.Dependents, error)
+	DeleteGroupTx(ctx context.Context, id uuid.UUID, now time.Time) error
+	RestoreGroupTx(ctx context.Context, id uuid.UUID, now time.Time) error
 }
 
 // IEduOrganizationService represents an edu organization service.
@@ -71,6 +96,7 @@ type IEduOrganizationService interface {
 type IGradeService interface {
 	GradesByIDs(ctx context.Context, ids []uuid.UUID) (domain.Grades, error)
 	GradeByID(ctx context.Context, id uuid.UUID) (domain.Grade, error)
+	GradeStandardByID(ctx context.Context, id uuid.UUID) (domain.GradeStandard, error)
 }
 
 // ITeacherService represents teacher service.
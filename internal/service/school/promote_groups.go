@@ -0,0 +1,84 @@
+package school
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// PromoteGroupsArgs is args for promoting groups of the school to the next academic year.
+type PromoteGroupsArgs struct {
+	SchoolID uuid.UUID
+	// FromAcademicYearID is academic year of the promoted groups, nil for groups without academic year.
+	FromAcademicYearID *uuid.UUID
+	ToAcademicYearID   uuid.UUID
+	HeldBack           []uuid.UUID
+	Graduating         []uuid.UUID
+}
+
+// PromoteGroups moves groups of the school to the next grade of the school grade standard.
+// Next year groups are created, so marks of the past year stay with the previous groups.
+func (s Service) PromoteGroups(ctx context.Context, args PromoteGroupsArgs) (_ domain.GroupPromotions, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on promote groups: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	school, err := s.schoolRepo.SchoolByIDTx(txCtx, args.SchoolID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get school by id: %w", err)
+	}
+
+	if school.GradeStandardID == nil {
+		return nil, domain.ErrGradeStandardNotFound
+	}
+
+	if _, err = s.schoolRepo.AcademicYearByIDTx(txCtx, args.ToAcademicYearID, args.SchoolID); err != nil {
+		return nil, fmt.Errorf("failed to get academic year by id: %w", err)
+	}
+
+	groups, err := s.groupRepo.PromotableGroupsTx(txCtx, args.SchoolID, args.FromAcademicYearID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get groups for promotion: %w", err)
+	}
+
+	if len(groups) == 0 {
+		return nil, domain.ErrNoGroupsToPromote
+	}
+
+	gradeStandard, err := s.gradeService.GradeStandardByID(txCtx, *school.GradeStandardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grade standard by id: %w", err)
+	}
+
+	students, err := s.groupRepo.GroupStudentsTx(txCtx, groups.IDs())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group students: %w", err)
+	}
+
+	promotions, err := domain.NewGroupPromotions(
+		groups, gradeStandard.Grades, students, args.ToAcademicYearID, args.HeldBack, args.Graduating, s.now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to promote groups: %w", err)
+	}
+
+	if err = s.groupRepo.PromoteGroupsTx(txCtx, promotions); err != nil {
+		return nil, fmt.Errorf("failed to store group promotions: %w", err)
+	}
+
+	return promotions, nil
+}
@@ -3,10 +3,13 @@ package school
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
 	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+	"bum-service/pkg/utils"
 )
 
 // AuditoriumList get school subject list.
@@ -39,6 +42,16 @@ func (s Service) AuditoriumByIDAndSchoolID(ctx context.Context, id, schoolID uui
 	return auditorium, nil
 }
 
+// SchoolAuditoriums get all auditoriums of the school.
+func (s Service) SchoolAuditoriums(ctx context.Context, schoolID uuid.UUID) (domain.Auditoriums, error) {
+	auditoriums, err := s.schoolRepo.SchoolAuditoriumsTx(ctx, schoolID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get school auditoriums from database: %w", err)
+	}
+
+	return auditoriums, nil
+}
+
 // CreateAuditoriumArgs is a request for creating a new auditorium.
 type CreateAuditoriumArgs struct {
 	Name            string
@@ -66,3 +79,128 @@ func (s Service) CreateAuditorium(
 
 	return auditorium, nil
 }
+
+// UpdateAuditoriumArgs is arguments for updating auditorium, absent fields are left unchanged.
+type UpdateAuditoriumArgs struct {
+	ID              uuid.UUID
+	SchoolID        uuid.UUID
+	Name            *string
+	SchoolSubjectID utils.Patch[uuid.UUID]
+	Description     utils.Patch[string]
+	// UpdatedAt is the version of the auditorium the update is based on.
+	UpdatedAt time.Time
+}
+
+// UpdateAuditorium updates auditorium of the school.
+func (s Service) UpdateAuditorium(ctx context.Context, args UpdateAuditoriumArgs) (_ domain.Auditorium, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.Auditorium{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on update auditorium: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	auditorium, err := s.schoolRepo.AuditoriumByIDAndSchoolIDTx(txCtx, args.ID, args.SchoolID)
+	if err != nil {
+		return domain.Auditorium{}, fmt.Errorf("failed to get auditorium by id: %w", err)
+	}
+
+	if err = domain.CheckVersion(auditorium.UpdatedAt, args.UpdatedAt); err != nil {
+		return domain.Auditorium{}, err
+	}
+
+	auditorium.Update(
+		utils.ValueOr(args.Name, auditorium.Name),
+		args.SchoolSubjectID.Apply(auditorium.SchoolSubjectID),
+		args.Description.Apply(auditorium.Description),
+		s.now,
+	)
+
+	if err = s.schoolRepo.UpdateAuditoriumTx(txCtx, auditorium, args.UpdatedAt); err != nil {
+		return domain.Auditorium{}, fmt.Errorf("failed to update auditorium: %w", err)
+	}
+
+	auditorium, err = s.schoolRepo.AuditoriumByIDAndSchoolIDTx(txCtx, auditorium.ID, auditorium.SchoolID)
+	if err != nil {
+		return domain.Auditorium{}, fmt.Errorf("failed to get auditorium by id: %w", err)
+	}
+
+	return auditorium, nil
+}
+
+// DeleteAuditorium deletes auditorium of the school, its upcoming lessons must be deleted
+// or moved to other auditoriums before.
+func (s Service) DeleteAuditorium(ctx context.Context, schoolID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on delete auditorium: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	if _, err = s.schoolRepo.AuditoriumByIDAndSchoolIDTx(txCtx, id, schoolID); err != nil {
+		return fmt.Errorf("failed to get auditorium by id: %w", err)
+	}
+
+	now := s.now()
+
+	dependents, err := s.schoolRepo.AuditoriumDependentsTx(txCtx, id, now)
+	if err != nil {
+		return fmt.Errorf("failed to get auditorium dependents: %w", err)
+	}
+
+	if err = dependents.CheckDelete(); err != nil {
+		return err
+	}
+
+	if err = s.schoolRepo.DeleteAuditoriumTx(txCtx, id, now); err != nil {
+		return fmt.Errorf("failed to delete auditorium: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreAuditorium restores deleted auditorium of the school.
+func (s Service) RestoreAuditorium(ctx context.Context, schoolID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on restore auditorium: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	if _, err = s.schoolRepo.SchoolShortByIDTx(txCtx, schoolID); err != nil {
+		return fmt.Errorf("failed to get school by id: %w", err)
+	}
+
+	if err = s.schoolRepo.RestoreAuditoriumTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to restore auditorium: %w", err)
+	}
+
+	if _, err = s.schoolRepo.AuditoriumByIDAndSchoolIDTx(txCtx, id, schoolID); err != nil {
+		return fmt.Errorf("failed to get auditorium by id: %w", err)
+	}
+
+	return nil
+}
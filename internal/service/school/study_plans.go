@@ -16,6 +16,7 @@ type AddStudyPlanArgs struct {
 	Title       string
 	Description *string
 	PlanOrder   int16
+	TermID      *uuid.UUID
 }
 
 // AssignStudyPlans assigns school group study plan.
@@ -33,6 +34,7 @@ func (s Service) AssignStudyPlans(
 			arg.Title,
 			arg.Description,
 			arg.PlanOrder,
+			arg.TermID,
 			s.now,
 		))
 	}
@@ -3,6 +3,7 @@ package school
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -11,13 +12,17 @@ import (
 
 // UpdateGroupArgs is a list of arguments to update group.
 type UpdateGroupArgs struct {
-	ID      uuid.UUID
-	Name    string
-	GradeID uuid.UUID
+	ID       uuid.UUID
+	SchoolID uuid.UUID
+	Name     string
+	GradeID  uuid.UUID
 
 	ClassTeacherID         *uuid.UUID
 	ClassPresidentID       *uuid.UUID
 	DeputyClassPresidentID *uuid.UUID
+
+	// Version is the version of the group the update is based on, nil if the update is not conditional.
+	Version *time.Time
 }
 
 // UpdateGroup updates group.
@@ -27,16 +32,28 @@ func (s Service) UpdateGroup(ctx context.Context, args UpdateGroupArgs) (domain.
 		return domain.Group{}, fmt.Errorf("failed to get group by id from database: %w", err)
 	}
 
+	if group.SchoolID != args.SchoolID {
+		return domain.Group{}, domain.ErrGroupNotFound
+	}
+
+	if args.Version != nil {
+		if err = domain.CheckVersion(group.UpdatedAt, *args.Version); err != nil {
+			return domain.Group{}, err
+		}
+	}
+
+	version := group.UpdatedAt
+
 	group.Update(
 		args.Name, args.GradeID, args.ClassTeacherID, args.ClassPresidentID, args.DeputyClassPresidentID, s.now,
 	)
 
-	err = s.groupRepo.UpdateGroupTx(ctx, group)
+	err = s.groupRepo.UpdateGroupTx(ctx, group, version)
 	if err != nil {
 		return domain.Group{}, fmt.Errorf("failed to update group to database: %w", err)
 	}
 
-	group, err = s.GroupByID(ctx, group.ID)
+	group, err = s.GroupByID(ctx, group.SchoolID, group.ID)
 	if err != nil {
 		return domain.Group{}, fmt.Errorf("failed to get group by id: %w", err)
 	}
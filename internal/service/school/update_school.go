@@ -3,6 +3,7 @@ package school
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -17,6 +18,8 @@ type UpdateSchoolArgs struct {
 	Phone           *string
 	Email           *string
 	GradeStandardID *uuid.UUID
+	// Version is the version of the school the update is based on, nil if the update is not conditional.
+	Version *time.Time
 }
 
 // UpdateSchool updates school.
@@ -26,9 +29,17 @@ func (s Service) UpdateSchool(ctx context.Context, args UpdateSchoolArgs) (domai
 		return domain.School{}, fmt.Errorf("failed to get school by id: %w", err)
 	}
 
+	if args.Version != nil {
+		if err = domain.CheckVersion(schoolDomain.UpdatedAt, *args.Version); err != nil {
+			return domain.School{}, err
+		}
+	}
+
+	version := schoolDomain.UpdatedAt
+
 	schoolDomain.Update(args.Name, args.Location, args.Phone, args.Email, args.GradeStandardID, s.now)
 	// TODO: тут валидировать не нада как и при создании, что телефон или почта не занята гуфта?
-	err = s.schoolRepo.UpdateSchoolTx(ctx, schoolDomain)
+	err = s.schoolRepo.UpdateSchoolTx(ctx, schoolDomain, version)
 	if err != nil {
 		return domain.School{}, fmt.Errorf("failed to update school to database: %w", err)
 	}
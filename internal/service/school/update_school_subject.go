@@ -0,0 +1,38 @@
+package school
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+)
+
+// UpdateSchoolSubjectArgs is a list of arguments to update school subject.
+type UpdateSchoolSubjectArgs struct {
+	ID          uuid.UUID
+	SchoolID    uuid.UUID
+	SubjectID   uuid.UUID
+	Name        string
+	Description *string
+}
+
+// UpdateSchoolSubject updates school subject.
+func (s Service) UpdateSchoolSubject(ctx context.Context, args UpdateSchoolSubjectArgs) (domain.SchoolSubject, error) {
+	schoolSubject, err := s.SchoolSubjectByIDAndSchoolID(ctx, args.ID, args.SchoolID)
+	if err != nil {
+		return domain.SchoolSubject{}, fmt.Errorf("failed to get school subject by id: %w", err)
+	}
+
+	version := schoolSubject.UpdatedAt
+
+	schoolSubject.Update(args.SubjectID, args.Name, args.Description, s.now)
+
+	err = s.schoolRepo.UpdateSchoolSubjectTx(ctx, schoolSubject, version)
+	if err != nil {
+		return domain.SchoolSubject{}, fmt.Errorf("failed to update school subject to database: %w", err)
+	}
+
+	return schoolSubject, nil
+}
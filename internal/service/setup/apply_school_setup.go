@@ -0,0 +1,335 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/internal/service/school"
+	"bum-service/internal/service/teacher"
+	"bum-service/internal/service/user"
+	"bum-service/pkg/utils"
+)
+
+// apply creates or updates the entries of the setup and counts them,
+// the entries are applied in the order they reference each other.
+func (i *schoolSetupImport) apply(ctx context.Context) error {
+	if err := i.applyTeachers(ctx); err != nil {
+		return err
+	}
+
+	if err := i.applySubjects(ctx); err != nil {
+		return err
+	}
+
+	return i.applyGroups(ctx)
+}
+
+// applyTeachers creates the new teachers with their users and updates the contacts of the existing ones.
+func (i *schoolSetupImport) applyTeachers(ctx context.Context) error {
+	for index, setupTeacher := range i.setup.Teachers {
+		existing := i.teachers[index]
+
+		switch {
+		case existing == nil:
+			newTeacher, err := i.addTeacher(ctx, setupTeacher, i.teacherUsers[index])
+			if err != nil {
+				return fmt.Errorf("failed to add teacher in line %d: %w", setupTeacher.Line, err)
+			}
+
+			existing = &newTeacher
+			i.result.Teachers.Created++
+		case !sameValue(existing.Email, setupTeacher.Email) ||
+			setupTeacher.Phone != nil && !sameValue(existing.Phone, *setupTeacher.Phone):
+			_, err := i.teacherService.UpdateTeacher(ctx, teacher.UpdateTeacherArgs{
+				ID:        existing.ID,
+				SchoolID:  i.school.ID,
+				Phone:     utils.Patch[string]{Set: setupTeacher.Phone != nil, Value: setupTeacher.Phone},
+				Email:     utils.Patch[string]{Set: true, Value: &setupTeacher.Email},
+				UpdatedAt: existing.UpdatedAt,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update teacher in line %d: %w", setupTeacher.Line, err)
+			}
+
+			i.result.Teachers.Updated++
+		default:
+			i.result.Teachers.Skipped++
+		}
+
+		i.teacherIDs[setupTeacher.Email] = existing.ID
+	}
+
+	return nil
+}
+
+// addTeacher adds the teacher of the setup to the school, the user of the teacher is created
+// with the random password if it doesn't exist.
+func (i *schoolSetupImport) addTeacher(
+	ctx context.Context,
+	setupTeacher domain.SchoolSetupTeacher,
+	existingUser *domain.User,
+) (domain.Teacher, error) {
+	if existingUser == nil {
+		password, err := domain.NewImportedUserPassword()
+		if err != nil {
+			return domain.Teacher{}, err
+		}
+
+		newUser, err := i.userService.AddUser(ctx, user.AddUserArgs{
+			FirstName:  setupTeacher.FirstName,
+			LastName:   setupTeacher.LastName,
+			MiddleName: setupTeacher.MiddleName,
+			Gender:     string(setupTeacher.Gender),
+			Phone:      setupTeacher.Phone,
+			Email:      setupTeacher.Email,
+			Password:   password,
+		})
+		if err != nil {
+			return domain.Teacher{}, fmt.Errorf("failed to add user: %w", err)
+		}
+
+		existingUser = &newUser
+	}
+
+	return i.teacherService.AddTeacher(ctx, teacher.AddTeacherArgs{
+		UserID:   existingUser.ID,
+		SchoolID: i.school.ID,
+		Phone:    setupTeacher.Phone,
+		Email:    &setupTeacher.Email,
+	})
+}
+
+// applySubjects creates the new school subjects and updates the existing ones.
+func (i *schoolSetupImport) applySubjects(ctx context.Context) error {
+	for index, setupSubject := range i.setup.Subjects {
+		var (
+			existing  = i.subjects[index]
+			subjectID = i.catalogSubjectIDs[index]
+		)
+
+		switch {
+		case existing == nil:
+			description := setupSubject.Description
+			if description == nil {
+				description = new(string)
+			}
+
+			newSubject, err := i.schoolService.CreateSchoolSubject(ctx, school.CreateSchoolSubjectArgs{
+				SchoolID:    i.school.ID,
+				SubjectID:   subjectID,
+				Name:        setupSubject.Name,
+				Description: description,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create school subject in line %d: %w", setupSubject.Line, err)
+			}
+
+			i.schoolSubjects = append(i.schoolSubjects, newSubject)
+			i.result.Subjects.Created++
+		case existing.SubjectID != subjectID || existing.Name != setupSubject.Name ||
+			setupSubject.Description != nil && !sameValue(existing.Description, *setupSubject.Description):
+			description := existing.Description
+			if setupSubject.Description != nil {
+				description = setupSubject.Description
+			}
+
+			_, err := i.schoolService.UpdateSchoolSubject(ctx, school.UpdateSchoolSubjectArgs{
+				ID:          existing.ID,
+				SchoolID:    i.school.ID,
+				SubjectID:   subjectID,
+				Name:        setupSubject.Name,
+				Description: description,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update school subject in line %d: %w", setupSubject.Line, err)
+			}
+
+			i.result.Subjects.Updated++
+		default:
+			i.result.Subjects.Skipped++
+		}
+	}
+
+	return nil
+}
+
+// applyGroups creates the new groups, updates the existing ones and applies the subjects of the groups.
+func (i *schoolSetupImport) applyGroups(ctx context.Context) error {
+	for index, setupGroup := range i.setup.Groups {
+		var (
+			existing       = i.groups[index]
+			classTeacherID = i.teacherID(setupGroup.ClassTeacher)
+			created        = existing == nil
+		)
+
+		switch {
+		case created:
+			newGroup, err := i.addGroup(ctx, setupGroup, i.gradeIDs[index], classTeacherID)
+			if err != nil {
+				return fmt.Errorf("failed to create group in line %d: %w", setupGroup.Line, err)
+			}
+
+			existing = &newGroup
+			i.result.Groups.Created++
+		case existing.Name != setupGroup.Name ||
+			classTeacherID != nil && !sameValue(existing.ClassTeacherID, *classTeacherID):
+			if classTeacherID == nil {
+				classTeacherID = existing.ClassTeacherID
+			}
+
+			_, err := i.schoolService.UpdateGroup(ctx, school.UpdateGroupArgs{
+				ID:                     existing.ID,
+				SchoolID:               i.school.ID,
+				Name:                   setupGroup.Name,
+				GradeID:                existing.GradeID,
+				ClassTeacherID:         classTeacherID,
+				ClassPresidentID:       existing.ClassPresidentID,
+				DeputyClassPresidentID: existing.DeputyClassPresidentID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update group in line %d: %w", setupGroup.Line, err)
+			}
+
+			i.result.Groups.Updated++
+		default:
+			i.result.Groups.Skipped++
+		}
+
+		if err := i.applyGroupSubjects(ctx, existing.ID, created, setupGroup.Subjects); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addGroup creates the group of the setup in the academic year of the import and assigns its class teacher.
+func (i *schoolSetupImport) addGroup(
+	ctx context.Context,
+	setupGroup domain.SchoolSetupGroup,
+	gradeID uuid.UUID,
+	classTeacherID *uuid.UUID,
+) (domain.Group, error) {
+	newGroup, err := i.schoolService.CreateGroup(ctx, school.CreateGroupArgs{
+		SchoolID:       i.school.ID,
+		Name:           setupGroup.Name,
+		GradeID:        gradeID,
+		AcademicYearID: i.academicYearID,
+	})
+	if err != nil {
+		return domain.Group{}, err
+	}
+
+	if classTeacherID == nil {
+		return newGroup, nil
+	}
+
+	return i.schoolService.UpdateGroup(ctx, school.UpdateGroupArgs{
+		ID:             newGroup.ID,
+		SchoolID:       i.school.ID,
+		Name:           newGroup.Name,
+		GradeID:        newGroup.GradeID,
+		ClassTeacherID: classTeacherID,
+	})
+}
+
+// applyGroupSubjects adds the new subjects to the group and updates the teachers and the counts of the existing ones.
+func (i *schoolSetupImport) applyGroupSubjects(
+	ctx context.Context,
+	groupID uuid.UUID,
+	created bool,
+	setupSubjects []domain.SchoolSetupGroupSubject,
+) error {
+	if len(setupSubjects) == 0 {
+		return nil
+	}
+
+	var existingSubjects domain.GroupSubjects
+
+	if !created {
+		var err error
+
+		existingSubjects, err = i.schoolService.GroupSubjectList(ctx, i.school.ID, groupID)
+		if err != nil {
+			return fmt.Errorf("failed to get group subject list: %w", err)
+		}
+	}
+
+	for _, setupSubject := range setupSubjects {
+		var (
+			schoolSubjectID = schoolSubjectByName(i.schoolSubjects, setupSubject.Subject).ID
+			teacherID       = i.teacherID(setupSubject.Teacher)
+			existing        = groupSubjectBySchoolSubjectID(existingSubjects, schoolSubjectID)
+		)
+
+		switch {
+		case existing == nil:
+			_, err := i.schoolService.AddGroupSubject(ctx, i.school.ID, groupID, school.AddGroupSubjectArgs{
+				SchoolSubjectID: schoolSubjectID,
+				TeacherID:       teacherID,
+				Count:           setupSubject.Count,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add group subject in line %d: %w", setupSubject.Line, err)
+			}
+
+			i.result.GroupSubjects.Created++
+		case teacherID != nil && !sameValue(existing.TeacherID, *teacherID) ||
+			setupSubject.Count != nil && !sameValue(existing.Count, *setupSubject.Count):
+			if teacherID == nil {
+				teacherID = existing.TeacherID
+			}
+
+			count := setupSubject.Count
+			if count == nil {
+				count = existing.Count
+			}
+
+			_, err := i.schoolService.UpdateGroupSubject(ctx, school.UpdateGroupSubjectArgs{
+				ID:        existing.ID,
+				TeacherID: teacherID,
+				Count:     count,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update group subject in line %d: %w", setupSubject.Line, err)
+			}
+
+			i.result.GroupSubjects.Updated++
+		default:
+			i.result.GroupSubjects.Skipped++
+		}
+	}
+
+	return nil
+}
+
+// teacherID returns the teacher of the school with the email, nil if the email is nil.
+func (i *schoolSetupImport) teacherID(email *string) *uuid.UUID {
+	if email == nil {
+		return nil
+	}
+
+	id := i.teacherIDs[*email]
+
+	return &id
+}
+
+// groupSubjectBySchoolSubjectID returns the subject of the group with the school subject,
+// nil if there is no such subject.
+func groupSubjectBySchoolSubjectID(subjects domain.GroupSubjects, schoolSubjectID uuid.UUID) *domain.GroupSubject {
+	for index := range subjects {
+		if subjects[index].SchoolSubjectID == schoolSubjectID {
+			return &subjects[index]
+		}
+	}
+
+	return nil
+}
+
+// sameValue checks whether the current value is set and equals to the value.
+func sameValue[T comparable](current *T, value T) bool {
+	return current != nil && *current == value
+}
@@ -0,0 +1,433 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// ImportSchoolSetupArgs is arguments for ImportSchoolSetup method.
+type ImportSchoolSetupArgs struct {
+	SchoolID uuid.UUID
+	// AcademicYearID is the academic year the groups are searched in and created in, nil for all groups of the school.
+	AcademicYearID *uuid.UUID
+	// Setup is the setup of the school, it's validated by the import.
+	Setup domain.SchoolSetup
+	// DryRun imports the setup and rolls it back, so the counts are reported but nothing is changed.
+	DryRun bool
+}
+
+// errDryRun rolls back the transaction of the dry run.
+var errDryRun = errors.New("dry run of the school setup import")
+
+// ImportSchoolSetup creates or updates the teachers, the subjects, the groups and the subjects of the groups
+// of the school. The entries are matched to the existing ones by their natural keys, so importing the same setup
+// again changes nothing. All references of the setup are resolved before anything is changed,
+// and the setup is imported in a single transaction, so it's either imported entirely or not at all.
+func (s Service) ImportSchoolSetup(
+	ctx context.Context,
+	args ImportSchoolSetupArgs,
+) (_ domain.SchoolSetupResult, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.SchoolSetupResult{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		endErr := err
+		if endErr == nil && args.DryRun {
+			endErr = errDryRun
+		}
+
+		errEnd := s.sessionAdapter.End(tx, endErr)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on import school setup: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	school, err := s.schoolService.SchoolByID(txCtx, args.SchoolID)
+	if err != nil {
+		return domain.SchoolSetupResult{}, fmt.Errorf("failed to get school by id: %w", err)
+	}
+
+	setup := args.Setup
+	if errs := setup.Validate(); len(errs) > 0 {
+		return domain.SchoolSetupResult{}, errs.Err()
+	}
+
+	imp := newSchoolSetupImport(s, school, args.AcademicYearID, &setup)
+
+	if err = imp.resolve(txCtx); err != nil {
+		return domain.SchoolSetupResult{}, err
+	}
+
+	if len(imp.errs) > 0 {
+		return domain.SchoolSetupResult{}, imp.errs.Err()
+	}
+
+	if err = imp.apply(txCtx); err != nil {
+		return domain.SchoolSetupResult{}, err
+	}
+
+	imp.result.DryRun = args.DryRun
+
+	return imp.result, nil
+}
+
+// schoolSetupImport is the import of the school setup, it keeps the existing entries the setup is matched to.
+type schoolSetupImport struct {
+	Service
+
+	school         domain.School
+	academicYearID *uuid.UUID
+	setup          *domain.SchoolSetup
+	errs           domain.SchoolSetupErrors
+
+	usersByEmail map[string]*domain.User
+	usersByPhone map[string]*domain.User
+
+	// teacherUsers are the existing users of the teachers of the setup, nil for the new users.
+	teacherUsers []*domain.User
+	// teachers are the existing teachers of the setup, nil for the new teachers.
+	teachers []*domain.Teacher
+	// teacherIDs are the teachers of the school by the emails of their users.
+	teacherIDs map[string]uuid.UUID
+
+	// catalogSubjectIDs are the subjects of the catalog of the school subjects of the setup.
+	catalogSubjectIDs []uuid.UUID
+	// subjects are the existing school subjects of the setup, nil for the new school subjects.
+	subjects []*domain.SchoolSubject
+	// schoolSubjects are all subjects of the school.
+	schoolSubjects domain.SchoolSubjects
+
+	// gradeIDs are the grades of the groups of the setup.
+	gradeIDs []uuid.UUID
+	// groups are the existing groups of the setup, nil for the new groups.
+	groups []*domain.Group
+
+	result domain.SchoolSetupResult
+}
+
+// newSchoolSetupImport creates a new schoolSetupImport.
+func newSchoolSetupImport(
+	s Service,
+	school domain.School,
+	academicYearID *uuid.UUID,
+	setup *domain.SchoolSetup,
+) *schoolSetupImport {
+	return &schoolSetupImport{
+		Service:        s,
+		school:         school,
+		academicYearID: academicYearID,
+		setup:          setup,
+
+		usersByEmail: make(map[string]*domain.User),
+		usersByPhone: make(map[string]*domain.User),
+
+		teacherUsers: make([]*domain.User, len(setup.Teachers)),
+		teachers:     make([]*domain.Teacher, len(setup.Teachers)),
+		teacherIDs:   make(map[string]uuid.UUID),
+
+		catalogSubjectIDs: make([]uuid.UUID, len(setup.Subjects)),
+		subjects:          make([]*domain.SchoolSubject, len(setup.Subjects)),
+
+		gradeIDs: make([]uuid.UUID, len(setup.Groups)),
+		groups:   make([]*domain.Group, len(setup.Groups)),
+	}
+}
+
+// resolve matches the entries of the setup to the existing ones and checks the references of the setup,
+// the errors of the entries are added to the errors of the import.
+func (i *schoolSetupImport) resolve(ctx context.Context) error {
+	if err := i.resolveTeachers(ctx); err != nil {
+		return err
+	}
+
+	if err := i.resolveSubjects(ctx); err != nil {
+		return err
+	}
+
+	return i.resolveGroups(ctx)
+}
+
+// resolveTeachers matches the teachers of the setup to the existing users and teachers by their emails.
+func (i *schoolSetupImport) resolveTeachers(ctx context.Context) error {
+	for index, setupTeacher := range i.setup.Teachers {
+		existing, err := i.userByEmail(ctx, setupTeacher.Email)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil {
+			i.teacherUsers[index] = existing
+
+			if i.teachers[index], err = i.teacherByUserID(ctx, existing.ID); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if setupTeacher.Phone == nil {
+			continue
+		}
+
+		existing, err = i.userByPhone(ctx, *setupTeacher.Phone)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil {
+			i.errs.Add(domain.SchoolSetupTeachers, setupTeacher.Line, "phone", "user with the phone already exists")
+		}
+	}
+
+	return nil
+}
+
+// resolveSubjects matches the school subjects of the setup to the existing ones by their names
+// and finds the subjects of the catalog they are based on.
+func (i *schoolSetupImport) resolveSubjects(ctx context.Context) error {
+	var err error
+
+	i.schoolSubjects, err = i.schoolService.SchoolSubjectsBySchoolID(ctx, i.school.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get school subjects: %w", err)
+	}
+
+	catalog := make(map[string]*uuid.UUID)
+
+	for index, setupSubject := range i.setup.Subjects {
+		i.subjects[index] = schoolSubjectByName(i.schoolSubjects, setupSubject.Name)
+
+		key := strings.ToLower(setupSubject.Subject)
+
+		if _, ok := catalog[key]; !ok {
+			subject, err := i.subjectService.SubjectByName(ctx, setupSubject.Subject)
+
+			switch {
+			case errors.Is(err, domain.ErrNotFound):
+				catalog[key] = nil
+			case err != nil:
+				return fmt.Errorf("failed to get subject by name: %w", err)
+			default:
+				catalog[key] = &subject.ID
+			}
+		}
+
+		if catalog[key] == nil {
+			i.errs.Add(domain.SchoolSetupSubjects, setupSubject.Line, "subject", "subject not found in the catalog")
+			continue
+		}
+
+		i.catalogSubjectIDs[index] = *catalog[key]
+	}
+
+	return nil
+}
+
+// resolveGroups matches the groups of the setup to the existing groups by their names and grades
+// and checks the teachers and the subjects of the groups.
+func (i *schoolSetupImport) resolveGroups(ctx context.Context) error {
+	if len(i.setup.Groups) == 0 {
+		return nil
+	}
+
+	var grades domain.Grades
+
+	if i.school.GradeStandardID != nil {
+		gradeStandard, err := i.gradeService.GradeStandardByID(ctx, *i.school.GradeStandardID)
+		if err != nil {
+			return fmt.Errorf("failed to get grade standard by id: %w", err)
+		}
+
+		grades = gradeStandard.Grades
+	}
+
+	groups, _, err := i.schoolService.GroupList(
+		ctx, i.school.ID, domain.NewGroupFilters(domain.ListFilter{}, i.academicYearID),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get group list: %w", err)
+	}
+
+	for index := range i.setup.Groups {
+		setupGroup := i.setup.Groups[index]
+
+		i.resolveGroup(index, grades, groups)
+
+		if setupGroup.ClassTeacher != nil {
+			if err = i.resolveTeacher(
+				ctx, *setupGroup.ClassTeacher, domain.SchoolSetupGroups, setupGroup.Line, "class_teacher",
+			); err != nil {
+				return err
+			}
+		}
+
+		for _, setupSubject := range setupGroup.Subjects {
+			if !i.hasSubject(setupSubject.Subject) {
+				i.errs.Add(domain.SchoolSetupGroupSubjects, setupSubject.Line, "subject", "subject not found")
+			}
+
+			if setupSubject.Teacher != nil {
+				if err = i.resolveTeacher(
+					ctx, *setupSubject.Teacher, domain.SchoolSetupGroupSubjects, setupSubject.Line, "teacher",
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveGroup finds the grade of the group of the setup and the existing group with its name and grade.
+func (i *schoolSetupImport) resolveGroup(index int, grades domain.Grades, groups domain.Groups) {
+	setupGroup := i.setup.Groups[index]
+
+	gradeIndex := slices.IndexFunc(grades, func(grade domain.Grade) bool {
+		return domain.SameName(grade.Name, setupGroup.Grade)
+	})
+	if gradeIndex < 0 {
+		i.errs.Add(domain.SchoolSetupGroups, setupGroup.Line, "grade", "grade not found in the grade standard of the school")
+		return
+	}
+
+	i.gradeIDs[index] = grades[gradeIndex].ID
+
+	var found domain.Groups
+
+	for _, group := range groups.ByName(setupGroup.Name) {
+		if group.GradeID == i.gradeIDs[index] {
+			found = append(found, group)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+	case 1:
+		i.groups[index] = &found[0]
+	default:
+		i.errs.Add(domain.SchoolSetupGroups, setupGroup.Line, "name", "group name is ambiguous, choose the academic year")
+	}
+}
+
+// resolveTeacher checks that the teacher with the email is in the setup or is the existing teacher of the school.
+func (i *schoolSetupImport) resolveTeacher(ctx context.Context, email, section string, line int, field string) error {
+	if _, ok := i.teacherIDs[email]; ok {
+		return nil
+	}
+
+	for _, setupTeacher := range i.setup.Teachers {
+		if setupTeacher.Email == email {
+			return nil
+		}
+	}
+
+	existing, err := i.userByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		teacher, err := i.teacherByUserID(ctx, existing.ID)
+		if err != nil {
+			return err
+		}
+
+		if teacher != nil {
+			i.teacherIDs[email] = teacher.ID
+			return nil
+		}
+	}
+
+	i.errs.Add(section, line, field, "teacher not found")
+
+	return nil
+}
+
+// hasSubject checks whether the school subject with the name is in the setup or is the existing one.
+func (i *schoolSetupImport) hasSubject(name string) bool {
+	for _, setupSubject := range i.setup.Subjects {
+		if domain.SameName(setupSubject.Name, name) {
+			return true
+		}
+	}
+
+	return schoolSubjectByName(i.schoolSubjects, name) != nil
+}
+
+// teacherByUserID returns the teacher of the school of the user, nil if the user is not the teacher of the school.
+func (i *schoolSetupImport) teacherByUserID(ctx context.Context, userID uuid.UUID) (*domain.Teacher, error) {
+	teacher, err := i.teacherService.TeacherByUserID(ctx, i.school.ID, userID)
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return nil, nil //nolint:nilnil // the user is not the teacher of the school
+	case err != nil:
+		return nil, fmt.Errorf("failed to get teacher by user id: %w", err)
+	}
+
+	return &teacher, nil
+}
+
+// userByEmail returns the user with the email, nil if there is no such user.
+func (i *schoolSetupImport) userByEmail(ctx context.Context, email string) (*domain.User, error) {
+	if existing, ok := i.usersByEmail[email]; ok {
+		return existing, nil
+	}
+
+	existing, err := i.userService.UserByEmail(ctx, email)
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		i.usersByEmail[email] = nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	default:
+		i.usersByEmail[email] = &existing
+	}
+
+	return i.usersByEmail[email], nil
+}
+
+// userByPhone returns the user with the phone, nil if there is no such user.
+func (i *schoolSetupImport) userByPhone(ctx context.Context, phone string) (*domain.User, error) {
+	if existing, ok := i.usersByPhone[phone]; ok {
+		return existing, nil
+	}
+
+	existing, err := i.userService.UserByPhone(ctx, phone)
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		i.usersByPhone[phone] = nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get user by phone: %w", err)
+	default:
+		i.usersByPhone[phone] = &existing
+	}
+
+	return i.usersByPhone[phone], nil
+}
+
+// schoolSubjectByName returns the school subject with the name, nil if there is no such subject.
+func schoolSubjectByName(subjects domain.SchoolSubjects, name string) *domain.SchoolSubject {
+	for index := range subjects {
+		if domain.SameName(subjects[index].Name, name) {
+			return &subjects[index]
+		}
+	}
+
+	return nil
+}
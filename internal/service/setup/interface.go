@@ -0,0 +1,57 @@
+package setup
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/internal/service/school"
+	"bum-service/internal/service/teacher"
+	"bum-service/internal/service/user"
+)
+
+// IUserService represents a user service for adding users.
+type IUserService interface {
+	AddUser(ctx context.Context, args user.AddUserArgs) (newUser domain.User, err error)
+	UserByEmail(ctx context.Context, email string) (domain.User, error)
+	UserByPhone(ctx context.Context, phone string) (domain.User, error)
+}
+
+// ITeacherService represents a teacher service.
+type ITeacherService interface {
+	AddTeacher(ctx context.Context, args teacher.AddTeacherArgs) (newTeacher domain.Teacher, err error)
+	UpdateTeacher(ctx context.Context, args teacher.UpdateTeacherArgs) (domain.Teacher, error)
+	TeacherByUserID(ctx context.Context, schoolID, userID uuid.UUID) (domain.Teacher, error)
+}
+
+// ISubjectService represents a subject service.
+type ISubjectService interface {
+	SubjectByName(ctx context.Context, name string) (domain.Subject, error)
+}
+
+// IGradeService represents a grade service.
+type IGradeService interface {
+	GradeStandardByID(ctx context.Context, id uuid.UUID) (domain.GradeStandard, error)
+}
+
+// ISchoolService represents a school service for school subjects, groups and their subjects.
+//
+//nolint:interfacebloat // it's ok
+type ISchoolService interface {
+	SchoolByID(ctx context.Context, schoolID uuid.UUID) (domain.School, error)
+
+	SchoolSubjectsBySchoolID(ctx context.Context, schoolID uuid.UUID) (domain.SchoolSubjects, error)
+	CreateSchoolSubject(ctx context.Context, args school.CreateSchoolSubjectArgs) (domain.SchoolSubject, error)
+	UpdateSchoolSubject(ctx context.Context, args school.UpdateSchoolSubjectArgs) (domain.SchoolSubject, error)
+
+	GroupList(ctx context.Context, schoolID uuid.UUID, filters domain.GroupFilters) (domain.Groups, int, error)
+	CreateGroup(ctx context.Context, arg school.CreateGroupArgs) (domain.Group, error)
+	UpdateGroup(ctx context.Context, args school.UpdateGroupArgs) (domain.Group, error)
+
+	GroupSubjectList(ctx context.Context, schoolID, groupID uuid.UUID) (domain.GroupSubjects, error)
+	AddGroupSubject(
+		ctx context.Context, schoolID, groupID uuid.UUID, args school.AddGroupSubjectArgs,
+	) (domain.GroupSubject, error)
+	UpdateGroupSubject(ctx context.Context, args school.UpdateGroupSubjectArgs) (domain.GroupSubject, error)
+}
@@ -0,0 +1,46 @@
+package setup
+
+import (
+	"time"
+
+	"bum-service/pkg/liblog"
+	"bum-service/pkg/transaction"
+)
+
+// Service is school setup service.
+type Service struct {
+	userService    IUserService
+	teacherService ITeacherService
+	subjectService ISubjectService
+	gradeService   IGradeService
+	schoolService  ISchoolService
+
+	sessionAdapter transaction.Session
+	logger         liblog.Logger
+	now            func() time.Time
+}
+
+// NewService creates a new school setup service.
+func NewService(
+	userService IUserService,
+	teacherService ITeacherService,
+	subjectService ISubjectService,
+	gradeService IGradeService,
+	schoolService ISchoolService,
+
+	sessionAdapter transaction.Session,
+	logger liblog.Logger,
+	nowFunc func() time.Time,
+) *Service {
+	return &Service{
+		userService:    userService,
+		teacherService: teacherService,
+		subjectService: subjectService,
+		gradeService:   gradeService,
+		schoolService:  schoolService,
+
+		sessionAdapter: sessionAdapter,
+		logger:         logger,
+		now:            nowFunc,
+	}
+}
@@ -55,6 +55,13 @@ func (s Service) AddStudent(ctx context.Context, args AddStudentArgs) (newStuden
 		return domain.Student{}, fmt.Errorf("failed add student to database : %w", err)
 	}
 
+	membership := domain.NewStudentMembership(newStudent.ID, args.GroupID, args.SchoolID, newStudent.CreatedAt, s.now)
+
+	err = s.studentRepo.AddStudentMembershipTx(txCtx, membership)
+	if err != nil {
+		return domain.Student{}, fmt.Errorf("failed add student group membership to database : %w", err)
+	}
+
 	newStudent, err = s.StudentByID(txCtx, newStudent.ID)
 	if err != nil {
 		return domain.Student{}, fmt.Errorf("failed get student by id : %w", err)
@@ -0,0 +1,153 @@
+package student
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// DeleteStudent deletes student of the school together with the student role and guardians.
+func (s Service) DeleteStudent(ctx context.Context, schoolID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on delete student: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	student, err := s.studentRepo.StudentByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get student by id: %w", err)
+	}
+
+	if student.SchoolID != schoolID {
+		return domain.ErrStudentNotFound
+	}
+
+	if err = s.studentRepo.DeleteStudentTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to delete student: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreStudent restores deleted student of the school together with the student role and guardians
+// deleted with the student, the student group must not be deleted.
+func (s Service) RestoreStudent(ctx context.Context, schoolID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on restore student: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	if err = s.studentRepo.RestoreStudentTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to restore student: %w", err)
+	}
+
+	student, err := s.studentRepo.StudentByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get student by id: %w", err)
+	}
+
+	if student.SchoolID != schoolID {
+		return domain.ErrStudentNotFound
+	}
+
+	if _, err = s.groupService.GroupByID(txCtx, schoolID, student.GroupID); err != nil {
+		return fmt.Errorf("failed to get group by id: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStudentGuardian deletes guardian of the student.
+func (s Service) DeleteStudentGuardian(ctx context.Context, schoolID, studentID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on delete student guardian: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	studentGuardian, err := s.studentRepo.StudentGuardianByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get student guardian by id: %w", err)
+	}
+
+	if studentGuardian.StudentID != studentID || studentGuardian.SchoolID != schoolID {
+		return domain.ErrStudentGuardianNotFound
+	}
+
+	if err = s.studentRepo.DeleteStudentGuardianTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to delete student guardian: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreStudentGuardian restores deleted guardian of the student, the student must not be deleted.
+func (s Service) RestoreStudentGuardian(ctx context.Context, schoolID, studentID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on restore student guardian: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	student, err := s.studentRepo.StudentByIDTx(txCtx, studentID)
+	if err != nil {
+		return fmt.Errorf("failed to get student by id: %w", err)
+	}
+
+	if student.SchoolID != schoolID {
+		return domain.ErrStudentNotFound
+	}
+
+	if err = s.studentRepo.RestoreStudentGuardianTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to restore student guardian: %w", err)
+	}
+
+	studentGuardian, err := s.studentRepo.StudentGuardianByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get student guardian by id: %w", err)
+	}
+
+	if studentGuardian.StudentID != studentID {
+		return domain.ErrStudentGuardianNotFound
+	}
+
+	return nil
+}
@@ -27,7 +27,7 @@ func (s Service) StudentByID(ctx context.Context, studentID uuid.UUID) (domain.S
 		return domain.Student{}, fmt.Errorf("failed get user school short info from service: %w", err)
 	}
 
-	group, err := s.groupService.GroupByID(ctx, studentDomain.GroupID)
+	group, err := s.groupService.GroupByID(ctx, studentDomain.SchoolID, studentDomain.GroupID)
 	if err != nil {
 		return domain.Student{}, fmt.Errorf("failed get group info by id from group service: %w", err)
 	}
@@ -39,6 +39,20 @@ func (s Service) StudentByID(ctx context.Context, studentID uuid.UUID) (domain.S
 	return studentDomain, nil
 }
 
+// StudentByIDAndSchoolID gets student of the school by id.
+func (s Service) StudentByIDAndSchoolID(ctx context.Context, studentID, schoolID uuid.UUID) (domain.Student, error) {
+	studentDomain, err := s.StudentByID(ctx, studentID)
+	if err != nil {
+		return domain.Student{}, err
+	}
+
+	if studentDomain.SchoolID != schoolID {
+		return domain.Student{}, domain.ErrStudentNotFound
+	}
+
+	return studentDomain, nil
+}
+
 // StudentShortInfoByID gets student short info by id.
 func (s Service) StudentShortInfoByID(ctx context.Context, studentID uuid.UUID) (domain.Student, error) {
 	studentDomain, err := s.studentRepo.StudentByIDTx(ctx, studentID)
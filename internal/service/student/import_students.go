@@ -0,0 +1,304 @@
+package student
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/internal/service/user"
+	"bum-service/pkg/transaction"
+)
+
+// ImportStudentsArgs is arguments for ImportStudents method.
+type ImportStudentsArgs struct {
+	SchoolID uuid.UUID
+	// AcademicYearID is the academic year the groups of the roster are searched in, nil for all groups of the school.
+	AcademicYearID *uuid.UUID
+	// Records are the rows of the roster, the first one is the header.
+	Records [][]string
+	// DryRun only validates the roster, nothing is created.
+	DryRun bool
+}
+
+// ImportStudents imports the students of the roster with their users and guardians.
+// Every row is validated before anything is created, and all rows are created in a single transaction,
+// so the roster is either imported entirely or not at all.
+func (s Service) ImportStudents(ctx context.Context, args ImportStudentsArgs) (_ domain.StudentImport, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.StudentImport{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on import students: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	_, err = s.schoolService.SchoolShortByID(txCtx, args.SchoolID)
+	if err != nil {
+		return domain.StudentImport{}, fmt.Errorf("failed to get school by id: %w", err)
+	}
+
+	rows, errs := domain.ParseStudentRoster(args.Records)
+	if len(errs) > 0 {
+		return domain.StudentImport{}, errs.Err()
+	}
+
+	groups, _, err := s.groupService.GroupList(
+		txCtx, args.SchoolID, domain.NewGroupFilters(domain.ListFilter{}, args.AcademicYearID),
+	)
+	if err != nil {
+		return domain.StudentImport{}, fmt.Errorf("failed to get group list: %w", err)
+	}
+
+	users := newImportUsers(s.userService)
+
+	for index := range rows {
+		row := &rows[index]
+
+		switch found := groups.ByName(row.GroupName); len(found) {
+		case 0:
+			errs.Add(row.Line, domain.RosterColumnGroup, "group not found")
+		case 1:
+			row.GroupID = found[0].ID
+		default:
+			errs.Add(row.Line, domain.RosterColumnGroup, "group name is ambiguous, choose the academic year")
+		}
+
+		if err = users.checkStudent(txCtx, row.Line, row.Student, &errs); err != nil {
+			return domain.StudentImport{}, err
+		}
+
+		for i := range row.Guardians {
+			row.Guardians[i].UserID, err = users.guardian(txCtx, row.Line, row.Guardians[i], &errs)
+			if err != nil {
+				return domain.StudentImport{}, err
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return domain.StudentImport{}, errs.Err()
+	}
+
+	result := domain.StudentImport{
+		DryRun: args.DryRun,
+		Rows:   rows,
+	}
+
+	if args.DryRun {
+		return result, nil
+	}
+
+	result.Students, err = s.importRows(txCtx, args.SchoolID, rows)
+	if err != nil {
+		return domain.StudentImport{}, err
+	}
+
+	return result, nil
+}
+
+// importRows creates the students of the rows, the users of the guardians are created once for all their students.
+func (s Service) importRows(
+	ctx context.Context,
+	schoolID uuid.UUID,
+	rows domain.StudentImportRows,
+) (domain.Students, error) {
+	var (
+		students       = make(domain.Students, 0, len(rows))
+		guardiansByKey = make(map[string]uuid.UUID)
+	)
+
+	for _, row := range rows {
+		studentUser, err := s.addImportedUser(ctx, row.Student)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add user of the student in line %d: %w", row.Line, err)
+		}
+
+		student, err := s.AddStudent(ctx, AddStudentArgs{
+			UserID:   studentUser.ID,
+			SchoolID: schoolID,
+			GroupID:  row.GroupID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add student in line %d: %w", row.Line, err)
+		}
+
+		for _, guardian := range row.Guardians {
+			userID, ok := guardiansByKey[guardian.Email]
+
+			switch {
+			case guardian.UserID != nil:
+				userID = *guardian.UserID
+			case !ok:
+				guardianUser, err := s.addImportedUser(ctx, guardian.StudentImportUser)
+				if err != nil {
+					return nil, fmt.Errorf("failed to add user of the guardian in line %d: %w", row.Line, err)
+				}
+
+				userID = guardianUser.ID
+				guardiansByKey[guardian.Email] = userID
+			}
+
+			_, err = s.AssignStudentGuardian(ctx, AssignStudentGuardianArgs{
+				StudentID: student.ID,
+				UserID:    userID,
+				Relation:  string(guardian.Relation),
+				SchoolID:  schoolID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to assign guardian in line %d: %w", row.Line, err)
+			}
+		}
+
+		students = append(students, student)
+	}
+
+	return students, nil
+}
+
+// addImportedUser adds the user of the roster with the random password.
+func (s Service) addImportedUser(ctx context.Context, importUser domain.StudentImportUser) (domain.User, error) {
+	password, err := domain.NewImportedUserPassword()
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	return s.userService.AddUser(ctx, user.AddUserArgs{
+		FirstName:  importUser.FirstName,
+		LastName:   importUser.LastName,
+		MiddleName: importUser.MiddleName,
+		Gender:     string(importUser.Gender),
+		Phone:      importUser.Phone,
+		Email:      importUser.Email,
+		Password:   password,
+	})
+}
+
+// importUsers looks up the existing users of the roster by their emails and phones.
+type importUsers struct {
+	userService IUserService
+
+	byEmail map[string]*domain.User
+	byPhone map[string]*domain.User
+}
+
+// newImportUsers creates a new importUsers.
+func newImportUsers(userService IUserService) importUsers {
+	return importUsers{
+		userService: userService,
+		byEmail:     make(map[string]*domain.User),
+		byPhone:     make(map[string]*domain.User),
+	}
+}
+
+// checkStudent checks that the email and the phone of the student are not used by the existing users.
+func (u importUsers) checkStudent(
+	ctx context.Context,
+	line int,
+	student domain.StudentImportUser,
+	errs *domain.StudentImportErrors,
+) error {
+	existing, err := u.userByEmail(ctx, student.Email)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		errs.Add(line, domain.RosterColumnEmail, "user with the email already exists")
+	}
+
+	if student.Phone == nil {
+		return nil
+	}
+
+	existing, err = u.userByPhone(ctx, *student.Phone)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		errs.Add(line, domain.RosterColumnPhone, "user with the phone already exists")
+	}
+
+	return nil
+}
+
+// guardian returns the existing user of the guardian found by the phone and the email,
+// nil if the user is created by the import. The user found by one of them must have the other one too.
+func (u importUsers) guardian(
+	ctx context.Context,
+	line int,
+	guardian domain.StudentImportGuardian,
+	errs *domain.StudentImportErrors,
+) (*uuid.UUID, error) {
+	byPhone, err := u.userByPhone(ctx, *guardian.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	byEmail, err := u.userByEmail(ctx, guardian.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case byPhone == nil && byEmail == nil:
+		return nil, nil //nolint:nilnil // the user of the guardian does not exist yet
+	case byPhone == nil:
+		errs.Add(line, guardian.Column+domain.RosterColumnPhone, "user with the email has another phone")
+	case byEmail == nil || byEmail.ID != byPhone.ID:
+		errs.Add(line, guardian.Column+domain.RosterColumnEmail, "user with the phone has another email")
+	default:
+		return &byPhone.ID, nil
+	}
+
+	return nil, nil //nolint:nilnil // the error of the row is added
+}
+
+// userByEmail returns the user with the email, nil if there is no such user.
+func (u importUsers) userByEmail(ctx context.Context, email string) (*domain.User, error) {
+	if existing, ok := u.byEmail[email]; ok {
+		return existing, nil
+	}
+
+	existing, err := u.userService.UserByEmail(ctx, email)
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		u.byEmail[email] = nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	default:
+		u.byEmail[email] = &existing
+	}
+
+	return u.byEmail[email], nil
+}
+
+// userByPhone returns the user with the phone, nil if there is no such user.
+func (u importUsers) userByPhone(ctx context.Context, phone string) (*domain.User, error) {
+	if existing, ok := u.byPhone[phone]; ok {
+		return existing, nil
+	}
+
+	existing, err := u.userService.UserByPhone(ctx, phone)
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		u.byPhone[phone] = nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get user by phone: %w", err)
+	default:
+		u.byPhone[phone] = &existing
+	}
+
+	return u.byPhone[phone], nil
+}
@@ -2,10 +2,12 @@ package student
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
 	"bum-service/internal/domain"
+	"bum-service/internal/service/user"
 )
 
 // IStudentRepo represents student repo.
@@ -15,6 +17,13 @@ type IStudentRepo interface {
 	StudentsByIDsTx(ctx context.Context, ids []uuid.UUID) (domain.Students, error)
 	StudentListTx(ctx context.Context, filters domain.StudentListFilter) (domain.Students, error)
 	StudentCountTx(ctx context.Context, filters domain.StudentListFilter) (int, error)
+	UpdateStudentTx(ctx context.Context, o domain.Student, version time.Time) error
+	DeleteStudentTx(ctx context.Context, id uuid.UUID, now time.Time) error
+	RestoreStudentTx(ctx context.Context, id uuid.UUID, now time.Time) error
+
+	AddStudentMembershipTx(ctx context.Context, membership domain.StudentMembership) error
+	StudentMembershipsTx(ctx context.Context, studentID uuid.UUID) (domain.StudentMemberships, error)
+	TransferStudentTx(ctx context.Context, closed, opened domain.StudentMembership) error
 
 	AddStudentGuardianTx(ctx context.Context, studentGuardian domain.StudentGuardian) error
 	StudentGuardianByIDTx(ctx context.Context, id uuid.UUID) (domain.StudentGuardian, error)
@@ -22,6 +31,8 @@ type IStudentRepo interface {
 	StudentGuardianByUserIDTx(ctx context.Context, id uuid.UUID) (domain.StudentGuardians, error)
 	StudentGuardianListTx(ctx context.Context, filters domain.StudentGuardianListFilter) (domain.StudentGuardians, error)
 	StudentGuardianListCountTx(ctx context.Context, filters domain.StudentGuardianListFilter) (int, error)
+	DeleteStudentGuardianTx(ctx context.Context, id uuid.UUID, now time.Time) error
+	RestoreStudentGuardianTx(ctx context.Context, id uuid.UUID, now time.Time) error
 }
 
 // IUserInfoService represents a user info service for headmaster use cases.
@@ -38,12 +49,16 @@ type ISchoolService interface {
 
 // IGroupService represents group service.
 type IGroupService interface {
-	GroupByID(ctx context.Context, groupID uuid.UUID) (domain.Group, error)
+	GroupByID(ctx context.Context, schoolID, groupID uuid.UUID) (domain.Group, error)
 	GroupsByIDs(ctx context.Context, ids []uuid.UUID) (domain.Groups, error)
+	GroupList(ctx context.Context, schoolID uuid.UUID, filters domain.GroupFilters) (domain.Groups, int, error)
 }
 
-// IUserService represents a user service for adding roles.
+// IUserService represents a user service for adding users and roles.
 type IUserService interface {
+	AddUser(ctx context.Context, args user.AddUserArgs) (newUser domain.User, err error)
+	UserByEmail(ctx context.Context, email string) (domain.User, error)
+	UserByPhone(ctx context.Context, phone string) (domain.User, error)
 	AddRoleToUser(
 		ctx context.Context,
 		userID uuid.UUID,
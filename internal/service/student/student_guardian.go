@@ -64,12 +64,12 @@ func (s Service) AssignStudentGuardian(
 		return domain.StudentGuardian{}, fmt.Errorf("failed to get student guardian by id: %w", err)
 	}
 
-	user, err := s.userInfoService.UserByID(ctx, studentGuardian.UserID)
+	user, err := s.userInfoService.UserByID(txCtx, studentGuardian.UserID)
 	if err != nil {
 		return domain.StudentGuardian{}, fmt.Errorf("failed to get user info by id: %w", err)
 	}
 
-	student, err := s.studentRepo.StudentByIDTx(ctx, studentGuardian.StudentID)
+	student, err := s.studentRepo.StudentByIDTx(txCtx, studentGuardian.StudentID)
 	if err != nil {
 		return domain.StudentGuardian{}, fmt.Errorf("failed to get student info by id: %w", err)
 	}
@@ -80,8 +80,19 @@ func (s Service) AssignStudentGuardian(
 	return studentGuardian, nil
 }
 
-// StudentGuardians returns a list of guardians for a student.
-func (s Service) StudentGuardians(ctx context.Context, studentID uuid.UUID) (domain.StudentGuardians, error) {
+// StudentGuardians returns a list of guardians for a student of the school.
+func (s Service) StudentGuardians(
+	ctx context.Context, studentID, schoolID uuid.UUID,
+) (domain.StudentGuardians, error) {
+	student, err := s.studentRepo.StudentByIDTx(ctx, studentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student by id from database: %w", err)
+	}
+
+	if student.SchoolID != schoolID {
+		return nil, domain.ErrStudentNotFound
+	}
+
 	list, err := s.studentRepo.StudentGuardiansByStudentIDTx(ctx, studentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed get student guardians by student id database: %w", err)
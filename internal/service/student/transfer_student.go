@@ -0,0 +1,140 @@
+package student
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// TransferStudentArgs is arguments for transferring a student into another group.
+type TransferStudentArgs struct {
+	StudentID uuid.UUID
+	// SchoolID is the school the student is transferred from.
+	SchoolID uuid.UUID
+	GroupID  uuid.UUID
+	// Date is the first day in the new group, today when not set.
+	Date *time.Time
+}
+
+// TransferStudent moves the student into another group of the school or of another school
+// of the same organization, the previous group stays in the student membership history.
+func (s Service) TransferStudent(
+	ctx context.Context, args TransferStudentArgs,
+) (_ domain.StudentMembership, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.StudentMembership{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on transfer student: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	student, err := s.studentRepo.StudentByIDTx(txCtx, args.StudentID)
+	if err != nil {
+		return domain.StudentMembership{}, fmt.Errorf("failed to get student by id: %w", err)
+	}
+
+	if student.SchoolID != args.SchoolID {
+		return domain.StudentMembership{}, domain.ErrStudentNotFound
+	}
+
+	// the group may be in another school of the organization.
+	groups, err := s.groupService.GroupsByIDs(txCtx, []uuid.UUID{args.GroupID})
+	if err != nil {
+		return domain.StudentMembership{}, fmt.Errorf("failed to get group by id: %w", err)
+	}
+
+	if len(groups) == 0 {
+		return domain.StudentMembership{}, domain.ErrGroupNotFound
+	}
+
+	group := groups[0]
+
+	if group.SchoolID != student.SchoolID {
+		if err = s.checkSameOrganization(txCtx, student.SchoolID, group.SchoolID); err != nil {
+			return domain.StudentMembership{}, err
+		}
+	}
+
+	memberships, err := s.studentRepo.StudentMembershipsTx(txCtx, student.ID)
+	if err != nil {
+		return domain.StudentMembership{}, fmt.Errorf("failed to get student group memberships: %w", err)
+	}
+
+	date := s.now()
+	if args.Date != nil {
+		date = *args.Date
+	}
+
+	closed, opened, err := memberships.Transfer(group.ID, group.SchoolID, date, s.now)
+	if err != nil {
+		return domain.StudentMembership{}, fmt.Errorf("failed to transfer student: %w", err)
+	}
+
+	if err = s.studentRepo.TransferStudentTx(txCtx, closed, opened); err != nil {
+		return domain.StudentMembership{}, fmt.Errorf("failed to store student transfer: %w", err)
+	}
+
+	return opened, nil
+}
+
+// checkSameOrganization checks that both schools belong to the same organization.
+func (s Service) checkSameOrganization(ctx context.Context, fromSchoolID, toSchoolID uuid.UUID) error {
+	from, err := s.schoolService.SchoolShortByID(ctx, fromSchoolID)
+	if err != nil {
+		return fmt.Errorf("failed to get school short info by id: %w", err)
+	}
+
+	to, err := s.schoolService.SchoolShortByID(ctx, toSchoolID)
+	if err != nil {
+		return fmt.Errorf("failed to get school short info by id: %w", err)
+	}
+
+	if from.OrganizationID != to.OrganizationID {
+		return domain.ErrTransferOutOfOrganization
+	}
+
+	return nil
+}
+
+// StudentMemberships returns group membership history of the student of the school,
+// only the membership of the group the student was in on the date is returned when date is set.
+func (s Service) StudentMemberships(
+	ctx context.Context, studentID, schoolID uuid.UUID, date *time.Time,
+) (domain.StudentMemberships, error) {
+	student, err := s.studentRepo.StudentByIDTx(ctx, studentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student by id from database: %w", err)
+	}
+
+	if student.SchoolID != schoolID {
+		return nil, domain.ErrStudentNotFound
+	}
+
+	memberships, err := s.studentRepo.StudentMembershipsTx(ctx, studentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student group memberships: %w", err)
+	}
+
+	if date == nil {
+		return memberships, nil
+	}
+
+	membership, ok := memberships.On(*date)
+	if !ok {
+		return nil, domain.ErrStudentMembershipNotFound
+	}
+
+	return domain.StudentMemberships{membership}, nil
+}
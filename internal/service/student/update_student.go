@@ -0,0 +1,78 @@
+package student
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+	"bum-service/pkg/utils"
+)
+
+// UpdateStudentArgs is arguments for updating personal info of the student, absent fields are left unchanged.
+type UpdateStudentArgs struct {
+	ID         uuid.UUID
+	SchoolID   uuid.UUID
+	FirstName  *string
+	LastName   *string
+	MiddleName utils.Patch[string]
+	Gender     *string
+	// UpdatedAt is the version of the student the update is based on.
+	UpdatedAt time.Time
+}
+
+// UpdateStudent updates personal info of the student of the school,
+// the student group is changed by transferring the student.
+func (s Service) UpdateStudent(ctx context.Context, args UpdateStudentArgs) (_ domain.Student, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.Student{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on update student: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	student, err := s.StudentByID(txCtx, args.ID)
+	if err != nil {
+		return domain.Student{}, fmt.Errorf("failed to get student by id: %w", err)
+	}
+
+	if student.SchoolID != args.SchoolID {
+		return domain.Student{}, domain.ErrStudentNotFound
+	}
+
+	if err = domain.CheckVersion(student.UpdatedAt, args.UpdatedAt); err != nil {
+		return domain.Student{}, err
+	}
+
+	err = student.UpdatePersonalInfo(
+		utils.ValueOr(args.FirstName, student.FirstName),
+		utils.ValueOr(args.LastName, student.LastName),
+		args.MiddleName.Apply(student.MiddleName),
+		utils.ValueOr(args.Gender, string(student.Gender)),
+		s.now,
+	)
+	if err != nil {
+		return domain.Student{}, fmt.Errorf("failed to update student personal info: %w", err)
+	}
+
+	if err = s.studentRepo.UpdateStudentTx(txCtx, student, args.UpdatedAt); err != nil {
+		return domain.Student{}, fmt.Errorf("failed to update student: %w", err)
+	}
+
+	student, err = s.StudentByID(txCtx, student.ID)
+	if err != nil {
+		return domain.Student{}, fmt.Errorf("failed to get student by id: %w", err)
+	}
+
+	return student, nil
+}
@@ -0,0 +1,35 @@
+package subject
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// DeleteSubject deletes subject, school subjects based on it must be deleted before.
+func (s Service) DeleteSubject(ctx context.Context, id uuid.UUID) error {
+	dependents, err := s.subjectRepo.SubjectDependentsTx(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get subject dependents: %w", err)
+	}
+
+	if err = dependents.CheckDelete(); err != nil {
+		return err
+	}
+
+	if err = s.subjectRepo.DeleteSubjectTx(ctx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to delete subject: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreSubject restores deleted subject.
+func (s Service) RestoreSubject(ctx context.Context, id uuid.UUID) error {
+	if err := s.subjectRepo.RestoreSubjectTx(ctx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to restore subject: %w", err)
+	}
+
+	return nil
+}
@@ -22,3 +22,13 @@ func (s Service) SubjectByID(
 
 	return subject, nil
 }
+
+// SubjectByName gets a subject by name.
+func (s Service) SubjectByName(ctx context.Context, name string) (domain.Subject, error) {
+	subject, err := s.subjectRepo.SubjectByNameTx(ctx, name)
+	if err != nil {
+		return domain.Subject{}, fmt.Errorf("failed to get subject by name from database: %w", err)
+	}
+
+	return subject, nil
+}
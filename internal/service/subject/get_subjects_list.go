@@ -17,7 +17,7 @@ func (s Service) SubjectList(
 		return nil, 0, fmt.Errorf("failed to get subject list from database: %w", err)
 	}
 
-	count, err = s.subjectRepo.SubjectCountTx(ctx)
+	count, err = s.subjectRepo.SubjectCountTx(ctx, filters)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get subject count from database: %w", err)
 	}
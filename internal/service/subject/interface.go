@@ -2,6 +2,7 @@ package subject
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -12,6 +13,12 @@ import (
 type ISubjectRepo interface {
 	CreateSubjectTx(ctx context.Context, subject domain.Subject) error
 	GetSubjectByIDTx(ctx context.Context, id uuid.UUID) (domain.Subject, error)
+	SubjectByNameTx(ctx context.Context, name string) (domain.Subject, error)
 	GetSubjectListTx(ctx context.Context, filters domain.SubjectListFilter) (domain.Subjects, error)
-	SubjectCountTx(ctx context.Context) (int, error)
+	SubjectCountTx(ctx context.Context, filters domain.SubjectListFilter) (int, error)
+	UpdateSubjectTx(ctx context.Context, subject domain.Subject, version time.Time) error
+
+	SubjectDependentsTx(ctx context.Context, id uuid.UUID) (domain.Dependents, error)
+	DeleteSubjectTx(ctx context.Context, id uuid.UUID, now time.Time) error
+	RestoreSubjectTx(ctx context.Context, id uuid.UUID, now time.Time) error
 }
@@ -0,0 +1,46 @@
+package subject
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/utils"
+)
+
+// UpdateSubjectArgs is arguments for updating subject, absent fields are left unchanged.
+type UpdateSubjectArgs struct {
+	ID          uuid.UUID
+	Name        *string
+	Description utils.Patch[string]
+	// UpdatedAt is the version of the subject the update is based on.
+	UpdatedAt time.Time
+}
+
+// UpdateSubject updates subject.
+func (s Service) UpdateSubject(ctx context.Context, args UpdateSubjectArgs) (domain.Subject, error) {
+	subject, err := s.subjectRepo.GetSubjectByIDTx(ctx, args.ID)
+	if err != nil {
+		return domain.Subject{}, fmt.Errorf("failed to get subject by id: %w", err)
+	}
+
+	if err = domain.CheckVersion(subject.UpdatedAt, args.UpdatedAt); err != nil {
+		return domain.Subject{}, err
+	}
+
+	subject.Update(utils.ValueOr(args.Name, subject.Name), args.Description.Apply(subject.Description), s.now)
+
+	if err = s.subjectRepo.UpdateSubjectTx(ctx, subject, args.UpdatedAt); err != nil {
+		return domain.Subject{}, fmt.Errorf("failed to update subject: %w", err)
+	}
+
+	subject, err = s.SubjectByID(ctx, subject.ID)
+	if err != nil {
+		return domain.Subject{}, fmt.Errorf("failed to get subject by id: %w", err)
+	}
+
+	return subject, nil
+}
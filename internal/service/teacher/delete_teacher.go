@@ -0,0 +1,91 @@
+package teacher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+)
+
+// DeleteTeacher deletes teacher of the school together with the teacher role, the teacher must not
+// be a class teacher, be assigned to group subjects or have upcoming lessons.
+func (s Service) DeleteTeacher(ctx context.Context, schoolID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on delete teacher: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	teacher, err := s.teacherRepo.TeacherByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get teacher by id: %w", err)
+	}
+
+	if teacher.SchoolID != schoolID {
+		return domain.ErrTeacherNotFound
+	}
+
+	now := s.now()
+
+	dependents, err := s.teacherRepo.TeacherDependentsTx(txCtx, id, now)
+	if err != nil {
+		return fmt.Errorf("failed to get teacher dependents: %w", err)
+	}
+
+	if err = dependents.CheckDelete(); err != nil {
+		return err
+	}
+
+	if err = s.teacherRepo.DeleteTeacherTx(txCtx, id, now); err != nil {
+		return fmt.Errorf("failed to delete teacher: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreTeacher restores deleted teacher of the school together with the teacher role.
+func (s Service) RestoreTeacher(ctx context.Context, schoolID, id uuid.UUID) (err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on restore teacher: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	if _, err = s.schoolService.SchoolShortByID(txCtx, schoolID); err != nil {
+		return fmt.Errorf("failed to get school by id: %w", err)
+	}
+
+	if err = s.teacherRepo.RestoreTeacherTx(txCtx, id, s.now()); err != nil {
+		return fmt.Errorf("failed to restore teacher: %w", err)
+	}
+
+	teacher, err := s.teacherRepo.TeacherByIDTx(txCtx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get teacher by id: %w", err)
+	}
+
+	if teacher.SchoolID != schoolID {
+		return domain.ErrTeacherNotFound
+	}
+
+	return nil
+}
@@ -26,6 +26,37 @@ func (s Service) TeacherByID(ctx context.Context, id uuid.UUID) (domain.Teacher,
 	return teacher, nil
 }
 
+// TeacherByIDAndSchoolID get teacher of the school by id.
+func (s Service) TeacherByIDAndSchoolID(ctx context.Context, id, schoolID uuid.UUID) (domain.Teacher, error) {
+	teacher, err := s.TeacherByID(ctx, id)
+	if err != nil {
+		return teacher, err
+	}
+
+	if teacher.SchoolID != schoolID {
+		return domain.Teacher{}, domain.ErrTeacherNotFound
+	}
+
+	return teacher, nil
+}
+
+// TeacherByUserID get the teacher of the school by user id.
+func (s Service) TeacherByUserID(ctx context.Context, schoolID, userID uuid.UUID) (domain.Teacher, error) {
+	teacher, err := s.teacherRepo.TeacherByUserIDTx(ctx, schoolID, userID)
+	if err != nil {
+		return teacher, fmt.Errorf("failed to get teacher by user id: %w", err)
+	}
+
+	user, err := s.userInfoService.UserByID(ctx, teacher.UserID)
+	if err != nil {
+		return teacher, fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	teacher.SetUser(user)
+
+	return teacher, nil
+}
+
 // TeachersByIDs get teachers by ids.
 func (s Service) TeachersByIDs(ctx context.Context, ids []uuid.UUID) (domain.Teachers, error) {
 	teachers, err := s.teacherRepo.TeachersByIDsTx(ctx, ids)
@@ -2,6 +2,7 @@ package teacher
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -12,9 +13,14 @@ import (
 type ITeacherRepo interface {
 	CreateTeacherTx(ctx context.Context, o domain.Teacher) error
 	TeacherByIDTx(ctx context.Context, id uuid.UUID) (domain.Teacher, error)
+	TeacherByUserIDTx(ctx context.Context, schoolID, userID uuid.UUID) (domain.Teacher, error)
+	UpdateTeacherTx(ctx context.Context, o domain.Teacher, version time.Time) error
 	TeachersByIDsTx(ctx context.Context, ids []uuid.UUID) (domain.Teachers, error)
 	TeacherListTx(ctx context.Context, filters domain.TeacherListFilter) (domain.Teachers, error)
 	TeacherCountTx(ctx context.Context, filters domain.TeacherListFilter) (int, error)
+	TeacherDependentsTx(ctx context.Context, id uuid.UUID, now time.Time) (domain.Dependents, error)
+	DeleteTeacherTx(ctx context.Context, id uuid.UUID, now time.Time) error
+	RestoreTeacherTx(ctx context.Context, id uuid.UUID, now time.Time) error
 }
 
 // IUserInfoService represents a user info service for teacher use cases.
@@ -25,6 +31,7 @@ type IUserInfoService interface {
 
 // ISchoolService represents a school service.
 type ISchoolService interface {
+	SchoolShortByID(ctx context.Context, id uuid.UUID) (domain.SchoolShortInfo, error)
 	SchoolShortByIDs(ctx context.Context, ids []uuid.UUID) (domain.SchoolShortInfos, error)
 }
 
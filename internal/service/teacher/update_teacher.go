@@ -0,0 +1,66 @@
+package teacher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"bum-service/internal/domain"
+	"bum-service/pkg/transaction"
+	"bum-service/pkg/utils"
+)
+
+// UpdateTeacherArgs is arguments for updating teacher contacts, absent fields are left unchanged.
+type UpdateTeacherArgs struct {
+	ID       uuid.UUID
+	SchoolID uuid.UUID
+	Phone    utils.Patch[string]
+	Email    utils.Patch[string]
+	// UpdatedAt is the version of the teacher the update is based on.
+	UpdatedAt time.Time
+}
+
+// UpdateTeacher updates contacts of the teacher of the school.
+func (s Service) UpdateTeacher(ctx context.Context, args UpdateTeacherArgs) (_ domain.Teacher, err error) {
+	txCtx, tx, err := s.sessionAdapter.Begin(ctx)
+	if err != nil {
+		return domain.Teacher{}, fmt.Errorf("failed to begin transaction : %w", err)
+	}
+
+	defer func(tx transaction.SessionSolver) {
+		errEnd := s.sessionAdapter.End(tx, err)
+		if errEnd != nil {
+			err = fmt.Errorf(
+				"failed to end transaction on update teacher: %w: %w", domain.ErrInternalServerError, errEnd,
+			)
+		}
+	}(tx)
+
+	teacher, err := s.teacherRepo.TeacherByIDTx(txCtx, args.ID)
+	if err != nil {
+		return domain.Teacher{}, fmt.Errorf("failed to get teacher by id: %w", err)
+	}
+
+	if teacher.SchoolID != args.SchoolID {
+		return domain.Teacher{}, domain.ErrTeacherNotFound
+	}
+
+	if err = domain.CheckVersion(teacher.UpdatedAt, args.UpdatedAt); err != nil {
+		return domain.Teacher{}, err
+	}
+
+	teacher.Update(args.Phone.Apply(teacher.Phone), args.Email.Apply(teacher.Email), s.now)
+
+	if err = s.teacherRepo.UpdateTeacherTx(txCtx, teacher, args.UpdatedAt); err != nil {
+		return domain.Teacher{}, fmt.Errorf("failed to update teacher: %w", err)
+	}
+
+	teacher, err = s.TeacherByID(txCtx, teacher.ID)
+	if err != nil {
+		return domain.Teacher{}, fmt.Errorf("failed to get teacher by id: %w", err)
+	}
+
+	return teacher, nil
+}
@@ -0,0 +1,18 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"bum-service/internal/domain"
+)
+
+// UserByPhone get user by phone.
+func (s Service) UserByPhone(ctx context.Context, phone string) (domain.User, error) {
+	user, err := s.userRepo.UserByPhoneTx(ctx, phone)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("failed to get the user by phone from database: %w", err)
+	}
+
+	return user, nil
+}
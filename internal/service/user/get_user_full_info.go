@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 
@@ -25,3 +26,17 @@ func (s Service) UserFullInfoByID(ctx context.Context, id uuid.UUID) (domain.Use
 
 	return user, nil
 }
+
+// UserFullInfoByIDAndSchoolID get full info of the user having a role in the school.
+func (s Service) UserFullInfoByIDAndSchoolID(ctx context.Context, id, schoolID uuid.UUID) (domain.User, error) {
+	user, err := s.UserFullInfoByID(ctx, id)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	if !slices.Contains(user.UserRoles.SchoolIDs(), schoolID) {
+		return domain.User{}, domain.ErrUserNotFound
+	}
+
+	return user, nil
+}
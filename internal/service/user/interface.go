@@ -14,8 +14,10 @@ type IUserRepo interface {
 	AddUserRoleTx(ctx context.Context, role domain.UserRole, withinOnConflict bool) error
 	UserByIDTx(ctx context.Context, id uuid.UUID) (domain.User, error)
 	UserByEmailTx(ctx context.Context, email string) (domain.User, error)
+	UserByPhoneTx(ctx context.Context, phone string) (domain.User, error)
 	GetUserListTx(ctx context.Context, filters domain.UserListFilter) (domain.Users, error)
 	UserCountTx(ctx context.Context, filters domain.UserListFilter) (int, error)
+	SearchTx(ctx context.Context, filter domain.SearchFilter) (domain.SearchHits, error)
 
 	UserRolesByIDTx(ctx context.Context, userID uuid.UUID) (domain.UserRoles, error)
 	UserRolesByIDsTx(ctx context.Context, userIDs []uuid.UUID) (domain.UserRoles, error)
@@ -45,7 +47,7 @@ type IStudentRepo interface {
 
 // IGroupService represents group service.
 type IGroupService interface {
-	GroupByID(ctx context.Context, groupID uuid.UUID) (domain.Group, error)
+	GroupByID(ctx context.Context, schoolID, groupID uuid.UUID) (domain.Group, error)
 	GroupsByIDs(ctx context.Context, ids []uuid.UUID) (domain.Groups, error)
 }
 
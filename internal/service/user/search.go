@@ -0,0 +1,22 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"bum-service/internal/domain"
+)
+
+// Search finds students, teachers, guardians and school staff by the names, email and phone of their users.
+func (s Service) Search(ctx context.Context, filter domain.SearchFilter) (domain.SearchHits, error) {
+	if filter.IsEmpty() {
+		return domain.SearchHits{}, nil
+	}
+
+	hits, err := s.userRepo.SearchTx(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users in database: %w", err)
+	}
+
+	return hits, nil
+}
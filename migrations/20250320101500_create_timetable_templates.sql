@@ -0,0 +1,125 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE timetable_templates
+(
+    id          uuid PRIMARY KEY                       NOT NULL,
+    school_id   uuid                                   NOT NULL,
+    group_id    uuid                                   NOT NULL,
+    name        varchar(255)                           NOT NULL,
+    date_from   DATE                                   NOT NULL,
+    date_till   DATE                                   NOT NULL,
+
+    created_at  TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    updated_at  TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    deleted_at  TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT timetable_templates_school_id_fkey
+        FOREIGN KEY (school_id) REFERENCES schools (id),
+    CONSTRAINT timetable_templates_group_id_fkey
+        FOREIGN KEY (group_id) REFERENCES groups (id),
+    CONSTRAINT timetable_templates_period_check
+        CHECK (date_till >= date_from)
+);
+
+COMMENT ON COLUMN timetable_templates.id        IS 'Timetable template identifier';
+COMMENT ON COLUMN timetable_templates.school_id IS 'School identifier';
+COMMENT ON COLUMN timetable_templates.group_id  IS 'Group identifier';
+COMMENT ON COLUMN timetable_templates.name      IS 'Timetable template name, e.g. term name';
+COMMENT ON COLUMN timetable_templates.date_from IS 'First day of the term the template is applied to';
+COMMENT ON COLUMN timetable_templates.date_till IS 'Last day of the term the template is applied to';
+
+COMMENT ON COLUMN timetable_templates.created_at IS 'Date and time the timetable template was created';
+COMMENT ON COLUMN timetable_templates.updated_at IS 'Date and time the timetable template was updated';
+COMMENT ON COLUMN timetable_templates.deleted_at IS 'Date and time the timetable template was deleted';
+
+CREATE TABLE timetable_template_slots
+(
+    id                uuid PRIMARY KEY                       NOT NULL,
+    template_id       uuid                                   NOT NULL,
+    group_subject_id  uuid                                   NOT NULL,
+    teacher_id        uuid,
+    auditorium_id     uuid                                   NOT NULL,
+    weekday           SMALLINT                               NOT NULL,
+    start_minute      SMALLINT                               NOT NULL,
+    end_minute        SMALLINT                               NOT NULL,
+    description       text,
+
+    created_at        TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    updated_at        TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    deleted_at        TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT timetable_template_slots_template_id_fkey
+        FOREIGN KEY (template_id) REFERENCES timetable_templates (id),
+    CONSTRAINT timetable_template_slots_group_subject_id_fkey
+        FOREIGN KEY (group_subject_id) REFERENCES group_subjects (id),
+    CONSTRAINT timetable_template_slots_teacher_id_fkey
+        FOREIGN KEY (teacher_id) REFERENCES teachers (id),
+    CONSTRAINT timetable_template_slots_auditorium_id_fkey
+        FOREIGN KEY (auditorium_id) REFERENCES auditoriums (id),
+    CONSTRAINT timetable_template_slots_weekday_check
+        CHECK (weekday BETWEEN 0 AND 6),
+    CONSTRAINT timetable_template_slots_time_check
+        CHECK (start_minute >= 0 AND end_minute > start_minute AND end_minute <= 1440)
+);
+
+COMMENT ON COLUMN timetable_template_slots.id               IS 'Timetable template slot identifier';
+COMMENT ON COLUMN timetable_template_slots.template_id      IS 'Timetable template identifier';
+COMMENT ON COLUMN timetable_template_slots.group_subject_id IS 'Group subject identifier';
+COMMENT ON COLUMN timetable_template_slots.teacher_id       IS 'Teacher identifier, group subject teacher is used if empty';
+COMMENT ON COLUMN timetable_template_slots.auditorium_id    IS 'Auditorium identifier';
+COMMENT ON COLUMN timetable_template_slots.weekday          IS 'Day of the week, 0 is Sunday';
+COMMENT ON COLUMN timetable_template_slots.start_minute     IS 'Bell slot start in minutes from the beginning of the day';
+COMMENT ON COLUMN timetable_template_slots.end_minute       IS 'Bell slot end in minutes from the beginning of the day';
+COMMENT ON COLUMN timetable_template_slots.description      IS 'Lesson description';
+
+COMMENT ON COLUMN timetable_template_slots.created_at IS 'Date and time the timetable template slot was created';
+COMMENT ON COLUMN timetable_template_slots.updated_at IS 'Date and time the timetable template slot was updated';
+COMMENT ON COLUMN timetable_template_slots.deleted_at IS 'Date and time the timetable template slot was deleted';
+
+CREATE TABLE timetable_template_exclusions
+(
+    id          uuid PRIMARY KEY                       NOT NULL,
+    template_id uuid                                   NOT NULL,
+    date_from   DATE                                   NOT NULL,
+    date_till   DATE                                   NOT NULL,
+    reason      varchar(255),
+
+    created_at  TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+
+    CONSTRAINT timetable_template_exclusions_template_id_fkey
+        FOREIGN KEY (template_id) REFERENCES timetable_templates (id),
+    CONSTRAINT timetable_template_exclusions_period_check
+        CHECK (date_till >= date_from)
+);
+
+COMMENT ON COLUMN timetable_template_exclusions.id          IS 'Timetable template exclusion identifier';
+COMMENT ON COLUMN timetable_template_exclusions.template_id IS 'Timetable template identifier';
+COMMENT ON COLUMN timetable_template_exclusions.date_from   IS 'First day without lessons, e.g. holidays start';
+COMMENT ON COLUMN timetable_template_exclusions.date_till   IS 'Last day without lessons, e.g. holidays end';
+COMMENT ON COLUMN timetable_template_exclusions.reason      IS 'Exclusion reason, e.g. holiday name';
+
+COMMENT ON COLUMN timetable_template_exclusions.created_at IS 'Date and time the exclusion was created';
+
+ALTER TABLE lessons
+    ADD COLUMN template_slot_id uuid,
+    ADD COLUMN is_overridden    BOOLEAN DEFAULT FALSE NOT NULL,
+    ADD CONSTRAINT lessons_template_slot_id_fkey
+        FOREIGN KEY (template_slot_id) REFERENCES timetable_template_slots (id);
+
+COMMENT ON COLUMN lessons.template_slot_id IS 'Timetable template slot the lesson was materialised from';
+COMMENT ON COLUMN lessons.is_overridden    IS 'Single occurrence was changed and must not be replaced by the template';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE lessons
+    DROP CONSTRAINT lessons_template_slot_id_fkey,
+    DROP COLUMN template_slot_id,
+    DROP COLUMN is_overridden;
+
+DROP TABLE timetable_template_exclusions;
+
+DROP TABLE timetable_template_slots;
+
+DROP TABLE timetable_templates;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE attendances
+(
+    id                  uuid PRIMARY KEY                        NOT NULL,
+    lesson_id           uuid                                    NOT NULL,
+    student_id          uuid                                    NOT NULL,
+    status              VARCHAR(16)                             NOT NULL,
+    description         text,
+
+    created_at          TIMESTAMP WITH TIME ZONE DEFAULT now()  NOT NULL,
+    updated_at          TIMESTAMP WITH TIME ZONE DEFAULT now()  NOT NULL,
+    deleted_at          TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT attendances_lesson_id_fkey
+        FOREIGN KEY (lesson_id) REFERENCES lessons (id),
+    CONSTRAINT attendances_student_id_fkey
+        FOREIGN KEY (student_id) REFERENCES students (id),
+    CONSTRAINT attendances_lesson_key UNIQUE (lesson_id, student_id),
+    CONSTRAINT attendances_status_check
+        CHECK (status IN ('present', 'absent', 'late', 'excused'))
+);
+
+CREATE INDEX attendances_student_id_idx ON attendances (student_id);
+
+COMMENT ON COLUMN attendances.id IS 'attendance identifier';
+COMMENT ON COLUMN attendances.lesson_id IS 'lesson identifier';
+COMMENT ON COLUMN attendances.student_id IS 'student identifier';
+COMMENT ON COLUMN attendances.status IS 'present, absent, late or excused';
+COMMENT ON COLUMN attendances.description IS 'attendance description, e.g. reason of absence';
+
+COMMENT ON COLUMN attendances.created_at IS 'Date and time the attendance was created';
+COMMENT ON COLUMN attendances.updated_at IS 'Date and time the attendance was updated';
+COMMENT ON COLUMN attendances.deleted_at IS 'Date and time the attendance was deleted';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE attendances;
+-- +goose StatementEnd
@@ -0,0 +1,105 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE grading_scales
+(
+    id              UUID PRIMARY KEY         NOT NULL,
+    organization_id UUID,
+    name            VARCHAR(50)              NOT NULL,
+    kind            VARCHAR(16)              NOT NULL,
+
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    deleted_at      TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT grading_scales_name_key UNIQUE (organization_id, name),
+    CONSTRAINT grading_scales_organization_id_fkey
+        FOREIGN KEY (organization_id) REFERENCES educational_organizations (id),
+    CONSTRAINT grading_scales_kind_check
+        CHECK (kind IN ('five_point', 'ten_point', 'letter', 'pass_fail', 'percentage'))
+);
+
+COMMENT ON COLUMN grading_scales.id              IS 'Grading scale identifier';
+COMMENT ON COLUMN grading_scales.organization_id IS 'Organization identifier, NULL for preset scales';
+COMMENT ON COLUMN grading_scales.name            IS 'Grading scale name';
+COMMENT ON COLUMN grading_scales.kind            IS 'five_point, ten_point, letter, pass_fail or percentage';
+COMMENT ON COLUMN grading_scales.created_at      IS 'Date and time the grading scale was created';
+COMMENT ON COLUMN grading_scales.updated_at      IS 'Date and time the grading scale was updated';
+COMMENT ON COLUMN grading_scales.deleted_at      IS 'Date and time the grading scale was deleted';
+
+CREATE TABLE grading_scale_values
+(
+    id               UUID PRIMARY KEY NOT NULL,
+    grading_scale_id UUID             NOT NULL,
+    value            VARCHAR(16)      NOT NULL,
+    weight           NUMERIC(6, 2)    NOT NULL,
+    position         SMALLINT         NOT NULL,
+
+    CONSTRAINT grading_scale_values_value_key UNIQUE (grading_scale_id, value),
+    CONSTRAINT grading_scale_values_grading_scale_id_fkey
+        FOREIGN KEY (grading_scale_id) REFERENCES grading_scales (id)
+);
+
+COMMENT ON COLUMN grading_scale_values.id               IS 'Grading scale value identifier';
+COMMENT ON COLUMN grading_scale_values.grading_scale_id IS 'Grading scale identifier';
+COMMENT ON COLUMN grading_scale_values.value            IS 'Mark as it is given by a teacher';
+COMMENT ON COLUMN grading_scale_values.weight           IS 'Numeric weight of the mark used for averages';
+COMMENT ON COLUMN grading_scale_values.position         IS 'Ordinal position of the value in the scale';
+
+INSERT INTO grading_scales (id, name, kind)
+VALUES ('7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c01', '5-point', 'five_point'),
+       ('7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c02', '10-point', 'ten_point'),
+       ('7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c03', 'A-F', 'letter'),
+       ('7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c04', 'Pass/Fail', 'pass_fail'),
+       ('7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c05', 'Percentage', 'percentage');
+
+INSERT INTO grading_scale_values (id, grading_scale_id, value, weight, position)
+SELECT gen_random_uuid(), '7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c01', n::text, n, n
+FROM generate_series(1, 5) AS n;
+
+INSERT INTO grading_scale_values (id, grading_scale_id, value, weight, position)
+SELECT gen_random_uuid(), '7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c02', n::text, n, n
+FROM generate_series(1, 10) AS n;
+
+INSERT INTO grading_scale_values (id, grading_scale_id, value, weight, position)
+VALUES (gen_random_uuid(), '7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c03', 'F', 0, 1),
+       (gen_random_uuid(), '7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c03', 'D', 1, 2),
+       (gen_random_uuid(), '7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c03', 'C', 2, 3),
+       (gen_random_uuid(), '7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c03', 'B', 3, 4),
+       (gen_random_uuid(), '7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c03', 'A', 4, 5),
+       (gen_random_uuid(), '7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c04', 'fail', 0, 1),
+       (gen_random_uuid(), '7d2b1a4e-5f0c-4c3e-9a57-0b1f2e3d4c04', 'pass', 1, 2);
+
+ALTER TABLE grade_standards
+    ADD COLUMN grading_scale_id UUID;
+
+ALTER TABLE grade_standards
+    ADD CONSTRAINT grade_standards_grading_scale_id_fkey
+        FOREIGN KEY (grading_scale_id) REFERENCES grading_scales (id);
+
+COMMENT ON COLUMN grade_standards.grading_scale_id IS 'Grading scale identifier';
+
+ALTER TABLE marks
+    ALTER COLUMN mark TYPE VARCHAR(16) USING mark::text;
+
+ALTER TABLE marks
+    ADD COLUMN weight NUMERIC(6, 2) NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN marks.weight IS 'Numeric weight of the mark by the grading scale';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE marks
+    DROP COLUMN weight;
+
+-- marks given by grading scales are not identifiers, so they must be deleted before the migration is rolled back.
+ALTER TABLE marks
+    ALTER COLUMN mark TYPE UUID USING mark::uuid;
+
+ALTER TABLE grade_standards
+    DROP COLUMN grading_scale_id;
+
+DROP TABLE grading_scale_values;
+
+DROP TABLE grading_scales;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE final_grades
+(
+    id                  uuid PRIMARY KEY                        NOT NULL,
+    group_subject_id    uuid                                    NOT NULL,
+    student_id          uuid                                    NOT NULL,
+    date_from           DATE                                    NOT NULL,
+    date_till           DATE                                    NOT NULL,
+    mark                VARCHAR(16)                             NOT NULL,
+    weight              NUMERIC(6, 2)                           NOT NULL,
+    average             NUMERIC(6, 2)                           NOT NULL,
+    locked_at           TIMESTAMP WITH TIME ZONE,
+
+    created_at          TIMESTAMP WITH TIME ZONE DEFAULT now()  NOT NULL,
+    updated_at          TIMESTAMP WITH TIME ZONE DEFAULT now()  NOT NULL,
+    deleted_at          TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT final_grades_group_subject_id_fkey
+        FOREIGN KEY (group_subject_id) REFERENCES group_subjects (id),
+    CONSTRAINT final_grades_student_id_fkey
+        FOREIGN KEY (student_id) REFERENCES students (id),
+    CONSTRAINT final_grades_period_key UNIQUE (group_subject_id, student_id, date_from, date_till),
+    CONSTRAINT final_grades_period_check CHECK (date_till >= date_from)
+);
+
+COMMENT ON COLUMN final_grades.id IS 'final grade identifier';
+COMMENT ON COLUMN final_grades.group_subject_id IS 'group subject identifier';
+COMMENT ON COLUMN final_grades.student_id IS 'student identifier';
+COMMENT ON COLUMN final_grades.date_from IS 'first day of the graded period, e.g. term';
+COMMENT ON COLUMN final_grades.date_till IS 'last day of the graded period';
+COMMENT ON COLUMN final_grades.mark IS 'final mark by the grading scale';
+COMMENT ON COLUMN final_grades.weight IS 'numeric weight of the final mark';
+COMMENT ON COLUMN final_grades.average IS 'average weight of the student marks for the period';
+COMMENT ON COLUMN final_grades.locked_at IS 'Date and time the final grade was locked, it can not be changed after';
+
+COMMENT ON COLUMN final_grades.created_at IS 'Date and time the final grade was created';
+COMMENT ON COLUMN final_grades.updated_at IS 'Date and time the final grade was updated';
+COMMENT ON COLUMN final_grades.deleted_at IS 'Date and time the final grade was deleted';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE final_grades;
+-- +goose StatementEnd
@@ -0,0 +1,137 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE academic_years
+(
+    id         UUID PRIMARY KEY                       NOT NULL,
+    school_id  UUID                                   NOT NULL,
+    name       VARCHAR(50)                            NOT NULL,
+    date_from  DATE                                   NOT NULL,
+    date_till  DATE                                   NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT academic_years_name_key UNIQUE (school_id, name),
+    CONSTRAINT academic_years_school_id_fkey
+        FOREIGN KEY (school_id) REFERENCES schools (id),
+    CONSTRAINT academic_years_period_check CHECK (date_till > date_from)
+);
+
+COMMENT ON COLUMN academic_years.id         IS 'Academic year identifier';
+COMMENT ON COLUMN academic_years.school_id  IS 'School identifier';
+COMMENT ON COLUMN academic_years.name       IS 'Academic year name, e.g. 2024/2025';
+COMMENT ON COLUMN academic_years.date_from  IS 'First day of the academic year';
+COMMENT ON COLUMN academic_years.date_till  IS 'Last day of the academic year';
+COMMENT ON COLUMN academic_years.created_at IS 'Date and time the academic year was created';
+COMMENT ON COLUMN academic_years.updated_at IS 'Date and time the academic year was updated';
+COMMENT ON COLUMN academic_years.deleted_at IS 'Date and time the academic year was deleted';
+
+CREATE TABLE terms
+(
+    id               UUID PRIMARY KEY                       NOT NULL,
+    academic_year_id UUID                                   NOT NULL,
+    name             VARCHAR(50)                            NOT NULL,
+    position         SMALLINT                               NOT NULL,
+    date_from        DATE                                   NOT NULL,
+    date_till        DATE                                   NOT NULL,
+
+    created_at       TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    updated_at       TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    deleted_at       TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT terms_position_key UNIQUE (academic_year_id, position),
+    CONSTRAINT terms_academic_year_id_fkey
+        FOREIGN KEY (academic_year_id) REFERENCES academic_years (id),
+    CONSTRAINT terms_period_check CHECK (date_till >= date_from)
+);
+
+COMMENT ON COLUMN terms.id               IS 'Term identifier';
+COMMENT ON COLUMN terms.academic_year_id IS 'Academic year identifier';
+COMMENT ON COLUMN terms.name             IS 'Term name, e.g. 1st quarter';
+COMMENT ON COLUMN terms.position         IS 'Ordinal number of the term in the academic year';
+COMMENT ON COLUMN terms.date_from        IS 'First day of the term';
+COMMENT ON COLUMN terms.date_till        IS 'Last day of the term';
+COMMENT ON COLUMN terms.created_at       IS 'Date and time the term was created';
+COMMENT ON COLUMN terms.updated_at       IS 'Date and time the term was updated';
+COMMENT ON COLUMN terms.deleted_at       IS 'Date and time the term was deleted';
+
+CREATE TABLE holidays
+(
+    id               UUID PRIMARY KEY                       NOT NULL,
+    academic_year_id UUID                                   NOT NULL,
+    name             VARCHAR(50)                            NOT NULL,
+    date_from        DATE                                   NOT NULL,
+    date_till        DATE                                   NOT NULL,
+
+    created_at       TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+
+    CONSTRAINT holidays_academic_year_id_fkey
+        FOREIGN KEY (academic_year_id) REFERENCES academic_years (id),
+    CONSTRAINT holidays_period_check CHECK (date_till >= date_from)
+);
+
+COMMENT ON COLUMN holidays.id               IS 'Holiday identifier';
+COMMENT ON COLUMN holidays.academic_year_id IS 'Academic year identifier';
+COMMENT ON COLUMN holidays.name             IS 'Holiday name, e.g. winter holidays';
+COMMENT ON COLUMN holidays.date_from        IS 'First day of the holidays';
+COMMENT ON COLUMN holidays.date_till        IS 'Last day of the holidays';
+COMMENT ON COLUMN holidays.created_at       IS 'Date and time the holiday was created';
+
+ALTER TABLE groups
+    ADD COLUMN academic_year_id UUID,
+    ADD CONSTRAINT groups_academic_year_id_fkey
+        FOREIGN KEY (academic_year_id) REFERENCES academic_years (id),
+    DROP CONSTRAINT groups_name_key;
+
+CREATE UNIQUE INDEX groups_name_key
+    ON groups (name, grade_id, school_id, COALESCE(academic_year_id, '00000000-0000-0000-0000-000000000000'))
+    WHERE deleted_at IS NULL;
+
+COMMENT ON COLUMN groups.academic_year_id IS 'Academic year identifier';
+
+ALTER TABLE study_plans
+    ADD COLUMN term_id UUID,
+    ADD CONSTRAINT study_plans_term_id_fkey
+        FOREIGN KEY (term_id) REFERENCES terms (id);
+
+COMMENT ON COLUMN study_plans.term_id IS 'Term the study plan is planned for';
+
+ALTER TABLE lessons
+    ADD COLUMN term_id UUID,
+    ADD CONSTRAINT lessons_term_id_fkey
+        FOREIGN KEY (term_id) REFERENCES terms (id);
+
+COMMENT ON COLUMN lessons.term_id IS 'Term of the lesson, resolved by the lesson date';
+
+ALTER TABLE marks
+    ADD COLUMN term_id UUID,
+    ADD CONSTRAINT marks_term_id_fkey
+        FOREIGN KEY (term_id) REFERENCES terms (id);
+
+COMMENT ON COLUMN marks.term_id IS 'Term of the mark, the same as of its lesson';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE marks
+    DROP COLUMN term_id;
+
+ALTER TABLE lessons
+    DROP COLUMN term_id;
+
+ALTER TABLE study_plans
+    DROP COLUMN term_id;
+
+DROP INDEX groups_name_key;
+
+ALTER TABLE groups
+    DROP COLUMN academic_year_id,
+    ADD CONSTRAINT groups_name_key UNIQUE (name, grade_id, school_id);
+
+DROP TABLE holidays;
+
+DROP TABLE terms;
+
+DROP TABLE academic_years;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE groups
+    ADD COLUMN previous_group_id UUID,
+    ADD CONSTRAINT groups_previous_group_id_fkey
+        FOREIGN KEY (previous_group_id) REFERENCES groups (id),
+    ADD CONSTRAINT groups_previous_group_id_key UNIQUE (previous_group_id);
+
+COMMENT ON COLUMN groups.previous_group_id IS 'Group of the previous academic year the group was promoted from';
+
+CREATE TABLE student_promotions
+(
+    id               UUID PRIMARY KEY                       NOT NULL,
+    student_id       UUID                                   NOT NULL,
+    from_group_id    UUID                                   NOT NULL,
+    to_group_id      UUID,
+    academic_year_id UUID                                   NOT NULL,
+    status           VARCHAR(16)                            NOT NULL,
+
+    created_at       TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+
+    CONSTRAINT student_promotions_student_id_fkey
+        FOREIGN KEY (student_id) REFERENCES students (id),
+    CONSTRAINT student_promotions_from_group_id_fkey
+        FOREIGN KEY (from_group_id) REFERENCES groups (id),
+    CONSTRAINT student_promotions_to_group_id_fkey
+        FOREIGN KEY (to_group_id) REFERENCES groups (id),
+    CONSTRAINT student_promotions_academic_year_id_fkey
+        FOREIGN KEY (academic_year_id) REFERENCES academic_years (id),
+    CONSTRAINT student_promotions_key UNIQUE (student_id, academic_year_id),
+    CONSTRAINT student_promotions_status_check CHECK (status IN ('promoted', 'held_back', 'graduated'))
+);
+
+COMMENT ON COLUMN student_promotions.id               IS 'Student promotion identifier';
+COMMENT ON COLUMN student_promotions.student_id       IS 'Student identifier';
+COMMENT ON COLUMN student_promotions.from_group_id    IS 'Group the student studied in before the promotion';
+COMMENT ON COLUMN student_promotions.to_group_id      IS 'Group the student was moved to, empty if the student was not moved';
+COMMENT ON COLUMN student_promotions.academic_year_id IS 'Academic year the student was promoted to';
+COMMENT ON COLUMN student_promotions.status           IS 'Promotion result: promoted, held_back or graduated';
+COMMENT ON COLUMN student_promotions.created_at       IS 'Date and time the student was promoted';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE student_promotions;
+
+ALTER TABLE groups
+    DROP COLUMN previous_group_id;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE student_group_memberships
+(
+    id         UUID PRIMARY KEY                       NOT NULL,
+    student_id UUID                                   NOT NULL,
+    group_id   UUID                                   NOT NULL,
+    date_from  DATE                                   NOT NULL,
+    date_till  DATE,
+
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+
+    CONSTRAINT student_group_memberships_student_id_fkey
+        FOREIGN KEY (student_id) REFERENCES students (id),
+    CONSTRAINT student_group_memberships_group_id_fkey
+        FOREIGN KEY (group_id) REFERENCES groups (id),
+    CONSTRAINT student_group_memberships_period_check CHECK (date_till IS NULL OR date_till >= date_from)
+);
+
+CREATE UNIQUE INDEX student_group_memberships_current_key
+    ON student_group_memberships (student_id) WHERE date_till IS NULL;
+
+CREATE INDEX student_group_memberships_group_id_idx
+    ON student_group_memberships (group_id, date_from);
+
+COMMENT ON COLUMN student_group_memberships.id         IS 'Student group membership identifier';
+COMMENT ON COLUMN student_group_memberships.student_id IS 'Student identifier';
+COMMENT ON COLUMN student_group_memberships.group_id   IS 'Group the student studied in';
+COMMENT ON COLUMN student_group_memberships.date_from  IS 'First day the student studied in the group';
+COMMENT ON COLUMN student_group_memberships.date_till  IS 'Day the student left the group, empty while the student is in it';
+COMMENT ON COLUMN student_group_memberships.created_at IS 'Date and time the membership was created';
+COMMENT ON COLUMN student_group_memberships.updated_at IS 'Date and time the membership was updated';
+
+INSERT INTO student_group_memberships (id, student_id, group_id, date_from)
+SELECT
+    gen_random_uuid(), id, group_id, created_at::date
+FROM
+    students
+WHERE
+    deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE student_group_memberships;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE auth_sessions
+(
+    id         UUID PRIMARY KEY                       NOT NULL,
+    user_id    UUID                                   NOT NULL,
+    token_id   UUID                                   NOT NULL,
+    user_agent VARCHAR(512)                           NOT NULL,
+    ip         VARCHAR(64)                            NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE               NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT auth_sessions_user_id_fkey
+        FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE INDEX auth_sessions_user_id_idx
+    ON auth_sessions (user_id) WHERE revoked_at IS NULL;
+
+COMMENT ON COLUMN auth_sessions.id         IS 'Auth session identifier';
+COMMENT ON COLUMN auth_sessions.user_id    IS 'User identifier';
+COMMENT ON COLUMN auth_sessions.token_id   IS 'Identifier of the only refresh token of the session that can be used';
+COMMENT ON COLUMN auth_sessions.user_agent IS 'User agent of the device the session was started on';
+COMMENT ON COLUMN auth_sessions.ip         IS 'IP address the session was started from';
+COMMENT ON COLUMN auth_sessions.expires_at IS 'Date and time the refresh token of the session expires';
+COMMENT ON COLUMN auth_sessions.created_at IS 'Date and time the session was started';
+COMMENT ON COLUMN auth_sessions.updated_at IS 'Date and time the refresh token of the session was rotated';
+COMMENT ON COLUMN auth_sessions.revoked_at IS 'Date and time the session was revoked';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE auth_sessions;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN email_verified_at TIMESTAMP WITH TIME ZONE;
+
+COMMENT ON COLUMN users.email_verified_at IS 'Date and time the user confirmed the email, empty if the email is not verified';
+
+CREATE TABLE user_tokens
+(
+    id         UUID PRIMARY KEY                       NOT NULL,
+    user_id    UUID                                   NOT NULL,
+    purpose    VARCHAR(32)                            NOT NULL,
+    token_hash VARCHAR(64)                            NOT NULL,
+    email      VARCHAR(255)                           NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE               NOT NULL,
+    used_at    TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+
+    CONSTRAINT user_tokens_user_id_fkey
+        FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT user_tokens_token_hash_key UNIQUE (token_hash),
+    CONSTRAINT user_tokens_purpose_check CHECK (purpose IN ('password_reset', 'email_verification'))
+);
+
+COMMENT ON COLUMN user_tokens.id         IS 'User token identifier';
+COMMENT ON COLUMN user_tokens.user_id    IS 'User identifier';
+COMMENT ON COLUMN user_tokens.purpose    IS 'Action the token confirms: password_reset or email_verification';
+COMMENT ON COLUMN user_tokens.token_hash IS 'SHA-256 hash of the token sent to the user';
+COMMENT ON COLUMN user_tokens.email      IS 'Email the token was sent to';
+COMMENT ON COLUMN user_tokens.expires_at IS 'Date and time the token expires';
+COMMENT ON COLUMN user_tokens.used_at    IS 'Date and time the token was used, empty if the token is not used';
+COMMENT ON COLUMN user_tokens.created_at IS 'Date and time the token was created';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_tokens;
+
+ALTER TABLE users
+    DROP COLUMN email_verified_at;
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE login_failures
+(
+    key            VARCHAR(320) PRIMARY KEY NOT NULL,
+    failures       INTEGER                  NOT NULL,
+    last_failed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    locked_until   TIMESTAMP WITH TIME ZONE
+);
+
+COMMENT ON COLUMN login_failures.key            IS 'Account email or IP address the failures are counted by, prefixed with account: or ip:';
+COMMENT ON COLUMN login_failures.failures       IS 'Number of failed logins since the failures were last forgotten';
+COMMENT ON COLUMN login_failures.last_failed_at IS 'Date and time of the last failed login';
+COMMENT ON COLUMN login_failures.locked_until   IS 'Date and time logins are locked until, empty if logins are not locked';
+
+CREATE TABLE failed_login_attempts
+(
+    id         UUID PRIMARY KEY                       NOT NULL,
+    email      VARCHAR(255)                           NOT NULL,
+    user_id    UUID,
+    ip         VARCHAR(64)                            NOT NULL,
+    user_agent TEXT                                   NOT NULL,
+    reason     VARCHAR(32)                            NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+
+    CONSTRAINT failed_login_attempts_user_id_fkey
+        FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT failed_login_attempts_reason_check CHECK (reason IN ('invalid_credentials', 'locked'))
+);
+
+CREATE INDEX failed_login_attempts_user_id_idx ON failed_login_attempts (user_id, created_at);
+
+COMMENT ON COLUMN failed_login_attempts.id         IS 'Failed login attempt identifier';
+COMMENT ON COLUMN failed_login_attempts.email      IS 'Email the login was attempted with';
+COMMENT ON COLUMN failed_login_attempts.user_id    IS 'User identifier, empty if there is no user with the email';
+COMMENT ON COLUMN failed_login_attempts.ip         IS 'IP address of the client';
+COMMENT ON COLUMN failed_login_attempts.user_agent IS 'User agent of the client';
+COMMENT ON COLUMN failed_login_attempts.reason     IS 'Reason the login failed: invalid_credentials or locked';
+COMMENT ON COLUMN failed_login_attempts.created_at IS 'Date and time of the attempt';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE failed_login_attempts;
+
+DROP TABLE login_failures;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE login_codes
+(
+    id         UUID PRIMARY KEY                       NOT NULL,
+    user_id    UUID                                   NOT NULL,
+    phone      VARCHAR(32)                            NOT NULL,
+    code_hash  VARCHAR(64)                            NOT NULL,
+    attempts   INTEGER                  DEFAULT 0     NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE               NOT NULL,
+    used_at    TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+
+    CONSTRAINT login_codes_user_id_fkey
+        FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE INDEX login_codes_phone_idx ON login_codes (phone, created_at);
+
+COMMENT ON COLUMN login_codes.id         IS 'Login code identifier';
+COMMENT ON COLUMN login_codes.user_id    IS 'User identifier';
+COMMENT ON COLUMN login_codes.phone      IS 'Phone the code was sent to';
+COMMENT ON COLUMN login_codes.code_hash  IS 'SHA-256 hash of the login code id and the code';
+COMMENT ON COLUMN login_codes.attempts   IS 'Number of times the code was tried';
+COMMENT ON COLUMN login_codes.expires_at IS 'Date and time the code expires';
+COMMENT ON COLUMN login_codes.used_at    IS 'Date and time the code was used, empty if the code is not used';
+COMMENT ON COLUMN login_codes.created_at IS 'Date and time the code was created';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE login_codes;
+-- +goose StatementEnd
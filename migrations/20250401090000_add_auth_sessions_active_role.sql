@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE auth_sessions
+    ADD COLUMN active_role_id UUID,
+    ADD CONSTRAINT auth_sessions_active_role_id_fkey
+        FOREIGN KEY (active_role_id) REFERENCES user_roles (id);
+
+COMMENT ON COLUMN auth_sessions.active_role_id IS 'Role of the user the session acts as, empty if no role is selected';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE auth_sessions
+    DROP COLUMN active_role_id;
+-- +goose StatementEnd
@@ -0,0 +1,104 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE student_guardians
+    ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+COMMENT ON COLUMN student_guardians.deleted_at IS 'Date and time the student guardian was deleted';
+
+-- unique keys apply to not deleted entries only, so deleted entries can be created again and restored
+-- unless an entry with the same key is created after the deletion.
+ALTER TABLE educational_organizations
+    DROP CONSTRAINT educational_organizations_name_key;
+
+CREATE UNIQUE INDEX educational_organizations_name_key
+    ON educational_organizations (name) WHERE deleted_at IS NULL;
+
+ALTER TABLE user_roles
+    DROP CONSTRAINT user_roles_user_id_role_school_id_organization_id_key;
+
+CREATE UNIQUE INDEX user_roles_user_id_role_school_id_organization_id_key
+    ON user_roles (user_id, role, school_id, organization_id) NULLS NOT DISTINCT WHERE deleted_at IS NULL;
+
+ALTER TABLE schools
+    DROP CONSTRAINT schools_name_key;
+
+CREATE UNIQUE INDEX schools_name_key
+    ON schools (name) WHERE deleted_at IS NULL;
+
+ALTER TABLE subjects
+    DROP CONSTRAINT subjects_name_key;
+
+CREATE UNIQUE INDEX subjects_name_key
+    ON subjects (name) WHERE deleted_at IS NULL;
+
+ALTER TABLE auditoriums
+    DROP CONSTRAINT auditoriums_name_key;
+
+CREATE UNIQUE INDEX auditoriums_name_key
+    ON auditoriums (school_id, name) WHERE deleted_at IS NULL;
+
+ALTER TABLE group_subjects
+    DROP CONSTRAINT group_subjects_key;
+
+CREATE UNIQUE INDEX group_subjects_key
+    ON group_subjects (school_subject_id, group_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE student_guardians
+    DROP CONSTRAINT student_guardians_key;
+
+CREATE UNIQUE INDEX student_guardians_key
+    ON student_guardians (student_id, relation) WHERE deleted_at IS NULL;
+
+ALTER TABLE marks
+    DROP CONSTRAINT marks_lesson_key;
+
+CREATE UNIQUE INDEX marks_lesson_key
+    ON marks (lesson_id, student_id) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX marks_lesson_key;
+
+ALTER TABLE marks
+    ADD CONSTRAINT marks_lesson_key UNIQUE (lesson_id, student_id);
+
+DROP INDEX student_guardians_key;
+
+ALTER TABLE student_guardians
+    ADD CONSTRAINT student_guardians_key UNIQUE (student_id, relation);
+
+DROP INDEX group_subjects_key;
+
+ALTER TABLE group_subjects
+    ADD CONSTRAINT group_subjects_key UNIQUE (school_subject_id, group_id);
+
+DROP INDEX auditoriums_name_key;
+
+ALTER TABLE auditoriums
+    ADD CONSTRAINT auditoriums_name_key UNIQUE (school_id, name);
+
+DROP INDEX subjects_name_key;
+
+ALTER TABLE subjects
+    ADD CONSTRAINT subjects_name_key UNIQUE (name);
+
+DROP INDEX schools_name_key;
+
+ALTER TABLE schools
+    ADD CONSTRAINT schools_name_key UNIQUE (name);
+
+DROP INDEX user_roles_user_id_role_school_id_organization_id_key;
+
+ALTER TABLE user_roles
+    ADD CONSTRAINT user_roles_user_id_role_school_id_organization_id_key
+        UNIQUE NULLS NOT DISTINCT (user_id, role, school_id, organization_id);
+
+DROP INDEX educational_organizations_name_key;
+
+ALTER TABLE educational_organizations
+    ADD CONSTRAINT educational_organizations_name_key UNIQUE (name);
+
+ALTER TABLE student_guardians
+    DROP COLUMN deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- lists are paginated by the sort key and then by the id, so the next page is found by the index
+-- instead of skipping the rows of the previous pages.
+CREATE INDEX users_created_at_id_idx ON users (created_at, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX students_created_at_id_idx ON students (created_at, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX lessons_start_time_id_idx ON lessons (start_time, id) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX lessons_start_time_id_idx;
+
+DROP INDEX students_created_at_id_idx;
+
+DROP INDEX users_created_at_id_idx;
+-- +goose StatementEnd
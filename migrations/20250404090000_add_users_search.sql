@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- users are searched by words of their names, email and phone with the full-text search
+-- and by misspelled or partial words with the trigram similarity.
+ALTER TABLE users
+    ADD COLUMN search_text TEXT GENERATED ALWAYS AS (
+        lower(
+            first_name || ' ' ||
+            last_name || ' ' ||
+            coalesce(middle_name, '') || ' ' ||
+            email || ' ' ||
+            coalesce(phone, '')
+        )
+    ) STORED;
+
+ALTER TABLE users
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        to_tsvector(
+            'simple',
+            first_name || ' ' ||
+            last_name || ' ' ||
+            coalesce(middle_name, '') || ' ' ||
+            email || ' ' ||
+            coalesce(phone, '')
+        )
+    ) STORED;
+
+COMMENT ON COLUMN users.search_text     IS 'Lowercase names, email and phone of the user for the search';
+COMMENT ON COLUMN users.search_vector   IS 'Full-text search vector of the names, email and phone of the user';
+
+CREATE INDEX users_search_text_trgm_idx ON users USING GIN (search_text gin_trgm_ops);
+
+CREATE INDEX users_search_vector_idx ON users USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX users_search_vector_idx;
+
+DROP INDEX users_search_text_trgm_idx;
+
+ALTER TABLE users DROP COLUMN search_vector;
+
+ALTER TABLE users DROP COLUMN search_text;
+-- +goose StatementEnd
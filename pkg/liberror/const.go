@@ -23,4 +23,11 @@ var (
 		Code:     "NOT_FOUND",
 		HTTPCode: http.StatusNotFound,
 	}
+
+	// ErrForbidden is returned when the caller is not allowed to access the resource.
+	ErrForbidden = &Error{
+		Err:      "forbidden error",
+		Code:     "FORBIDDEN",
+		HTTPCode: http.StatusForbidden,
+	}
 )
@@ -8,18 +8,41 @@ import (
 
 // Error represents json error with http code and error.
 type Error struct {
-	Err      string `json:"error,omitempty"`
-	Code     string `json:"code,omitempty"`
-	HTTPCode int    `json:"-"`
+	Err      string       `json:"error,omitempty"`
+	Code     string       `json:"code,omitempty"`
+	Details  any          `json:"details,omitempty"`
+	Fields   []FieldError `json:"fields,omitempty"`
+	HTTPCode int          `json:"-"`
 	child    error
 }
 
+// FieldError is an error of a field of the request.
+type FieldError struct {
+	// Field is the path of the field in the request, e.g. lessons[0].start_time.
+	Field string `json:"field"`
+	// Rule is the validation rule the field does not satisfy, e.g. required or min.
+	Rule string `json:"rule"`
+	// Params are the parameters of the rule, e.g. the minimum length of min.
+	Params  []string `json:"params,omitempty"`
+	Message string   `json:"message,omitempty"`
+}
+
+// WithFields returns a copy of the error with the field errors.
+func (e *Error) WithFields(fields ...FieldError) *Error {
+	err := *e
+	err.Fields = fields
+
+	return &err
+}
+
 // MarshalJSON is an implementation of the MarshalJSON interface in encoding/json.
 func (e *Error) MarshalJSON() ([]byte, error) {
 	type t struct {
-		Err      string `json:"error,omitempty"`
-		Code     string `json:"code,omitempty"`
-		HTTPCode int    `json:"-"`
+		Err      string       `json:"error,omitempty"`
+		Code     string       `json:"code,omitempty"`
+		Details  any          `json:"details,omitempty"`
+		Fields   []FieldError `json:"fields,omitempty"`
+		HTTPCode int          `json:"-"`
 		child    error
 	}
 
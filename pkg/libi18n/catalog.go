@@ -0,0 +1,33 @@
+package libi18n
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Catalog is a collection of messages by their keys in every language.
+// Messages may contain {0}, {1}... placeholders replaced by the arguments.
+type Catalog map[string]map[Language]string
+
+// Message returns the message with the key in the language, the English message if there is no translation.
+// False is returned if there is no message with the key at all.
+func (c Catalog) Message(language Language, key string, args ...string) (string, bool) {
+	translations, ok := c[key]
+	if !ok {
+		return "", false
+	}
+
+	message, ok := translations[language]
+	if !ok {
+		message, ok = translations[English]
+		if !ok {
+			return "", false
+		}
+	}
+
+	for i, arg := range args {
+		message = strings.ReplaceAll(message, "{"+strconv.Itoa(i)+"}", arg)
+	}
+
+	return message, true
+}
@@ -0,0 +1,88 @@
+package libi18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AcceptLanguageHeader is the header the client lists its preferred languages in.
+const AcceptLanguageHeader = "Accept-Language"
+
+// Language is a two-letter ISO 639-1 language code.
+type Language string
+
+const (
+	// English is the default language.
+	English Language = "en"
+	// Russian is the Russian language.
+	Russian Language = "ru"
+	// Uzbek is the Uzbek language.
+	Uzbek Language = "uz"
+	// Tajik is the Tajik language.
+	Tajik Language = "tg"
+)
+
+// Supported returns the supported languages.
+func Supported() []Language {
+	return []Language{English, Russian, Uzbek, Tajik}
+}
+
+// IsSupported checks whether there are messages in the language.
+func (l Language) IsSupported() bool {
+	for _, language := range Supported() {
+		if l == language {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAcceptLanguage returns the supported language the client prefers the most according to
+// the Accept-Language header value, for example "ru-RU,ru;q=0.9,en;q=0.8". English is returned by default.
+func ParseAcceptLanguage(header string) Language {
+	type weightedLanguage struct {
+		language Language
+		weight   float64
+	}
+
+	var languages []weightedLanguage
+
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		// only the primary subtag matters, ru-RU and ru-UZ are both Russian.
+		primary, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+		language := Language(strings.ToLower(primary))
+
+		if !language.IsSupported() {
+			continue
+		}
+
+		weight := 1.0
+
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+
+			weight = parsed
+		}
+
+		if weight > 0 {
+			languages = append(languages, weightedLanguage{language: language, weight: weight})
+		}
+	}
+
+	if len(languages) == 0 {
+		return English
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].weight > languages[j].weight
+	})
+
+	return languages[0].language
+}
@@ -0,0 +1,54 @@
+package libi18n
+
+import "testing"
+
+func TestParseAcceptLanguage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		header string
+		want   Language
+	}{
+		{name: "empty", header: "", want: English},
+		{name: "single", header: "ru", want: Russian},
+		{name: "region", header: "tg-TJ", want: Tajik},
+		{name: "weights", header: "en;q=0.5, uz-UZ;q=0.9, ru;q=0.7", want: Uzbek},
+		{name: "unsupported are skipped", header: "de-DE, fr;q=0.9, ru;q=0.1", want: Russian},
+		{name: "refused language", header: "ru;q=0, en;q=0.1", want: English},
+		{name: "only unsupported", header: "de, fr", want: English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ParseAcceptLanguage(tt.header); got != tt.want {
+				t.Errorf("ParseAcceptLanguage(%q) = %v, want %v", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogMessage(t *testing.T) {
+	t.Parallel()
+
+	catalog := Catalog{
+		"min": {
+			English: "must be at least {0} characters",
+			Russian: "должно быть не менее {0} символов",
+		},
+	}
+
+	if got, _ := catalog.Message(Russian, "min", "8"); got != "должно быть не менее 8 символов" {
+		t.Errorf("unexpected russian message %q", got)
+	}
+
+	if got, _ := catalog.Message(Tajik, "min", "8"); got != "must be at least 8 characters" {
+		t.Errorf("expected english fallback, got %q", got)
+	}
+
+	if _, ok := catalog.Message(English, "unknown"); ok {
+		t.Errorf("expected no message for unknown key")
+	}
+}
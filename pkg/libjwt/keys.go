@@ -0,0 +1,160 @@
+package libjwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// KeyIDHeader is the token header containing id of the key the token is signed with.
+const KeyIDHeader = "kid"
+
+var (
+	// ErrInvalidKey represents an error when the key can not be used for signing tokens.
+	ErrInvalidKey = errors.New("invalid signing key")
+
+	// ErrUnknownKey represents an error when the token is signed with a key that is not in the key set.
+	ErrUnknownKey = errors.New("unknown signing key")
+)
+
+// Key is a key for signing and verifying tokens.
+type Key struct {
+	ID     string
+	Method jwt.SigningMethod
+
+	// signKey is the private key for asymmetric methods and the secret for HMAC.
+	signKey any
+	// verifyKey is the public key for asymmetric methods and the secret for HMAC.
+	verifyKey any
+}
+
+// NewHMACKey creates a HS256 key from the shared secret.
+func NewHMACKey(id string, secret []byte) (Key, error) {
+	if len(secret) == 0 {
+		return Key{}, fmt.Errorf("%w: empty secret", ErrInvalidKey)
+	}
+
+	return Key{
+		ID:        id,
+		Method:    jwt.SigningMethodHS256,
+		signKey:   secret,
+		verifyKey: secret,
+	}, nil
+}
+
+// ParsePrivateKeyPEM creates a key from PEM encoded PKCS #8 or PKCS #1 private key,
+// RSA keys sign tokens with RS256 and Ed25519 keys with EdDSA.
+func ParsePrivateKeyPEM(id string, data []byte) (Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return Key{}, fmt.Errorf("%w: no PEM data found", ErrInvalidKey)
+	}
+
+	var (
+		privateKey any
+		err        error
+	)
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+
+	if err != nil {
+		return Key{}, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+
+	return NewPrivateKey(id, privateKey)
+}
+
+// NewPrivateKey creates a key from RSA or Ed25519 private key.
+func NewPrivateKey(id string, privateKey any) (Key, error) {
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return Key{ID: id, Method: jwt.SigningMethodRS256, signKey: key, verifyKey: key.Public()}, nil
+	case ed25519.PrivateKey:
+		return Key{ID: id, Method: jwt.SigningMethodEdDSA, signKey: key, verifyKey: key.Public()}, nil
+	default:
+		return Key{}, fmt.Errorf("%w: unsupported key type %T", ErrInvalidKey, privateKey)
+	}
+}
+
+// KeySet signs tokens with the signing key and verifies tokens signed with any key of the set,
+// so tokens issued before the signing key rotation are still valid.
+type KeySet struct {
+	signing Key
+	keys    map[string]Key
+	ids     []string
+}
+
+// NewKeySet creates a new key set, the signing key is added to the set.
+func NewKeySet(signing Key, keys ...Key) (*KeySet, error) {
+	set := &KeySet{
+		signing: signing,
+		keys:    make(map[string]Key, len(keys)+1),
+	}
+
+	for _, key := range append([]Key{signing}, keys...) {
+		if _, ok := set.keys[key.ID]; ok {
+			if key.ID == signing.ID {
+				continue
+			}
+
+			return nil, fmt.Errorf("%w: duplicated key id %q", ErrInvalidKey, key.ID)
+		}
+
+		set.keys[key.ID] = key
+		set.ids = append(set.ids, key.ID)
+	}
+
+	return set, nil
+}
+
+// Sign signs the claims with the signing key.
+func (k *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(k.signing.Method, claims)
+	token.Header[KeyIDHeader] = k.signing.ID
+
+	signed, err := token.SignedString(k.signing.signKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return signed, nil
+}
+
+// Keyfunc returns the key the token is signed with, it is used for jwt.Parse.
+// Tokens without key id are verified with the signing key.
+func (k *KeySet) Keyfunc(token *jwt.Token) (any, error) {
+	key := k.signing
+
+	if kid, ok := token.Header[KeyIDHeader].(string); ok {
+		if key, ok = k.keys[kid]; !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownKey, kid)
+		}
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("%w: unexpected signing method %s", ErrUnknownKey, token.Method.Alg())
+	}
+
+	return key.verifyKey, nil
+}
+
+// Methods returns signing methods of the keys of the set.
+func (k *KeySet) Methods() []string {
+	methods := make([]string, 0, len(k.ids))
+
+	for _, id := range k.ids {
+		methods = append(methods, k.keys[id].Method.Alg())
+	}
+
+	return methods
+}
@@ -0,0 +1,113 @@
+package libjwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newTestKeys(t *testing.T) (rsaKey, edKey Key) {
+	t.Helper()
+
+	rsaPrivate, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+
+	_, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ed25519 key: %v", err)
+	}
+
+	if rsaKey, err = NewPrivateKey("old", rsaPrivate); err != nil {
+		t.Fatalf("NewPrivateKey() error = %v", err)
+	}
+
+	if edKey, err = NewPrivateKey("new", edPrivate); err != nil {
+		t.Fatalf("NewPrivateKey() error = %v", err)
+	}
+
+	return rsaKey, edKey
+}
+
+func TestKeySetRotation(t *testing.T) {
+	t.Parallel()
+
+	oldKey, newKey := newTestKeys(t)
+
+	oldSet, err := NewKeySet(oldKey)
+	if err != nil {
+		t.Fatalf("NewKeySet() error = %v", err)
+	}
+
+	rotatedSet, err := NewKeySet(newKey, oldKey)
+	if err != nil {
+		t.Fatalf("NewKeySet() error = %v", err)
+	}
+
+	claims := jwt.RegisteredClaims{Subject: "user"}
+
+	oldToken, err := oldSet.Sign(claims)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	newToken, err := rotatedSet.Sign(claims)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	for name, token := range map[string]string{"old": oldToken, "new": newToken} {
+		parsed, errParse := jwt.Parse(token, rotatedSet.Keyfunc, jwt.WithValidMethods(rotatedSet.Methods()))
+		if errParse != nil {
+			t.Errorf("expected the %s token to be verified after rotation: %v", name, errParse)
+			continue
+		}
+
+		if parsed.Header[KeyIDHeader] != name {
+			t.Errorf("expected the %s token to have key id %q, got %v", name, name, parsed.Header[KeyIDHeader])
+		}
+	}
+
+	if _, err = jwt.Parse(newToken, oldSet.Keyfunc); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("expected the new token to be unknown before rotation, got %v", err)
+	}
+}
+
+func TestKeySetJWKS(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, edKey := newTestKeys(t)
+
+	hmacKey, err := NewHMACKey("hmac", []byte("secret"))
+	if err != nil {
+		t.Fatalf("NewHMACKey() error = %v", err)
+	}
+
+	set, err := NewKeySet(rsaKey, edKey, hmacKey)
+	if err != nil {
+		t.Fatalf("NewKeySet() error = %v", err)
+	}
+
+	jwks := set.JWKS()
+
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("expected only 2 public keys to be published, got %d", len(jwks.Keys))
+	}
+
+	if key := jwks.Keys[0]; key.KeyType != "RSA" || key.Algorithm != "RS256" || key.N == "" || key.E != "AQAB" {
+		t.Errorf("unexpected rsa jwk: %+v", key)
+	}
+
+	if key := jwks.Keys[1]; key.KeyType != "OKP" || key.Algorithm != "EdDSA" || key.Curve != "Ed25519" || key.X == "" {
+		t.Errorf("unexpected ed25519 jwk: %+v", key)
+	}
+
+	if _, err = NewKeySet(rsaKey, edKey, Key{ID: edKey.ID, Method: edKey.Method}); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("expected duplicated key id to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package libsheet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// utf8BOM is the byte order mark spreadsheet editors put at the beginning of UTF-8 CSV files.
+const utf8BOM = "\ufeff"
+
+// ReadCSV reads the rows of the CSV file. The values are separated by commas or,
+// as spreadsheet editors export them in many locales, by semicolons if there are more of them in the first line.
+func ReadCSV(r io.Reader) ([][]string, error) {
+	buffered := bufio.NewReader(r)
+
+	firstLine, err := buffered.Peek(buffered.Size())
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, fmt.Errorf("failed to read csv: %w", err)
+	}
+
+	if end := bytes.IndexByte(firstLine, '\n'); end >= 0 {
+		firstLine = firstLine[:end]
+	}
+
+	if bytes.HasPrefix(firstLine, []byte(utf8BOM)) {
+		_, _ = buffered.Discard(len(utf8BOM))
+	}
+
+	reader := csv.NewReader(buffered)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	if bytes.Count(firstLine, []byte{';'}) > bytes.Count(firstLine, []byte{','}) {
+		reader.Comma = ';'
+	}
+
+	var rows [][]string
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+		}
+
+		// the empty lines are skipped by the reader, so the rows are aligned with the lines.
+		line, _ := reader.FieldPos(0)
+		for len(rows) < line-1 {
+			rows = append(rows, nil)
+		}
+
+		rows = append(rows, record)
+	}
+
+	return rows, nil
+}
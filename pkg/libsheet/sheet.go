@@ -0,0 +1,65 @@
+package libsheet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Format is a format of the spreadsheet file.
+type Format string
+
+const (
+	// CSV is a comma or semicolon separated values file.
+	CSV Format = "csv"
+	// XLSX is an Office Open XML workbook, only its first worksheet is read.
+	XLSX Format = "xlsx"
+)
+
+var (
+	// ErrUnknownFormat represents an error when the file is neither CSV nor XLSX.
+	ErrUnknownFormat = errors.New("unknown spreadsheet format")
+
+	// ErrTooLarge represents an error when the file is larger than the allowed size.
+	ErrTooLarge = errors.New("spreadsheet is too large")
+
+	// ErrInvalidFile represents an error when the file can not be read in its format.
+	ErrInvalidFile = errors.New("invalid spreadsheet")
+)
+
+// FormatOf returns the format of the file by the extension of its name.
+func FormatOf(name string) (Format, error) {
+	switch format := Format(strings.ToLower(strings.TrimPrefix(filepath.Ext(name), "."))); format {
+	case CSV, XLSX:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownFormat, name)
+	}
+}
+
+// Read reads the rows of the spreadsheet file of the format given by the file name.
+// The row of the index i is the line i+1 of the file, the empty lines are empty rows.
+func Read(name string, r io.Reader, maxSize int64) ([][]string, error) {
+	format, err := FormatOf(name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spreadsheet: %w", err)
+	}
+
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrTooLarge, maxSize)
+	}
+
+	if format == XLSX {
+		return ReadXLSX(bytes.NewReader(data), int64(len(data)))
+	}
+
+	return ReadCSV(bytes.NewReader(data))
+}
@@ -0,0 +1,132 @@
+package libsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newTestXLSX creates a workbook with the given parts.
+func newTestXLSX(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	archive := zip.NewWriter(&buf)
+
+	for name, content := range parts {
+		w, err := archive.Create(name)
+		if err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+
+		if _, err = w.Write([]byte(content)); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		t.Fatalf("failed to close workbook: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestReadCSV(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		csv  string
+		want [][]string
+	}{
+		{
+			name: "comma separated",
+			csv:  "first_name,group\nAli,5A\n",
+			want: [][]string{{"first_name", "group"}, {"Ali", "5A"}},
+		},
+		{
+			name: "semicolon separated with byte order mark",
+			csv:  "\ufefffirst_name;last_name;note\nAli;Valiev;5A, 2024\n",
+			want: [][]string{{"first_name", "last_name", "note"}, {"Ali", "Valiev", "5A, 2024"}},
+		},
+		{
+			name: "empty and multiline rows keep line numbers",
+			csv:  "first_name\n\n\"Ali\nVali\"\nZarina\n",
+			want: [][]string{{"first_name"}, nil, {"Ali\nVali"}, nil, {"Zarina"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ReadCSV(strings.NewReader(tt.csv))
+			if err != nil {
+				t.Fatalf("ReadCSV() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadCSV() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadXLSX(t *testing.T) {
+	t.Parallel()
+
+	workbook := newTestXLSX(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Roster" sheetId="1" r:id="rId3"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships>` +
+			`<Relationship Id="rId3" Target="worksheets/roster.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst><si><t>first_name</t></si><si><t>phone</t></si>` +
+			`<si><r><t>A</t></r><r><t>li</t></r></si></sst>`,
+		"xl/worksheets/roster.xml": `<worksheet><sheetData>` +
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1" t="s"><v>1</v></c></row>` +
+			`<row r="3"><c r="A3" t="s"><v>2</v></c><c r="B3" t="inlineStr"><is><t>5A</t></is></c>` +
+			`<c r="C3"><v>9.98901234567E+11</v></c></row>` +
+			`</sheetData></worksheet>`,
+	})
+
+	got, err := ReadXLSX(bytes.NewReader(workbook), int64(len(workbook)))
+	if err != nil {
+		t.Fatalf("ReadXLSX() error = %v", err)
+	}
+
+	want := [][]string{{"first_name", "", "phone"}, nil, {"Ali", "5A", "998901234567"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadXLSX() got = %q, want %q", got, want)
+	}
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		maxSize int64
+		wantErr error
+	}{
+		{name: "csv", file: "roster.CSV", content: "first_name\nAli\n", maxSize: 100},
+		{name: "unknown format", file: "roster.pdf", content: "first_name", maxSize: 100, wantErr: ErrUnknownFormat},
+		{name: "too large", file: "roster.csv", content: "first_name\nAli\n", maxSize: 5, wantErr: ErrTooLarge},
+		{name: "not a workbook", file: "roster.xlsx", content: "first_name", maxSize: 100, wantErr: ErrInvalidFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := Read(tt.file, strings.NewReader(tt.content), tt.maxSize); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Read() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
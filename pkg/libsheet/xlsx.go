@@ -0,0 +1,248 @@
+package libsheet
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxXLSXPartSize is the maximum size of the unpacked part of the workbook, so a small file
+	// can not be unpacked into a huge one.
+	maxXLSXPartSize = 64 << 20
+
+	// maxXLSXRows is the maximum number of the rows of the worksheet.
+	maxXLSXRows = 1 << 20
+	// maxXLSXColumns is the maximum number of the columns of the worksheet.
+	maxXLSXColumns = 1 << 14
+
+	workbookPath      = "xl/workbook.xml"
+	workbookRelsPath  = "xl/_rels/workbook.xml.rels"
+	sharedStringsPath = "xl/sharedStrings.xml"
+	firstSheetPath    = "xl/worksheets/sheet1.xml"
+)
+
+// errPartNotFound represents an error when there is no such part in the workbook.
+var errPartNotFound = errors.New("part not found")
+
+// xlsxWorkbook is the list of the worksheets of the workbook.
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelationID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+// xlsxRelations is the list of the parts of the workbook.
+type xlsxRelations struct {
+	Relations []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// xlsxText is the plain or the rich text of the cell.
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+// String returns the text without formatting.
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+
+	var text strings.Builder
+
+	for _, run := range t.Runs {
+		text.WriteString(run.T)
+	}
+
+	return text.String()
+}
+
+// xlsxSharedStrings is the table of the strings of the cells.
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+// xlsxWorksheet is the rows of the worksheet.
+type xlsxWorksheet struct {
+	Rows []struct {
+		Number int `xml:"r,attr"`
+		Cells  []struct {
+			Ref       string   `xml:"r,attr"`
+			Type      string   `xml:"t,attr"`
+			Value     string   `xml:"v"`
+			InlineStr xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// ReadXLSX reads the rows of the first worksheet of the XLSX workbook.
+// The numbers are read as they are stored, e.g. dates are read as the numbers of days.
+func ReadXLSX(r io.ReaderAt, size int64) ([][]string, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFile, err)
+	}
+
+	sheetPath, err := xlsxFirstSheetPath(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	var sharedStrings xlsxSharedStrings
+
+	// the workbook without strings has no shared strings part.
+	err = readXLSXPart(archive, sharedStringsPath, &sharedStrings)
+	if err != nil && !errors.Is(err, errPartNotFound) {
+		return nil, err
+	}
+
+	var sheet xlsxWorksheet
+
+	if err = readXLSXPart(archive, sheetPath, &sheet); err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(sheet.Rows))
+
+	for _, row := range sheet.Rows {
+		if row.Number == 0 {
+			row.Number = len(rows) + 1
+		}
+
+		if row.Number < len(rows)+1 || row.Number > maxXLSXRows {
+			return nil, fmt.Errorf("%w: invalid row number %d", ErrInvalidFile, row.Number)
+		}
+
+		for len(rows) < row.Number-1 {
+			rows = append(rows, nil)
+		}
+
+		var values []string
+
+		for _, cell := range row.Cells {
+			column := len(values)
+
+			if cell.Ref != "" {
+				if column, err = xlsxColumn(cell.Ref); err != nil {
+					return nil, err
+				}
+			}
+
+			value, err := xlsxValue(cell.Type, cell.Value, cell.InlineStr, sharedStrings)
+			if err != nil {
+				return nil, err
+			}
+
+			for len(values) < column {
+				values = append(values, "")
+			}
+
+			values = append(values[:column], value)
+		}
+
+		rows = append(rows, values)
+	}
+
+	return rows, nil
+}
+
+// xlsxFirstSheetPath returns the path of the first worksheet of the workbook.
+func xlsxFirstSheetPath(archive *zip.Reader) (string, error) {
+	var (
+		workbook  xlsxWorkbook
+		relations xlsxRelations
+	)
+
+	if err := readXLSXPart(archive, workbookPath, &workbook); err != nil {
+		return "", err
+	}
+
+	// the first worksheet of the workbook without relations is at its default path.
+	if readXLSXPart(archive, workbookRelsPath, &relations) != nil || len(workbook.Sheets) == 0 {
+		return firstSheetPath, nil
+	}
+
+	for _, relation := range relations.Relations {
+		if relation.ID != workbook.Sheets[0].RelationID {
+			continue
+		}
+
+		if strings.HasPrefix(relation.Target, "/") {
+			return strings.TrimPrefix(relation.Target, "/"), nil
+		}
+
+		return path.Join(path.Dir(workbookPath), relation.Target), nil
+	}
+
+	return firstSheetPath, nil
+}
+
+// readXLSXPart decodes the XML part of the workbook.
+func readXLSXPart(archive *zip.Reader, name string, v any) error {
+	file, err := archive.Open(name)
+	if err != nil {
+		return fmt.Errorf("%w: %w: %s", ErrInvalidFile, errPartNotFound, name)
+	}
+
+	defer file.Close()
+
+	if err = xml.NewDecoder(io.LimitReader(file, maxXLSXPartSize)).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidFile, name, err)
+	}
+
+	return nil
+}
+
+// xlsxColumn returns the index of the column of the cell reference, e.g. 27 for AB12.
+func xlsxColumn(ref string) (int, error) {
+	column := 0
+
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+
+		column = column*26 + int(r-'A') + 1
+	}
+
+	if column == 0 || column > maxXLSXColumns {
+		return 0, fmt.Errorf("%w: invalid cell reference %q", ErrInvalidFile, ref)
+	}
+
+	return column - 1, nil
+}
+
+// xlsxValue returns the value of the cell of the type.
+func xlsxValue(cellType, value string, inline xlsxText, sharedStrings xlsxSharedStrings) (string, error) {
+	switch cellType {
+	case "s":
+		index, err := strconv.Atoi(value)
+		if err != nil || index < 0 || index >= len(sharedStrings.Items) {
+			return "", fmt.Errorf("%w: invalid shared string %q", ErrInvalidFile, value)
+		}
+
+		return sharedStrings.Items[index].String(), nil
+	case "inlineStr":
+		return inline.String(), nil
+	case "", "n":
+		// the long numbers, e.g. phones, are stored in the exponential notation.
+		if number, err := strconv.ParseFloat(value, 64); err == nil && strings.ContainsAny(value, "eE") {
+			return strconv.FormatFloat(number, 'f', -1, 64), nil
+		}
+
+		return value, nil
+	default:
+		return value, nil
+	}
+}
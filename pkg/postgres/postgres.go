@@ -15,6 +15,7 @@ type DB interface {
 
 	PrepareNamedContext(ctx context.Context, query string) (*sqlx.NamedStmt, error)
 	NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error)
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
 	SelectContext(ctx context.Context, dest any, query string, args ...any) error
 	GetContext(ctx context.Context, dest any, query string, args ...any) error
 	PreparexContext(ctx context.Context, query string) (*sqlx.Stmt, error)
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Patch is a nullable field of JSON merge patch (RFC 7396). The field is left unchanged when it is absent,
+// is cleared when it is null and is set otherwise.
+type Patch[T any] struct {
+	// Set is true if the field is present in the patch.
+	Set bool
+	// Value is the new value of the field, nil if the field is null.
+	Value *T
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It's called only for the fields present in the patch, including null ones.
+func (p *Patch[T]) UnmarshalJSON(data []byte) error {
+	p.Set = true
+	p.Value = nil
+
+	if string(data) == "null" {
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+
+	p.Value = &value
+
+	return nil
+}
+
+// ValidationValue returns the value of the field for validation, nil if the field is absent or null.
+func (p Patch[T]) ValidationValue() any {
+	if p.Value == nil {
+		return nil
+	}
+
+	return *p.Value
+}
+
+// Apply returns the patched value of the field with the current value.
+func (p Patch[T]) Apply(current *T) *T {
+	if !p.Set {
+		return current
+	}
+
+	return p.Value
+}
+
+// ValueOr returns the value if it's set, the current value otherwise.
+func ValueOr[T any](value *T, current T) T {
+	if value == nil {
+		return current
+	}
+
+	return *value
+}
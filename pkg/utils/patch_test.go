@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPatch_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	current := "current"
+	value := "value"
+
+	tests := []struct {
+		name string
+		data string
+		want *string
+	}{
+		{"absent field is left unchanged", `{}`, &current},
+		{"null field is cleared", `{"field":null}`, nil},
+		{"field is set", `{"field":"value"}`, &value},
+	}
+	for _, tt := range tests {
+		pp := tt
+		t.Run(pp.name, func(t *testing.T) {
+			t.Parallel()
+
+			var patch struct {
+				Field Patch[string] `json:"field"`
+			}
+
+			if err := json.Unmarshal([]byte(pp.data), &patch); err != nil {
+				t.Errorf("UnmarshalJSON() error = %v", err)
+				return
+			}
+
+			if got := patch.Field.Apply(&current); !reflect.DeepEqual(got, pp.want) {
+				t.Errorf("Apply() got = %v, want %v", got, pp.want)
+			}
+		})
+	}
+}
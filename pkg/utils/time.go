@@ -6,17 +6,18 @@ import (
 )
 
 // RFC3339Time is an alias of time.RFC3339Time.
+// Fractional seconds are kept, so the time of the last update can be sent back as the version of the entity.
 type RFC3339Time time.Time
 
 // MarshalJSON marshals the response.
 func (t RFC3339Time) MarshalJSON() ([]byte, error) {
 	ts := time.Time(t)
-	return []byte(fmt.Sprintf("%q", ts.Format(time.RFC3339))), nil
+	return []byte(fmt.Sprintf("%q", ts.Format(time.RFC3339Nano))), nil
 }
 
 func (t RFC3339Time) String() string {
 	ts := time.Time(t)
-	return ts.Format(time.RFC3339)
+	return ts.Format(time.RFC3339Nano)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.